@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/arowden/augment-fund/internal/auth"
+	"github.com/google/uuid"
+)
+
+type apiKeyView struct {
+	ID        uuid.UUID  `json:"id"`
+	Name      string     `json:"name"`
+	Prefix    string     `json:"prefix"`
+	Role      string     `json:"role"`
+	Key       string     `json:"key,omitempty"`
+	CreatedAt time.Time  `json:"createdAt"`
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+}
+
+var apiKeyHeaders = []string{"ID", "NAME", "PREFIX", "ROLE", "CREATED_AT", "REVOKED_AT"}
+
+func newAPIKeyView(k *auth.APIKey) apiKeyView {
+	return apiKeyView{
+		ID:        k.ID,
+		Name:      k.Name,
+		Prefix:    k.Prefix,
+		Role:      string(k.Role),
+		CreatedAt: k.CreatedAt,
+		RevokedAt: k.RevokedAt,
+	}
+}
+
+func (v apiKeyView) row() []string {
+	revoked := ""
+	if v.RevokedAt != nil {
+		revoked = formatTime(*v.RevokedAt)
+	}
+	return []string{v.ID.String(), v.Name, v.Prefix, v.Role, formatTime(v.CreatedAt), revoked}
+}
+
+func (a *app) apiKeysCreate(ctx context.Context, args []string) error {
+	fs := a.flagSet("api-keys create")
+	name := fs.String("name", "", "label identifying who or what uses the key")
+	roleFlag := fs.String("role", "", "viewer, operator or admin")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *name == "" || *roleFlag == "" {
+		return errors.New("api-keys create: --name and --role are required")
+	}
+	role, err := auth.ParseRole(*roleFlag)
+	if err != nil {
+		return err
+	}
+
+	return a.withServices(ctx, func(svc *services) error {
+		key, raw, err := svc.auth.CreateKey(ctx, *name, role)
+		if err != nil {
+			return err
+		}
+		v := newAPIKeyView(key)
+		v.Key = raw
+		return a.render(v, table{
+			headers: append([]string{"KEY"}, apiKeyHeaders[:4]...),
+			rows:    [][]string{append([]string{raw}, v.row()[:4]...)},
+		})
+	})
+}
+
+func (a *app) apiKeysList(ctx context.Context, args []string) error {
+	fs := a.flagSet("api-keys list")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	return a.withServices(ctx, func(svc *services) error {
+		keys, err := svc.auth.ListKeys(ctx)
+		if err != nil {
+			return err
+		}
+
+		views := make([]apiKeyView, len(keys))
+		rows := make([][]string, len(keys))
+		for i, k := range keys {
+			views[i] = newAPIKeyView(k)
+			rows[i] = views[i].row()
+		}
+		return a.render(views, table{headers: apiKeyHeaders, rows: rows})
+	})
+}
+
+func (a *app) apiKeysRevoke(ctx context.Context, args []string) error {
+	fs := a.flagSet("api-keys revoke")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errors.New("api-keys revoke: exactly one key ID is required")
+	}
+	id, err := uuid.Parse(fs.Arg(0))
+	if err != nil {
+		return fmt.Errorf("invalid key ID %q: %w", fs.Arg(0), err)
+	}
+
+	return a.withServices(ctx, func(svc *services) error {
+		if err := svc.auth.RevokeKey(ctx, id); err != nil {
+			return err
+		}
+		fmt.Fprintf(a.stdout, "revoked api key %s\n", id)
+		return nil
+	})
+}
@@ -0,0 +1,231 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/arowden/augment-fund/internal/audit"
+	"github.com/arowden/augment-fund/internal/auth"
+	"github.com/arowden/augment-fund/internal/fund"
+	"github.com/arowden/augment-fund/internal/outbox"
+	"github.com/arowden/augment-fund/internal/owner"
+	"github.com/arowden/augment-fund/internal/ownership"
+	"github.com/arowden/augment-fund/internal/postgres"
+	"github.com/arowden/augment-fund/internal/reconciliation"
+	"github.com/arowden/augment-fund/internal/transfer"
+	"github.com/kelseyhightower/envconfig"
+)
+
+var errUsage = errors.New("usage")
+
+const usage = `Usage: captablectl [-o table|json|csv] <command> [flags]
+
+Commands:
+  funds create     --name NAME --units N (--owner NAME | --owner-id UUID)
+  funds import     --name NAME --units N --file CSV
+  funds list       [--status open|closed|suspended|liquidated] [--limit N] [--offset N | --cursor TOKEN]
+  funds status     --fund ID --status open|closed|suspended|liquidated [--reason TEXT]
+  cap-table        --fund ID [--limit N] [--offset N | --cursor TOKEN] [--as-of RFC3339]
+  transfers create --fund ID (--from NAME | --from-id UUID) (--to NAME | --to-id UUID) --units N [--idempotency-key UUID]
+  transfers list   --fund ID [--owner-id UUID] [--from-id UUID] [--to-id UUID] [--since RFC3339] [--until RFC3339]
+                   [--min-units N] [--max-units N] [--sort asc|desc] [--limit N] [--offset N | --cursor TOKEN]
+  migrate up       [--steps N]
+  migrate down     --steps N | --all
+  migrate goto     VERSION
+  migrate force    VERSION
+  migrate status
+  reconcile        [--fail-on-drift]
+  api-keys create  --name NAME --role viewer|operator|admin
+  api-keys list
+  api-keys revoke  ID
+
+Database settings are read from the same DB_* environment variables as the server.
+Changes are recorded in the audit log as cli:<user>.
+`
+
+type services struct {
+	pool           *postgres.Pool
+	funds          *fund.Service
+	ownership      *ownership.Service
+	transfers      *transfer.Service
+	reconciliation *reconciliation.Service
+	auth           *auth.Service
+	close          func()
+}
+
+type connectFunc func(ctx context.Context) (*services, error)
+
+type app struct {
+	stdout  io.Writer
+	stderr  io.Writer
+	connect connectFunc
+	format  format
+}
+
+func newApp(stdout, stderr io.Writer, connect connectFunc) *app {
+	return &app{
+		stdout:  stdout,
+		stderr:  stderr,
+		connect: connect,
+		format:  formatTable,
+	}
+}
+
+func (a *app) run(ctx context.Context, args []string) error {
+	fs := a.flagSet("captablectl")
+	output := fs.String("o", string(formatTable), "output format: table, json or csv")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	f, err := parseFormat(*output)
+	if err != nil {
+		return err
+	}
+	a.format = f
+
+	rest := fs.Args()
+	if len(rest) == 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	switch rest[0] {
+	case "funds":
+		return a.subcommand(ctx, rest[1:], map[string]func(context.Context, []string) error{
+			"create": a.fundsCreate,
+			"import": a.fundsImport,
+			"list":   a.fundsList,
+			"status": a.fundsStatus,
+		})
+	case "cap-table":
+		return a.capTable(ctx, rest[1:])
+	case "migrate":
+		return a.migrate(ctx, rest[1:])
+	case "reconcile":
+		return a.reconcile(ctx, rest[1:])
+	case "api-keys":
+		return a.subcommand(ctx, rest[1:], map[string]func(context.Context, []string) error{
+			"create": a.apiKeysCreate,
+			"list":   a.apiKeysList,
+			"revoke": a.apiKeysRevoke,
+		})
+	case "transfers":
+		return a.subcommand(ctx, rest[1:], map[string]func(context.Context, []string) error{
+			"create": a.transfersCreate,
+			"list":   a.transfersList,
+		})
+	default:
+		fmt.Fprint(a.stderr, usage)
+		return fmt.Errorf("unknown command %q", rest[0])
+	}
+}
+
+func (a *app) subcommand(ctx context.Context, args []string, cmds map[string]func(context.Context, []string) error) error {
+	if len(args) == 0 {
+		fmt.Fprint(a.stderr, usage)
+		return errUsage
+	}
+	cmd, ok := cmds[args[0]]
+	if !ok {
+		fmt.Fprint(a.stderr, usage)
+		return fmt.Errorf("unknown subcommand %q", args[0])
+	}
+	return cmd(ctx, args[1:])
+}
+
+func (a *app) flagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(a.stderr)
+	fs.Usage = func() { fmt.Fprint(a.stderr, usage) }
+	return fs
+}
+
+func (a *app) withServices(ctx context.Context, fn func(*services) error) error {
+	svc, err := a.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer svc.close()
+	return fn(svc)
+}
+
+func connect(ctx context.Context) (*services, error) {
+	var cfg postgres.Config
+	if err := envconfig.Process("", &cfg); err != nil {
+		return nil, fmt.Errorf("load database config: %w", err)
+	}
+
+	log := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn}))
+	pool, err := postgres.New(ctx, cfg, log)
+	if err != nil {
+		return nil, err
+	}
+
+	ownershipStore := ownership.NewStore(pool)
+	ownerStore := owner.NewStore(pool)
+	outboxStore := outbox.NewStore(pool)
+	auditStore := audit.NewStore(pool)
+
+	fundService, err := fund.NewService(
+		fund.NewStore(pool),
+		fund.WithPool(pool.Pool),
+		fund.WithOwnershipRepository(ownershipStore),
+		fund.WithOwnerRepository(ownerStore),
+		fund.WithOutbox(outboxStore),
+		fund.WithAudit(auditStore),
+	)
+	if err != nil {
+		pool.Close()
+		return nil, err
+	}
+
+	ownershipService, err := ownership.NewService(ownership.WithRepository(ownershipStore))
+	if err != nil {
+		pool.Close()
+		return nil, err
+	}
+
+	transferService, err := transfer.NewService(
+		transfer.WithRepository(transfer.NewStore(pool)),
+		transfer.WithOwnershipRepository(ownershipStore),
+		transfer.WithOwnerRepository(ownerStore),
+		transfer.WithPool(pool.Pool),
+		transfer.WithOutbox(outboxStore),
+		transfer.WithAudit(auditStore),
+	)
+	if err != nil {
+		pool.Close()
+		return nil, err
+	}
+
+	reconciliationService, err := reconciliation.NewService(
+		reconciliation.WithRepository(reconciliation.NewStore(pool.Pool)),
+		reconciliation.WithLogger(log),
+	)
+	if err != nil {
+		pool.Close()
+		return nil, err
+	}
+
+	authService, err := auth.NewService(auth.WithRepository(auth.NewStore(pool)))
+	if err != nil {
+		pool.Close()
+		return nil, err
+	}
+
+	return &services{
+		pool:           pool,
+		funds:          fundService,
+		ownership:      ownershipService,
+		transfers:      transferService,
+		reconciliation: reconciliationService,
+		auth:           authService,
+		close:          pool.Close,
+	}, nil
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/arowden/augment-fund/internal/fund"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func noConnect(t *testing.T) connectFunc {
+	return func(context.Context) (*services, error) {
+		t.Fatal("connect should not be called")
+		return nil, nil
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	for _, in := range []string{"table", "JSON", " csv "} {
+		_, err := parseFormat(in)
+		assert.NoError(t, err, in)
+	}
+
+	_, err := parseFormat("yaml")
+	assert.Error(t, err)
+}
+
+func TestApp_Render(t *testing.T) {
+	total := 1
+	data := fundListView{Funds: []fundView{{Name: "Growth Fund", TotalUnits: 1000}}, Total: &total, Limit: 100}
+	tbl := table{
+		headers: []string{"NAME", "TOTAL_UNITS"},
+		rows:    [][]string{{"Growth Fund", "1000"}, {"Fund, with comma", "5"}},
+	}
+
+	t.Run("table", func(t *testing.T) {
+		var out bytes.Buffer
+		a := newApp(&out, &out, noConnect(t))
+
+		require.NoError(t, a.render(data, tbl))
+		assert.Regexp(t, `(?m)^NAME\s+TOTAL_UNITS$`, out.String())
+		assert.Regexp(t, `(?m)^Growth Fund\s+1000$`, out.String())
+	})
+
+	t.Run("csv quotes fields", func(t *testing.T) {
+		var out bytes.Buffer
+		a := newApp(&out, &out, noConnect(t))
+		a.format = formatCSV
+
+		require.NoError(t, a.render(data, tbl))
+		assert.Equal(t, "NAME,TOTAL_UNITS\nGrowth Fund,1000\n\"Fund, with comma\",5\n", out.String())
+	})
+
+	t.Run("json encodes data", func(t *testing.T) {
+		var out bytes.Buffer
+		a := newApp(&out, &out, noConnect(t))
+		a.format = formatJSON
+
+		require.NoError(t, a.render(data, tbl))
+		var decoded fundListView
+		require.NoError(t, json.Unmarshal(out.Bytes(), &decoded))
+		assert.Equal(t, data.Funds[0].Name, decoded.Funds[0].Name)
+		require.NotNil(t, decoded.Total)
+		assert.Equal(t, 1, *decoded.Total)
+	})
+}
+
+func TestApp_Run(t *testing.T) {
+	run := func(t *testing.T, args ...string) error {
+		var out bytes.Buffer
+		return newApp(&out, &out, noConnect(t)).run(context.Background(), args)
+	}
+
+	t.Run("no command prints usage", func(t *testing.T) {
+		assert.ErrorIs(t, run(t), errUsage)
+	})
+
+	t.Run("unknown command", func(t *testing.T) {
+		assert.ErrorContains(t, run(t, "bogus"), "unknown command")
+	})
+
+	t.Run("unknown subcommand", func(t *testing.T) {
+		assert.ErrorContains(t, run(t, "funds", "delete"), "unknown subcommand")
+	})
+
+	t.Run("invalid output format", func(t *testing.T) {
+		assert.ErrorContains(t, run(t, "-o", "xml", "funds", "list"), "unsupported output format")
+	})
+
+	t.Run("funds create requires flags", func(t *testing.T) {
+		assert.ErrorContains(t, run(t, "funds", "create", "--name", "Fund"), "required")
+	})
+
+	t.Run("funds status rejects unknown statuses before connecting", func(t *testing.T) {
+		err := run(t, "funds", "status", "--fund", uuid.NewString(), "--status", "archived")
+		assert.ErrorIs(t, err, fund.ErrInvalidStatus)
+	})
+
+	t.Run("funds import requires flags", func(t *testing.T) {
+		assert.ErrorContains(t, run(t, "funds", "import", "--name", "Fund", "--units", "100"), "required")
+	})
+
+	t.Run("funds import reports invalid rows before connecting", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "holders.csv")
+		require.NoError(t, os.WriteFile(path, []byte("owner,units\nAlice,60\nBob,forty\n"), 0o600))
+
+		var out bytes.Buffer
+		err := newApp(&out, &out, noConnect(t)).run(context.Background(), []string{"funds", "import", "--name", "Fund", "--units", "100", "--file", path})
+		assert.ErrorContains(t, err, "1 of 2 import rows are invalid")
+		assert.Contains(t, out.String(), `row 2: units "forty" is not a whole number`)
+	})
+
+	t.Run("cap-table requires fund", func(t *testing.T) {
+		assert.ErrorContains(t, run(t, "cap-table"), "--fund is required")
+	})
+
+	t.Run("cap-table rejects malformed fund ID", func(t *testing.T) {
+		assert.ErrorContains(t, run(t, "cap-table", "--fund", "nope"), "invalid fund ID")
+	})
+
+	t.Run("transfers create rejects malformed idempotency key", func(t *testing.T) {
+		err := run(t, "transfers", "create",
+			"--fund", "550e8400-e29b-41d4-a716-446655440000",
+			"--from", "Alice", "--to", "Bob", "--units", "10",
+			"--idempotency-key", "nope",
+		)
+		assert.ErrorContains(t, err, "invalid idempotency key")
+	})
+
+	t.Run("cap-table rejects malformed as-of", func(t *testing.T) {
+		assert.ErrorContains(t, run(t, "cap-table", "--fund", uuid.NewString(), "--as-of", "2024-03-31"), "invalid --as-of")
+	})
+
+	t.Run("migrate down requires steps or all", func(t *testing.T) {
+		assert.ErrorContains(t, run(t, "migrate", "down"), "exactly one of --steps N or --all")
+		assert.ErrorContains(t, run(t, "migrate", "down", "--steps", "1", "--all"), "exactly one of --steps N or --all")
+	})
+
+	t.Run("migrate goto requires numeric version", func(t *testing.T) {
+		assert.ErrorContains(t, run(t, "migrate", "goto", "latest"), "invalid version")
+	})
+
+	t.Run("migrate force rejects versions below -1", func(t *testing.T) {
+		assert.ErrorContains(t, run(t, "migrate", "force", "-2"), "invalid version")
+	})
+
+	t.Run("api-keys create requires a known role", func(t *testing.T) {
+		assert.ErrorContains(t, run(t, "api-keys", "create", "--name", "ci"), "required")
+		assert.ErrorContains(t, run(t, "api-keys", "create", "--name", "ci", "--role", "root"), "role must be one of")
+	})
+
+	t.Run("api-keys revoke rejects malformed ID", func(t *testing.T) {
+		assert.ErrorContains(t, run(t, "api-keys", "revoke", "nope"), "invalid key ID")
+	})
+
+	t.Run("connection errors are returned", func(t *testing.T) {
+		var out bytes.Buffer
+		connErr := errors.New("connection refused")
+		a := newApp(&out, &out, func(context.Context) (*services, error) { return nil, connErr })
+
+		assert.ErrorIs(t, a.run(context.Background(), []string{"funds", "list"}), connErr)
+	})
+}
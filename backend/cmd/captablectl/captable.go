@@ -0,0 +1,165 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/arowden/augment-fund/internal/ownership"
+	"github.com/arowden/augment-fund/internal/validation"
+	"github.com/google/uuid"
+)
+
+type capTableEntryView struct {
+	OwnerID         uuid.UUID `json:"ownerId"`
+	OwnerName       string    `json:"ownerName"`
+	ClassID         uuid.UUID `json:"classId"`
+	ClassName       string    `json:"className"`
+	Units           int       `json:"units"`
+	Percentage      float64   `json:"percentage"`
+	ClassPercentage float64   `json:"classPercentage"`
+	AcquiredAt      time.Time `json:"acquiredAt"`
+}
+
+type capTableView struct {
+	FundID     uuid.UUID           `json:"fundId"`
+	Entries    []capTableEntryView `json:"entries"`
+	Total      *int                `json:"total,omitempty"`
+	Limit      int                 `json:"limit"`
+	Offset     int                 `json:"offset"`
+	NextCursor string              `json:"nextCursor,omitempty"`
+}
+
+var capTableHeaders = []string{"OWNER", "CLASS", "UNITS", "PERCENTAGE", "CLASS_PERCENTAGE", "ACQUIRED_AT"}
+
+func (a *app) capTable(ctx context.Context, args []string) error {
+	fs := a.flagSet("cap-table")
+	fundFlag := fs.String("fund", "", "fund ID")
+	limit := fs.Int("limit", 0, "maximum entries to return")
+	offset := fs.Int("offset", 0, "number of entries to skip")
+	cursor := fs.String("cursor", "", "nextCursor from the previous page")
+	asOfFlag := fs.String("as-of", "", "reconstruct the cap table at this RFC 3339 instant")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	fundID, err := parseFundID(*fundFlag)
+	if err != nil {
+		return err
+	}
+	var asOf time.Time
+	if *asOfFlag != "" {
+		if asOf, err = time.Parse(time.RFC3339, *asOfFlag); err != nil {
+			return fmt.Errorf("invalid --as-of %q: want RFC 3339", *asOfFlag)
+		}
+	}
+
+	return a.withServices(ctx, func(svc *services) error {
+		f, err := svc.funds.GetFund(ctx, fundID)
+		if err != nil {
+			return err
+		}
+
+		params := ownership.ListParams{Limit: *limit, Offset: *offset, Cursor: *cursor}
+		totalUnits := f.TotalUnits
+		var ct *ownership.CapTableView
+		if asOf.IsZero() {
+			ct, err = svc.ownership.GetCapTable(ctx, fundID, params)
+		} else {
+			ct, err = svc.ownership.GetCapTableAsOf(ctx, fundID, asOf, params)
+			if err == nil {
+				totalUnits = ct.UnitsOutstanding
+			}
+		}
+		if err != nil {
+			return err
+		}
+
+		view := capTableView{
+			FundID:     fundID,
+			Entries:    make([]capTableEntryView, len(ct.Entries)),
+			Total:      pageTotal(params, ct.TotalCount),
+			Limit:      ct.Limit,
+			Offset:     ct.Offset,
+			NextCursor: ct.NextCursor,
+		}
+		rows := make([][]string, len(ct.Entries))
+		for i, e := range ct.Entries {
+			var classUnits int
+			if class := ct.FindClass(e.ClassID); class != nil {
+				classUnits = class.OutstandingUnits
+			}
+			view.Entries[i] = capTableEntryView{
+				OwnerID:         e.OwnerID,
+				OwnerName:       e.OwnerName,
+				ClassID:         e.ClassID,
+				ClassName:       e.ClassName,
+				Units:           e.Units,
+				Percentage:      ownership.Percentage(e.Units, totalUnits),
+				ClassPercentage: ownership.Percentage(e.Units, classUnits),
+				AcquiredAt:      e.AcquiredAt,
+			}
+			rows[i] = []string{
+				e.OwnerName,
+				e.ClassName,
+				strconv.Itoa(e.Units),
+				formatPercentage(view.Entries[i].Percentage),
+				formatPercentage(view.Entries[i].ClassPercentage),
+				formatTime(e.AcquiredAt),
+			}
+		}
+		return a.render(view, table{headers: capTableHeaders, rows: rows})
+	})
+}
+
+func parseFundID(s string) (uuid.UUID, error) {
+	if s == "" {
+		return uuid.Nil, errors.New("--fund is required")
+	}
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid fund ID %q: %w", s, err)
+	}
+	return id, nil
+}
+
+func pageTotal(params validation.ListParams, total int) *int {
+	if params.Cursor != "" {
+		return nil
+	}
+	return &total
+}
+
+func parseTime(flagName, s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s %q: want RFC 3339", flagName, s)
+	}
+	return &t, nil
+}
+
+func intFlag(dst **int) func(string) error {
+	return func(s string) error {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return err
+		}
+		*dst = &n
+		return nil
+	}
+}
+
+func parseOwnerID(flagName, s string) (*uuid.UUID, error) {
+	if s == "" {
+		return nil, nil
+	}
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s %q: %w", flagName, s, err)
+	}
+	return &id, nil
+}
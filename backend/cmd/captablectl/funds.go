@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/arowden/augment-fund/internal/fund"
+	"github.com/google/uuid"
+)
+
+type fundView struct {
+	ID              uuid.UUID   `json:"id"`
+	Name            string      `json:"name"`
+	TotalUnits      int         `json:"totalUnits"`
+	Status          fund.Status `json:"status"`
+	StatusChangedAt time.Time   `json:"statusChangedAt"`
+	CreatedAt       time.Time   `json:"createdAt"`
+}
+
+type fundListView struct {
+	Funds      []fundView `json:"funds"`
+	Total      *int       `json:"total,omitempty"`
+	Limit      int        `json:"limit"`
+	Offset     int        `json:"offset"`
+	NextCursor string     `json:"nextCursor,omitempty"`
+}
+
+var fundHeaders = []string{"ID", "NAME", "TOTAL_UNITS", "STATUS", "CREATED_AT"}
+
+func newFundView(f *fund.Fund) fundView {
+	return fundView{
+		ID:              f.ID,
+		Name:            f.Name,
+		TotalUnits:      f.TotalUnits,
+		Status:          f.Status,
+		StatusChangedAt: f.StatusChangedAt,
+		CreatedAt:       f.CreatedAt,
+	}
+}
+
+func (v fundView) row() []string {
+	return []string{v.ID.String(), v.Name, strconv.Itoa(v.TotalUnits), string(v.Status), formatTime(v.CreatedAt)}
+}
+
+func (a *app) fundsCreate(ctx context.Context, args []string) error {
+	fs := a.flagSet("funds create")
+	name := fs.String("name", "", "fund name")
+	units := fs.Int("units", 0, "total units")
+	owner := fs.String("owner", "", "initial owner receiving all units")
+	ownerIDFlag := fs.String("owner-id", "", "ID of an existing owner receiving all units")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *name == "" || (*owner == "" && *ownerIDFlag == "") || *units <= 0 {
+		return errors.New("funds create: --name, --units and --owner or --owner-id are required")
+	}
+	ownerID, err := parseOwnerID("owner-id", *ownerIDFlag)
+	if err != nil {
+		return err
+	}
+
+	return a.withServices(ctx, func(svc *services) error {
+		var (
+			f   *fund.Fund
+			err error
+		)
+		if ownerID != nil {
+			f, err = svc.funds.CreateFundForOwner(ctx, *name, *units, *ownerID)
+		} else {
+			f, err = svc.funds.CreateFundWithInitialOwner(ctx, *name, *units, *owner)
+		}
+		if err != nil {
+			return err
+		}
+		v := newFundView(f)
+		return a.render(v, table{headers: fundHeaders, rows: [][]string{v.row()}})
+	})
+}
+
+func (a *app) fundsImport(ctx context.Context, args []string) error {
+	fs := a.flagSet("funds import")
+	name := fs.String("name", "", "fund name")
+	units := fs.Int("units", 0, "total units; the rows must add up to it")
+	file := fs.String("file", "", "CSV with a units column and an owner or ownerId column")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *name == "" || *units <= 0 || *file == "" {
+		return errors.New("funds import: --name, --units and --file are required")
+	}
+
+	f, err := os.Open(*file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	rows, err := fund.ReadImportCSV(f)
+	if err != nil {
+		return a.importError(err)
+	}
+
+	return a.withServices(ctx, func(svc *services) error {
+		imported, err := svc.funds.ImportFund(ctx, fund.ImportRequest{Name: *name, TotalUnits: *units, Rows: rows})
+		if err != nil {
+			return a.importError(err)
+		}
+		v := newFundView(imported)
+		return a.render(v, table{headers: fundHeaders, rows: [][]string{v.row()}})
+	})
+}
+
+func (a *app) importError(err error) error {
+	var importErr *fund.ImportError
+	if errors.As(err, &importErr) {
+		for _, rowErr := range importErr.Rows {
+			fmt.Fprintln(a.stderr, rowErr)
+		}
+	}
+	return err
+}
+
+func (a *app) fundsList(ctx context.Context, args []string) error {
+	fs := a.flagSet("funds list")
+	limit := fs.Int("limit", 0, "maximum funds to return")
+	offset := fs.Int("offset", 0, "number of funds to skip")
+	cursor := fs.String("cursor", "", "nextCursor from the previous page")
+	statusFlag := fs.String("status", "", "only funds with this status")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	var status *fund.Status
+	if *statusFlag != "" {
+		s := fund.Status(*statusFlag)
+		if !s.Valid() {
+			return fund.ErrInvalidStatus
+		}
+		status = &s
+	}
+
+	return a.withServices(ctx, func(svc *services) error {
+		params := fund.ListParams{Limit: *limit, Offset: *offset, Cursor: *cursor}
+		result, err := svc.funds.ListFunds(ctx, status, params)
+		if err != nil {
+			return err
+		}
+
+		view := fundListView{
+			Funds:      make([]fundView, len(result.Items)),
+			Total:      pageTotal(params, result.Total),
+			Limit:      result.Limit,
+			Offset:     result.Offset,
+			NextCursor: result.NextCursor,
+		}
+		rows := make([][]string, len(result.Items))
+		for i, f := range result.Items {
+			view.Funds[i] = newFundView(f)
+			rows[i] = view.Funds[i].row()
+		}
+		return a.render(view, table{headers: fundHeaders, rows: rows})
+	})
+}
+
+func (a *app) fundsStatus(ctx context.Context, args []string) error {
+	fs := a.flagSet("funds status")
+	fundFlag := fs.String("fund", "", "fund ID")
+	statusFlag := fs.String("status", "", "new status")
+	reason := fs.String("reason", "", "reason recorded with the change")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	fundID, err := parseFundID(*fundFlag)
+	if err != nil {
+		return err
+	}
+	change := fund.StatusChange{FundID: fundID, Status: fund.Status(*statusFlag), Reason: *reason}
+	if err := change.Validate(); err != nil {
+		return err
+	}
+
+	return a.withServices(ctx, func(svc *services) error {
+		f, err := svc.funds.ChangeStatus(ctx, change)
+		if err != nil {
+			return err
+		}
+		v := newFundView(f)
+		return a.render(v, table{headers: fundHeaders, rows: [][]string{v.row()}})
+	})
+}
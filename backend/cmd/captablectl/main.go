@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"os/user"
+	"syscall"
+
+	"github.com/arowden/augment-fund/internal/auth"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	ctx = auth.WithPrincipal(ctx, cliPrincipal())
+
+	a := newApp(os.Stdout, os.Stderr, connect)
+	if err := a.run(ctx, os.Args[1:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) || errors.Is(err, errUsage) {
+			os.Exit(2)
+		}
+		fmt.Fprintf(os.Stderr, "captablectl: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func cliPrincipal() *auth.Principal {
+	name := os.Getenv("USER")
+	if u, err := user.Current(); err == nil {
+		name = u.Username
+	}
+	return &auth.Principal{Subject: "cli:" + name, Name: name, Role: auth.RoleAdmin}
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/arowden/augment-fund/internal/postgres"
+)
+
+type migrationStatusView struct {
+	Version uint   `json:"version"`
+	Dirty   bool   `json:"dirty"`
+	Latest  uint   `json:"latest"`
+	Applied []uint `json:"applied"`
+	Pending []uint `json:"pending"`
+}
+
+var migrationHeaders = []string{"VERSION", "STATE"}
+
+func (a *app) migrate(ctx context.Context, args []string) error {
+	return a.subcommand(ctx, args, map[string]func(context.Context, []string) error{
+		"up":      a.migrateUp,
+		"down":    a.migrateDown,
+		"goto":    a.migrateGoto,
+		"force":   a.migrateForce,
+		"status":  a.migrateStatus,
+		"version": a.migrateStatus,
+	})
+}
+
+func (a *app) migrateUp(ctx context.Context, args []string) error {
+	fs := a.flagSet("migrate up")
+	steps := fs.Int("steps", 0, "number of migrations to apply (default: all pending)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *steps < 0 {
+		return errors.New("migrate up: --steps must be positive")
+	}
+
+	return a.withMigrator(ctx, func(mg *postgres.Migrator) error {
+		if *steps > 0 {
+			return mg.Steps(*steps)
+		}
+		return mg.Up()
+	})
+}
+
+func (a *app) migrateDown(ctx context.Context, args []string) error {
+	fs := a.flagSet("migrate down")
+	steps := fs.Int("steps", 0, "number of migrations to roll back")
+	all := fs.Bool("all", false, "roll back every migration")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if (*steps > 0) == *all || *steps < 0 {
+		return errors.New("migrate down: exactly one of --steps N or --all is required")
+	}
+
+	return a.withMigrator(ctx, func(mg *postgres.Migrator) error {
+		if *all {
+			return mg.Down()
+		}
+		return mg.Steps(-*steps)
+	})
+}
+
+func (a *app) migrateGoto(ctx context.Context, args []string) error {
+	if len(args) != 1 {
+		return errors.New("migrate goto: exactly one VERSION argument is required")
+	}
+	version, err := strconv.ParseUint(args[0], 10, 0)
+	if err != nil {
+		return fmt.Errorf("migrate goto: invalid version %q", args[0])
+	}
+
+	return a.withMigrator(ctx, func(mg *postgres.Migrator) error {
+		return mg.Goto(uint(version))
+	})
+}
+
+func (a *app) migrateForce(ctx context.Context, args []string) error {
+	if len(args) != 1 {
+		return errors.New("migrate force: exactly one VERSION argument is required (-1 clears the version)")
+	}
+	version, err := strconv.Atoi(args[0])
+	if err != nil || version < -1 {
+		return fmt.Errorf("migrate force: invalid version %q", args[0])
+	}
+
+	return a.withMigrator(ctx, func(mg *postgres.Migrator) error {
+		return mg.Force(version)
+	})
+}
+
+func (a *app) migrateStatus(ctx context.Context, args []string) error {
+	if len(args) != 0 {
+		return fmt.Errorf("migrate status: unexpected arguments %v", args)
+	}
+	return a.withMigrator(ctx, func(*postgres.Migrator) error { return nil })
+}
+
+func (a *app) withMigrator(ctx context.Context, fn func(*postgres.Migrator) error) error {
+	return a.withServices(ctx, func(svc *services) error {
+		mg, err := postgres.NewMigrator(svc.pool.Pool)
+		if err != nil {
+			return err
+		}
+		defer mg.Close()
+
+		if err := fn(mg); err != nil {
+			return err
+		}
+
+		status, err := mg.Status()
+		if err != nil {
+			return err
+		}
+		return a.renderMigrationStatus(status)
+	})
+}
+
+func (a *app) renderMigrationStatus(status *postgres.MigrationStatus) error {
+	view := migrationStatusView{
+		Version: status.Version,
+		Dirty:   status.Dirty,
+		Latest:  status.Latest,
+		Applied: status.Applied,
+		Pending: status.Pending,
+	}
+
+	rows := make([][]string, 0, len(status.Applied)+len(status.Pending))
+	for _, v := range status.Applied {
+		state := "applied"
+		if v == status.Version && status.Dirty {
+			state = "dirty"
+		}
+		rows = append(rows, []string{strconv.FormatUint(uint64(v), 10), state})
+	}
+	for _, v := range status.Pending {
+		rows = append(rows, []string{strconv.FormatUint(uint64(v), 10), "pending"})
+	}
+	return a.render(view, table{headers: migrationHeaders, rows: rows})
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+type format string
+
+const (
+	formatTable format = "table"
+	formatJSON  format = "json"
+	formatCSV   format = "csv"
+)
+
+func parseFormat(s string) (format, error) {
+	switch f := format(strings.ToLower(strings.TrimSpace(s))); f {
+	case formatTable, formatJSON, formatCSV:
+		return f, nil
+	default:
+		return "", fmt.Errorf("unsupported output format %q (want table, json or csv)", s)
+	}
+}
+
+type table struct {
+	headers []string
+	rows    [][]string
+}
+
+func (a *app) render(data any, t table) error {
+	switch a.format {
+	case formatJSON:
+		enc := json.NewEncoder(a.stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(data)
+	case formatCSV:
+		w := csv.NewWriter(a.stdout)
+		if err := w.Write(t.headers); err != nil {
+			return err
+		}
+		if err := w.WriteAll(t.rows); err != nil {
+			return err
+		}
+		return w.Error()
+	default:
+		tw := tabwriter.NewWriter(a.stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, strings.Join(t.headers, "\t"))
+		for _, row := range t.rows {
+			fmt.Fprintln(tw, strings.Join(row, "\t"))
+		}
+		return tw.Flush()
+	}
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+func formatPercentage(p float64) string {
+	return strconv.FormatFloat(p, 'f', 4, 64)
+}
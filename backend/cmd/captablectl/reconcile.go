@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var errDrift = errors.New("units-balance drift detected")
+
+type ownerDriftView struct {
+	OwnerID       uuid.UUID `json:"ownerId"`
+	OwnerName     string    `json:"ownerName"`
+	ClassID       uuid.UUID `json:"classId"`
+	RecordedUnits int       `json:"recordedUnits"`
+	ReplayedUnits int       `json:"replayedUnits"`
+}
+
+type fundDriftView struct {
+	FundID        uuid.UUID        `json:"fundId"`
+	FundName      string           `json:"fundName"`
+	TotalUnits    int              `json:"totalUnits"`
+	RecordedUnits int              `json:"recordedUnits"`
+	Owners        []ownerDriftView `json:"owners"`
+}
+
+type reconciliationView struct {
+	StartedAt    time.Time       `json:"startedAt"`
+	CompletedAt  time.Time       `json:"completedAt"`
+	FundsChecked int             `json:"fundsChecked"`
+	Balanced     bool            `json:"balanced"`
+	Drifted      []fundDriftView `json:"drifted"`
+}
+
+var reconciliationHeaders = []string{"FUND_ID", "FUND", "OWNER", "RECORDED_UNITS", "EXPECTED_UNITS"}
+
+func (a *app) reconcile(ctx context.Context, args []string) error {
+	fs := a.flagSet("reconcile")
+	failOnDrift := fs.Bool("fail-on-drift", false, "exit non-zero when any fund drifts")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	return a.withServices(ctx, func(svc *services) error {
+		report, err := svc.reconciliation.Run(ctx)
+		if err != nil {
+			return err
+		}
+
+		view := reconciliationView{
+			StartedAt:    report.StartedAt,
+			CompletedAt:  report.CompletedAt,
+			FundsChecked: report.FundsChecked,
+			Balanced:     report.Balanced(),
+			Drifted:      make([]fundDriftView, len(report.Drifted)),
+		}
+		var rows [][]string
+		for i, f := range report.Drifted {
+			view.Drifted[i] = fundDriftView{
+				FundID:        f.FundID,
+				FundName:      f.FundName,
+				TotalUnits:    f.TotalUnits,
+				RecordedUnits: f.RecordedUnits,
+				Owners:        make([]ownerDriftView, len(f.Owners)),
+			}
+			if f.RecordedUnits != f.TotalUnits {
+				rows = append(rows, []string{f.FundID.String(), f.FundName, "*", strconv.Itoa(f.RecordedUnits), strconv.Itoa(f.TotalUnits)})
+			}
+			for j, o := range f.Owners {
+				view.Drifted[i].Owners[j] = ownerDriftView(o)
+				rows = append(rows, []string{f.FundID.String(), f.FundName, o.OwnerName, strconv.Itoa(o.RecordedUnits), strconv.Itoa(o.ReplayedUnits)})
+			}
+		}
+
+		if err := a.render(view, table{headers: reconciliationHeaders, rows: rows}); err != nil {
+			return err
+		}
+		if a.format == formatTable {
+			fmt.Fprintf(a.stderr, "checked %d funds, %d drifted\n", report.FundsChecked, len(report.Drifted))
+		}
+		if *failOnDrift && !report.Balanced() {
+			return errDrift
+		}
+		return nil
+	})
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/arowden/augment-fund/internal/transfer"
+	"github.com/google/uuid"
+)
+
+type transferView struct {
+	ID             uuid.UUID  `json:"id"`
+	FundID         uuid.UUID  `json:"fundId"`
+	FromOwner      string     `json:"fromOwner"`
+	FromOwnerID    uuid.UUID  `json:"fromOwnerId"`
+	ToOwner        string     `json:"toOwner"`
+	ToOwnerID      uuid.UUID  `json:"toOwnerId"`
+	Units          int        `json:"units"`
+	IdempotencyKey *uuid.UUID `json:"idempotencyKey,omitempty"`
+	Status         string     `json:"status"`
+	TransferredAt  time.Time  `json:"transferredAt"`
+}
+
+type transferListView struct {
+	FundID     uuid.UUID      `json:"fundId"`
+	Transfers  []transferView `json:"transfers"`
+	Total      *int           `json:"total,omitempty"`
+	Limit      int            `json:"limit"`
+	Offset     int            `json:"offset"`
+	NextCursor string         `json:"nextCursor,omitempty"`
+}
+
+var transferHeaders = []string{"ID", "FROM", "TO", "UNITS", "IDEMPOTENCY_KEY", "STATUS", "TRANSFERRED_AT"}
+
+func newTransferView(t *transfer.Transfer) transferView {
+	return transferView{
+		ID:             t.ID,
+		FundID:         t.FundID,
+		FromOwner:      t.FromOwner,
+		FromOwnerID:    t.FromOwnerID,
+		ToOwner:        t.ToOwner,
+		ToOwnerID:      t.ToOwnerID,
+		Units:          t.Units,
+		IdempotencyKey: t.IdempotencyKey,
+		Status:         string(t.Status),
+		TransferredAt:  t.TransferredAt,
+	}
+}
+
+func (v transferView) row() []string {
+	key := ""
+	if v.IdempotencyKey != nil {
+		key = v.IdempotencyKey.String()
+	}
+	return []string{v.ID.String(), v.FromOwner, v.ToOwner, strconv.Itoa(v.Units), key, v.Status, formatTime(v.TransferredAt)}
+}
+
+func (a *app) transfersCreate(ctx context.Context, args []string) error {
+	fs := a.flagSet("transfers create")
+	fundFlag := fs.String("fund", "", "fund ID")
+	from := fs.String("from", "", "owner sending units")
+	fromIDFlag := fs.String("from-id", "", "ID of the owner sending units")
+	to := fs.String("to", "", "owner receiving units")
+	toIDFlag := fs.String("to-id", "", "ID of the owner receiving units")
+	units := fs.Int("units", 0, "units to transfer")
+	keyFlag := fs.String("idempotency-key", "", "UUID used to deduplicate retries (generated when omitted)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	fundID, err := parseFundID(*fundFlag)
+	if err != nil {
+		return err
+	}
+	if (*from == "" && *fromIDFlag == "") || (*to == "" && *toIDFlag == "") || *units <= 0 {
+		return errors.New("transfers create: --from or --from-id, --to or --to-id, and --units are required")
+	}
+	fromID, err := parseOwnerID("from-id", *fromIDFlag)
+	if err != nil {
+		return err
+	}
+	toID, err := parseOwnerID("to-id", *toIDFlag)
+	if err != nil {
+		return err
+	}
+
+	key := uuid.New()
+	if *keyFlag != "" {
+		key, err = uuid.Parse(*keyFlag)
+		if err != nil {
+			return fmt.Errorf("invalid idempotency key %q: %w", *keyFlag, err)
+		}
+	}
+
+	return a.withServices(ctx, func(svc *services) error {
+		t, err := svc.transfers.ExecuteTransfer(ctx, transfer.Request{
+			FundID:         fundID,
+			FromOwner:      *from,
+			FromOwnerID:    fromID,
+			ToOwner:        *to,
+			ToOwnerID:      toID,
+			Units:          *units,
+			IdempotencyKey: &key,
+		})
+		if err != nil {
+			return err
+		}
+		v := newTransferView(t)
+		return a.render(v, table{headers: transferHeaders, rows: [][]string{v.row()}})
+	})
+}
+
+func (a *app) transfersList(ctx context.Context, args []string) error {
+	fs := a.flagSet("transfers list")
+	fundFlag := fs.String("fund", "", "fund ID")
+	ownerIDFlag := fs.String("owner-id", "", "only transfers to or from this owner ID")
+	fromIDFlag := fs.String("from-id", "", "only transfers sent by this owner ID")
+	toIDFlag := fs.String("to-id", "", "only transfers received by this owner ID")
+	sinceFlag := fs.String("since", "", "only transfers at or after this RFC 3339 instant")
+	untilFlag := fs.String("until", "", "only transfers before this RFC 3339 instant")
+	var filter transfer.Filter
+	fs.Func("min-units", "only transfers of at least N units", intFlag(&filter.MinUnits))
+	fs.Func("max-units", "only transfers of at most N units", intFlag(&filter.MaxUnits))
+	sortFlag := fs.String("sort", "asc", "order by transfer date: asc or desc")
+	limit := fs.Int("limit", 0, "maximum transfers to return")
+	offset := fs.Int("offset", 0, "number of transfers to skip")
+	cursor := fs.String("cursor", "", "nextCursor from the previous page")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	fundID, err := parseFundID(*fundFlag)
+	if err != nil {
+		return err
+	}
+	if filter.OwnerID, err = parseOwnerID("owner-id", *ownerIDFlag); err != nil {
+		return err
+	}
+	if filter.FromOwnerID, err = parseOwnerID("from-id", *fromIDFlag); err != nil {
+		return err
+	}
+	if filter.ToOwnerID, err = parseOwnerID("to-id", *toIDFlag); err != nil {
+		return err
+	}
+	if filter.Since, err = parseTime("since", *sinceFlag); err != nil {
+		return err
+	}
+	if filter.Until, err = parseTime("until", *untilFlag); err != nil {
+		return err
+	}
+	filter.Sort = transfer.SortOrder(*sortFlag)
+
+	return a.withServices(ctx, func(svc *services) error {
+		if _, err := svc.funds.GetFund(ctx, fundID); err != nil {
+			return err
+		}
+
+		params := transfer.ListParams{Limit: *limit, Offset: *offset, Cursor: *cursor}
+		list, err := svc.transfers.ListTransfers(ctx, fundID, filter, params)
+		if err != nil {
+			return err
+		}
+
+		view := transferListView{
+			FundID:     fundID,
+			Transfers:  make([]transferView, len(list.Transfers)),
+			Total:      pageTotal(params, list.TotalCount),
+			Limit:      list.Limit,
+			Offset:     list.Offset,
+			NextCursor: list.NextCursor,
+		}
+		rows := make([][]string, len(list.Transfers))
+		for i, t := range list.Transfers {
+			view.Transfers[i] = newTransferView(t)
+			rows[i] = view.Transfers[i].row()
+		}
+		return a.render(view, table{headers: transferHeaders, rows: rows})
+	})
+}
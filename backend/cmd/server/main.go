@@ -0,0 +1,330 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/arowden/augment-fund/internal/audit"
+	"github.com/arowden/augment-fund/internal/auth"
+	"github.com/arowden/augment-fund/internal/commitment"
+	"github.com/arowden/augment-fund/internal/config"
+	"github.com/arowden/augment-fund/internal/distribution"
+	"github.com/arowden/augment-fund/internal/fund"
+	apihttp "github.com/arowden/augment-fund/internal/http"
+	"github.com/arowden/augment-fund/internal/otel"
+	"github.com/arowden/augment-fund/internal/outbox"
+	"github.com/arowden/augment-fund/internal/owner"
+	"github.com/arowden/augment-fund/internal/ownership"
+	"github.com/arowden/augment-fund/internal/postgres"
+	"github.com/arowden/augment-fund/internal/reconciliation"
+	"github.com/arowden/augment-fund/internal/transfer"
+	"github.com/arowden/augment-fund/internal/webhook"
+)
+
+var Version = "dev"
+
+const readHeaderTimeout = 10 * time.Second
+
+type workers struct {
+	reconciliation *reconciliation.Service
+	webhooks       *webhook.Service
+	events         *outbox.Broker
+	jwks           *auth.JWKS
+}
+
+func main() {
+	log := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	slog.SetDefault(log)
+
+	if err := run(log); err != nil {
+		log.Error("server exited with error", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+}
+
+func run(log *slog.Logger) error {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("load config: %w", err)
+	}
+	if Version != "dev" {
+		cfg.Telemetry.Version = Version
+	}
+
+	providers, err := otel.Init(ctx, cfg.Telemetry, log)
+	if err != nil {
+		return fmt.Errorf("init telemetry: %w", err)
+	}
+	if err := otel.InitMetrics(); err != nil {
+		return fmt.Errorf("init metrics: %w", err)
+	}
+
+	pool, err := postgres.New(ctx, cfg.Database, log)
+	if err != nil {
+		return err
+	}
+
+	srv, jobs, err := newServer(ctx, cfg, pool, log)
+	if err != nil {
+		pool.Close()
+		return err
+	}
+
+	var background sync.WaitGroup
+	background.Add(3)
+	go func() {
+		defer background.Done()
+		jobs.reconciliation.Schedule(ctx, cfg.Reconciliation.Interval)
+	}()
+	go func() {
+		defer background.Done()
+		jobs.webhooks.Schedule(ctx, cfg.Webhook.PollInterval)
+	}()
+	go func() {
+		defer background.Done()
+		jobs.events.Run(ctx)
+	}()
+	if jobs.jwks != nil {
+		background.Add(1)
+		go func() {
+			defer background.Done()
+			jobs.jwks.Schedule(ctx, cfg.Auth.JWKSRefresh)
+		}()
+	}
+
+	serveErr := make(chan error, 1)
+	go func() {
+		log.Info("server listening", slog.String("addr", srv.Addr), slog.String("version", cfg.Telemetry.Version))
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			serveErr <- err
+		}
+		close(serveErr)
+	}()
+
+	select {
+	case err := <-serveErr:
+		stop()
+		background.Wait()
+		pool.Close()
+		return fmt.Errorf("serve: %w", err)
+	case <-ctx.Done():
+	}
+
+	log.Info("shutdown signal received, draining in-flight requests",
+		slog.Duration("timeout", cfg.Server.ShutdownTimeout),
+	)
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+	defer cancel()
+
+	var errs []error
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		errs = append(errs, fmt.Errorf("http shutdown: %w", err))
+	}
+	background.Wait()
+	pool.Close()
+	if err := providers.Shutdown(shutdownCtx); err != nil {
+		errs = append(errs, fmt.Errorf("telemetry shutdown: %w", err))
+	}
+
+	log.Info("server stopped")
+	return errors.Join(errs...)
+}
+
+func newServer(ctx context.Context, cfg *config.Config, pool *postgres.Pool, log *slog.Logger) (*http.Server, *workers, error) {
+	switch cfg.Server.MigrationMode {
+	case config.MigrationModeAuto:
+		log.Info("running database migrations")
+		if err := postgres.Migrate(pool.Pool); err != nil {
+			return nil, nil, err
+		}
+	case config.MigrationModeVerify:
+		if err := postgres.CheckSchema(pool.Pool); err != nil {
+			return nil, nil, fmt.Errorf("refusing to serve: %w", err)
+		}
+	}
+
+	if err := postgres.RegisterMetrics(pool); err != nil {
+		return nil, nil, fmt.Errorf("register pool metrics: %w", err)
+	}
+
+	fundStore := fund.NewStore(pool)
+	ownershipStore := ownership.NewStore(pool)
+	ownerStore := owner.NewStore(pool)
+	transferStore := transfer.NewStore(pool)
+	outboxStore := outbox.NewStore(pool)
+	auditStore := audit.NewStore(pool)
+
+	fundService, err := fund.NewService(
+		fundStore,
+		fund.WithPool(pool.Pool),
+		fund.WithOwnershipRepository(ownershipStore),
+		fund.WithOwnerRepository(ownerStore),
+		fund.WithOutbox(outboxStore),
+		fund.WithAudit(auditStore),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ownershipService, err := ownership.NewService(ownership.WithRepository(ownershipStore))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ownerService, err := owner.NewService(owner.WithRepository(ownerStore))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	transferService, err := transfer.NewService(
+		transfer.WithRepository(transferStore),
+		transfer.WithOwnershipRepository(ownershipStore),
+		transfer.WithOwnerRepository(ownerStore),
+		transfer.WithPool(pool.Pool),
+		transfer.WithOutbox(outboxStore),
+		transfer.WithAudit(auditStore),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	distributionService, err := distribution.NewService(
+		distribution.WithRepository(distribution.NewStore(pool)),
+		distribution.WithOwnershipRepository(ownershipStore),
+		distribution.WithPool(pool.Pool),
+		distribution.WithAudit(auditStore),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	commitmentService, err := commitment.NewService(
+		commitment.WithRepository(commitment.NewStore(pool)),
+		commitment.WithOwnerRepository(ownerStore),
+		commitment.WithPool(pool.Pool),
+		commitment.WithAudit(auditStore),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reconciliationService, err := reconciliation.NewService(
+		reconciliation.WithRepository(reconciliation.NewStore(pool.Pool)),
+		reconciliation.WithLogger(log),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := reconciliation.RegisterMetrics(reconciliationService); err != nil {
+		return nil, nil, fmt.Errorf("register reconciliation metrics: %w", err)
+	}
+
+	webhookService, err := webhook.NewService(
+		webhook.WithRepository(webhook.NewStore(pool)),
+		webhook.WithHTTPClient(&http.Client{Timeout: cfg.Webhook.Timeout}),
+		webhook.WithRetryPolicy(cfg.Webhook.RetryPolicy()),
+		webhook.WithLogger(log),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	broker := outbox.NewBroker(pool.Pool, log)
+	eventService, err := outbox.NewService(
+		outbox.WithRepository(outboxStore),
+		outbox.WithNotifier(broker),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	authService, err := auth.NewService(auth.WithRepository(auth.NewStore(pool)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	auditService, err := audit.NewService(audit.WithRepository(auditStore))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		middlewares []apihttp.StrictMiddlewareFunc
+		jwks        *auth.JWKS
+	)
+	if cfg.Auth.Enabled {
+		authOpts := []apihttp.AuthOption{apihttp.WithAPIKeys(authService)}
+		if cfg.Auth.JWTEnabled() {
+			jwks, err = loadJWKS(ctx, cfg.Auth, log)
+			if err != nil {
+				return nil, nil, err
+			}
+			verifier, err := auth.NewTokenVerifier(jwks,
+				auth.WithIssuer(cfg.Auth.JWTIssuer),
+				auth.WithAudience(cfg.Auth.JWTAudience),
+				auth.WithLeeway(cfg.Auth.JWTLeeway),
+				auth.WithRoleClaim(cfg.Auth.JWTRoleClaim),
+				auth.WithFundsClaim(cfg.Auth.JWTFundClaim),
+			)
+			if err != nil {
+				return nil, nil, err
+			}
+			authOpts = append(authOpts, apihttp.WithBearerTokens(verifier))
+		}
+		middlewares = append(middlewares, apihttp.NewAuthMiddleware(authOpts...))
+	} else {
+		log.Warn("authentication disabled, every endpoint is open")
+	}
+
+	handler, err := apihttp.NewAPIHandlerStrict(
+		apihttp.WithFundService(fundService),
+		apihttp.WithOwnershipService(ownershipService),
+		apihttp.WithOwnerService(ownerService),
+		apihttp.WithTransferService(transferService),
+		apihttp.WithDistributionService(distributionService),
+		apihttp.WithCommitmentService(commitmentService),
+		apihttp.WithReconciliationService(reconciliationService),
+		apihttp.WithWebhookService(webhookService),
+		apihttp.WithEventService(eventService),
+		apihttp.WithAuthService(authService),
+		apihttp.WithAuditService(auditService),
+		apihttp.WithPool(pool.Pool),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	router := newRouter(handler, middlewares, pool.HealthCheck, cfg.Server.CORSOrigins)
+
+	return &http.Server{
+		Addr:              net.JoinHostPort(cfg.Server.Host, strconv.Itoa(cfg.Server.Port)),
+		Handler:           otel.WrapHandler(router, "augment-fund-api"),
+		ReadHeaderTimeout: readHeaderTimeout,
+	}, &workers{reconciliation: reconciliationService, webhooks: webhookService, events: broker, jwks: jwks}, nil
+}
+
+func loadJWKS(ctx context.Context, cfg auth.Config, log *slog.Logger) (*auth.JWKS, error) {
+	jwks := auth.NewFileJWKS(cfg.JWKSFile, auth.WithJWKSLogger(log))
+	if cfg.JWKSURL != "" {
+		jwks = auth.NewURLJWKS(cfg.JWKSURL, auth.WithJWKSLogger(log))
+	}
+	if err := jwks.Load(ctx); err != nil {
+		return nil, fmt.Errorf("load jwks: %w", err)
+	}
+	log.Info("jwt authentication enabled", slog.String("issuer", cfg.JWTIssuer), slog.String("audience", cfg.JWTAudience))
+	return jwks, nil
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/arowden/augment-fund/internal/audit"
+	apihttp "github.com/arowden/augment-fund/internal/http"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/cors"
+)
+
+const corsMaxAge = 300
+
+type healthFunc func(ctx context.Context) error
+
+func newRouter(handler apihttp.StrictServerInterface, middlewares []apihttp.StrictMiddlewareFunc, health healthFunc, corsOrigins []string) http.Handler {
+	r := chi.NewRouter()
+
+	r.Use(middleware.RequestID)
+	r.Use(audit.ClientIP)
+	r.Use(middleware.RealIP)
+	r.Use(middleware.Recoverer)
+	r.Use(cors.Handler(cors.Options{
+		AllowedOrigins: corsOrigins,
+		AllowedMethods: []string{http.MethodGet, http.MethodPost, http.MethodDelete, http.MethodOptions},
+		AllowedHeaders: []string{"Accept", "Content-Type", "X-Request-Id", "Last-Event-ID", "Authorization", apihttp.APIKeyHeader},
+		ExposedHeaders: []string{"X-Request-Id", "Content-Disposition"},
+		MaxAge:         corsMaxAge,
+	}))
+
+	r.Get("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		if err := health(r.Context()); err != nil {
+			slog.WarnContext(r.Context(), "health check failed", slog.String("error", err.Error()))
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	strict := apihttp.NewStrictHandlerWithOptions(handler, middlewares, apihttp.StrictHTTPServerOptions{
+		RequestErrorHandlerFunc:  writeRequestError,
+		ResponseErrorHandlerFunc: writeResponseError,
+	})
+
+	api := apihttp.HandlerWithOptions(strict, apihttp.ChiServerOptions{
+		BaseRouter:       chi.NewRouter(),
+		ErrorHandlerFunc: writeRequestError,
+	})
+
+	r.Mount("/api", api)
+	r.Mount("/", api)
+
+	return r
+}
+
+func writeRequestError(w http.ResponseWriter, r *http.Request, err error) {
+	writeError(w, r, http.StatusBadRequest, apihttp.INVALIDREQUEST, err.Error())
+}
+
+func writeResponseError(w http.ResponseWriter, r *http.Request, err error) {
+	slog.ErrorContext(r.Context(), "failed to write response", slog.String("error", err.Error()))
+	writeError(w, r, http.StatusInternalServerError, apihttp.INTERNALERROR, "an unexpected error occurred")
+}
+
+func writeError(w http.ResponseWriter, r *http.Request, status int, code apihttp.ErrorCode, message string) {
+	body := apihttp.Error{Code: code, Message: message}
+	if reqID := middleware.GetReqID(r.Context()); reqID != "" {
+		body.Details = &map[string]interface{}{"requestId": reqID}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,253 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/arowden/augment-fund/internal/audit"
+	"github.com/arowden/augment-fund/internal/auth"
+	apihttp "github.com/arowden/augment-fund/internal/http"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func healthy(context.Context) error { return nil }
+
+func TestNewRouter_Healthz(t *testing.T) {
+	t.Run("returns 200 when database is reachable", func(t *testing.T) {
+		router := newRouter(apihttp.NewAPIHandler(), nil, healthy, nil)
+
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("returns 503 when database is unreachable", func(t *testing.T) {
+		router := newRouter(apihttp.NewAPIHandler(), nil, func(context.Context) error {
+			return errors.New("connection refused")
+		}, nil)
+
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	})
+}
+
+func TestNewRouter_BasePath(t *testing.T) {
+	router := newRouter(apihttp.NewAPIHandler(), nil, healthy, nil)
+
+	for _, path := range []string{"/funds", "/api/funds"} {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code, path)
+		assert.Contains(t, rec.Body.String(), "fund service not configured", path)
+	}
+}
+
+func TestNewRouter_AuditClientIP(t *testing.T) {
+	var got string
+	capture := func(f apihttp.StrictHandlerFunc, _ string) apihttp.StrictHandlerFunc {
+		return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+			got = audit.ClientIPFromContext(ctx)
+			return f(ctx, w, r, request)
+		}
+	}
+	router := newRouter(apihttp.NewAPIHandler(), []apihttp.StrictMiddlewareFunc{capture}, healthy, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/funds", nil)
+	req.RemoteAddr = "203.0.113.7:52000"
+	req.Header.Set("X-Forwarded-For", "198.51.100.99")
+	req.Header.Set("X-Real-IP", "198.51.100.98")
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Equal(t, "203.0.113.7", got)
+}
+
+func TestNewRouter_CORS(t *testing.T) {
+	router := newRouter(apihttp.NewAPIHandler(), nil, healthy, []string{"http://localhost:*"})
+
+	t.Run("allows configured origin", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodOptions, "/funds", nil)
+		req.Header.Set("Origin", "http://localhost:3000")
+		req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, "http://localhost:3000", rec.Header().Get("Access-Control-Allow-Origin"))
+	})
+
+	t.Run("rejects unknown origin", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodOptions, "/funds", nil)
+		req.Header.Set("Origin", "https://evil.example.com")
+		req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		assert.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+	})
+}
+
+func TestNewRouter_MalformedRequest(t *testing.T) {
+	router := newRouter(apihttp.NewAPIHandler(), nil, healthy, nil)
+
+	t.Run("invalid JSON body returns Error schema", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/funds", strings.NewReader("{not json"))
+		req.Header.Set("Content-Type", "application/json")
+
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		var body apihttp.Error
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+		assert.Equal(t, apihttp.INVALIDREQUEST, body.Code)
+		require.NotNil(t, body.Details)
+		assert.NotEmpty(t, (*body.Details)["requestId"])
+	})
+
+	t.Run("invalid fund ID returns Error schema", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/funds/not-a-uuid", nil))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		var body apihttp.Error
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+		assert.Equal(t, apihttp.INVALIDREQUEST, body.Code)
+	})
+}
+
+type staticKeys map[string]auth.Role
+
+func (k staticKeys) Authenticate(ctx context.Context, rawKey string) (*auth.Principal, error) {
+	role, ok := k[rawKey]
+	if !ok {
+		return nil, auth.ErrUnauthenticated
+	}
+	return &auth.Principal{Subject: "api_key:" + rawKey, Name: rawKey, Role: role}, nil
+}
+
+func TestNewRouter_Auth(t *testing.T) {
+	keys := staticKeys{"viewer-key": auth.RoleViewer, "admin-key": auth.RoleAdmin}
+	router := newRouter(apihttp.NewAPIHandler(), []apihttp.StrictMiddlewareFunc{apihttp.NewAuthMiddleware(apihttp.WithAPIKeys(keys))}, healthy, nil)
+
+	do := func(method, path, key string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		if key != "" {
+			req.Header.Set(apihttp.APIKeyHeader, key)
+		}
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("healthz stays public", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, do(http.MethodGet, "/healthz", "").Code)
+	})
+
+	t.Run("missing or unknown key returns 401", func(t *testing.T) {
+		for _, key := range []string{"", "bogus"} {
+			rec := do(http.MethodGet, "/funds", key)
+			require.Equal(t, http.StatusUnauthorized, rec.Code)
+			var body apihttp.Error
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+			assert.Equal(t, apihttp.UNAUTHENTICATED, body.Code)
+		}
+	})
+
+	t.Run("viewer cannot reset", func(t *testing.T) {
+		rec := do(http.MethodPost, "/reset", "viewer-key")
+		require.Equal(t, http.StatusForbidden, rec.Code)
+		var body apihttp.Error
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+		assert.Equal(t, apihttp.FORBIDDEN, body.Code)
+		require.NotNil(t, body.Details)
+		assert.Equal(t, "viewer", (*body.Details)["role"])
+	})
+
+	t.Run("authorized requests reach the handler", func(t *testing.T) {
+		rec := do(http.MethodGet, "/funds", "viewer-key")
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Contains(t, rec.Body.String(), "fund service not configured")
+
+		rec = do(http.MethodPost, "/reset", "admin-key")
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Contains(t, rec.Body.String(), "database pool not configured")
+	})
+}
+
+type staticTokens map[string]*auth.Principal
+
+func (k staticTokens) Authenticate(ctx context.Context, token string) (*auth.Principal, error) {
+	p, ok := k[token]
+	if !ok {
+		return nil, auth.ErrUnauthenticated
+	}
+	return p, nil
+}
+
+func TestNewRouter_BearerTokens(t *testing.T) {
+	fundA, fundB := uuid.New(), uuid.New()
+	tokens := staticTokens{
+		"fund-a-operator": {Subject: "jwt:alice", Funds: map[uuid.UUID]auth.Role{fundA: auth.RoleOperator}},
+		"global-viewer":   {Subject: "jwt:bob", Role: auth.RoleViewer},
+	}
+	mw := apihttp.NewAuthMiddleware(apihttp.WithAPIKeys(staticKeys{}), apihttp.WithBearerTokens(tokens))
+	router := newRouter(apihttp.NewAPIHandler(), []apihttp.StrictMiddlewareFunc{mw}, healthy, nil)
+
+	transfer := func(fundID uuid.UUID, authorization string) *httptest.ResponseRecorder {
+		body := `{"fromOwner":"Founder","toOwner":"Alice","units":10,"idempotencyKey":"` + uuid.NewString() + `"}`
+		req := httptest.NewRequest(http.MethodPost, "/funds/"+fundID.String()+"/transfers", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", authorization)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("fund-scoped token reaches its own fund", func(t *testing.T) {
+		rec := transfer(fundA, "Bearer fund-a-operator")
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Contains(t, rec.Body.String(), "not configured")
+	})
+
+	t.Run("fund-scoped token is forbidden on another fund", func(t *testing.T) {
+		rec := transfer(fundB, "Bearer fund-a-operator")
+		require.Equal(t, http.StatusForbidden, rec.Code)
+		var body apihttp.Error
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+		assert.Equal(t, apihttp.FORBIDDEN, body.Code)
+		require.NotNil(t, body.Details)
+		assert.Equal(t, fundB.String(), (*body.Details)["fundId"])
+	})
+
+	t.Run("global viewer cannot transfer", func(t *testing.T) {
+		assert.Equal(t, http.StatusForbidden, transfer(fundA, "Bearer global-viewer").Code)
+	})
+
+	t.Run("fund-scoped token cannot list every fund", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/funds", nil)
+		req.Header.Set("Authorization", "Bearer fund-a-operator")
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("invalid token returns 401 with a bearer challenge", func(t *testing.T) {
+		for _, header := range []string{"Bearer nope", "Basic dXNlcjpwYXNz"} {
+			rec := transfer(fundA, header)
+			assert.Equal(t, http.StatusUnauthorized, rec.Code, header)
+			assert.Contains(t, rec.Header().Get("WWW-Authenticate"), "Bearer", header)
+		}
+	})
+}
@@ -0,0 +1,84 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/arowden/augment-fund/internal/auth"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+)
+
+const AnonymousActor = "anonymous"
+
+const (
+	OperationFundCreate         = "fund.create"
+	OperationFundImport         = "fund.import"
+	OperationFundStatus         = "fund.status"
+	OperationTransferExecute    = "transfer.execute"
+	OperationTransferRequest    = "transfer.request"
+	OperationTransferApprove    = "transfer.approve"
+	OperationTransferReject     = "transfer.reject"
+	OperationTransferReverse    = "transfer.reverse"
+	OperationTransferBatch      = "transfer.batch"
+	OperationUnitsIssue         = "units.issue"
+	OperationUnitsRedeem        = "units.redeem"
+	OperationDistributionCreate = "distribution.create"
+	OperationCommitmentCreate   = "commitment.create"
+	OperationCapitalCallCreate  = "capital_call.create"
+	OperationDatabaseReset      = "database.reset"
+)
+
+type Event struct {
+	ID         uuid.UUID
+	OccurredAt time.Time
+	Actor      string
+	ActorName  string
+	RequestID  string
+	ClientIP   string
+	Operation  string
+	FundID     *uuid.UUID
+	TransferID *uuid.UUID
+	Before     map[string]int
+	After      map[string]int
+	Details    map[string]any
+}
+
+func NewEvent(ctx context.Context, operation string) *Event {
+	e := &Event{
+		ID:        uuid.New(),
+		Actor:     AnonymousActor,
+		RequestID: middleware.GetReqID(ctx),
+		ClientIP:  ClientIPFromContext(ctx),
+		Operation: operation,
+	}
+	if p, ok := auth.PrincipalFromContext(ctx); ok {
+		e.Actor = p.Subject
+		e.ActorName = p.Name
+	}
+	return e
+}
+
+func (e *Event) ForFund(fundID uuid.UUID) *Event {
+	e.FundID = &fundID
+	return e
+}
+
+func (e *Event) ForTransfer(transferID uuid.UUID) *Event {
+	e.TransferID = &transferID
+	return e
+}
+
+type Filter struct {
+	FundID *uuid.UUID
+	Actor  *string
+	From   *time.Time
+	To     *time.Time
+}
+
+func (f Filter) Validate() error {
+	if f.From != nil && f.To != nil && f.From.After(*f.To) {
+		return ErrInvalidTimeRange
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package audit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/arowden/augment-fund/internal/auth"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEvent(t *testing.T) {
+	t.Run("defaults to anonymous without a principal", func(t *testing.T) {
+		e := NewEvent(context.Background(), OperationFundCreate)
+
+		assert.NotEqual(t, uuid.Nil, e.ID)
+		assert.Equal(t, AnonymousActor, e.Actor)
+		assert.Empty(t, e.ActorName)
+		assert.Empty(t, e.RequestID)
+		assert.Empty(t, e.ClientIP)
+		assert.Equal(t, OperationFundCreate, e.Operation)
+	})
+
+	t.Run("captures principal, request ID and client IP", func(t *testing.T) {
+		ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "api_key:1", Name: "ops-bot", Role: auth.RoleOperator})
+		ctx = context.WithValue(ctx, middleware.RequestIDKey, "req-1")
+		ctx = WithClientIP(ctx, "203.0.113.7")
+
+		fundID, transferID := uuid.New(), uuid.New()
+		e := NewEvent(ctx, OperationTransferExecute).ForFund(fundID).ForTransfer(transferID)
+
+		assert.Equal(t, "api_key:1", e.Actor)
+		assert.Equal(t, "ops-bot", e.ActorName)
+		assert.Equal(t, "req-1", e.RequestID)
+		assert.Equal(t, "203.0.113.7", e.ClientIP)
+		require.NotNil(t, e.FundID)
+		assert.Equal(t, fundID, *e.FundID)
+		require.NotNil(t, e.TransferID)
+		assert.Equal(t, transferID, *e.TransferID)
+	})
+}
+
+func TestFilter_Validate(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-time.Hour)
+
+	assert.NoError(t, Filter{}.Validate())
+	assert.NoError(t, Filter{From: &earlier, To: &now}.Validate())
+	assert.NoError(t, Filter{From: &now, To: &now}.Validate())
+	assert.ErrorIs(t, Filter{From: &now, To: &earlier}.Validate(), ErrInvalidTimeRange)
+}
+
+func TestClientIP(t *testing.T) {
+	var got string
+	handler := ClientIP(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = ClientIPFromContext(r.Context())
+	}))
+
+	for remoteAddr, want := range map[string]string{
+		"203.0.113.7:52000": "203.0.113.7",
+		"[2001:db8::1]:443": "2001:db8::1",
+		"198.51.100.2":      "198.51.100.2",
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = remoteAddr
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+		assert.Equal(t, want, got, remoteAddr)
+	}
+}
@@ -0,0 +1,7 @@
+package audit
+
+import "errors"
+
+var ErrNilEvent = errors.New("audit: cannot append nil event")
+
+var ErrInvalidTimeRange = errors.New("from must not be after to")
@@ -0,0 +1,27 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/arowden/augment-fund/internal/validation"
+	"github.com/jackc/pgx/v5"
+)
+
+type ListParams = validation.ListParams
+
+type EventList struct {
+	Events []*Event
+	Total  int
+	Limit  int
+	Offset int
+}
+
+type Writer interface {
+	AppendTx(ctx context.Context, tx pgx.Tx, event *Event) error
+}
+
+type Repository interface {
+	Writer
+
+	List(ctx context.Context, filter Filter, params ListParams) (*EventList, error)
+}
@@ -0,0 +1,40 @@
+package audit
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type Service struct {
+	repo Repository
+}
+
+type ServiceOption func(*Service)
+
+func WithRepository(r Repository) ServiceOption {
+	return func(s *Service) { s.repo = r }
+}
+
+func NewService(opts ...ServiceOption) (*Service, error) {
+	s := &Service{}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.repo == nil {
+		return nil, errors.New("audit: repository is required")
+	}
+	return s, nil
+}
+
+func (s *Service) ListEvents(ctx context.Context, filter Filter, params ListParams) (*EventList, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+	return s.repo.List(ctx, filter, params)
+}
+
+func (s *Service) AppendTx(ctx context.Context, tx pgx.Tx, event *Event) error {
+	return s.repo.AppendTx(ctx, tx, event)
+}
@@ -0,0 +1,61 @@
+package audit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRepository struct {
+	filter Filter
+	params ListParams
+	calls  int
+}
+
+func (m *mockRepository) AppendTx(ctx context.Context, tx pgx.Tx, event *Event) error {
+	return nil
+}
+
+func (m *mockRepository) List(ctx context.Context, filter Filter, params ListParams) (*EventList, error) {
+	m.calls++
+	m.filter = filter
+	m.params = params
+	return &EventList{Events: []*Event{}, Limit: params.Limit, Offset: params.Offset}, nil
+}
+
+func TestNewService(t *testing.T) {
+	_, err := NewService()
+	assert.Error(t, err)
+
+	svc, err := NewService(WithRepository(&mockRepository{}))
+	require.NoError(t, err)
+	assert.NotNil(t, svc)
+}
+
+func TestService_ListEvents(t *testing.T) {
+	repo := &mockRepository{}
+	svc, err := NewService(WithRepository(repo))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	t.Run("passes filters through", func(t *testing.T) {
+		actor := "jwt:alice"
+		_, err := svc.ListEvents(ctx, Filter{Actor: &actor}, ListParams{Limit: 10})
+		require.NoError(t, err)
+		require.NotNil(t, repo.filter.Actor)
+		assert.Equal(t, actor, *repo.filter.Actor)
+		assert.Equal(t, 10, repo.params.Limit)
+	})
+
+	t.Run("rejects inverted time range", func(t *testing.T) {
+		calls := repo.calls
+		from, to := time.Now(), time.Now().Add(-time.Minute)
+		_, err := svc.ListEvents(ctx, Filter{From: &from, To: &to}, ListParams{})
+		assert.ErrorIs(t, err, ErrInvalidTimeRange)
+		assert.Equal(t, calls, repo.calls)
+	})
+}
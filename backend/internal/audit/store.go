@@ -0,0 +1,144 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type DB interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+type Store struct {
+	db DB
+}
+
+func NewStore(db DB) *Store {
+	if db == nil {
+		return nil
+	}
+	return &Store{db: db}
+}
+
+func (s *Store) AppendTx(ctx context.Context, tx pgx.Tx, event *Event) error {
+	if event == nil {
+		return ErrNilEvent
+	}
+
+	before, err := marshalJSON(event.Before)
+	if err != nil {
+		return fmt.Errorf("marshal before balances: %w", err)
+	}
+	after, err := marshalJSON(event.After)
+	if err != nil {
+		return fmt.Errorf("marshal after balances: %w", err)
+	}
+	details, err := marshalJSON(event.Details)
+	if err != nil {
+		return fmt.Errorf("marshal details: %w", err)
+	}
+
+	const query = `
+		INSERT INTO audit_events (
+			id, occurred_at, actor, actor_name, request_id, client_ip, operation,
+			fund_id, transfer_id, before_balances, after_balances, details
+		)
+		VALUES ($1, NOW(), $2, NULLIF($3, ''), NULLIF($4, ''), NULLIF($5, ''), $6, $7, $8, $9, $10, $11)
+		RETURNING occurred_at
+	`
+	err = tx.QueryRow(ctx, query,
+		event.ID, event.Actor, event.ActorName, event.RequestID, event.ClientIP, event.Operation,
+		event.FundID, event.TransferID, before, after, details,
+	).Scan(&event.OccurredAt)
+	if err != nil {
+		return fmt.Errorf("append %s audit event %s: %w", event.Operation, event.ID, err)
+	}
+	return nil
+}
+
+func (s *Store) List(ctx context.Context, filter Filter, params ListParams) (*EventList, error) {
+	params = params.Normalize()
+
+	const where = `
+		WHERE ($1::uuid IS NULL OR fund_id = $1)
+		  AND ($2::text IS NULL OR actor = $2)
+		  AND ($3::timestamptz IS NULL OR occurred_at >= $3)
+		  AND ($4::timestamptz IS NULL OR occurred_at <= $4)
+	`
+	const query = `
+		SELECT id, occurred_at, actor, COALESCE(actor_name, ''), COALESCE(request_id, ''),
+		       COALESCE(client_ip, ''), operation, fund_id, transfer_id,
+		       before_balances, after_balances, details, COUNT(*) OVER() AS total
+		FROM audit_events
+	` + where + `
+		ORDER BY occurred_at DESC, id DESC
+		LIMIT $5 OFFSET $6
+	`
+	rows, err := s.db.Query(ctx, query, filter.FundID, filter.Actor, filter.From, filter.To, params.Limit, params.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("list audit events: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]*Event, 0, params.Limit)
+	var total int
+	for rows.Next() {
+		var e Event
+		var before, after, details []byte
+		err := rows.Scan(
+			&e.ID, &e.OccurredAt, &e.Actor, &e.ActorName, &e.RequestID,
+			&e.ClientIP, &e.Operation, &e.FundID, &e.TransferID,
+			&before, &after, &details, &total,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scan audit event row: %w", err)
+		}
+		if err := unmarshalJSON(before, &e.Before); err != nil {
+			return nil, fmt.Errorf("decode before balances of audit event %s: %w", e.ID, err)
+		}
+		if err := unmarshalJSON(after, &e.After); err != nil {
+			return nil, fmt.Errorf("decode after balances of audit event %s: %w", e.ID, err)
+		}
+		if err := unmarshalJSON(details, &e.Details); err != nil {
+			return nil, fmt.Errorf("decode details of audit event %s: %w", e.ID, err)
+		}
+		events = append(events, &e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate audit event rows: %w", err)
+	}
+
+	if len(events) == 0 && params.Offset > 0 {
+		const countQuery = `SELECT COUNT(*) FROM audit_events ` + where
+		if err := s.db.QueryRow(ctx, countQuery, filter.FundID, filter.Actor, filter.From, filter.To).Scan(&total); err != nil {
+			return nil, fmt.Errorf("count audit events: %w", err)
+		}
+	}
+
+	return &EventList{
+		Events: events,
+		Total:  total,
+		Limit:  params.Limit,
+		Offset: params.Offset,
+	}, nil
+}
+
+func marshalJSON[T any](v map[string]T) ([]byte, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return json.Marshal(v)
+}
+
+func unmarshalJSON[T any](data []byte, v *map[string]T) error {
+	if data == nil {
+		return nil
+	}
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,130 @@
+package audit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/arowden/augment-fund/internal/audit"
+	"github.com/arowden/augment-fund/internal/auth"
+	"github.com/arowden/augment-fund/internal/fund"
+	"github.com/arowden/augment-fund/internal/owner"
+	"github.com/arowden/augment-fund/internal/ownership"
+	"github.com/arowden/augment-fund/internal/postgres"
+	"github.com/arowden/augment-fund/internal/transfer"
+	"github.com/go-chi/chi/v5/middleware"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx := context.Background()
+	tc, err := postgres.NewTestContainer(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() { tc.Cleanup(ctx) })
+
+	store := audit.NewStore(tc.Pool())
+	ownershipStore := ownership.NewStore(tc.Pool())
+	ownerStore := owner.NewStore(tc.Pool())
+	fundService, err := fund.NewService(
+		fund.NewStore(tc.Pool()),
+		fund.WithPool(tc.Pool()),
+		fund.WithOwnershipRepository(ownershipStore),
+		fund.WithOwnerRepository(ownerStore),
+		fund.WithAudit(store),
+	)
+	require.NoError(t, err)
+	transferService, err := transfer.NewService(
+		transfer.WithRepository(transfer.NewStore(tc.Pool())),
+		transfer.WithOwnershipRepository(ownershipStore),
+		transfer.WithOwnerRepository(ownerStore),
+		transfer.WithPool(tc.Pool()),
+		transfer.WithAudit(store),
+	)
+	require.NoError(t, err)
+
+	actorCtx := func(subject string) context.Context {
+		ctx := auth.WithPrincipal(ctx, &auth.Principal{Subject: subject, Name: subject, Role: auth.RoleAdmin})
+		ctx = context.WithValue(ctx, middleware.RequestIDKey, "req-"+subject)
+		return audit.WithClientIP(ctx, "203.0.113.7")
+	}
+
+	t.Run("records fund creation and transfers with balances", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		f, err := fundService.CreateFundWithInitialOwner(actorCtx("api_key:ops"), "Audited Fund", 1000, "Founder")
+		require.NoError(t, err)
+		tr, err := transferService.ExecuteTransfer(actorCtx("jwt:alice"), transfer.Request{FundID: f.ID, FromOwner: "Founder", ToOwner: "Alice", Units: 100})
+		require.NoError(t, err)
+
+		list, err := store.List(ctx, audit.Filter{FundID: &f.ID}, audit.ListParams{})
+		require.NoError(t, err)
+		require.Len(t, list.Events, 2)
+		assert.Equal(t, 2, list.Total)
+
+		executed := list.Events[0]
+		assert.Equal(t, audit.OperationTransferExecute, executed.Operation)
+		assert.Equal(t, "jwt:alice", executed.Actor)
+		assert.Equal(t, "req-jwt:alice", executed.RequestID)
+		assert.Equal(t, "203.0.113.7", executed.ClientIP)
+		require.NotNil(t, executed.TransferID)
+		assert.Equal(t, tr.ID, *executed.TransferID)
+		assert.Equal(t, map[string]int{"Founder": 1000, "Alice": 0}, executed.Before)
+		assert.Equal(t, map[string]int{"Founder": 900, "Alice": 100}, executed.After)
+		assert.EqualValues(t, 100, executed.Details["units"])
+
+		created := list.Events[1]
+		assert.Equal(t, audit.OperationFundCreate, created.Operation)
+		assert.Equal(t, "api_key:ops", created.Actor)
+		assert.Nil(t, created.Before)
+		assert.Equal(t, map[string]int{"Founder": 1000}, created.After)
+	})
+
+	t.Run("filters by actor and time range", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		start := time.Now().Add(-time.Second)
+		_, err := fundService.CreateFundWithInitialOwner(actorCtx("api_key:ops"), "Fund A", 100, "Founder")
+		require.NoError(t, err)
+		_, err = fundService.CreateFundWithInitialOwner(actorCtx("jwt:bob"), "Fund B", 100, "Founder")
+		require.NoError(t, err)
+
+		actor := "jwt:bob"
+		list, err := store.List(ctx, audit.Filter{Actor: &actor}, audit.ListParams{})
+		require.NoError(t, err)
+		require.Len(t, list.Events, 1)
+		assert.Equal(t, "Fund B", list.Events[0].Details["name"])
+
+		list, err = store.List(ctx, audit.Filter{From: &start}, audit.ListParams{Limit: 1})
+		require.NoError(t, err)
+		assert.Len(t, list.Events, 1)
+		assert.Equal(t, 2, list.Total)
+
+		past := start.Add(-time.Hour)
+		list, err = store.List(ctx, audit.Filter{To: &past}, audit.ListParams{})
+		require.NoError(t, err)
+		assert.Empty(t, list.Events)
+	})
+
+	t.Run("rejects updates and deletes", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		_, err := fundService.CreateFundWithInitialOwner(ctx, "Immutable Fund", 100, "Founder")
+		require.NoError(t, err)
+
+		_, err = tc.Pool().Exec(ctx, `UPDATE audit_events SET actor = 'someone-else'`)
+		assert.ErrorContains(t, err, "append-only")
+		_, err = tc.Pool().Exec(ctx, `DELETE FROM audit_events`)
+		assert.ErrorContains(t, err, "append-only")
+
+		list, err := store.List(ctx, audit.Filter{}, audit.ListParams{})
+		require.NoError(t, err)
+		require.Len(t, list.Events, 1)
+		assert.Equal(t, audit.AnonymousActor, list.Events[0].Actor)
+	})
+}
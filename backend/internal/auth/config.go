@@ -0,0 +1,35 @@
+package auth
+
+import (
+	"errors"
+	"time"
+)
+
+type Config struct {
+	Enabled      bool          `envconfig:"AUTH_ENABLED" default:"true"`
+	JWKSFile     string        `envconfig:"AUTH_JWKS_FILE"`
+	JWKSURL      string        `envconfig:"AUTH_JWKS_URL"`
+	JWKSRefresh  time.Duration `envconfig:"AUTH_JWKS_REFRESH_INTERVAL" default:"5m"`
+	JWTIssuer    string        `envconfig:"AUTH_JWT_ISSUER"`
+	JWTAudience  string        `envconfig:"AUTH_JWT_AUDIENCE"`
+	JWTLeeway    time.Duration `envconfig:"AUTH_JWT_LEEWAY" default:"1m"`
+	JWTRoleClaim string        `envconfig:"AUTH_JWT_ROLE_CLAIM" default:"role"`
+	JWTFundClaim string        `envconfig:"AUTH_JWT_FUNDS_CLAIM" default:"funds"`
+}
+
+func (c Config) JWTEnabled() bool {
+	return c.JWKSFile != "" || c.JWKSURL != ""
+}
+
+func (c Config) Validate() error {
+	if !c.JWTEnabled() {
+		return nil
+	}
+	if c.JWKSFile != "" && c.JWKSURL != "" {
+		return errors.New("set only one of AUTH_JWKS_FILE and AUTH_JWKS_URL")
+	}
+	if c.JWTIssuer == "" || c.JWTAudience == "" {
+		return errors.New("AUTH_JWT_ISSUER and AUTH_JWT_AUDIENCE are required when a JWKS is configured")
+	}
+	return nil
+}
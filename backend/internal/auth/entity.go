@@ -0,0 +1,120 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	KeyPrefix        = "afk_"
+	maxKeyNameLength = 100
+	displayPrefixLen = len(KeyPrefix) + 8
+)
+
+type Role string
+
+const (
+	RoleViewer   Role = "viewer"
+	RoleOperator Role = "operator"
+	RoleAdmin    Role = "admin"
+)
+
+var Roles = []Role{RoleViewer, RoleOperator, RoleAdmin}
+
+func ParseRole(s string) (Role, error) {
+	for _, r := range Roles {
+		if string(r) == s {
+			return r, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %q", ErrInvalidRole, s)
+}
+
+type Permission string
+
+const (
+	PermissionReadCapTable    Permission = "cap_table:read"
+	PermissionCreateTransfers Permission = "transfers:create"
+	PermissionCreateFunds     Permission = "funds:create"
+	PermissionAdminister      Permission = "admin"
+)
+
+var rolePermissions = map[Role][]Permission{
+	RoleViewer:   {PermissionReadCapTable},
+	RoleOperator: {PermissionReadCapTable, PermissionCreateTransfers},
+	RoleAdmin:    {PermissionReadCapTable, PermissionCreateTransfers, PermissionCreateFunds, PermissionAdminister},
+}
+
+func (r Role) Can(p Permission) bool {
+	for _, granted := range rolePermissions[r] {
+		if granted == p {
+			return true
+		}
+	}
+	return false
+}
+
+type Principal struct {
+	Subject string
+	Name    string
+	Role    Role
+	Funds   map[uuid.UUID]Role
+}
+
+func (p *Principal) Can(perm Permission) bool {
+	return p != nil && p.Role.Can(perm)
+}
+
+func (p *Principal) CanOnFund(perm Permission, fundID uuid.UUID) bool {
+	return p.Can(perm) || (p != nil && p.Funds[fundID].Can(perm))
+}
+
+type APIKey struct {
+	ID        uuid.UUID
+	Name      string
+	Prefix    string
+	Hash      []byte
+	Role      Role
+	CreatedAt time.Time
+	RevokedAt *time.Time
+}
+
+func NewAPIKey(name string, role Role) (*APIKey, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > maxKeyNameLength {
+		return nil, "", ErrInvalidKeyName
+	}
+	if _, err := ParseRole(string(role)); err != nil {
+		return nil, "", err
+	}
+
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return nil, "", fmt.Errorf("generate api key: %w", err)
+	}
+	raw := KeyPrefix + hex.EncodeToString(b)
+
+	return &APIKey{
+		ID:        uuid.New(),
+		Name:      name,
+		Prefix:    raw[:displayPrefixLen],
+		Hash:      HashKey(raw),
+		Role:      role,
+		CreatedAt: time.Now(),
+	}, raw, nil
+}
+
+func HashKey(raw string) []byte {
+	sum := sha256.Sum256([]byte(raw))
+	return sum[:]
+}
+
+func (k *APIKey) Principal() *Principal {
+	return &Principal{Subject: "api_key:" + k.ID.String(), Name: k.Name, Role: k.Role}
+}
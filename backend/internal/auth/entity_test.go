@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRole_Can(t *testing.T) {
+	tests := []struct {
+		role    Role
+		allowed []Permission
+		denied  []Permission
+	}{
+		{RoleViewer, []Permission{PermissionReadCapTable}, []Permission{PermissionCreateTransfers, PermissionCreateFunds, PermissionAdminister}},
+		{RoleOperator, []Permission{PermissionReadCapTable, PermissionCreateTransfers}, []Permission{PermissionCreateFunds, PermissionAdminister}},
+		{RoleAdmin, []Permission{PermissionReadCapTable, PermissionCreateTransfers, PermissionCreateFunds, PermissionAdminister}, nil},
+		{Role("auditor"), nil, []Permission{PermissionReadCapTable}},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.role), func(t *testing.T) {
+			for _, p := range tt.allowed {
+				assert.True(t, tt.role.Can(p), p)
+			}
+			for _, p := range tt.denied {
+				assert.False(t, tt.role.Can(p), p)
+			}
+		})
+	}
+}
+
+func TestParseRole(t *testing.T) {
+	r, err := ParseRole("operator")
+	require.NoError(t, err)
+	assert.Equal(t, RoleOperator, r)
+
+	_, err = ParseRole("root")
+	assert.ErrorIs(t, err, ErrInvalidRole)
+}
+
+func TestNewAPIKey(t *testing.T) {
+	t.Run("generates a hashed key", func(t *testing.T) {
+		key, raw, err := NewAPIKey("  ci  ", RoleOperator)
+		require.NoError(t, err)
+		assert.Equal(t, "ci", key.Name)
+		assert.True(t, strings.HasPrefix(raw, KeyPrefix))
+		assert.True(t, strings.HasPrefix(raw, key.Prefix))
+		assert.Equal(t, HashKey(raw), key.Hash)
+		assert.NotContains(t, string(key.Hash), raw)
+
+		p := key.Principal()
+		assert.Equal(t, "ci", p.Name)
+		assert.Equal(t, RoleOperator, p.Role)
+		assert.Equal(t, "api_key:"+key.ID.String(), p.Subject)
+	})
+
+	t.Run("rejects invalid input", func(t *testing.T) {
+		_, _, err := NewAPIKey(" ", RoleAdmin)
+		assert.ErrorIs(t, err, ErrInvalidKeyName)
+		_, _, err = NewAPIKey(strings.Repeat("a", 101), RoleAdmin)
+		assert.ErrorIs(t, err, ErrInvalidKeyName)
+		_, _, err = NewAPIKey("ci", Role("root"))
+		assert.ErrorIs(t, err, ErrInvalidRole)
+	})
+}
+
+func TestPrincipalFromContext(t *testing.T) {
+	_, ok := PrincipalFromContext(context.Background())
+	assert.False(t, ok)
+
+	want := &Principal{Subject: "api_key:1", Role: RoleViewer}
+	got, ok := PrincipalFromContext(WithPrincipal(context.Background(), want))
+	require.True(t, ok)
+	assert.Same(t, want, got)
+
+	var nilPrincipal *Principal
+	assert.False(t, nilPrincipal.Can(PermissionReadCapTable))
+}
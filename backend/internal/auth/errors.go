@@ -0,0 +1,15 @@
+package auth
+
+import "errors"
+
+var ErrUnauthenticated = errors.New("missing or invalid credentials")
+
+var ErrForbidden = errors.New("insufficient permissions for this operation")
+
+var ErrInvalidRole = errors.New("role must be one of viewer, operator or admin")
+
+var ErrInvalidKeyName = errors.New("api key name must be non-empty (max 100 chars)")
+
+var ErrKeyNotFound = errors.New("api key not found")
+
+var ErrNoSigningKeys = errors.New("jwks contains no usable RS256 or ES256 public keys")
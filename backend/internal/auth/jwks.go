@@ -0,0 +1,164 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+)
+
+const maxJWKSSize = 1 << 20
+
+type JWKS struct {
+	fetch  func(ctx context.Context) ([]byte, error)
+	client *http.Client
+	log    *slog.Logger
+
+	mu   sync.RWMutex
+	keys []jose.JSONWebKey
+}
+
+type JWKSOption func(*JWKS)
+
+func WithJWKSHTTPClient(c *http.Client) JWKSOption {
+	return func(k *JWKS) { k.client = c }
+}
+
+func WithJWKSLogger(l *slog.Logger) JWKSOption {
+	return func(k *JWKS) { k.log = l }
+}
+
+func NewFileJWKS(path string, opts ...JWKSOption) *JWKS {
+	k := newJWKS(opts)
+	k.fetch = func(context.Context) ([]byte, error) {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read jwks file: %w", err)
+		}
+		return data, nil
+	}
+	return k
+}
+
+func NewURLJWKS(url string, opts ...JWKSOption) *JWKS {
+	k := newJWKS(opts)
+	k.fetch = func(ctx context.Context) ([]byte, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, fmt.Errorf("build jwks request: %w", err)
+		}
+		resp, err := k.client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("fetch jwks: %w", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("fetch jwks: unexpected status %d", resp.StatusCode)
+		}
+		return io.ReadAll(io.LimitReader(resp.Body, maxJWKSSize))
+	}
+	return k
+}
+
+func newJWKS(opts []JWKSOption) *JWKS {
+	k := &JWKS{
+		client: &http.Client{Timeout: 10 * time.Second},
+		log:    slog.Default(),
+	}
+	for _, opt := range opts {
+		opt(k)
+	}
+	return k
+}
+
+func (k *JWKS) Load(ctx context.Context) error {
+	data, err := k.fetch(ctx)
+	if err != nil {
+		return err
+	}
+
+	var set jose.JSONWebKeySet
+	if err := json.Unmarshal(data, &set); err != nil {
+		return fmt.Errorf("parse jwks: %w", err)
+	}
+
+	keys := make([]jose.JSONWebKey, 0, len(set.Keys))
+	for _, key := range set.Keys {
+		if key.Use != "" && key.Use != "sig" {
+			continue
+		}
+		if !key.IsPublic() {
+			key = key.Public()
+		}
+		if !supportedKey(key) {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return ErrNoSigningKeys
+	}
+
+	k.mu.Lock()
+	k.keys = keys
+	k.mu.Unlock()
+	return nil
+}
+
+func (k *JWKS) Lookup(kid string) []jose.JSONWebKey {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	if kid == "" {
+		return k.keys
+	}
+	var matched []jose.JSONWebKey
+	for _, key := range k.keys {
+		if key.KeyID == kid {
+			matched = append(matched, key)
+		}
+	}
+	return matched
+}
+
+func (k *JWKS) Schedule(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := k.Load(ctx); err != nil && ctx.Err() == nil {
+			k.log.Warn("jwks refresh failed, keeping previous keys", slog.String("error", err.Error()))
+		}
+	}
+}
+
+func supportedKey(key jose.JSONWebKey) bool {
+	switch pub := key.Key.(type) {
+	case *rsa.PublicKey:
+		return key.Algorithm == "" || key.Algorithm == string(jose.RS256)
+	case *ecdsa.PublicKey:
+		return pub.Curve == elliptic.P256() && (key.Algorithm == "" || key.Algorithm == string(jose.ES256))
+	default:
+		return false
+	}
+}
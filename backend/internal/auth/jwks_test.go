@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJWKS_Load(t *testing.T) {
+	ctx := context.Background()
+	rsaKey := newRSAKey(t, "rsa-1")
+	ecKey := newECKey(t, "ec-1")
+
+	set, err := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+		rsaKey.public(),
+		ecKey.public(),
+		{Key: []byte("shared-secret"), KeyID: "hmac", Algorithm: string(jose.HS256)},
+	}})
+	require.NoError(t, err)
+
+	t.Run("loads public keys from a file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "jwks.json")
+		require.NoError(t, os.WriteFile(path, set, 0o600))
+
+		k := NewFileJWKS(path)
+		require.NoError(t, k.Load(ctx))
+		assert.Len(t, k.Lookup(""), 2)
+		require.Len(t, k.Lookup("ec-1"), 1)
+		assert.Empty(t, k.Lookup("hmac"))
+	})
+
+	t.Run("loads public keys from a URL", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(set)
+		}))
+		defer srv.Close()
+
+		k := NewURLJWKS(srv.URL)
+		require.NoError(t, k.Load(ctx))
+		require.Len(t, k.Lookup("rsa-1"), 1)
+	})
+
+	t.Run("keeps previous keys when a reload fails", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "jwks.json")
+		require.NoError(t, os.WriteFile(path, set, 0o600))
+		k := NewFileJWKS(path)
+		require.NoError(t, k.Load(ctx))
+
+		require.NoError(t, os.WriteFile(path, []byte(`{"keys":[]}`), 0o600))
+		assert.ErrorIs(t, k.Load(ctx), ErrNoSigningKeys)
+		assert.Len(t, k.Lookup(""), 2)
+	})
+
+	t.Run("reports fetch errors", func(t *testing.T) {
+		srv := httptest.NewServer(http.NotFoundHandler())
+		defer srv.Close()
+		assert.ErrorContains(t, NewURLJWKS(srv.URL).Load(ctx), "unexpected status 404")
+		assert.ErrorContains(t, NewFileJWKS(filepath.Join(t.TempDir(), "missing.json")).Load(ctx), "read jwks file")
+	})
+}
@@ -0,0 +1,17 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, key *APIKey) error
+
+	FindByHash(ctx context.Context, hash []byte) (*APIKey, error)
+
+	List(ctx context.Context) ([]*APIKey, error)
+
+	Revoke(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type Service struct {
+	repo Repository
+}
+
+type ServiceOption func(*Service)
+
+func WithRepository(r Repository) ServiceOption {
+	return func(s *Service) { s.repo = r }
+}
+
+func NewService(opts ...ServiceOption) (*Service, error) {
+	s := &Service{}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.repo == nil {
+		return nil, errors.New("auth: repository is required")
+	}
+	return s, nil
+}
+
+func (s *Service) Authenticate(ctx context.Context, rawKey string) (*Principal, error) {
+	if !strings.HasPrefix(rawKey, KeyPrefix) {
+		return nil, ErrUnauthenticated
+	}
+	key, err := s.repo.FindByHash(ctx, HashKey(rawKey))
+	if errors.Is(err, ErrKeyNotFound) {
+		return nil, ErrUnauthenticated
+	}
+	if err != nil {
+		return nil, err
+	}
+	return key.Principal(), nil
+}
+
+func (s *Service) CreateKey(ctx context.Context, name string, role Role) (*APIKey, string, error) {
+	key, raw, err := NewAPIKey(name, role)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := s.repo.Create(ctx, key); err != nil {
+		return nil, "", err
+	}
+	return key, raw, nil
+}
+
+func (s *Service) ListKeys(ctx context.Context) ([]*APIKey, error) {
+	return s.repo.List(ctx)
+}
+
+func (s *Service) RevokeKey(ctx context.Context, id uuid.UUID) error {
+	return s.repo.Revoke(ctx, id)
+}
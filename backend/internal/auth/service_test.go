@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRepository struct {
+	keys    []*APIKey
+	findErr error
+}
+
+func (m *mockRepository) Create(ctx context.Context, key *APIKey) error {
+	m.keys = append(m.keys, key)
+	return nil
+}
+
+func (m *mockRepository) FindByHash(ctx context.Context, hash []byte) (*APIKey, error) {
+	if m.findErr != nil {
+		return nil, m.findErr
+	}
+	for _, k := range m.keys {
+		if bytes.Equal(k.Hash, hash) && k.RevokedAt == nil {
+			return k, nil
+		}
+	}
+	return nil, ErrKeyNotFound
+}
+
+func (m *mockRepository) List(ctx context.Context) ([]*APIKey, error) {
+	return m.keys, nil
+}
+
+func (m *mockRepository) Revoke(ctx context.Context, id uuid.UUID) error {
+	return ErrKeyNotFound
+}
+
+func TestNewService(t *testing.T) {
+	svc, err := NewService()
+	assert.Nil(t, svc)
+	assert.ErrorContains(t, err, "repository is required")
+}
+
+func TestService_Authenticate(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockRepository{}
+	svc, err := NewService(WithRepository(repo))
+	require.NoError(t, err)
+
+	key, raw, err := svc.CreateKey(ctx, "dashboard", RoleViewer)
+	require.NoError(t, err)
+	require.Len(t, repo.keys, 1)
+
+	t.Run("resolves a valid key", func(t *testing.T) {
+		p, err := svc.Authenticate(ctx, raw)
+		require.NoError(t, err)
+		assert.Equal(t, RoleViewer, p.Role)
+		assert.Equal(t, "api_key:"+key.ID.String(), p.Subject)
+	})
+
+	t.Run("rejects unknown and malformed keys", func(t *testing.T) {
+		for _, k := range []string{"", "not-a-key", KeyPrefix + "deadbeef"} {
+			_, err := svc.Authenticate(ctx, k)
+			assert.ErrorIs(t, err, ErrUnauthenticated, k)
+		}
+	})
+
+	t.Run("propagates repository errors", func(t *testing.T) {
+		repo.findErr = errors.New("db down")
+		defer func() { repo.findErr = nil }()
+		_, err := svc.Authenticate(ctx, raw)
+		assert.ErrorContains(t, err, "db down")
+		assert.NotErrorIs(t, err, ErrUnauthenticated)
+	})
+}
@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type DB interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+type Store struct {
+	db DB
+}
+
+func NewStore(db DB) *Store {
+	if db == nil {
+		return nil
+	}
+	return &Store{db: db}
+}
+
+func (s *Store) Create(ctx context.Context, key *APIKey) error {
+	const query = `
+		INSERT INTO api_keys (id, name, prefix, key_hash, role, created_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+		RETURNING created_at
+	`
+	err := s.db.QueryRow(ctx, query, key.ID, key.Name, key.Prefix, key.Hash, key.Role).Scan(&key.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("create api key %s: %w", key.ID, err)
+	}
+	return nil
+}
+
+func (s *Store) FindByHash(ctx context.Context, hash []byte) (*APIKey, error) {
+	const query = `
+		SELECT id, name, prefix, key_hash, role, created_at, revoked_at
+		FROM api_keys
+		WHERE key_hash = $1 AND revoked_at IS NULL
+	`
+	var k APIKey
+	err := s.db.QueryRow(ctx, query, hash).Scan(&k.ID, &k.Name, &k.Prefix, &k.Hash, &k.Role, &k.CreatedAt, &k.RevokedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("find api key: %w", err)
+	}
+	return &k, nil
+}
+
+func (s *Store) List(ctx context.Context) ([]*APIKey, error) {
+	const query = `
+		SELECT id, name, prefix, key_hash, role, created_at, revoked_at
+		FROM api_keys
+		ORDER BY created_at ASC, id ASC
+	`
+	rows, err := s.db.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("list api keys: %w", err)
+	}
+	defer rows.Close()
+
+	keys := []*APIKey{}
+	for rows.Next() {
+		var k APIKey
+		if err := rows.Scan(&k.ID, &k.Name, &k.Prefix, &k.Hash, &k.Role, &k.CreatedAt, &k.RevokedAt); err != nil {
+			return nil, fmt.Errorf("scan api key row: %w", err)
+		}
+		keys = append(keys, &k)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate api key rows: %w", err)
+	}
+	return keys, nil
+}
+
+func (s *Store) Revoke(ctx context.Context, id uuid.UUID) error {
+	tag, err := s.db.Exec(ctx, `UPDATE api_keys SET revoked_at = NOW() WHERE id = $1 AND revoked_at IS NULL`, id)
+	if err != nil {
+		return fmt.Errorf("revoke api key %s: %w", id, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrKeyNotFound
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arowden/augment-fund/internal/auth"
+	"github.com/arowden/augment-fund/internal/postgres"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx := context.Background()
+	tc, err := postgres.NewTestContainer(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() { tc.Cleanup(ctx) })
+
+	svc, err := auth.NewService(auth.WithRepository(auth.NewStore(tc.Pool())))
+	require.NoError(t, err)
+
+	t.Run("authenticates until revoked", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		key, raw, err := svc.CreateKey(ctx, "ops", auth.RoleAdmin)
+		require.NoError(t, err)
+
+		p, err := svc.Authenticate(ctx, raw)
+		require.NoError(t, err)
+		assert.Equal(t, auth.RoleAdmin, p.Role)
+		assert.Equal(t, "ops", p.Name)
+
+		keys, err := svc.ListKeys(ctx)
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		assert.Equal(t, key.Prefix, keys[0].Prefix)
+		assert.Nil(t, keys[0].RevokedAt)
+
+		require.NoError(t, svc.RevokeKey(ctx, key.ID))
+		assert.ErrorIs(t, svc.RevokeKey(ctx, key.ID), auth.ErrKeyNotFound)
+
+		_, err = svc.Authenticate(ctx, raw)
+		assert.ErrorIs(t, err, auth.ErrUnauthenticated)
+
+		keys, err = svc.ListKeys(ctx)
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		assert.NotNil(t, keys[0].RevokedAt)
+	})
+}
@@ -0,0 +1,161 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/google/uuid"
+)
+
+var signatureAlgorithms = []jose.SignatureAlgorithm{jose.RS256, jose.ES256}
+
+type KeySource interface {
+	Lookup(kid string) []jose.JSONWebKey
+}
+
+type TokenVerifier struct {
+	keys       KeySource
+	issuer     string
+	audience   string
+	leeway     time.Duration
+	roleClaim  string
+	fundsClaim string
+	now        func() time.Time
+}
+
+type TokenVerifierOption func(*TokenVerifier)
+
+func WithIssuer(iss string) TokenVerifierOption {
+	return func(v *TokenVerifier) { v.issuer = iss }
+}
+
+func WithAudience(aud string) TokenVerifierOption {
+	return func(v *TokenVerifier) { v.audience = aud }
+}
+
+func WithLeeway(d time.Duration) TokenVerifierOption {
+	return func(v *TokenVerifier) { v.leeway = d }
+}
+
+func WithRoleClaim(name string) TokenVerifierOption {
+	return func(v *TokenVerifier) { v.roleClaim = name }
+}
+
+func WithFundsClaim(name string) TokenVerifierOption {
+	return func(v *TokenVerifier) { v.fundsClaim = name }
+}
+
+func WithClock(now func() time.Time) TokenVerifierOption {
+	return func(v *TokenVerifier) { v.now = now }
+}
+
+func NewTokenVerifier(keys KeySource, opts ...TokenVerifierOption) (*TokenVerifier, error) {
+	v := &TokenVerifier{
+		keys:       keys,
+		leeway:     jwt.DefaultLeeway,
+		roleClaim:  "role",
+		fundsClaim: "funds",
+		now:        time.Now,
+	}
+	for _, opt := range opts {
+		opt(v)
+	}
+	if v.keys == nil {
+		return nil, errors.New("auth: key source is required")
+	}
+	if v.issuer == "" || v.audience == "" {
+		return nil, errors.New("auth: issuer and audience are required")
+	}
+	return v, nil
+}
+
+func (v *TokenVerifier) Authenticate(ctx context.Context, raw string) (*Principal, error) {
+	tok, err := jwt.ParseSigned(raw, signatureAlgorithms)
+	if err != nil {
+		return nil, invalidToken("malformed or unsupported token")
+	}
+	header := tok.Headers[0]
+
+	var (
+		claims   jwt.Claims
+		custom   map[string]any
+		verified bool
+	)
+	for _, key := range v.keys.Lookup(header.KeyID) {
+		if key.Algorithm != "" && key.Algorithm != header.Algorithm {
+			continue
+		}
+		if err := tok.Claims(key.Key, &claims, &custom); err == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, invalidToken("signature does not match a configured key")
+	}
+
+	if claims.Expiry == nil {
+		return nil, invalidToken("token has no exp claim")
+	}
+	expected := jwt.Expected{Issuer: v.issuer, AnyAudience: jwt.Audience{v.audience}, Time: v.now()}
+	if err := claims.ValidateWithLeeway(expected, v.leeway); err != nil {
+		switch {
+		case errors.Is(err, jwt.ErrExpired):
+			return nil, invalidToken("token is expired")
+		case errors.Is(err, jwt.ErrInvalidIssuer):
+			return nil, invalidToken("token issuer is not accepted")
+		case errors.Is(err, jwt.ErrInvalidAudience):
+			return nil, invalidToken("token audience is not accepted")
+		default:
+			return nil, invalidToken("token is not valid yet")
+		}
+	}
+	if claims.Subject == "" {
+		return nil, invalidToken("token has no sub claim")
+	}
+
+	return v.principal(claims, custom)
+}
+
+func (v *TokenVerifier) principal(claims jwt.Claims, custom map[string]any) (*Principal, error) {
+	p := &Principal{Subject: "jwt:" + claims.Subject, Name: claims.Subject}
+	if name, ok := custom["name"].(string); ok && name != "" {
+		p.Name = name
+	}
+
+	if raw, ok := custom[v.roleClaim]; ok {
+		s, _ := raw.(string)
+		role, err := ParseRole(s)
+		if err != nil {
+			return nil, invalidToken(fmt.Sprintf("%s claim is not a known role", v.roleClaim))
+		}
+		p.Role = role
+	}
+
+	if raw, ok := custom[v.fundsClaim]; ok {
+		grants, ok := raw.(map[string]any)
+		if !ok {
+			return nil, invalidToken(fmt.Sprintf("%s claim must map fund IDs to roles", v.fundsClaim))
+		}
+		p.Funds = make(map[uuid.UUID]Role, len(grants))
+		for id, r := range grants {
+			fundID, err := uuid.Parse(id)
+			s, _ := r.(string)
+			role, roleErr := ParseRole(s)
+			if err != nil || roleErr != nil {
+				return nil, invalidToken(fmt.Sprintf("%s claim must map fund IDs to roles", v.fundsClaim))
+			}
+			p.Funds[fundID] = role
+		}
+	}
+
+	return p, nil
+}
+
+func invalidToken(reason string) error {
+	return fmt.Errorf("%w: %s", ErrUnauthenticated, reason)
+}
@@ -0,0 +1,174 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testIssuer   = "https://sso.example.com"
+	testAudience = "augment-fund"
+)
+
+type staticKeys []jose.JSONWebKey
+
+func (s staticKeys) Lookup(kid string) []jose.JSONWebKey {
+	set := jose.JSONWebKeySet{Keys: s}
+	if kid == "" {
+		return s
+	}
+	return set.Key(kid)
+}
+
+type signingKey struct {
+	kid string
+	alg jose.SignatureAlgorithm
+	key any
+}
+
+func newRSAKey(t *testing.T, kid string) signingKey {
+	t.Helper()
+	k, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return signingKey{kid: kid, alg: jose.RS256, key: k}
+}
+
+func newECKey(t *testing.T, kid string) signingKey {
+	t.Helper()
+	k, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return signingKey{kid: kid, alg: jose.ES256, key: k}
+}
+
+func (s signingKey) public() jose.JSONWebKey {
+	jwk := jose.JSONWebKey{Key: s.key, KeyID: s.kid, Algorithm: string(s.alg), Use: "sig"}
+	return jwk.Public()
+}
+
+func (s signingKey) sign(t *testing.T, claims jwt.Claims, custom map[string]any) string {
+	t.Helper()
+	opts := (&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", s.kid)
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: s.alg, Key: s.key}, opts)
+	require.NoError(t, err)
+	token, err := jwt.Signed(signer).Claims(claims).Claims(custom).Serialize()
+	require.NoError(t, err)
+	return token
+}
+
+func validClaims(now time.Time) jwt.Claims {
+	return jwt.Claims{
+		Issuer:   testIssuer,
+		Audience: jwt.Audience{testAudience},
+		Subject:  "alice@example.com",
+		Expiry:   jwt.NewNumericDate(now.Add(time.Hour)),
+		IssuedAt: jwt.NewNumericDate(now),
+	}
+}
+
+func TestNewTokenVerifier(t *testing.T) {
+	_, err := NewTokenVerifier(nil, WithIssuer(testIssuer), WithAudience(testAudience))
+	assert.ErrorContains(t, err, "key source is required")
+
+	_, err = NewTokenVerifier(staticKeys{}, WithIssuer(testIssuer))
+	assert.ErrorContains(t, err, "issuer and audience are required")
+}
+
+func TestTokenVerifier_Authenticate(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	rsaKey := newRSAKey(t, "rsa-1")
+	ecKey := newECKey(t, "ec-1")
+	fundA := uuid.New()
+
+	v, err := NewTokenVerifier(staticKeys{rsaKey.public(), ecKey.public()},
+		WithIssuer(testIssuer),
+		WithAudience(testAudience),
+		WithLeeway(0),
+		WithClock(func() time.Time { return now }),
+	)
+	require.NoError(t, err)
+
+	t.Run("accepts RS256 tokens with a global role", func(t *testing.T) {
+		token := rsaKey.sign(t, validClaims(now), map[string]any{"role": "admin", "name": "Alice"})
+		p, err := v.Authenticate(ctx, token)
+		require.NoError(t, err)
+		assert.Equal(t, "jwt:alice@example.com", p.Subject)
+		assert.Equal(t, "Alice", p.Name)
+		assert.Equal(t, RoleAdmin, p.Role)
+	})
+
+	t.Run("accepts ES256 tokens with fund-scoped roles", func(t *testing.T) {
+		token := ecKey.sign(t, validClaims(now), map[string]any{"funds": map[string]string{fundA.String(): "operator"}})
+		p, err := v.Authenticate(ctx, token)
+		require.NoError(t, err)
+		assert.Empty(t, p.Role)
+		assert.True(t, p.CanOnFund(PermissionCreateTransfers, fundA))
+		assert.False(t, p.CanOnFund(PermissionCreateTransfers, uuid.New()))
+		assert.False(t, p.Can(PermissionReadCapTable))
+	})
+
+	rejects := []struct {
+		name   string
+		token  func() string
+		reason string
+	}{
+		{"expired", func() string {
+			c := validClaims(now)
+			c.Expiry = jwt.NewNumericDate(now.Add(-time.Minute))
+			return rsaKey.sign(t, c, nil)
+		}, "expired"},
+		{"missing exp", func() string {
+			c := validClaims(now)
+			c.Expiry = nil
+			return rsaKey.sign(t, c, nil)
+		}, "no exp"},
+		{"wrong issuer", func() string {
+			c := validClaims(now)
+			c.Issuer = "https://evil.example.com"
+			return rsaKey.sign(t, c, nil)
+		}, "issuer"},
+		{"wrong audience", func() string {
+			c := validClaims(now)
+			c.Audience = jwt.Audience{"another-service"}
+			return rsaKey.sign(t, c, nil)
+		}, "audience"},
+		{"unknown signing key", func() string {
+			return newRSAKey(t, "rsa-1").sign(t, validClaims(now), nil)
+		}, "signature"},
+		{"unknown kid", func() string {
+			return newECKey(t, "ec-2").sign(t, validClaims(now), nil)
+		}, "signature"},
+		{"HS256", func() string {
+			signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.HS256, Key: []byte("0123456789abcdef0123456789abcdef")}, nil)
+			require.NoError(t, err)
+			token, err := jwt.Signed(signer).Claims(validClaims(now)).Serialize()
+			require.NoError(t, err)
+			return token
+		}, "unsupported"},
+		{"garbage", func() string { return "not.a.jwt" }, "malformed"},
+		{"unknown role", func() string {
+			return rsaKey.sign(t, validClaims(now), map[string]any{"role": "root"})
+		}, "role claim"},
+		{"malformed funds claim", func() string {
+			return rsaKey.sign(t, validClaims(now), map[string]any{"funds": map[string]string{"fund-a": "viewer"}})
+		}, "funds claim"},
+	}
+	for _, tt := range rejects {
+		t.Run("rejects "+tt.name, func(t *testing.T) {
+			_, err := v.Authenticate(ctx, tt.token())
+			assert.ErrorIs(t, err, ErrUnauthenticated)
+			assert.ErrorContains(t, err, tt.reason)
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package commitment
+
+import (
+	"bytes"
+	"math/bits"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/arowden/augment-fund/internal/validation"
+	"github.com/google/uuid"
+)
+
+type ListParams = validation.ListParams
+
+type Commitment struct {
+	ID           uuid.UUID
+	FundID       uuid.UUID
+	OwnerID      uuid.UUID
+	OwnerName    string
+	Amount       int64
+	CalledAmount int64
+	Units        int
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (c *Commitment) Unfunded() int64 {
+	return c.Amount - c.CalledAmount
+}
+
+type Request struct {
+	FundID  uuid.UUID
+	Owner   string
+	OwnerID *uuid.UUID
+	Amount  int64
+}
+
+func (r Request) Validate() error {
+	name := strings.TrimSpace(r.Owner)
+	if utf8.RuneCountInString(name) > validation.MaxNameLength || (r.OwnerID == nil && name == "") {
+		return ErrInvalidOwner
+	}
+	if r.Amount <= 0 {
+		return ErrInvalidAmount
+	}
+	return nil
+}
+
+type List struct {
+	Items      []*Commitment
+	Total      int
+	Limit      int
+	Offset     int
+	NextCursor string
+}
+
+type CapitalCall struct {
+	ID          uuid.UUID
+	FundID      uuid.UUID
+	TotalAmount int64
+	Investors   int
+	CreatedAt   time.Time
+	LineItems   []*LineItem
+}
+
+type LineItem struct {
+	OwnerID    uuid.UUID
+	OwnerName  string
+	Commitment int64
+	Amount     int64
+	Unfunded   int64
+}
+
+type CallRequest struct {
+	FundID      uuid.UUID
+	TotalAmount int64
+}
+
+func (r CallRequest) Validate() error {
+	if r.TotalAmount <= 0 {
+		return ErrInvalidAmount
+	}
+	return nil
+}
+
+type CallList struct {
+	Items      []*CapitalCall
+	Total      int
+	Limit      int
+	Offset     int
+	NextCursor string
+}
+
+func Allocate(amount int64, commitments []*Commitment) ([]*LineItem, error) {
+	if amount <= 0 {
+		return nil, ErrInvalidAmount
+	}
+
+	var open []*Commitment
+	var unfunded int64
+	for _, c := range commitments {
+		if c.Unfunded() > 0 {
+			open = append(open, c)
+			unfunded += c.Unfunded()
+		}
+	}
+	if len(open) == 0 {
+		return nil, ErrNoUnfundedCommitments
+	}
+	if amount > unfunded {
+		return nil, CallExceedsUnfundedError(unfunded)
+	}
+
+	items := make([]*LineItem, len(open))
+	for i, c := range open {
+		items[i] = &LineItem{OwnerID: c.OwnerID, OwnerName: c.OwnerName, Commitment: c.Amount, Unfunded: c.Unfunded()}
+	}
+
+	pending := make([]*LineItem, len(items))
+	copy(pending, items)
+	remaining := amount
+	for len(pending) > 0 {
+		shares := prorate(remaining, pending)
+		var uncapped []*LineItem
+		for i, item := range pending {
+			if shares[i] > item.Unfunded {
+				item.Amount = item.Unfunded
+				remaining -= item.Unfunded
+				continue
+			}
+			uncapped = append(uncapped, item)
+		}
+		if len(uncapped) == len(pending) {
+			for i, item := range pending {
+				item.Amount = shares[i]
+			}
+			break
+		}
+		pending = uncapped
+	}
+
+	for _, item := range items {
+		item.Unfunded -= item.Amount
+	}
+	sortLineItems(items)
+	return items, nil
+}
+
+func prorate(amount int64, items []*LineItem) []int64 {
+	var total uint64
+	for _, item := range items {
+		total += uint64(item.Commitment)
+	}
+
+	shares := make([]int64, len(items))
+	remainders := make([]uint64, len(items))
+	order := make([]int, len(items))
+	allocated := int64(0)
+	for i, item := range items {
+		hi, lo := bits.Mul64(uint64(amount), uint64(item.Commitment))
+		quo, rem := bits.Div64(hi, lo, total)
+		shares[i], remainders[i], order[i] = int64(quo), rem, i
+		allocated += shares[i]
+	}
+
+	sort.Slice(order, func(i, j int) bool {
+		a, b := order[i], order[j]
+		if remainders[a] != remainders[b] {
+			return remainders[a] > remainders[b]
+		}
+		if items[a].Commitment != items[b].Commitment {
+			return items[a].Commitment > items[b].Commitment
+		}
+		return bytes.Compare(items[a].OwnerID[:], items[b].OwnerID[:]) < 0
+	})
+	for i := int64(0); i < amount-allocated; i++ {
+		shares[order[i]]++
+	}
+	return shares
+}
+
+func sortLineItems(items []*LineItem) {
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Amount != items[j].Amount {
+			return items[i].Amount > items[j].Amount
+		}
+		if items[i].Commitment != items[j].Commitment {
+			return items[i].Commitment > items[j].Commitment
+		}
+		return bytes.Compare(items[i].OwnerID[:], items[j].OwnerID[:]) < 0
+	})
+}
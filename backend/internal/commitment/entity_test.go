@@ -0,0 +1,126 @@
+package commitment
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sumAmounts(items []*LineItem) int64 {
+	var sum int64
+	for _, item := range items {
+		sum += item.Amount
+	}
+	return sum
+}
+
+func amountsByName(items []*LineItem) map[string]int64 {
+	out := make(map[string]int64, len(items))
+	for _, item := range items {
+		out[item.OwnerName] = item.Amount
+	}
+	return out
+}
+
+func commitmentOf(name string, amount, called int64) *Commitment {
+	return &Commitment{ID: uuid.New(), OwnerID: uuid.New(), OwnerName: name, Amount: amount, CalledAmount: called}
+}
+
+func TestAllocate(t *testing.T) {
+	t.Run("splits the call pro rata to commitment", func(t *testing.T) {
+		items, err := Allocate(1000, []*Commitment{
+			commitmentOf("Alice", 6000, 0),
+			commitmentOf("Bob", 4000, 0),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int64{"Alice": 600, "Bob": 400}, amountsByName(items))
+		assert.Equal(t, int64(5400), items[0].Unfunded)
+		assert.Equal(t, int64(6000), items[0].Commitment)
+	})
+
+	t.Run("hands leftover amounts to the largest remainders", func(t *testing.T) {
+		items, err := Allocate(100, []*Commitment{
+			commitmentOf("A", 1000, 0),
+			commitmentOf("B", 2000, 0),
+			commitmentOf("C", 4000, 0),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int64{"A": 14, "B": 29, "C": 57}, amountsByName(items))
+	})
+
+	t.Run("caps investors at their unfunded commitment and reallocates the rest", func(t *testing.T) {
+		items, err := Allocate(900, []*Commitment{
+			commitmentOf("Early", 1000, 900),
+			commitmentOf("Alice", 1000, 0),
+			commitmentOf("Bob", 2000, 0),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int64{"Early": 100, "Alice": 267, "Bob": 533}, amountsByName(items))
+		assert.Equal(t, int64(900), sumAmounts(items))
+		for _, item := range items {
+			assert.GreaterOrEqual(t, item.Unfunded, int64(0))
+		}
+	})
+
+	t.Run("calls every unfunded amount when the call equals it", func(t *testing.T) {
+		items, err := Allocate(1500, []*Commitment{
+			commitmentOf("Alice", 1000, 500),
+			commitmentOf("Bob", 1000, 0),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int64{"Alice": 500, "Bob": 1000}, amountsByName(items))
+		for _, item := range items {
+			assert.Zero(t, item.Unfunded)
+		}
+	})
+
+	t.Run("skips fully called commitments", func(t *testing.T) {
+		items, err := Allocate(10, []*Commitment{
+			commitmentOf("Funded", 1000, 1000),
+			commitmentOf("Alice", 1000, 0),
+		})
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.Equal(t, "Alice", items[0].OwnerName)
+	})
+
+	t.Run("handles commitments whose products overflow 64 bits", func(t *testing.T) {
+		items, err := Allocate(math.MaxInt64/2, []*Commitment{
+			commitmentOf("Alice", math.MaxInt64/2, 0),
+			commitmentOf("Bob", math.MaxInt64/2, 0),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(math.MaxInt64/2), sumAmounts(items))
+	})
+
+	t.Run("rejects a call larger than the unfunded commitments", func(t *testing.T) {
+		_, err := Allocate(501, []*Commitment{commitmentOf("Alice", 1000, 500)})
+		assert.ErrorIs(t, err, ErrCallExceedsUnfunded)
+		assert.ErrorContains(t, err, "500 unfunded")
+	})
+
+	t.Run("rejects a fund without unfunded commitments", func(t *testing.T) {
+		_, err := Allocate(1, []*Commitment{commitmentOf("Alice", 1000, 1000)})
+		assert.ErrorIs(t, err, ErrNoUnfundedCommitments)
+		_, err = Allocate(1, nil)
+		assert.ErrorIs(t, err, ErrNoUnfundedCommitments)
+	})
+
+	t.Run("rejects non-positive amounts", func(t *testing.T) {
+		_, err := Allocate(0, []*Commitment{commitmentOf("Alice", 1000, 0)})
+		assert.ErrorIs(t, err, ErrInvalidAmount)
+	})
+}
+
+func TestRequest_Validate(t *testing.T) {
+	id := uuid.New()
+
+	assert.NoError(t, Request{Owner: "Alice", Amount: 1}.Validate())
+	assert.NoError(t, Request{OwnerID: &id, Amount: 1}.Validate())
+	assert.ErrorIs(t, Request{Owner: "  ", Amount: 1}.Validate(), ErrInvalidOwner)
+	assert.ErrorIs(t, Request{Owner: "Alice", Amount: 0}.Validate(), ErrInvalidAmount)
+	assert.ErrorIs(t, CallRequest{TotalAmount: -1}.Validate(), ErrInvalidAmount)
+}
@@ -0,0 +1,47 @@
+package commitment
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/arowden/augment-fund/internal/fundstatus"
+	"github.com/arowden/augment-fund/internal/validation"
+	"github.com/google/uuid"
+)
+
+var ErrCapitalCallNotFound = errors.New("capital call not found")
+
+var ErrFundNotFound = errors.New("fund not found")
+
+var ErrFundNotOpen = errors.New("fund is not open")
+
+var ErrInvalidAmount = errors.New("amount must be a positive number of minor currency units")
+
+var ErrInvalidOwner = fmt.Errorf("owner must be given by ID or by a non-empty name (max %d chars)", validation.MaxNameLength)
+
+var ErrOwnerNotFound = errors.New("owner not found")
+
+var ErrOwnerConflict = errors.New("owner cannot be resolved unambiguously in this fund")
+
+var ErrDuplicateCommitment = errors.New("owner already has a commitment to this fund")
+
+var ErrCommitmentLimit = fmt.Errorf("a fund's commitments cannot total more than %d", int64(math.MaxInt64))
+
+var ErrNoUnfundedCommitments = errors.New("fund has no unfunded commitments to call")
+
+var ErrCallExceedsUnfunded = errors.New("capital call exceeds the fund's unfunded commitments")
+
+var ErrPoolRequired = errors.New("commitment: database pool is required for transactional operations")
+
+func CapitalCallNotFoundError(id uuid.UUID) error {
+	return fmt.Errorf("capital call %s: %w", id, ErrCapitalCallNotFound)
+}
+
+func NotOpenError(status fundstatus.Status) error {
+	return fmt.Errorf("%w (%s)", ErrFundNotOpen, status)
+}
+
+func CallExceedsUnfundedError(unfunded int64) error {
+	return fmt.Errorf("%w (%d unfunded)", ErrCallExceedsUnfunded, unfunded)
+}
@@ -0,0 +1,31 @@
+package commitment
+
+import (
+	"context"
+
+	"github.com/arowden/augment-fund/internal/fundstatus"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type Repository interface {
+	LockFundTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID) (fundstatus.Status, error)
+
+	TotalCommittedTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID) (int64, error)
+
+	CreateTx(ctx context.Context, tx pgx.Tx, c *Commitment) error
+
+	FindByOwnerTx(ctx context.Context, tx pgx.Tx, fundID, ownerID uuid.UUID) (*Commitment, error)
+
+	ListForUpdateTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID) ([]*Commitment, error)
+
+	List(ctx context.Context, fundID uuid.UUID, params ListParams) (*List, error)
+
+	CreateCallTx(ctx context.Context, tx pgx.Tx, call *CapitalCall) error
+
+	FindCall(ctx context.Context, fundID, id uuid.UUID) (*CapitalCall, error)
+
+	ListCalls(ctx context.Context, fundID uuid.UUID, params ListParams) (*CallList, error)
+
+	ListCallLineItems(ctx context.Context, id uuid.UUID) ([]*LineItem, error)
+}
@@ -0,0 +1,246 @@
+package commitment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/arowden/augment-fund/internal/audit"
+	"github.com/arowden/augment-fund/internal/fundstatus"
+	"github.com/arowden/augment-fund/internal/owner"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Service struct {
+	repo      Repository
+	ownerRepo owner.Repository
+	pool      *pgxpool.Pool
+	audit     audit.Writer
+	now       func() time.Time
+}
+
+type ServiceOption func(*Service)
+
+func WithRepository(r Repository) ServiceOption {
+	return func(s *Service) { s.repo = r }
+}
+
+func WithOwnerRepository(r owner.Repository) ServiceOption {
+	return func(s *Service) { s.ownerRepo = r }
+}
+
+func WithPool(p *pgxpool.Pool) ServiceOption {
+	return func(s *Service) { s.pool = p }
+}
+
+func WithAudit(w audit.Writer) ServiceOption {
+	return func(s *Service) { s.audit = w }
+}
+
+func WithClock(now func() time.Time) ServiceOption {
+	return func(s *Service) { s.now = now }
+}
+
+func NewService(opts ...ServiceOption) (*Service, error) {
+	s := &Service{now: time.Now}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.repo == nil {
+		return nil, errors.New("commitment: repository is required")
+	}
+	if s.ownerRepo == nil {
+		return nil, errors.New("commitment: owner repository is required")
+	}
+	return s, nil
+}
+
+func (s *Service) CreateCommitment(ctx context.Context, req Request) (*Commitment, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if s.pool == nil {
+		return nil, ErrPoolRequired
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := s.lockOpenFund(ctx, tx, req.FundID); err != nil {
+		return nil, err
+	}
+
+	o, err := s.resolveOwner(ctx, tx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	committed, err := s.repo.TotalCommittedTx(ctx, tx, req.FundID)
+	if err != nil {
+		return nil, err
+	}
+	if committed > math.MaxInt64-req.Amount {
+		return nil, ErrCommitmentLimit
+	}
+
+	now := s.now().UTC().Truncate(time.Microsecond)
+	c := &Commitment{
+		ID:        uuid.New(),
+		FundID:    req.FundID,
+		OwnerID:   o.ID,
+		Amount:    req.Amount,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.repo.CreateTx(ctx, tx, c); err != nil {
+		return nil, err
+	}
+	c, err = s.repo.FindByOwnerTx(ctx, tx, req.FundID, o.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.audit != nil {
+		record := audit.NewEvent(ctx, audit.OperationCommitmentCreate).ForFund(c.FundID)
+		record.Details = map[string]any{
+			"commitmentId":   c.ID,
+			"ownerId":        c.OwnerID,
+			"amount":         c.Amount,
+			"totalCommitted": committed + c.Amount,
+		}
+		if err := s.audit.AppendTx(ctx, tx, record); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+
+	return c, nil
+}
+
+func (s *Service) ListCommitments(ctx context.Context, fundID uuid.UUID, params ListParams) (*List, error) {
+	return s.repo.List(ctx, fundID, params)
+}
+
+func (s *Service) CreateCapitalCall(ctx context.Context, req CallRequest) (*CapitalCall, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if s.pool == nil {
+		return nil, ErrPoolRequired
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := s.lockOpenFund(ctx, tx, req.FundID); err != nil {
+		return nil, err
+	}
+
+	commitments, err := s.repo.ListForUpdateTx(ctx, tx, req.FundID)
+	if err != nil {
+		return nil, err
+	}
+	items, err := Allocate(req.TotalAmount, commitments)
+	if err != nil {
+		return nil, err
+	}
+
+	call := &CapitalCall{
+		ID:          uuid.New(),
+		FundID:      req.FundID,
+		TotalAmount: req.TotalAmount,
+		Investors:   len(items),
+		CreatedAt:   s.now().UTC().Truncate(time.Microsecond),
+		LineItems:   items,
+	}
+	if err := s.repo.CreateCallTx(ctx, tx, call); err != nil {
+		return nil, err
+	}
+
+	if s.audit != nil {
+		var unfunded int64
+		for _, item := range items {
+			unfunded += item.Unfunded
+		}
+		record := audit.NewEvent(ctx, audit.OperationCapitalCallCreate).ForFund(call.FundID)
+		record.Details = map[string]any{
+			"capitalCallId":  call.ID,
+			"totalAmount":    call.TotalAmount,
+			"investors":      call.Investors,
+			"unfundedBefore": unfunded + call.TotalAmount,
+			"unfundedAfter":  unfunded,
+		}
+		if err := s.audit.AppendTx(ctx, tx, record); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+
+	return call, nil
+}
+
+func (s *Service) ListCapitalCalls(ctx context.Context, fundID uuid.UUID, params ListParams) (*CallList, error) {
+	return s.repo.ListCalls(ctx, fundID, params)
+}
+
+func (s *Service) GetCapitalCall(ctx context.Context, fundID, id uuid.UUID) (*CapitalCall, error) {
+	call, err := s.repo.FindCall(ctx, fundID, id)
+	if err != nil {
+		return nil, err
+	}
+	call.LineItems, err = s.repo.ListCallLineItems(ctx, call.ID)
+	if err != nil {
+		return nil, err
+	}
+	return call, nil
+}
+
+func (s *Service) lockOpenFund(ctx context.Context, tx pgx.Tx, fundID uuid.UUID) error {
+	status, err := s.repo.LockFundTx(ctx, tx, fundID)
+	if err != nil {
+		return err
+	}
+	if status != fundstatus.Open {
+		return NotOpenError(status)
+	}
+	return nil
+}
+
+func (s *Service) resolveOwner(ctx context.Context, tx pgx.Tx, req Request) (*owner.Owner, error) {
+	var (
+		o   *owner.Owner
+		err error
+	)
+	if req.OwnerID != nil {
+		o, err = s.ownerRepo.FindByIDTx(ctx, tx, *req.OwnerID)
+	} else {
+		o, err = s.ownerRepo.ResolveTx(ctx, tx, req.FundID, strings.TrimSpace(req.Owner))
+	}
+	if errors.Is(err, owner.ErrNotFound) {
+		return nil, ErrOwnerNotFound
+	}
+	if errors.Is(err, owner.ErrAmbiguousName) {
+		return nil, fmt.Errorf("%w: %w", ErrOwnerConflict, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("resolve owner: %w", err)
+	}
+	return o, nil
+}
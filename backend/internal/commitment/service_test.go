@@ -0,0 +1,118 @@
+package commitment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arowden/augment-fund/internal/owner"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRepository struct {
+	Repository
+	findCallFunc          func(ctx context.Context, fundID, id uuid.UUID) (*CapitalCall, error)
+	listCallLineItemsFunc func(ctx context.Context, id uuid.UUID) ([]*LineItem, error)
+}
+
+func (m *mockRepository) FindCall(ctx context.Context, fundID, id uuid.UUID) (*CapitalCall, error) {
+	if m.findCallFunc != nil {
+		return m.findCallFunc(ctx, fundID, id)
+	}
+	return nil, CapitalCallNotFoundError(id)
+}
+
+func (m *mockRepository) ListCallLineItems(ctx context.Context, id uuid.UUID) ([]*LineItem, error) {
+	if m.listCallLineItemsFunc != nil {
+		return m.listCallLineItemsFunc(ctx, id)
+	}
+	return []*LineItem{}, nil
+}
+
+type stubOwnerRepository struct {
+	owner.Repository
+}
+
+func newTestService(t *testing.T, repo Repository) *Service {
+	t.Helper()
+	svc, err := NewService(
+		WithRepository(repo),
+		WithOwnerRepository(&stubOwnerRepository{}),
+	)
+	require.NoError(t, err)
+	return svc
+}
+
+func TestNewService(t *testing.T) {
+	t.Run("returns error when repository is nil", func(t *testing.T) {
+		svc, err := NewService(WithOwnerRepository(&stubOwnerRepository{}))
+		assert.Nil(t, svc)
+		assert.ErrorContains(t, err, "repository is required")
+	})
+
+	t.Run("returns error when owner repository is nil", func(t *testing.T) {
+		svc, err := NewService(WithRepository(&mockRepository{}))
+		assert.Nil(t, svc)
+		assert.ErrorContains(t, err, "owner repository is required")
+	})
+
+	t.Run("creates service with repositories", func(t *testing.T) {
+		assert.NotNil(t, newTestService(t, &mockRepository{}))
+	})
+}
+
+func TestService_CreateCommitment(t *testing.T) {
+	svc := newTestService(t, &mockRepository{})
+	fundID := uuid.New()
+
+	t.Run("rejects invalid requests before opening a transaction", func(t *testing.T) {
+		_, err := svc.CreateCommitment(context.Background(), Request{FundID: fundID, Amount: 100})
+		assert.ErrorIs(t, err, ErrInvalidOwner)
+		_, err = svc.CreateCommitment(context.Background(), Request{FundID: fundID, Owner: "Alice", Amount: 0})
+		assert.ErrorIs(t, err, ErrInvalidAmount)
+	})
+
+	t.Run("requires a pool", func(t *testing.T) {
+		_, err := svc.CreateCommitment(context.Background(), Request{FundID: fundID, Owner: "Alice", Amount: 100})
+		assert.ErrorIs(t, err, ErrPoolRequired)
+	})
+}
+
+func TestService_CreateCapitalCall(t *testing.T) {
+	svc := newTestService(t, &mockRepository{})
+	fundID := uuid.New()
+
+	_, err := svc.CreateCapitalCall(context.Background(), CallRequest{FundID: fundID, TotalAmount: 0})
+	assert.ErrorIs(t, err, ErrInvalidAmount)
+
+	_, err = svc.CreateCapitalCall(context.Background(), CallRequest{FundID: fundID, TotalAmount: 100})
+	assert.ErrorIs(t, err, ErrPoolRequired)
+}
+
+func TestService_GetCapitalCall(t *testing.T) {
+	fundID, id := uuid.New(), uuid.New()
+
+	t.Run("loads line items", func(t *testing.T) {
+		items := []*LineItem{{OwnerID: uuid.New(), OwnerName: "Alice", Commitment: 1000, Amount: 100, Unfunded: 900}}
+		svc := newTestService(t, &mockRepository{
+			findCallFunc: func(_ context.Context, _, id uuid.UUID) (*CapitalCall, error) {
+				return &CapitalCall{ID: id, FundID: fundID, TotalAmount: 100, Investors: 1}, nil
+			},
+			listCallLineItemsFunc: func(_ context.Context, _ uuid.UUID) ([]*LineItem, error) {
+				return items, nil
+			},
+		})
+
+		call, err := svc.GetCapitalCall(context.Background(), fundID, id)
+		require.NoError(t, err)
+		assert.Equal(t, items, call.LineItems)
+	})
+
+	t.Run("returns not found", func(t *testing.T) {
+		svc := newTestService(t, &mockRepository{})
+
+		_, err := svc.GetCapitalCall(context.Background(), fundID, id)
+		assert.ErrorIs(t, err, ErrCapitalCallNotFound)
+	})
+}
@@ -0,0 +1,360 @@
+package commitment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/arowden/augment-fund/internal/fundstatus"
+	"github.com/arowden/augment-fund/internal/validation"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	commitmentCursorKind  = "commitments"
+	capitalCallCursorKind = "capital_calls"
+	fundOwnerConstraint   = "commitments_fund_owner_key"
+)
+
+type DB interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+type Store struct {
+	db DB
+}
+
+func NewStore(db DB) *Store {
+	if db == nil {
+		return nil
+	}
+	return &Store{db: db}
+}
+
+func (s *Store) LockFundTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID) (fundstatus.Status, error) {
+	const query = `SELECT status FROM funds WHERE id = $1 FOR UPDATE`
+	var status fundstatus.Status
+	err := tx.QueryRow(ctx, query, fundID).Scan(&status)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", fmt.Errorf("fund %s: %w", fundID, ErrFundNotFound)
+	}
+	if err != nil {
+		return "", fmt.Errorf("lock fund %s: %w", fundID, err)
+	}
+	return status, nil
+}
+
+func (s *Store) TotalCommittedTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID) (int64, error) {
+	const query = `SELECT COALESCE(SUM(amount), 0)::BIGINT FROM commitments WHERE fund_id = $1`
+	var total int64
+	if err := tx.QueryRow(ctx, query, fundID).Scan(&total); err != nil {
+		return 0, fmt.Errorf("sum commitments for fund %s: %w", fundID, err)
+	}
+	return total, nil
+}
+
+func (s *Store) CreateTx(ctx context.Context, tx pgx.Tx, c *Commitment) error {
+	const query = `
+		INSERT INTO commitments (id, fund_id, owner_id, amount, called_amount, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	_, err := tx.Exec(ctx, query, c.ID, c.FundID, c.OwnerID, c.Amount, c.CalledAmount, c.CreatedAt, c.UpdatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == fundOwnerConstraint {
+			return fmt.Errorf("owner %s in fund %s: %w", c.OwnerID, c.FundID, ErrDuplicateCommitment)
+		}
+		return fmt.Errorf("create commitment %s: %w", c.ID, err)
+	}
+	return nil
+}
+
+const commitmentColumns = `c.id, c.fund_id, c.owner_id, o.legal_name, c.amount, c.called_amount, c.created_at, c.updated_at`
+
+const heldUnits = `COALESCE((
+	SELECT SUM(e.units) FROM cap_table_entries e
+	WHERE e.fund_id = c.fund_id AND e.owner_id = c.owner_id AND e.deleted_at IS NULL
+), 0)`
+
+func (c *Commitment) scanTargets() []any {
+	return []any{&c.ID, &c.FundID, &c.OwnerID, &c.OwnerName, &c.Amount, &c.CalledAmount, &c.CreatedAt, &c.UpdatedAt}
+}
+
+func (s *Store) FindByOwnerTx(ctx context.Context, tx pgx.Tx, fundID, ownerID uuid.UUID) (*Commitment, error) {
+	const query = `
+		SELECT ` + commitmentColumns + `, ` + heldUnits + `
+		FROM commitments c
+		JOIN owners o ON o.id = c.owner_id
+		WHERE c.fund_id = $1 AND c.owner_id = $2
+	`
+	var c Commitment
+	if err := tx.QueryRow(ctx, query, fundID, ownerID).Scan(append(c.scanTargets(), &c.Units)...); err != nil {
+		return nil, fmt.Errorf("find commitment of owner %s in fund %s: %w", ownerID, fundID, err)
+	}
+	return &c, nil
+}
+
+func (s *Store) ListForUpdateTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID) ([]*Commitment, error) {
+	const query = `
+		SELECT ` + commitmentColumns + `
+		FROM commitments c
+		JOIN owners o ON o.id = c.owner_id
+		WHERE c.fund_id = $1
+		ORDER BY c.created_at ASC, c.id ASC
+		FOR UPDATE OF c
+	`
+	rows, err := tx.Query(ctx, query, fundID)
+	if err != nil {
+		return nil, fmt.Errorf("lock commitments for fund %s: %w", fundID, err)
+	}
+	defer rows.Close()
+
+	var commitments []*Commitment
+	for rows.Next() {
+		var c Commitment
+		if err := rows.Scan(c.scanTargets()...); err != nil {
+			return nil, fmt.Errorf("scan commitment row: %w", err)
+		}
+		commitments = append(commitments, &c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate commitment rows: %w", err)
+	}
+	return commitments, nil
+}
+
+type pageCursor struct {
+	CreatedAt time.Time `json:"createdAt"`
+	ID        uuid.UUID `json:"id"`
+}
+
+func (s *Store) List(ctx context.Context, fundID uuid.UUID, params ListParams) (*List, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	params = params.Normalize()
+
+	var rows pgx.Rows
+	var err error
+	if params.Cursor != "" {
+		var after pageCursor
+		if err := validation.DecodeCursor(commitmentCursorKind, params.Cursor, &after); err != nil {
+			return nil, err
+		}
+		const query = `
+			SELECT ` + commitmentColumns + `, ` + heldUnits + `, 0 AS total
+			FROM commitments c
+			JOIN owners o ON o.id = c.owner_id
+			WHERE c.fund_id = $1 AND (c.created_at, c.id) > ($2::timestamptz, $3::uuid)
+			ORDER BY c.created_at ASC, c.id ASC
+			LIMIT $4
+		`
+		rows, err = s.db.Query(ctx, query, fundID, after.CreatedAt, after.ID, params.Limit+1)
+	} else {
+		const query = `
+			SELECT ` + commitmentColumns + `, ` + heldUnits + `, COUNT(*) OVER() AS total
+			FROM commitments c
+			JOIN owners o ON o.id = c.owner_id
+			WHERE c.fund_id = $1
+			ORDER BY c.created_at ASC, c.id ASC
+			LIMIT $2 OFFSET $3
+		`
+		rows, err = s.db.Query(ctx, query, fundID, params.Limit+1, params.Offset)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("list commitments for fund %s: %w", fundID, err)
+	}
+	defer rows.Close()
+
+	items := make([]*Commitment, 0, params.Limit+1)
+	var total int
+	for rows.Next() {
+		var c Commitment
+		if err := rows.Scan(append(c.scanTargets(), &c.Units, &total)...); err != nil {
+			return nil, fmt.Errorf("scan commitment row: %w", err)
+		}
+		items = append(items, &c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate commitment rows: %w", err)
+	}
+
+	if len(items) == 0 && params.Offset > 0 {
+		const countQuery = `SELECT COUNT(*) FROM commitments WHERE fund_id = $1`
+		if err := s.db.QueryRow(ctx, countQuery, fundID).Scan(&total); err != nil {
+			return nil, fmt.Errorf("count commitments for fund %s: %w", fundID, err)
+		}
+	}
+
+	items, next, err := validation.NextPage(commitmentCursorKind, items, params.Limit, func(c *Commitment) any {
+		return pageCursor{CreatedAt: c.CreatedAt, ID: c.ID}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &List{
+		Items:      items,
+		Total:      total,
+		Limit:      params.Limit,
+		Offset:     params.Offset,
+		NextCursor: next,
+	}, nil
+}
+
+func (s *Store) CreateCallTx(ctx context.Context, tx pgx.Tx, call *CapitalCall) error {
+	const query = `
+		INSERT INTO capital_calls (id, fund_id, total_amount, investors, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	if _, err := tx.Exec(ctx, query, call.ID, call.FundID, call.TotalAmount, call.Investors, call.CreatedAt); err != nil {
+		return fmt.Errorf("create capital call %s: %w", call.ID, err)
+	}
+
+	const itemQuery = `
+		INSERT INTO capital_call_line_items (capital_call_id, owner_id, owner_name, commitment, amount, unfunded)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	const drawQuery = `
+		UPDATE commitments
+		SET called_amount = called_amount + $3, updated_at = $4
+		WHERE fund_id = $1 AND owner_id = $2
+	`
+	for _, item := range call.LineItems {
+		if _, err := tx.Exec(ctx, itemQuery, call.ID, item.OwnerID, item.OwnerName, item.Commitment, item.Amount, item.Unfunded); err != nil {
+			return fmt.Errorf("create line item for owner %s in capital call %s: %w", item.OwnerID, call.ID, err)
+		}
+		if item.Amount == 0 {
+			continue
+		}
+		if _, err := tx.Exec(ctx, drawQuery, call.FundID, item.OwnerID, item.Amount, call.CreatedAt); err != nil {
+			return fmt.Errorf("draw commitment of owner %s in fund %s: %w", item.OwnerID, call.FundID, err)
+		}
+	}
+	return nil
+}
+
+const callColumns = `id, fund_id, total_amount, investors, created_at`
+
+func (call *CapitalCall) scanTargets() []any {
+	return []any{&call.ID, &call.FundID, &call.TotalAmount, &call.Investors, &call.CreatedAt}
+}
+
+func (s *Store) FindCall(ctx context.Context, fundID, id uuid.UUID) (*CapitalCall, error) {
+	const query = `
+		SELECT ` + callColumns + `
+		FROM capital_calls
+		WHERE fund_id = $1 AND id = $2
+	`
+	var call CapitalCall
+	if err := s.db.QueryRow(ctx, query, fundID, id).Scan(call.scanTargets()...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, CapitalCallNotFoundError(id)
+		}
+		return nil, fmt.Errorf("find capital call %s: %w", id, err)
+	}
+	return &call, nil
+}
+
+func (s *Store) ListCalls(ctx context.Context, fundID uuid.UUID, params ListParams) (*CallList, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	params = params.Normalize()
+
+	var rows pgx.Rows
+	var err error
+	if params.Cursor != "" {
+		var after pageCursor
+		if err := validation.DecodeCursor(capitalCallCursorKind, params.Cursor, &after); err != nil {
+			return nil, err
+		}
+		const query = `
+			SELECT ` + callColumns + `, 0 AS total
+			FROM capital_calls
+			WHERE fund_id = $1 AND (created_at, id) > ($2::timestamptz, $3::uuid)
+			ORDER BY created_at ASC, id ASC
+			LIMIT $4
+		`
+		rows, err = s.db.Query(ctx, query, fundID, after.CreatedAt, after.ID, params.Limit+1)
+	} else {
+		const query = `
+			SELECT ` + callColumns + `, COUNT(*) OVER() AS total
+			FROM capital_calls
+			WHERE fund_id = $1
+			ORDER BY created_at ASC, id ASC
+			LIMIT $2 OFFSET $3
+		`
+		rows, err = s.db.Query(ctx, query, fundID, params.Limit+1, params.Offset)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("list capital calls for fund %s: %w", fundID, err)
+	}
+	defer rows.Close()
+
+	items := make([]*CapitalCall, 0, params.Limit+1)
+	var total int
+	for rows.Next() {
+		var call CapitalCall
+		if err := rows.Scan(append(call.scanTargets(), &total)...); err != nil {
+			return nil, fmt.Errorf("scan capital call row: %w", err)
+		}
+		items = append(items, &call)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate capital call rows: %w", err)
+	}
+
+	if len(items) == 0 && params.Offset > 0 {
+		const countQuery = `SELECT COUNT(*) FROM capital_calls WHERE fund_id = $1`
+		if err := s.db.QueryRow(ctx, countQuery, fundID).Scan(&total); err != nil {
+			return nil, fmt.Errorf("count capital calls for fund %s: %w", fundID, err)
+		}
+	}
+
+	items, next, err := validation.NextPage(capitalCallCursorKind, items, params.Limit, func(call *CapitalCall) any {
+		return pageCursor{CreatedAt: call.CreatedAt, ID: call.ID}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CallList{
+		Items:      items,
+		Total:      total,
+		Limit:      params.Limit,
+		Offset:     params.Offset,
+		NextCursor: next,
+	}, nil
+}
+
+func (s *Store) ListCallLineItems(ctx context.Context, id uuid.UUID) ([]*LineItem, error) {
+	const query = `
+		SELECT owner_id, owner_name, commitment, amount, unfunded
+		FROM capital_call_line_items
+		WHERE capital_call_id = $1
+		ORDER BY amount DESC, commitment DESC, owner_id ASC
+	`
+	rows, err := s.db.Query(ctx, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("list line items for capital call %s: %w", id, err)
+	}
+	defer rows.Close()
+
+	items := []*LineItem{}
+	for rows.Next() {
+		var item LineItem
+		if err := rows.Scan(&item.OwnerID, &item.OwnerName, &item.Commitment, &item.Amount, &item.Unfunded); err != nil {
+			return nil, fmt.Errorf("scan line item row: %w", err)
+		}
+		items = append(items, &item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate line item rows: %w", err)
+	}
+	return items, nil
+}
@@ -0,0 +1,165 @@
+package commitment_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arowden/augment-fund/internal/audit"
+	"github.com/arowden/augment-fund/internal/commitment"
+	"github.com/arowden/augment-fund/internal/fund"
+	"github.com/arowden/augment-fund/internal/owner"
+	"github.com/arowden/augment-fund/internal/ownership"
+	"github.com/arowden/augment-fund/internal/postgres"
+	"github.com/google/uuid"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx := context.Background()
+	tc, err := postgres.NewTestContainer(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() { tc.Cleanup(ctx) })
+
+	ownershipStore := ownership.NewStore(tc.Pool())
+	ownerStore := owner.NewStore(tc.Pool())
+	auditStore := audit.NewStore(tc.Pool())
+	fundService, err := fund.NewService(
+		fund.NewStore(tc.Pool()),
+		fund.WithPool(tc.Pool()),
+		fund.WithOwnershipRepository(ownershipStore),
+		fund.WithOwnerRepository(ownerStore),
+	)
+	require.NoError(t, err)
+	ownershipService, err := ownership.NewService(ownership.WithRepository(ownershipStore))
+	require.NoError(t, err)
+
+	svc, err := commitment.NewService(
+		commitment.WithRepository(commitment.NewStore(tc.Pool())),
+		commitment.WithOwnerRepository(ownerStore),
+		commitment.WithPool(tc.Pool()),
+		commitment.WithAudit(auditStore),
+	)
+	require.NoError(t, err)
+
+	unfunded := func(t *testing.T, fundID uuid.UUID) map[string]int64 {
+		list, err := svc.ListCommitments(ctx, fundID, commitment.ListParams{})
+		require.NoError(t, err)
+		out := make(map[string]int64, len(list.Items))
+		for _, c := range list.Items {
+			out[c.OwnerName] = c.Unfunded()
+		}
+		return out
+	}
+
+	t.Run("CreateCommitment links the investor without a cap table entry", func(t *testing.T) {
+		tc.Reset(ctx)
+		f, err := fundService.CreateFundWithInitialOwner(ctx, "Committed Fund", 1000, "Founder")
+		require.NoError(t, err)
+
+		c, err := svc.CreateCommitment(ctx, commitment.Request{FundID: f.ID, Owner: "Alice", Amount: 5000})
+		require.NoError(t, err)
+		assert.Equal(t, "Alice", c.OwnerName)
+		assert.Equal(t, int64(5000), c.Unfunded())
+		assert.Zero(t, c.Units)
+
+		_, err = ownershipService.GetOwnership(ctx, f.ID, nil, "Alice")
+		assert.ErrorIs(t, err, ownership.ErrOwnerNotFound)
+
+		again, err := svc.CreateCommitment(ctx, commitment.Request{FundID: f.ID, Owner: "Alice", Amount: 100})
+		assert.ErrorIs(t, err, commitment.ErrDuplicateCommitment)
+		assert.Nil(t, again)
+
+		founder, err := svc.CreateCommitment(ctx, commitment.Request{FundID: f.ID, Owner: "Founder", Amount: 1000})
+		require.NoError(t, err)
+		assert.Equal(t, 1000, founder.Units)
+
+		events, err := auditStore.List(ctx, audit.Filter{FundID: &f.ID}, audit.ListParams{})
+		require.NoError(t, err)
+		var recorded int
+		for _, e := range events.Events {
+			if e.Operation == audit.OperationCommitmentCreate {
+				recorded++
+			}
+		}
+		assert.Equal(t, 2, recorded)
+	})
+
+	t.Run("CreateCommitment rejects a second commitment from the same investor", func(t *testing.T) {
+		tc.Reset(ctx)
+		f, err := fundService.CreateFundWithInitialOwner(ctx, "Duplicate Fund", 1000, "Founder")
+		require.NoError(t, err)
+		c, err := svc.CreateCommitment(ctx, commitment.Request{FundID: f.ID, Owner: "Alice", Amount: 5000})
+		require.NoError(t, err)
+
+		_, err = svc.CreateCommitment(ctx, commitment.Request{FundID: f.ID, OwnerID: &c.OwnerID, Amount: 100})
+		assert.ErrorIs(t, err, commitment.ErrDuplicateCommitment)
+	})
+
+	t.Run("CreateCommitment requires an open fund", func(t *testing.T) {
+		tc.Reset(ctx)
+		f, err := fundService.CreateFundWithInitialOwner(ctx, "Closed Fund", 1000, "Founder")
+		require.NoError(t, err)
+		_, err = fundService.ChangeStatus(ctx, fund.StatusChange{FundID: f.ID, Status: fund.StatusClosed})
+		require.NoError(t, err)
+
+		_, err = svc.CreateCommitment(ctx, commitment.Request{FundID: f.ID, Owner: "Alice", Amount: 5000})
+		assert.ErrorIs(t, err, commitment.ErrFundNotOpen)
+
+		_, err = svc.CreateCommitment(ctx, commitment.Request{FundID: uuid.New(), Owner: "Alice", Amount: 5000})
+		assert.ErrorIs(t, err, commitment.ErrFundNotFound)
+	})
+
+	t.Run("CreateCapitalCall draws down commitments pro rata", func(t *testing.T) {
+		tc.Reset(ctx)
+		f, err := fundService.CreateFundWithInitialOwner(ctx, "Calling Fund", 1000, "Founder")
+		require.NoError(t, err)
+		_, err = svc.CreateCommitment(ctx, commitment.Request{FundID: f.ID, Owner: "Alice", Amount: 6000})
+		require.NoError(t, err)
+		_, err = svc.CreateCommitment(ctx, commitment.Request{FundID: f.ID, Owner: "Bob", Amount: 4000})
+		require.NoError(t, err)
+
+		call, err := svc.CreateCapitalCall(ctx, commitment.CallRequest{FundID: f.ID, TotalAmount: 2500})
+		require.NoError(t, err)
+		assert.Equal(t, 2, call.Investors)
+		assert.Equal(t, map[string]int64{"Alice": 4500, "Bob": 3000}, unfunded(t, f.ID))
+
+		found, err := svc.GetCapitalCall(ctx, f.ID, call.ID)
+		require.NoError(t, err)
+		assert.Equal(t, call.LineItems, found.LineItems)
+		assert.True(t, call.CreatedAt.Equal(found.CreatedAt))
+
+		_, err = svc.CreateCapitalCall(ctx, commitment.CallRequest{FundID: f.ID, TotalAmount: 7501})
+		assert.ErrorIs(t, err, commitment.ErrCallExceedsUnfunded)
+
+		_, err = svc.CreateCapitalCall(ctx, commitment.CallRequest{FundID: f.ID, TotalAmount: 7500})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int64{"Alice": 0, "Bob": 0}, unfunded(t, f.ID))
+
+		_, err = svc.CreateCapitalCall(ctx, commitment.CallRequest{FundID: f.ID, TotalAmount: 1})
+		assert.ErrorIs(t, err, commitment.ErrNoUnfundedCommitments)
+
+		calls, err := svc.ListCapitalCalls(ctx, f.ID, commitment.ListParams{})
+		require.NoError(t, err)
+		assert.Equal(t, 2, calls.Total)
+		assert.Nil(t, calls.Items[0].LineItems)
+	})
+
+	t.Run("GetCapitalCall is scoped to the fund", func(t *testing.T) {
+		tc.Reset(ctx)
+		f, err := fundService.CreateFundWithInitialOwner(ctx, "Scoped Fund", 10, "Founder")
+		require.NoError(t, err)
+		_, err = svc.CreateCommitment(ctx, commitment.Request{FundID: f.ID, Owner: "Founder", Amount: 100})
+		require.NoError(t, err)
+		call, err := svc.CreateCapitalCall(ctx, commitment.CallRequest{FundID: f.ID, TotalAmount: 10})
+		require.NoError(t, err)
+
+		_, err = svc.GetCapitalCall(ctx, uuid.New(), call.ID)
+		assert.ErrorIs(t, err, commitment.ErrCapitalCallNotFound)
+	})
+}
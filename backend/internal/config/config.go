@@ -1,22 +1,39 @@
 package config
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/arowden/augment-fund/internal/auth"
 	"github.com/arowden/augment-fund/internal/otel"
 	"github.com/arowden/augment-fund/internal/postgres"
+	"github.com/arowden/augment-fund/internal/reconciliation"
+	"github.com/arowden/augment-fund/internal/webhook"
 
 	"github.com/kelseyhightower/envconfig"
 )
 
+const (
+	MigrationModeAuto   = "auto"
+	MigrationModeVerify = "verify"
+	MigrationModeNone   = "none"
+)
+
 type Config struct {
-	Database  postgres.Config
-	Server    Server
-	Telemetry otel.Config
+	Database       postgres.Config
+	Server         Server
+	Telemetry      otel.Config
+	Reconciliation reconciliation.Config
+	Webhook        webhook.Config
+	Auth           auth.Config
 }
 
 type Server struct {
-	Host        string   `envconfig:"SERVER_HOST" default:"0.0.0.0"`
-	Port        int      `envconfig:"SERVER_PORT" default:"8080"`
-	CORSOrigins []string `envconfig:"CORS_ORIGINS" default:"http://localhost:*,http://127.0.0.1:*"`
+	Host            string        `envconfig:"SERVER_HOST" default:"0.0.0.0"`
+	Port            int           `envconfig:"SERVER_PORT" default:"8080"`
+	CORSOrigins     []string      `envconfig:"CORS_ORIGINS" default:"http://localhost:*,http://127.0.0.1:*"`
+	MigrationMode   string        `envconfig:"MIGRATION_MODE" default:"auto"`
+	ShutdownTimeout time.Duration `envconfig:"SERVER_SHUTDOWN_TIMEOUT" default:"30s"`
 }
 
 func Load() (*Config, error) {
@@ -34,5 +51,27 @@ func Load() (*Config, error) {
 		return nil, err
 	}
 
+	if err := envconfig.Process("", &cfg.Reconciliation); err != nil {
+		return nil, err
+	}
+
+	if err := envconfig.Process("", &cfg.Webhook); err != nil {
+		return nil, err
+	}
+
+	if err := envconfig.Process("", &cfg.Auth); err != nil {
+		return nil, err
+	}
+	if err := cfg.Auth.Validate(); err != nil {
+		return nil, err
+	}
+
+	switch cfg.Server.MigrationMode {
+	case MigrationModeAuto, MigrationModeVerify, MigrationModeNone:
+	default:
+		return nil, fmt.Errorf("MIGRATION_MODE must be one of %q, %q or %q, got %q",
+			MigrationModeAuto, MigrationModeVerify, MigrationModeNone, cfg.Server.MigrationMode)
+	}
+
 	return &cfg, nil
 }
@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -18,6 +19,12 @@ func TestLoad(t *testing.T) {
 		"DB_SSLMODE":  os.Getenv("DB_SSLMODE"),
 		"SERVER_HOST": os.Getenv("SERVER_HOST"),
 		"SERVER_PORT": os.Getenv("SERVER_PORT"),
+
+		"MIGRATION_MODE":          os.Getenv("MIGRATION_MODE"),
+		"SERVER_SHUTDOWN_TIMEOUT": os.Getenv("SERVER_SHUTDOWN_TIMEOUT"),
+		"RECONCILIATION_INTERVAL": os.Getenv("RECONCILIATION_INTERVAL"),
+		"WEBHOOK_MAX_ATTEMPTS":    os.Getenv("WEBHOOK_MAX_ATTEMPTS"),
+		"AUTH_ENABLED":            os.Getenv("AUTH_ENABLED"),
 	}
 	t.Cleanup(func() {
 		for k, v := range originalEnv {
@@ -62,6 +69,11 @@ func TestLoad(t *testing.T) {
 		os.Unsetenv("DB_SSLMODE")
 		os.Unsetenv("SERVER_HOST")
 		os.Unsetenv("SERVER_PORT")
+		os.Unsetenv("MIGRATION_MODE")
+		os.Unsetenv("SERVER_SHUTDOWN_TIMEOUT")
+		os.Unsetenv("RECONCILIATION_INTERVAL")
+		os.Unsetenv("WEBHOOK_MAX_ATTEMPTS")
+		os.Unsetenv("AUTH_ENABLED")
 
 		cfg, err := Load()
 		require.NoError(t, err)
@@ -71,6 +83,95 @@ func TestLoad(t *testing.T) {
 		assert.Equal(t, "require", cfg.Database.SSLMode)
 		assert.Equal(t, "0.0.0.0", cfg.Server.Host)
 		assert.Equal(t, 8080, cfg.Server.Port)
+		assert.Equal(t, MigrationModeAuto, cfg.Server.MigrationMode)
+		assert.Equal(t, time.Hour, cfg.Reconciliation.Interval)
+		assert.Equal(t, 30*time.Second, cfg.Server.ShutdownTimeout)
+		assert.Equal(t, time.Second, cfg.Webhook.PollInterval)
+		assert.Equal(t, 10*time.Second, cfg.Webhook.Timeout)
+		assert.Equal(t, 8, cfg.Webhook.MaxAttempts)
+		assert.Equal(t, 5*time.Second, cfg.Webhook.BackoffBase)
+		assert.Equal(t, time.Hour, cfg.Webhook.BackoffMax)
+		assert.True(t, cfg.Auth.Enabled)
+	})
+
+	t.Run("loads webhook retry settings", func(t *testing.T) {
+		os.Setenv("DB_HOST", "localhost")
+		os.Setenv("DB_USER", "testuser")
+		os.Setenv("DB_PASSWORD", "testpass")
+		os.Setenv("DB_NAME", "testdb")
+		os.Setenv("WEBHOOK_MAX_ATTEMPTS", "3")
+		defer os.Unsetenv("WEBHOOK_MAX_ATTEMPTS")
+
+		cfg, err := Load()
+		require.NoError(t, err)
+		assert.Equal(t, 3, cfg.Webhook.RetryPolicy().MaxAttempts)
+	})
+
+	t.Run("allows disabling authentication for local development", func(t *testing.T) {
+		os.Setenv("DB_HOST", "localhost")
+		os.Setenv("DB_USER", "testuser")
+		os.Setenv("DB_PASSWORD", "testpass")
+		os.Setenv("DB_NAME", "testdb")
+		os.Setenv("AUTH_ENABLED", "false")
+		defer os.Unsetenv("AUTH_ENABLED")
+
+		cfg, err := Load()
+		require.NoError(t, err)
+		assert.False(t, cfg.Auth.Enabled)
+	})
+
+	t.Run("requires issuer and audience with a JWKS", func(t *testing.T) {
+		os.Setenv("DB_HOST", "localhost")
+		os.Setenv("DB_USER", "testuser")
+		os.Setenv("DB_PASSWORD", "testpass")
+		os.Setenv("DB_NAME", "testdb")
+		os.Setenv("AUTH_JWKS_FILE", "/etc/augment/jwks.json")
+		defer os.Unsetenv("AUTH_JWKS_FILE")
+
+		_, err := Load()
+		assert.ErrorContains(t, err, "AUTH_JWT_ISSUER and AUTH_JWT_AUDIENCE are required")
+
+		os.Setenv("AUTH_JWT_ISSUER", "https://sso.example.com")
+		os.Setenv("AUTH_JWT_AUDIENCE", "augment-fund")
+		defer os.Unsetenv("AUTH_JWT_ISSUER")
+		defer os.Unsetenv("AUTH_JWT_AUDIENCE")
+
+		cfg, err := Load()
+		require.NoError(t, err)
+		assert.True(t, cfg.Auth.JWTEnabled())
+		assert.Equal(t, 5*time.Minute, cfg.Auth.JWKSRefresh)
+		assert.Equal(t, "funds", cfg.Auth.JWTFundClaim)
+
+		os.Setenv("AUTH_JWKS_URL", "https://sso.example.com/.well-known/jwks.json")
+		defer os.Unsetenv("AUTH_JWKS_URL")
+		_, err = Load()
+		assert.ErrorContains(t, err, "only one of AUTH_JWKS_FILE and AUTH_JWKS_URL")
+	})
+
+	t.Run("accepts verify migration mode", func(t *testing.T) {
+		os.Setenv("DB_HOST", "localhost")
+		os.Setenv("DB_USER", "testuser")
+		os.Setenv("DB_PASSWORD", "testpass")
+		os.Setenv("DB_NAME", "testdb")
+		os.Setenv("MIGRATION_MODE", "verify")
+		defer os.Unsetenv("MIGRATION_MODE")
+
+		cfg, err := Load()
+		require.NoError(t, err)
+		assert.Equal(t, MigrationModeVerify, cfg.Server.MigrationMode)
+	})
+
+	t.Run("fails on unknown migration mode", func(t *testing.T) {
+		os.Setenv("DB_HOST", "localhost")
+		os.Setenv("DB_USER", "testuser")
+		os.Setenv("DB_PASSWORD", "testpass")
+		os.Setenv("DB_NAME", "testdb")
+		os.Setenv("MIGRATION_MODE", "sometimes")
+		defer os.Unsetenv("MIGRATION_MODE")
+
+		cfg, err := Load()
+		assert.Error(t, err)
+		assert.Nil(t, cfg)
 	})
 
 	t.Run("fails when required DB_HOST is missing", func(t *testing.T) {
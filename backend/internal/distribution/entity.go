@@ -0,0 +1,145 @@
+package distribution
+
+import (
+	"bytes"
+	"math/bits"
+	"sort"
+	"time"
+
+	"github.com/arowden/augment-fund/internal/ownership"
+	"github.com/arowden/augment-fund/internal/validation"
+	"github.com/google/uuid"
+)
+
+type ListParams = validation.ListParams
+
+type Distribution struct {
+	ID          uuid.UUID
+	FundID      uuid.UUID
+	TotalAmount int64
+	RecordDate  time.Time
+	TotalUnits  int
+	Holders     int
+	CreatedAt   time.Time
+	LineItems   []*LineItem
+}
+
+type LineItem struct {
+	OwnerID   uuid.UUID
+	OwnerName string
+	Units     int
+	Amount    int64
+}
+
+type Request struct {
+	FundID      uuid.UUID
+	TotalAmount int64
+	RecordDate  *time.Time
+}
+
+func (r Request) Validate(now time.Time) error {
+	if r.TotalAmount <= 0 {
+		return ErrInvalidAmount
+	}
+	if r.RecordDate != nil && r.RecordDate.After(now) {
+		return ErrFutureRecordDate
+	}
+	return nil
+}
+
+type List struct {
+	Items      []*Distribution
+	Total      int
+	Limit      int
+	Offset     int
+	NextCursor string
+}
+
+type Holding struct {
+	OwnerID   uuid.UUID
+	OwnerName string
+	Units     int
+}
+
+func Holdings(entries []*ownership.Entry) []Holding {
+	index := make(map[uuid.UUID]int, len(entries))
+	holdings := make([]Holding, 0, len(entries))
+	for _, e := range entries {
+		if e.Units <= 0 {
+			continue
+		}
+		if i, ok := index[e.OwnerID]; ok {
+			holdings[i].Units += e.Units
+			continue
+		}
+		index[e.OwnerID] = len(holdings)
+		holdings = append(holdings, Holding{OwnerID: e.OwnerID, OwnerName: e.OwnerName, Units: e.Units})
+	}
+	return holdings
+}
+
+func Allocate(amount int64, holdings []Holding) ([]*LineItem, int, error) {
+	if amount <= 0 {
+		return nil, 0, ErrInvalidAmount
+	}
+
+	totalUnits := 0
+	for _, h := range holdings {
+		if h.Units > 0 {
+			totalUnits += h.Units
+		}
+	}
+	if totalUnits == 0 {
+		return nil, 0, ErrNoUnitsOutstanding
+	}
+
+	type share struct {
+		item      *LineItem
+		remainder uint64
+	}
+	shares := make([]share, 0, len(holdings))
+	allocated := int64(0)
+	for _, h := range holdings {
+		if h.Units <= 0 {
+			continue
+		}
+		hi, lo := bits.Mul64(uint64(amount), uint64(h.Units))
+		quo, rem := bits.Div64(hi, lo, uint64(totalUnits))
+		item := &LineItem{OwnerID: h.OwnerID, OwnerName: h.OwnerName, Units: h.Units, Amount: int64(quo)}
+		shares = append(shares, share{item: item, remainder: rem})
+		allocated += item.Amount
+	}
+
+	sort.Slice(shares, func(i, j int) bool {
+		a, b := shares[i], shares[j]
+		if a.remainder != b.remainder {
+			return a.remainder > b.remainder
+		}
+		if a.item.Units != b.item.Units {
+			return a.item.Units > b.item.Units
+		}
+		return bytes.Compare(a.item.OwnerID[:], b.item.OwnerID[:]) < 0
+	})
+	for i := int64(0); i < amount-allocated; i++ {
+		shares[i].item.Amount++
+	}
+
+	items := make([]*LineItem, len(shares))
+	for i, s := range shares {
+		items[i] = s.item
+	}
+	sortLineItems(items)
+	return items, totalUnits, nil
+}
+
+func sortLineItems(items []*LineItem) {
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Amount != items[j].Amount {
+			return items[i].Amount > items[j].Amount
+		}
+		if items[i].Units != items[j].Units {
+			return items[i].Units > items[j].Units
+		}
+		return bytes.Compare(items[i].OwnerID[:], items[j].OwnerID[:]) < 0
+	})
+}
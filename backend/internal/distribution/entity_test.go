@@ -0,0 +1,162 @@
+package distribution
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/arowden/augment-fund/internal/ownership"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sumAmounts(items []*LineItem) int64 {
+	var sum int64
+	for _, item := range items {
+		sum += item.Amount
+	}
+	return sum
+}
+
+func amountsByName(items []*LineItem) map[string]int64 {
+	out := make(map[string]int64, len(items))
+	for _, item := range items {
+		out[item.OwnerName] = item.Amount
+	}
+	return out
+}
+
+func TestAllocate(t *testing.T) {
+	t.Run("splits evenly divisible amounts exactly", func(t *testing.T) {
+		items, total, err := Allocate(1000, []Holding{
+			{OwnerID: uuid.New(), OwnerName: "Founder", Units: 700},
+			{OwnerID: uuid.New(), OwnerName: "Alice", Units: 300},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1000, total)
+		assert.Equal(t, map[string]int64{"Founder": 700, "Alice": 300}, amountsByName(items))
+	})
+
+	t.Run("hands leftover units to the largest remainders", func(t *testing.T) {
+		items, total, err := Allocate(100, []Holding{
+			{OwnerID: uuid.New(), OwnerName: "A", Units: 1},
+			{OwnerID: uuid.New(), OwnerName: "B", Units: 2},
+			{OwnerID: uuid.New(), OwnerName: "C", Units: 4},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 7, total)
+		assert.Equal(t, map[string]int64{"A": 14, "B": 29, "C": 57}, amountsByName(items))
+		assert.Equal(t, int64(100), sumAmounts(items))
+	})
+
+	t.Run("breaks remainder ties by units then owner ID", func(t *testing.T) {
+		low := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+		high := uuid.MustParse("ffffffff-ffff-ffff-ffff-ffffffffffff")
+		items, _, err := Allocate(1, []Holding{
+			{OwnerID: high, OwnerName: "High", Units: 1},
+			{OwnerID: low, OwnerName: "Low", Units: 1},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int64{"Low": 1, "High": 0}, amountsByName(items))
+
+		items, _, err = Allocate(2, []Holding{
+			{OwnerID: low, OwnerName: "Low", Units: 1},
+			{OwnerID: high, OwnerName: "High", Units: 3},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int64{"Low": 0, "High": 2}, amountsByName(items))
+	})
+
+	t.Run("is deterministic regardless of input order", func(t *testing.T) {
+		holdings := make([]Holding, 7)
+		for i := range holdings {
+			holdings[i] = Holding{OwnerID: uuid.New(), OwnerName: string(rune('A' + i)), Units: 3}
+		}
+		first, _, err := Allocate(1000, holdings)
+		require.NoError(t, err)
+
+		reversed := make([]Holding, len(holdings))
+		for i, h := range holdings {
+			reversed[len(holdings)-1-i] = h
+		}
+		second, _, err := Allocate(1000, reversed)
+		require.NoError(t, err)
+
+		assert.Equal(t, first, second)
+		assert.Equal(t, int64(1000), sumAmounts(first))
+	})
+
+	t.Run("orders line items by amount then units", func(t *testing.T) {
+		items, _, err := Allocate(10, []Holding{
+			{OwnerID: uuid.New(), OwnerName: "Small", Units: 1},
+			{OwnerID: uuid.New(), OwnerName: "Large", Units: 8},
+			{OwnerID: uuid.New(), OwnerName: "Medium", Units: 1},
+		})
+		require.NoError(t, err)
+		require.Len(t, items, 3)
+		assert.Equal(t, "Large", items[0].OwnerName)
+		assert.Equal(t, int64(8), items[0].Amount)
+		assert.GreaterOrEqual(t, items[1].Amount, items[2].Amount)
+	})
+
+	t.Run("handles amounts whose products overflow 64 bits", func(t *testing.T) {
+		items, total, err := Allocate(math.MaxInt64, []Holding{
+			{OwnerID: uuid.New(), OwnerName: "Founder", Units: math.MaxInt32},
+			{OwnerID: uuid.New(), OwnerName: "Alice", Units: math.MaxInt32},
+			{OwnerID: uuid.New(), OwnerName: "Bob", Units: 1},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2*math.MaxInt32+1, total)
+		assert.Equal(t, int64(math.MaxInt64), sumAmounts(items))
+	})
+
+	t.Run("skips holders without units", func(t *testing.T) {
+		items, total, err := Allocate(50, []Holding{
+			{OwnerID: uuid.New(), OwnerName: "Founder", Units: 10},
+			{OwnerID: uuid.New(), OwnerName: "Former", Units: 0},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 10, total)
+		assert.Equal(t, map[string]int64{"Founder": 50}, amountsByName(items))
+	})
+
+	t.Run("rejects non-positive amounts", func(t *testing.T) {
+		_, _, err := Allocate(0, []Holding{{OwnerID: uuid.New(), OwnerName: "Founder", Units: 10}})
+		assert.ErrorIs(t, err, ErrInvalidAmount)
+	})
+
+	t.Run("rejects a cap table without units", func(t *testing.T) {
+		_, _, err := Allocate(100, nil)
+		assert.ErrorIs(t, err, ErrNoUnitsOutstanding)
+	})
+}
+
+func TestHoldings(t *testing.T) {
+	founder, alice := uuid.New(), uuid.New()
+	classA, classB := uuid.New(), uuid.New()
+
+	holdings := Holdings([]*ownership.Entry{
+		{OwnerID: founder, OwnerName: "Founder", ClassID: classA, Units: 600},
+		{OwnerID: alice, OwnerName: "Alice", ClassID: classA, Units: 300},
+		{OwnerID: founder, OwnerName: "Founder", ClassID: classB, Units: 100},
+		{OwnerID: uuid.New(), OwnerName: "Former", ClassID: classA, Units: 0},
+	})
+
+	assert.Equal(t, []Holding{
+		{OwnerID: founder, OwnerName: "Founder", Units: 700},
+		{OwnerID: alice, OwnerName: "Alice", Units: 300},
+	}, holdings)
+}
+
+func TestRequest_Validate(t *testing.T) {
+	now := time.Date(2024, 6, 30, 12, 0, 0, 0, time.UTC)
+	past, future := now.Add(-time.Hour), now.Add(time.Second)
+
+	assert.NoError(t, Request{TotalAmount: 1}.Validate(now))
+	assert.NoError(t, Request{TotalAmount: 1, RecordDate: &past}.Validate(now))
+	assert.NoError(t, Request{TotalAmount: 1, RecordDate: &now}.Validate(now))
+	assert.ErrorIs(t, Request{TotalAmount: 0}.Validate(now), ErrInvalidAmount)
+	assert.ErrorIs(t, Request{TotalAmount: -5}.Validate(now), ErrInvalidAmount)
+	assert.ErrorIs(t, Request{TotalAmount: 1, RecordDate: &future}.Validate(now), ErrFutureRecordDate)
+}
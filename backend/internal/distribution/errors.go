@@ -0,0 +1,24 @@
+package distribution
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+var ErrNotFound = errors.New("distribution not found")
+
+var ErrFundNotFound = errors.New("fund not found")
+
+var ErrInvalidAmount = errors.New("total amount must be a positive number of minor currency units")
+
+var ErrFutureRecordDate = errors.New("record date cannot be in the future")
+
+var ErrNoUnitsOutstanding = errors.New("fund had no units outstanding at the record date")
+
+var ErrPoolRequired = errors.New("distribution: database pool is required for transactional operations")
+
+func NotFoundError(id uuid.UUID) error {
+	return fmt.Errorf("distribution %s: %w", id, ErrNotFound)
+}
@@ -0,0 +1,20 @@
+package distribution
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type Repository interface {
+	CreateTx(ctx context.Context, tx pgx.Tx, d *Distribution) error
+
+	FindByID(ctx context.Context, fundID, id uuid.UUID) (*Distribution, error)
+
+	List(ctx context.Context, fundID uuid.UUID, params ListParams) (*List, error)
+
+	ListLineItems(ctx context.Context, id uuid.UUID) ([]*LineItem, error)
+
+	StreamLineItems(ctx context.Context, id uuid.UUID, fn func(*LineItem) error) error
+}
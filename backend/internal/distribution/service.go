@@ -0,0 +1,151 @@
+package distribution
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/arowden/augment-fund/internal/audit"
+	"github.com/arowden/augment-fund/internal/ownership"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Service struct {
+	repo          Repository
+	ownershipRepo ownership.Repository
+	pool          *pgxpool.Pool
+	audit         audit.Writer
+	now           func() time.Time
+}
+
+type ServiceOption func(*Service)
+
+func WithRepository(r Repository) ServiceOption {
+	return func(s *Service) { s.repo = r }
+}
+
+func WithOwnershipRepository(r ownership.Repository) ServiceOption {
+	return func(s *Service) { s.ownershipRepo = r }
+}
+
+func WithPool(p *pgxpool.Pool) ServiceOption {
+	return func(s *Service) { s.pool = p }
+}
+
+func WithAudit(w audit.Writer) ServiceOption {
+	return func(s *Service) { s.audit = w }
+}
+
+func WithClock(now func() time.Time) ServiceOption {
+	return func(s *Service) { s.now = now }
+}
+
+func NewService(opts ...ServiceOption) (*Service, error) {
+	s := &Service{now: time.Now}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.repo == nil {
+		return nil, errors.New("distribution: repository is required")
+	}
+	if s.ownershipRepo == nil {
+		return nil, errors.New("distribution: ownership repository is required")
+	}
+	return s, nil
+}
+
+func (s *Service) CreateDistribution(ctx context.Context, req Request) (*Distribution, error) {
+	now := s.now()
+	if err := req.Validate(now); err != nil {
+		return nil, err
+	}
+
+	recordDate := now
+	if req.RecordDate != nil {
+		recordDate = *req.RecordDate
+	}
+	recordDate = recordDate.UTC().Truncate(time.Microsecond)
+
+	ledger, err := s.ownershipRepo.FindLedger(ctx, req.FundID, recordDate)
+	if errors.Is(err, ownership.ErrNotFound) {
+		return nil, fmt.Errorf("fund %s: %w", req.FundID, ErrFundNotFound)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	items, totalUnits, err := Allocate(req.TotalAmount, Holdings(ledger.Replay(recordDate)))
+	if err != nil {
+		return nil, err
+	}
+
+	d := &Distribution{
+		ID:          uuid.New(),
+		FundID:      req.FundID,
+		TotalAmount: req.TotalAmount,
+		RecordDate:  recordDate,
+		TotalUnits:  totalUnits,
+		Holders:     len(items),
+		CreatedAt:   now,
+		LineItems:   items,
+	}
+	if s.pool == nil {
+		return nil, ErrPoolRequired
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := s.repo.CreateTx(ctx, tx, d); err != nil {
+		return nil, err
+	}
+
+	if s.audit != nil {
+		record := audit.NewEvent(ctx, audit.OperationDistributionCreate).ForFund(d.FundID)
+		record.Details = map[string]any{
+			"distributionId": d.ID,
+			"totalAmount":    d.TotalAmount,
+			"recordDate":     d.RecordDate,
+			"totalUnits":     d.TotalUnits,
+			"holders":        d.Holders,
+		}
+		if err := s.audit.AppendTx(ctx, tx, record); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+
+	return d, nil
+}
+
+func (s *Service) ListDistributions(ctx context.Context, fundID uuid.UUID, params ListParams) (*List, error) {
+	return s.repo.List(ctx, fundID, params)
+}
+
+func (s *Service) GetSummary(ctx context.Context, fundID, id uuid.UUID) (*Distribution, error) {
+	return s.repo.FindByID(ctx, fundID, id)
+}
+
+func (s *Service) GetDistribution(ctx context.Context, fundID, id uuid.UUID) (*Distribution, error) {
+	d, err := s.repo.FindByID(ctx, fundID, id)
+	if err != nil {
+		return nil, err
+	}
+	d.LineItems, err = s.repo.ListLineItems(ctx, d.ID)
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (s *Service) ExportLineItems(ctx context.Context, id uuid.UUID, fn func(*LineItem) error) error {
+	return s.repo.StreamLineItems(ctx, id, fn)
+}
@@ -0,0 +1,175 @@
+package distribution
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/arowden/augment-fund/internal/ownership"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRepository struct {
+	findByIDFunc      func(ctx context.Context, fundID, id uuid.UUID) (*Distribution, error)
+	listLineItemsFunc func(ctx context.Context, id uuid.UUID) ([]*LineItem, error)
+}
+
+func (m *mockRepository) CreateTx(_ context.Context, _ pgx.Tx, _ *Distribution) error {
+	return nil
+}
+
+func (m *mockRepository) FindByID(ctx context.Context, fundID, id uuid.UUID) (*Distribution, error) {
+	if m.findByIDFunc != nil {
+		return m.findByIDFunc(ctx, fundID, id)
+	}
+	return nil, NotFoundError(id)
+}
+
+func (m *mockRepository) List(_ context.Context, _ uuid.UUID, _ ListParams) (*List, error) {
+	return &List{}, nil
+}
+
+func (m *mockRepository) ListLineItems(ctx context.Context, id uuid.UUID) ([]*LineItem, error) {
+	if m.listLineItemsFunc != nil {
+		return m.listLineItemsFunc(ctx, id)
+	}
+	return []*LineItem{}, nil
+}
+
+func (m *mockRepository) StreamLineItems(_ context.Context, _ uuid.UUID, _ func(*LineItem) error) error {
+	return nil
+}
+
+type stubLedgerRepository struct {
+	ownership.Repository
+	ledger *ownership.Ledger
+	err    error
+	until  time.Time
+}
+
+func (s *stubLedgerRepository) FindLedger(_ context.Context, _ uuid.UUID, until time.Time) (*ownership.Ledger, error) {
+	s.until = until
+	return s.ledger, s.err
+}
+
+func TestNewService(t *testing.T) {
+	t.Run("returns error when repository is nil", func(t *testing.T) {
+		svc, err := NewService(WithOwnershipRepository(&stubLedgerRepository{}))
+		assert.Nil(t, svc)
+		assert.ErrorContains(t, err, "repository is required")
+	})
+
+	t.Run("returns error when ownership repository is nil", func(t *testing.T) {
+		svc, err := NewService(WithRepository(&mockRepository{}))
+		assert.Nil(t, svc)
+		assert.ErrorContains(t, err, "ownership repository is required")
+	})
+
+	t.Run("creates service with repositories", func(t *testing.T) {
+		svc, err := NewService(WithRepository(&mockRepository{}), WithOwnershipRepository(&stubLedgerRepository{}))
+		require.NoError(t, err)
+		assert.NotNil(t, svc)
+	})
+}
+
+func TestService_CreateDistribution(t *testing.T) {
+	now := time.Date(2024, 7, 1, 9, 0, 0, 0, time.UTC)
+	fundID, founder := uuid.New(), uuid.New()
+	ledger := &ownership.Ledger{
+		FundID:    fundID,
+		CreatedAt: now.Add(-48 * time.Hour),
+		Movements: []ownership.Movement{{ToOwner: "Founder", ToOwnerID: founder, Units: 1000, At: now.Add(-48 * time.Hour)}},
+	}
+
+	newService := func(t *testing.T, ledgers *stubLedgerRepository) *Service {
+		svc, err := NewService(
+			WithRepository(&mockRepository{}),
+			WithOwnershipRepository(ledgers),
+			WithClock(func() time.Time { return now }),
+		)
+		require.NoError(t, err)
+		return svc
+	}
+
+	t.Run("rejects invalid requests before reading the ledger", func(t *testing.T) {
+		ledgers := &stubLedgerRepository{ledger: ledger}
+		svc := newService(t, ledgers)
+		future := now.Add(time.Minute)
+
+		_, err := svc.CreateDistribution(context.Background(), Request{FundID: fundID, TotalAmount: 0})
+		assert.ErrorIs(t, err, ErrInvalidAmount)
+		_, err = svc.CreateDistribution(context.Background(), Request{FundID: fundID, TotalAmount: 100, RecordDate: &future})
+		assert.ErrorIs(t, err, ErrFutureRecordDate)
+		assert.True(t, ledgers.until.IsZero())
+	})
+
+	t.Run("maps a missing fund", func(t *testing.T) {
+		svc := newService(t, &stubLedgerRepository{err: fmt.Errorf("ledger for fund %s: %w", fundID, ownership.ErrNotFound)})
+
+		_, err := svc.CreateDistribution(context.Background(), Request{FundID: fundID, TotalAmount: 100})
+		assert.ErrorIs(t, err, ErrFundNotFound)
+	})
+
+	t.Run("rejects a record date before the fund had units", func(t *testing.T) {
+		ledgers := &stubLedgerRepository{ledger: ledger}
+		svc := newService(t, ledgers)
+		before := ledger.CreatedAt.Add(-time.Hour)
+
+		_, err := svc.CreateDistribution(context.Background(), Request{FundID: fundID, TotalAmount: 100, RecordDate: &before})
+		assert.ErrorIs(t, err, ErrNoUnitsOutstanding)
+		assert.Equal(t, before, ledgers.until)
+	})
+
+	t.Run("replays the ledger as of now when no record date is given", func(t *testing.T) {
+		ledgers := &stubLedgerRepository{ledger: ledger}
+		svc := newService(t, ledgers)
+
+		_, err := svc.CreateDistribution(context.Background(), Request{FundID: fundID, TotalAmount: 100})
+		assert.ErrorIs(t, err, ErrPoolRequired)
+		assert.Equal(t, now, ledgers.until)
+	})
+
+	t.Run("propagates ledger errors", func(t *testing.T) {
+		svc := newService(t, &stubLedgerRepository{err: errors.New("connection refused")})
+
+		_, err := svc.CreateDistribution(context.Background(), Request{FundID: fundID, TotalAmount: 100})
+		assert.ErrorContains(t, err, "connection refused")
+	})
+}
+
+func TestService_GetDistribution(t *testing.T) {
+	fundID, id := uuid.New(), uuid.New()
+
+	t.Run("loads line items", func(t *testing.T) {
+		items := []*LineItem{{OwnerID: uuid.New(), OwnerName: "Founder", Units: 10, Amount: 100}}
+		svc, err := NewService(
+			WithRepository(&mockRepository{
+				findByIDFunc: func(_ context.Context, _, id uuid.UUID) (*Distribution, error) {
+					return &Distribution{ID: id, FundID: fundID, TotalAmount: 100, TotalUnits: 10, Holders: 1}, nil
+				},
+				listLineItemsFunc: func(_ context.Context, _ uuid.UUID) ([]*LineItem, error) {
+					return items, nil
+				},
+			}),
+			WithOwnershipRepository(&stubLedgerRepository{}),
+		)
+		require.NoError(t, err)
+
+		d, err := svc.GetDistribution(context.Background(), fundID, id)
+		require.NoError(t, err)
+		assert.Equal(t, items, d.LineItems)
+	})
+
+	t.Run("returns not found", func(t *testing.T) {
+		svc, err := NewService(WithRepository(&mockRepository{}), WithOwnershipRepository(&stubLedgerRepository{}))
+		require.NoError(t, err)
+
+		_, err = svc.GetDistribution(context.Background(), fundID, id)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
@@ -0,0 +1,190 @@
+package distribution
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/arowden/augment-fund/internal/validation"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const cursorKind = "distributions"
+
+type DB interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+type Store struct {
+	db DB
+}
+
+func NewStore(db DB) *Store {
+	if db == nil {
+		return nil
+	}
+	return &Store{db: db}
+}
+
+func (s *Store) CreateTx(ctx context.Context, tx pgx.Tx, d *Distribution) error {
+	const query = `
+		INSERT INTO distributions (id, fund_id, total_amount, record_date, total_units, holders, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	if _, err := tx.Exec(ctx, query, d.ID, d.FundID, d.TotalAmount, d.RecordDate, d.TotalUnits, d.Holders, d.CreatedAt); err != nil {
+		return fmt.Errorf("create distribution %s: %w", d.ID, err)
+	}
+
+	const itemQuery = `
+		INSERT INTO distribution_line_items (distribution_id, owner_id, owner_name, units, amount)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	for _, item := range d.LineItems {
+		if _, err := tx.Exec(ctx, itemQuery, d.ID, item.OwnerID, item.OwnerName, item.Units, item.Amount); err != nil {
+			return fmt.Errorf("create line item for owner %s in distribution %s: %w", item.OwnerID, d.ID, err)
+		}
+	}
+	return nil
+}
+
+const distributionColumns = `id, fund_id, total_amount, record_date, total_units, holders, created_at`
+
+func (d *Distribution) scanTargets() []any {
+	return []any{&d.ID, &d.FundID, &d.TotalAmount, &d.RecordDate, &d.TotalUnits, &d.Holders, &d.CreatedAt}
+}
+
+func (s *Store) FindByID(ctx context.Context, fundID, id uuid.UUID) (*Distribution, error) {
+	const query = `
+		SELECT ` + distributionColumns + `
+		FROM distributions
+		WHERE fund_id = $1 AND id = $2
+	`
+	var d Distribution
+	if err := s.db.QueryRow(ctx, query, fundID, id).Scan(d.scanTargets()...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, NotFoundError(id)
+		}
+		return nil, fmt.Errorf("find distribution %s: %w", id, err)
+	}
+	return &d, nil
+}
+
+type distributionCursor struct {
+	CreatedAt time.Time `json:"createdAt"`
+	ID        uuid.UUID `json:"id"`
+}
+
+func (s *Store) List(ctx context.Context, fundID uuid.UUID, params ListParams) (*List, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	params = params.Normalize()
+
+	var rows pgx.Rows
+	var err error
+	if params.Cursor != "" {
+		var after distributionCursor
+		if err := validation.DecodeCursor(cursorKind, params.Cursor, &after); err != nil {
+			return nil, err
+		}
+		const query = `
+			SELECT ` + distributionColumns + `, 0 AS total
+			FROM distributions
+			WHERE fund_id = $1 AND (created_at, id) > ($2::timestamptz, $3::uuid)
+			ORDER BY created_at ASC, id ASC
+			LIMIT $4
+		`
+		rows, err = s.db.Query(ctx, query, fundID, after.CreatedAt, after.ID, params.Limit+1)
+	} else {
+		const query = `
+			SELECT ` + distributionColumns + `, COUNT(*) OVER() AS total
+			FROM distributions
+			WHERE fund_id = $1
+			ORDER BY created_at ASC, id ASC
+			LIMIT $2 OFFSET $3
+		`
+		rows, err = s.db.Query(ctx, query, fundID, params.Limit+1, params.Offset)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("list distributions for fund %s: %w", fundID, err)
+	}
+	defer rows.Close()
+
+	items := make([]*Distribution, 0, params.Limit+1)
+	var total int
+	for rows.Next() {
+		var d Distribution
+		if err := rows.Scan(append(d.scanTargets(), &total)...); err != nil {
+			return nil, fmt.Errorf("scan distribution row: %w", err)
+		}
+		items = append(items, &d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate distribution rows: %w", err)
+	}
+
+	if len(items) == 0 && params.Offset > 0 {
+		const countQuery = `SELECT COUNT(*) FROM distributions WHERE fund_id = $1`
+		if err := s.db.QueryRow(ctx, countQuery, fundID).Scan(&total); err != nil {
+			return nil, fmt.Errorf("count distributions for fund %s: %w", fundID, err)
+		}
+	}
+
+	items, next, err := validation.NextPage(cursorKind, items, params.Limit, func(d *Distribution) any {
+		return distributionCursor{CreatedAt: d.CreatedAt, ID: d.ID}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &List{
+		Items:      items,
+		Total:      total,
+		Limit:      params.Limit,
+		Offset:     params.Offset,
+		NextCursor: next,
+	}, nil
+}
+
+func (s *Store) ListLineItems(ctx context.Context, id uuid.UUID) ([]*LineItem, error) {
+	items := []*LineItem{}
+	err := s.StreamLineItems(ctx, id, func(item *LineItem) error {
+		items = append(items, item)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (s *Store) StreamLineItems(ctx context.Context, id uuid.UUID, fn func(*LineItem) error) error {
+	const query = `
+		SELECT owner_id, owner_name, units, amount
+		FROM distribution_line_items
+		WHERE distribution_id = $1
+		ORDER BY amount DESC, units DESC, owner_id ASC
+	`
+	rows, err := s.db.Query(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("stream line items for distribution %s: %w", id, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item LineItem
+		if err := rows.Scan(&item.OwnerID, &item.OwnerName, &item.Units, &item.Amount); err != nil {
+			return fmt.Errorf("scan line item row: %w", err)
+		}
+		if err := fn(&item); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("iterate line item rows: %w", err)
+	}
+	return nil
+}
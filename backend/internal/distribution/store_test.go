@@ -0,0 +1,183 @@
+package distribution_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/arowden/augment-fund/internal/audit"
+	"github.com/arowden/augment-fund/internal/distribution"
+	"github.com/arowden/augment-fund/internal/fund"
+	"github.com/arowden/augment-fund/internal/owner"
+	"github.com/arowden/augment-fund/internal/ownership"
+	"github.com/arowden/augment-fund/internal/postgres"
+	"github.com/arowden/augment-fund/internal/transfer"
+	"github.com/google/uuid"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx := context.Background()
+	tc, err := postgres.NewTestContainer(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() { tc.Cleanup(ctx) })
+
+	ownershipStore := ownership.NewStore(tc.Pool())
+	ownerStore := owner.NewStore(tc.Pool())
+	auditStore := audit.NewStore(tc.Pool())
+	fundService, err := fund.NewService(
+		fund.NewStore(tc.Pool()),
+		fund.WithPool(tc.Pool()),
+		fund.WithOwnershipRepository(ownershipStore),
+		fund.WithOwnerRepository(ownerStore),
+	)
+	require.NoError(t, err)
+	ownershipService, err := ownership.NewService(ownership.WithRepository(ownershipStore))
+	require.NoError(t, err)
+	transferService, err := transfer.NewService(
+		transfer.WithRepository(transfer.NewStore(tc.Pool())),
+		transfer.WithOwnershipRepository(ownershipStore),
+		transfer.WithOwnerRepository(ownerStore),
+		transfer.WithPool(tc.Pool()),
+	)
+	require.NoError(t, err)
+
+	store := distribution.NewStore(tc.Pool())
+	svc, err := distribution.NewService(
+		distribution.WithRepository(store),
+		distribution.WithOwnershipRepository(ownershipStore),
+		distribution.WithPool(tc.Pool()),
+		distribution.WithAudit(auditStore),
+	)
+	require.NoError(t, err)
+
+	amounts := func(items []*distribution.LineItem) map[string]int64 {
+		out := make(map[string]int64, len(items))
+		for _, item := range items {
+			out[item.OwnerName] = item.Amount
+		}
+		return out
+	}
+
+	t.Run("CreateDistribution records line items that add up to the amount", func(t *testing.T) {
+		tc.Reset(ctx)
+		f, err := fundService.CreateFundWithInitialOwner(ctx, "Distributing Fund", 3, "Founder")
+		require.NoError(t, err)
+		_, err = transferService.ExecuteTransfer(ctx, transfer.Request{FundID: f.ID, FromOwner: "Founder", ToOwner: "Alice", Units: 1})
+		require.NoError(t, err)
+
+		d, err := svc.CreateDistribution(ctx, distribution.Request{FundID: f.ID, TotalAmount: 100})
+		require.NoError(t, err)
+		assert.Equal(t, 3, d.TotalUnits)
+		assert.Equal(t, 2, d.Holders)
+		assert.Equal(t, map[string]int64{"Founder": 67, "Alice": 33}, amounts(d.LineItems))
+
+		found, err := svc.GetDistribution(ctx, f.ID, d.ID)
+		require.NoError(t, err)
+		assert.Equal(t, d.TotalAmount, found.TotalAmount)
+		assert.True(t, d.RecordDate.Equal(found.RecordDate))
+		assert.Equal(t, d.LineItems, found.LineItems)
+
+		var streamed []*distribution.LineItem
+		require.NoError(t, svc.ExportLineItems(ctx, d.ID, func(item *distribution.LineItem) error {
+			streamed = append(streamed, item)
+			return nil
+		}))
+		assert.Equal(t, d.LineItems, streamed)
+
+		events, err := auditStore.List(ctx, audit.Filter{FundID: &f.ID}, audit.ListParams{})
+		require.NoError(t, err)
+		var recorded *audit.Event
+		for _, e := range events.Events {
+			if e.Operation == audit.OperationDistributionCreate {
+				recorded = e
+			}
+		}
+		require.NotNil(t, recorded)
+		assert.EqualValues(t, 100, recorded.Details["totalAmount"])
+	})
+
+	t.Run("CreateDistribution allocates against the cap table at the record date", func(t *testing.T) {
+		tc.Reset(ctx)
+		f, err := fundService.CreateFundWithInitialOwner(ctx, "Historic Fund", 1000, "Founder")
+		require.NoError(t, err)
+		recordDate := time.Now()
+		_, err = transferService.ExecuteTransfer(ctx, transfer.Request{FundID: f.ID, FromOwner: "Founder", ToOwner: "Alice", Units: 400})
+		require.NoError(t, err)
+
+		d, err := svc.CreateDistribution(ctx, distribution.Request{FundID: f.ID, TotalAmount: 5000, RecordDate: &recordDate})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int64{"Founder": 5000}, amounts(d.LineItems))
+
+		d, err = svc.CreateDistribution(ctx, distribution.Request{FundID: f.ID, TotalAmount: 5000})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int64{"Founder": 3000, "Alice": 2000}, amounts(d.LineItems))
+	})
+
+	t.Run("CreateDistribution sums an owner's units across share classes", func(t *testing.T) {
+		tc.Reset(ctx)
+		f, err := fundService.CreateFundWithInitialOwner(ctx, "Classy Fund", 600, "Founder")
+		require.NoError(t, err)
+		class, err := ownershipService.CreateShareClass(ctx, f.ID, "Class B", 1000)
+		require.NoError(t, err)
+		_, err = fundService.IssueUnits(ctx, fund.UnitEventRequest{FundID: f.ID, Owner: "Founder", ClassID: &class.ID, Units: 200})
+		require.NoError(t, err)
+		_, err = fundService.IssueUnits(ctx, fund.UnitEventRequest{FundID: f.ID, Owner: "Bob", ClassID: &class.ID, Units: 200})
+		require.NoError(t, err)
+
+		d, err := svc.CreateDistribution(ctx, distribution.Request{FundID: f.ID, TotalAmount: 1000})
+		require.NoError(t, err)
+		assert.Equal(t, 1000, d.TotalUnits)
+		assert.Equal(t, map[string]int64{"Founder": 800, "Bob": 200}, amounts(d.LineItems))
+	})
+
+	t.Run("CreateDistribution reports a missing fund", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		_, err := svc.CreateDistribution(ctx, distribution.Request{FundID: uuid.New(), TotalAmount: 100})
+		assert.ErrorIs(t, err, distribution.ErrFundNotFound)
+	})
+
+	t.Run("List pages through a fund's distributions oldest first", func(t *testing.T) {
+		tc.Reset(ctx)
+		f, err := fundService.CreateFundWithInitialOwner(ctx, "Paged Fund", 10, "Founder")
+		require.NoError(t, err)
+		var ids []uuid.UUID
+		for i := 1; i <= 3; i++ {
+			d, err := svc.CreateDistribution(ctx, distribution.Request{FundID: f.ID, TotalAmount: int64(i * 100)})
+			require.NoError(t, err)
+			ids = append(ids, d.ID)
+		}
+
+		page, err := svc.ListDistributions(ctx, f.ID, distribution.ListParams{Limit: 2})
+		require.NoError(t, err)
+		assert.Equal(t, 3, page.Total)
+		require.Len(t, page.Items, 2)
+		assert.Equal(t, ids[0], page.Items[0].ID)
+		assert.Nil(t, page.Items[0].LineItems)
+		require.NotEmpty(t, page.NextCursor)
+
+		next, err := svc.ListDistributions(ctx, f.ID, distribution.ListParams{Limit: 2, Cursor: page.NextCursor})
+		require.NoError(t, err)
+		require.Len(t, next.Items, 1)
+		assert.Equal(t, ids[2], next.Items[0].ID)
+		assert.Empty(t, next.NextCursor)
+	})
+
+	t.Run("GetDistribution is scoped to the fund", func(t *testing.T) {
+		tc.Reset(ctx)
+		f, err := fundService.CreateFundWithInitialOwner(ctx, "Scoped Fund", 10, "Founder")
+		require.NoError(t, err)
+		d, err := svc.CreateDistribution(ctx, distribution.Request{FundID: f.ID, TotalAmount: 10})
+		require.NoError(t, err)
+
+		_, err = svc.GetDistribution(ctx, uuid.New(), d.ID)
+		assert.ErrorIs(t, err, distribution.ErrNotFound)
+	})
+}
@@ -10,10 +10,15 @@ import (
 )
 
 type Fund struct {
-	ID         uuid.UUID
-	Name       string
-	TotalUnits int
-	CreatedAt  time.Time
+	ID                uuid.UUID
+	Name              string
+	TotalUnits        int
+	CreatedAt         time.Time
+	Status            Status
+	StatusChangedAt   time.Time
+	ApprovalThreshold *int
+	LedgerSequence    int64
+	LedgerHead        []byte
 }
 
 func NewFund(name string, totalUnits int) (*Fund, error) {
@@ -24,10 +29,20 @@ func NewFund(name string, totalUnits int) (*Fund, error) {
 	if totalUnits <= 0 || totalUnits > validation.MaxUnits {
 		return nil, ErrInvalidFund
 	}
+	now := time.Now()
 	return &Fund{
-		ID:         uuid.New(),
-		Name:       trimmedName,
-		TotalUnits: totalUnits,
-		CreatedAt:  time.Now(),
+		ID:              uuid.New(),
+		Name:            trimmedName,
+		TotalUnits:      totalUnits,
+		CreatedAt:       now,
+		Status:          StatusOpen,
+		StatusChangedAt: now,
 	}, nil
 }
+
+func ValidateApprovalThreshold(threshold *int) error {
+	if threshold != nil && (*threshold <= 0 || *threshold > validation.MaxUnits) {
+		return ErrInvalidApprovalThreshold
+	}
+	return nil
+}
@@ -12,6 +12,8 @@ var ErrNotFound = errors.New("not found")
 
 var ErrInvalidFund = fmt.Errorf("invalid fund: name must be non-empty (max %d chars) and totalUnits must be positive (max %d)", validation.MaxNameLength, validation.MaxUnits)
 
+var ErrInvalidApprovalThreshold = fmt.Errorf("approval threshold must be between 1 and %d units", validation.MaxUnits)
+
 var ErrNilFund = errors.New("fund: cannot operate on nil fund")
 
 var ErrDuplicateFundName = errors.New("fund name already exists")
@@ -20,6 +22,90 @@ var ErrPoolRequired = errors.New("fund: database pool is required for transactio
 
 var ErrOwnershipRepoRequired = errors.New("fund: ownership repository is required for fund creation with initial owner")
 
+var ErrOwnerRepoRequired = errors.New("fund: owner repository is required for fund creation with initial owner")
+
+var ErrInvalidUnits = fmt.Errorf("units must be between %d and %d", validation.MinUnits, validation.MaxUnits)
+
+var ErrInvalidOwner = fmt.Errorf("owner must be given by ID or by a non-empty name (max %d chars)", validation.MaxNameLength)
+
+var ErrOwnerNotFound = errors.New("owner not found")
+
+var ErrOwnerConflict = errors.New("owner cannot be resolved unambiguously in this fund")
+
+var ErrInsufficientUnits = errors.New("owner does not hold enough unreserved units to redeem")
+
+var ErrTotalUnitsExceeded = fmt.Errorf("issuance would take the fund above %d units", validation.MaxUnits)
+
+var ErrLastUnits = errors.New("a fund must keep at least one unit outstanding")
+
+var ErrShareClassNotFound = errors.New("share class not found")
+
+var ErrAuthorizedUnitsExceeded = errors.New("issuance would take the share class above its authorized units")
+
+var ErrUnitsImbalance = errors.New("cap table units do not add up to the fund's total units")
+
+var ErrInvalidStatus = fmt.Errorf("status must be %q, %q, %q or %q", StatusOpen, StatusClosed, StatusSuspended, StatusLiquidated)
+
+var ErrInvalidStatusReason = fmt.Errorf("status reason must be at most %d chars", MaxStatusReasonLength)
+
+var ErrStatusTransition = errors.New("fund status cannot change this way")
+
+var ErrFundNotOpen = errors.New("fund is not open")
+
+var ErrInvalidImport = errors.New("invalid cap table import")
+
+var ErrEmptyImport = fmt.Errorf("%w: at least one row is required", ErrInvalidImport)
+
+var ErrTooManyImportRows = fmt.Errorf("%w: at most %d rows are allowed", ErrInvalidImport, MaxImportRows)
+
+var ErrDuplicateImportOwner = errors.New("owner appears in more than one row")
+
+type RowError struct {
+	Row int
+	Err error
+}
+
+func (e *RowError) Error() string {
+	return fmt.Sprintf("row %d: %v", e.Row, e.Err)
+}
+
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+type ImportError struct {
+	Rows  []*RowError
+	Total int
+}
+
+func (e *ImportError) Error() string {
+	return fmt.Sprintf("%d of %d import rows are invalid", len(e.Rows), e.Total)
+}
+
+func (e *ImportError) Unwrap() error {
+	return ErrInvalidImport
+}
+
+func DuplicateImportOwnerError(firstRow int) error {
+	return fmt.Errorf("%w (first in row %d)", ErrDuplicateImportOwner, firstRow)
+}
+
+func ImportUnitsMismatchError(sum, totalUnits int) error {
+	return fmt.Errorf("%w: rows add up to %d units but the fund has %d", ErrInvalidImport, sum, totalUnits)
+}
+
+func AuthorizedUnitsExceededError(unissued int) error {
+	return fmt.Errorf("%w (%d unissued)", ErrAuthorizedUnitsExceeded, unissued)
+}
+
+func StatusTransitionError(from, to Status) error {
+	return fmt.Errorf("%w: %s to %s", ErrStatusTransition, from, to)
+}
+
+func NotOpenError(status Status) error {
+	return fmt.Errorf("%w (%s)", ErrFundNotOpen, status)
+}
+
 func NotFoundError(id uuid.UUID) error {
 	return fmt.Errorf("fund %s: %w", id, ErrNotFound)
 }
@@ -0,0 +1,45 @@
+package fund
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type createdEvent struct {
+	ID           uuid.UUID  `json:"id"`
+	Name         string     `json:"name"`
+	TotalUnits   int        `json:"totalUnits"`
+	InitialOwner string     `json:"initialOwner,omitempty"`
+	OwnerID      *uuid.UUID `json:"initialOwnerId,omitempty"`
+	Holders      int        `json:"holders"`
+	CreatedAt    time.Time  `json:"createdAt"`
+}
+
+type statusChangedEvent struct {
+	ID        uuid.UUID `json:"id"`
+	From      Status    `json:"from"`
+	To        Status    `json:"to"`
+	Reason    string    `json:"reason,omitempty"`
+	ChangedAt time.Time `json:"changedAt"`
+}
+
+type unitsChangedEvent struct {
+	ID         uuid.UUID `json:"id"`
+	FundID     uuid.UUID `json:"fundId"`
+	OwnerID    uuid.UUID `json:"ownerId"`
+	OwnerName  string    `json:"ownerName"`
+	ClassID    uuid.UUID `json:"classId"`
+	Units      int       `json:"units"`
+	TotalUnits int       `json:"totalUnits"`
+	OccurredAt time.Time `json:"occurredAt"`
+}
+
+type balanceChangedEvent struct {
+	OwnerID     uuid.UUID `json:"ownerId"`
+	OwnerName   string    `json:"ownerName"`
+	ClassID     uuid.UUID `json:"classId"`
+	Units       int       `json:"units"`
+	Delta       int       `json:"delta"`
+	UnitEventID uuid.UUID `json:"unitEventId"`
+}
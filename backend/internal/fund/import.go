@@ -0,0 +1,109 @@
+package fund
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const MaxImportRows = 10000
+
+type ImportRow struct {
+	Owner   string
+	OwnerID *uuid.UUID
+	Units   int
+}
+
+type ImportRequest struct {
+	Name       string
+	TotalUnits int
+	Rows       []ImportRow
+}
+
+func (r ImportRequest) Validate() error {
+	if len(r.Rows) == 0 {
+		return ErrEmptyImport
+	}
+	if len(r.Rows) > MaxImportRows {
+		return ErrTooManyImportRows
+	}
+	return nil
+}
+
+func ReadImportCSV(r io.Reader) ([]ImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, ErrEmptyImport
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImport, err)
+	}
+
+	ownerCol, ownerIDCol, unitsCol := -1, -1, -1
+	for i, name := range header {
+		switch strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))) {
+		case "owner":
+			ownerCol = i
+		case "ownerid":
+			ownerIDCol = i
+		case "units":
+			unitsCol = i
+		}
+	}
+	if unitsCol < 0 || (ownerCol < 0 && ownerIDCol < 0) {
+		return nil, fmt.Errorf("%w: CSV header must name a units column and an owner or ownerId column", ErrInvalidImport)
+	}
+
+	var rows []ImportRow
+	var rowErrs []*RowError
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidImport, err)
+		}
+		if len(rows) == MaxImportRows {
+			return nil, ErrTooManyImportRows
+		}
+
+		var row ImportRow
+		n := len(rows) + 1
+		if ownerCol >= 0 {
+			row.Owner = strings.TrimSpace(record[ownerCol])
+		}
+		if ownerIDCol >= 0 {
+			if v := strings.TrimSpace(record[ownerIDCol]); v != "" {
+				id, err := uuid.Parse(v)
+				if err != nil {
+					rowErrs = append(rowErrs, &RowError{Row: n, Err: fmt.Errorf("ownerId %q is not a UUID", v)})
+				} else {
+					row.OwnerID = &id
+				}
+			}
+		}
+		v := strings.TrimSpace(record[unitsCol])
+		if row.Units, err = strconv.Atoi(v); err != nil {
+			rowErrs = append(rowErrs, &RowError{Row: n, Err: fmt.Errorf("units %q is not a whole number", v)})
+		}
+		rows = append(rows, row)
+	}
+
+	if len(rowErrs) > 0 {
+		return nil, &ImportError{Rows: rowErrs, Total: len(rows)}
+	}
+	if len(rows) == 0 {
+		return nil, ErrEmptyImport
+	}
+	return rows, nil
+}
@@ -0,0 +1,61 @@
+package fund
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadImportCSV(t *testing.T) {
+	t.Run("reads owner and units columns in any order", func(t *testing.T) {
+		id := uuid.New()
+		rows, err := ReadImportCSV(strings.NewReader("\ufeffUnits, Owner ,ownerId,percentage\n600,Founder LLC,,60\n400,, " + id.String() + ",40\n"))
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+		assert.Equal(t, ImportRow{Owner: "Founder LLC", Units: 600}, rows[0])
+		assert.Equal(t, ImportRow{OwnerID: &id, Units: 400}, rows[1])
+	})
+
+	t.Run("requires units and an owner column", func(t *testing.T) {
+		_, err := ReadImportCSV(strings.NewReader("name,units\nAlice,10\n"))
+		assert.ErrorIs(t, err, ErrInvalidImport)
+	})
+
+	t.Run("rejects an empty body", func(t *testing.T) {
+		_, err := ReadImportCSV(strings.NewReader(""))
+		assert.ErrorIs(t, err, ErrEmptyImport)
+
+		_, err = ReadImportCSV(strings.NewReader("owner,units\n"))
+		assert.ErrorIs(t, err, ErrEmptyImport)
+	})
+
+	t.Run("collects every malformed row", func(t *testing.T) {
+		_, err := ReadImportCSV(strings.NewReader("owner,ownerId,units\nAlice,,10\nBob,,ten\nCarol,not-a-uuid,5\n"))
+		var importErr *ImportError
+		require.ErrorAs(t, err, &importErr)
+		assert.ErrorIs(t, err, ErrInvalidImport)
+		assert.Equal(t, 3, importErr.Total)
+		require.Len(t, importErr.Rows, 2)
+		assert.Equal(t, 2, importErr.Rows[0].Row)
+		assert.Equal(t, 3, importErr.Rows[1].Row)
+	})
+
+	t.Run("stops reading past the row limit", func(t *testing.T) {
+		var b strings.Builder
+		b.WriteString("owner,units\n")
+		for i := 0; i <= MaxImportRows; i++ {
+			b.WriteString("Holder,1\n")
+		}
+		_, err := ReadImportCSV(strings.NewReader(b.String()))
+		assert.ErrorIs(t, err, ErrTooManyImportRows)
+	})
+}
+
+func TestImportRequest_Validate(t *testing.T) {
+	assert.ErrorIs(t, ImportRequest{}.Validate(), ErrEmptyImport)
+	assert.ErrorIs(t, ImportRequest{Rows: make([]ImportRow, MaxImportRows+1)}.Validate(), ErrTooManyImportRows)
+	assert.NoError(t, ImportRequest{Rows: []ImportRow{{Owner: "Alice", Units: 1}}}.Validate())
+}
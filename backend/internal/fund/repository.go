@@ -11,15 +11,24 @@ import (
 type ListParams = validation.ListParams
 
 type ListResult struct {
-	Items  []*Fund
-	Total  int
-	Limit  int
-	Offset int
+	Items      []*Fund
+	Total      int
+	Limit      int
+	Offset     int
+	NextCursor string
 }
 
 type Repository interface {
 	Create(ctx context.Context, fund *Fund) error
 	CreateTx(ctx context.Context, tx pgx.Tx, fund *Fund) error
 	FindByID(ctx context.Context, id uuid.UUID) (*Fund, error)
-	List(ctx context.Context, params ListParams) (*ListResult, error)
+	List(ctx context.Context, status *Status, params ListParams) (*ListResult, error)
+	UpdateApprovalThreshold(ctx context.Context, id uuid.UUID, threshold *int) error
+	UpdateStatusTx(ctx context.Context, tx pgx.Tx, fund *Fund) error
+	LockTx(ctx context.Context, tx pgx.Tx, id uuid.UUID) (*Fund, error)
+	UpdateTotalUnitsTx(ctx context.Context, tx pgx.Tx, id uuid.UUID, totalUnits int) error
+	SumHoldingsTx(ctx context.Context, tx pgx.Tx, id uuid.UUID) (int, error)
+	ReservedUnitsTx(ctx context.Context, tx pgx.Tx, fundID, classID, ownerID uuid.UUID) (int, error)
+	CreateUnitEventTx(ctx context.Context, tx pgx.Tx, event *UnitEvent) error
+	ListUnitEvents(ctx context.Context, fundID uuid.UUID, params ListParams) (*UnitEventList, error)
 }
@@ -4,9 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/arowden/augment-fund/internal/audit"
+	"github.com/arowden/augment-fund/internal/outbox"
+	"github.com/arowden/augment-fund/internal/owner"
 	"github.com/arowden/augment-fund/internal/ownership"
+	"github.com/arowden/augment-fund/internal/validation"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -14,6 +21,9 @@ type Service struct {
 	repo          Repository
 	pool          *pgxpool.Pool
 	ownershipRepo ownership.Repository
+	ownerRepo     owner.Repository
+	outbox        outbox.Writer
+	audit         audit.Writer
 }
 
 type ServiceOption func(*Service)
@@ -26,6 +36,18 @@ func WithOwnershipRepository(r ownership.Repository) ServiceOption {
 	return func(s *Service) { s.ownershipRepo = r }
 }
 
+func WithOwnerRepository(r owner.Repository) ServiceOption {
+	return func(s *Service) { s.ownerRepo = r }
+}
+
+func WithOutbox(w outbox.Writer) ServiceOption {
+	return func(s *Service) { s.outbox = w }
+}
+
+func WithAudit(w audit.Writer) ServiceOption {
+	return func(s *Service) { s.audit = w }
+}
+
 func NewService(repo Repository, opts ...ServiceOption) (*Service, error) {
 	if repo == nil {
 		return nil, errors.New("fund: repository is required")
@@ -43,17 +65,126 @@ func (s *Service) CreateFundWithInitialOwner(ctx context.Context, name string, t
 		return nil, err
 	}
 
-	entry, err := ownership.NewCapTableEntry(fund.ID, initialOwner, totalUnits)
-	if err != nil {
+	legalName := strings.TrimSpace(initialOwner)
+	if _, err := owner.NewOwner(legalName, owner.TypeIndividual, nil); err != nil {
 		return nil, fmt.Errorf("invalid initial owner: %w", err)
 	}
 
+	return s.createFund(ctx, fund, audit.OperationFundCreate, s.initialHolder(fund, func(ctx context.Context, tx pgx.Tx) (*owner.Owner, error) {
+		return s.ownerRepo.ResolveTx(ctx, tx, fund.ID, legalName)
+	}))
+}
+
+func (s *Service) CreateFundForOwner(ctx context.Context, name string, totalUnits int, ownerID uuid.UUID) (*Fund, error) {
+	fund, err := NewFund(name, totalUnits)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.createFund(ctx, fund, audit.OperationFundCreate, s.initialHolder(fund, func(ctx context.Context, tx pgx.Tx) (*owner.Owner, error) {
+		return s.ownerRepo.FindByIDTx(ctx, tx, ownerID)
+	}))
+}
+
+func (s *Service) ImportFund(ctx context.Context, req ImportRequest) (*Fund, error) {
+	fund, err := NewFund(req.Name, req.TotalUnits)
+	if err != nil {
+		return nil, err
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	return s.createFund(ctx, fund, audit.OperationFundImport, func(ctx context.Context, tx pgx.Tx) ([]*ownership.Entry, error) {
+		return s.importHolders(ctx, tx, fund, req.Rows)
+	})
+}
+
+type holdersFunc func(context.Context, pgx.Tx) ([]*ownership.Entry, error)
+
+func (s *Service) initialHolder(fund *Fund, initialOwner func(context.Context, pgx.Tx) (*owner.Owner, error)) holdersFunc {
+	return func(ctx context.Context, tx pgx.Tx) ([]*ownership.Entry, error) {
+		o, err := initialOwner(ctx, tx)
+		if err != nil {
+			return nil, fmt.Errorf("resolve initial owner: %w", err)
+		}
+
+		entry, err := ownership.NewCapTableEntry(fund.ID, o.ID, o.LegalName, fund.TotalUnits)
+		if err != nil {
+			return nil, fmt.Errorf("invalid initial owner: %w", err)
+		}
+		return []*ownership.Entry{entry}, nil
+	}
+}
+
+func (s *Service) importHolders(ctx context.Context, tx pgx.Tx, fund *Fund, rows []ImportRow) ([]*ownership.Entry, error) {
+	entries := make([]*ownership.Entry, 0, len(rows))
+	var rowErrs []*RowError
+	byOwner := make(map[uuid.UUID]int, len(rows))
+	byName := make(map[string]int, len(rows))
+	sum := 0
+
+	for i, row := range rows {
+		n := i + 1
+		sum += row.Units
+
+		o, err := s.resolveImportOwner(ctx, tx, fund.ID, row)
+		if err != nil {
+			if !errors.Is(err, owner.ErrNotFound) && !errors.Is(err, owner.ErrAmbiguousName) && !errors.Is(err, owner.ErrInvalidName) {
+				return nil, fmt.Errorf("resolve owner in row %d: %w", n, err)
+			}
+			rowErrs = append(rowErrs, &RowError{Row: n, Err: err})
+			continue
+		}
+
+		entry, err := ownership.NewCapTableEntry(fund.ID, o.ID, o.LegalName, row.Units)
+		if err != nil {
+			rowErrs = append(rowErrs, &RowError{Row: n, Err: err})
+			continue
+		}
+		if first, ok := byOwner[o.ID]; ok {
+			rowErrs = append(rowErrs, &RowError{Row: n, Err: DuplicateImportOwnerError(first)})
+			continue
+		}
+		if first, ok := byName[entry.OwnerName]; ok {
+			rowErrs = append(rowErrs, &RowError{Row: n, Err: fmt.Errorf("%w (row %d)", ownership.ErrOwnerNameTaken, first)})
+			continue
+		}
+		byOwner[o.ID] = n
+		byName[entry.OwnerName] = n
+		entries = append(entries, entry)
+	}
+
+	if len(rowErrs) > 0 {
+		return nil, &ImportError{Rows: rowErrs, Total: len(rows)}
+	}
+	if sum != fund.TotalUnits {
+		return nil, ImportUnitsMismatchError(sum, fund.TotalUnits)
+	}
+	return entries, nil
+}
+
+func (s *Service) resolveImportOwner(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, row ImportRow) (*owner.Owner, error) {
+	if row.OwnerID != nil {
+		return s.ownerRepo.FindByIDTx(ctx, tx, *row.OwnerID)
+	}
+	legalName := strings.TrimSpace(row.Owner)
+	if _, err := owner.NewOwner(legalName, owner.TypeIndividual, nil); err != nil {
+		return nil, err
+	}
+	return s.ownerRepo.ResolveTx(ctx, tx, fundID, legalName)
+}
+
+func (s *Service) createFund(ctx context.Context, fund *Fund, operation string, holders holdersFunc) (*Fund, error) {
 	if s.pool == nil {
 		return nil, ErrPoolRequired
 	}
 	if s.ownershipRepo == nil {
 		return nil, ErrOwnershipRepoRequired
 	}
+	if s.ownerRepo == nil {
+		return nil, ErrOwnerRepoRequired
+	}
 
 	tx, err := s.pool.Begin(ctx)
 	if err != nil {
@@ -65,8 +196,65 @@ func (s *Service) CreateFundWithInitialOwner(ctx context.Context, name string, t
 		return nil, err
 	}
 
-	if err := s.ownershipRepo.CreateTx(ctx, tx, entry); err != nil {
-		return nil, fmt.Errorf("create initial ownership: %w", err)
+	entries, err := holders(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	after := make(map[string]int, len(entries))
+	issued := 0
+	for _, entry := range entries {
+		if err := s.ownershipRepo.CreateTx(ctx, tx, entry); err != nil {
+			return nil, fmt.Errorf("create ownership for %q: %w", entry.OwnerName, err)
+		}
+		after[entry.OwnerName] = entry.Units
+		if entry.Units == 0 {
+			continue
+		}
+
+		issued += entry.Units
+		opening := &UnitEvent{
+			ID:         uuid.New(),
+			FundID:     fund.ID,
+			OwnerID:    entry.OwnerID,
+			OwnerName:  entry.OwnerName,
+			Kind:       KindIssuance,
+			Units:      entry.Units,
+			TotalUnits: issued,
+			OccurredAt: fund.CreatedAt,
+		}
+		if err := s.repo.CreateUnitEventTx(ctx, tx, opening); err != nil {
+			return nil, err
+		}
+	}
+
+	if s.outbox != nil {
+		payload := createdEvent{
+			ID:         fund.ID,
+			Name:       fund.Name,
+			TotalUnits: fund.TotalUnits,
+			Holders:    len(entries),
+			CreatedAt:  fund.CreatedAt,
+		}
+		if len(entries) == 1 {
+			payload.InitialOwner = entries[0].OwnerName
+			payload.OwnerID = &entries[0].OwnerID
+		}
+		event, err := outbox.NewEvent(outbox.EventFundCreated, fund.ID, payload)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.outbox.AppendTx(ctx, tx, event); err != nil {
+			return nil, err
+		}
+	}
+
+	if s.audit != nil {
+		event := audit.NewEvent(ctx, operation).ForFund(fund.ID)
+		event.After = after
+		event.Details = map[string]any{"name": fund.Name, "totalUnits": fund.TotalUnits, "holders": len(entries)}
+		if err := s.audit.AppendTx(ctx, tx, event); err != nil {
+			return nil, err
+		}
 	}
 
 	if err := tx.Commit(ctx); err != nil {
@@ -80,6 +268,319 @@ func (s *Service) GetFund(ctx context.Context, id uuid.UUID) (*Fund, error) {
 	return s.repo.FindByID(ctx, id)
 }
 
-func (s *Service) ListFunds(ctx context.Context, params ListParams) (*ListResult, error) {
-	return s.repo.List(ctx, params)
+func (s *Service) ListFunds(ctx context.Context, status *Status, params ListParams) (*ListResult, error) {
+	if status != nil && !status.Valid() {
+		return nil, ErrInvalidStatus
+	}
+	return s.repo.List(ctx, status, params)
+}
+
+func (s *Service) IssueUnits(ctx context.Context, req UnitEventRequest) (*UnitEvent, error) {
+	return s.adjustUnits(ctx, KindIssuance, req)
+}
+
+func (s *Service) RedeemUnits(ctx context.Context, req UnitEventRequest) (*UnitEvent, error) {
+	return s.adjustUnits(ctx, KindRedemption, req)
+}
+
+func (s *Service) ListUnitEvents(ctx context.Context, fundID uuid.UUID, params ListParams) (*UnitEventList, error) {
+	return s.repo.ListUnitEvents(ctx, fundID, params)
+}
+
+func (s *Service) adjustUnits(ctx context.Context, kind UnitEventKind, req UnitEventRequest) (*UnitEvent, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if s.pool == nil {
+		return nil, ErrPoolRequired
+	}
+	if s.ownershipRepo == nil {
+		return nil, ErrOwnershipRepoRequired
+	}
+	if s.ownerRepo == nil {
+		return nil, ErrOwnerRepoRequired
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	fund, err := s.repo.LockTx(ctx, tx, req.FundID)
+	if err != nil {
+		return nil, err
+	}
+	if fund.Status != StatusOpen {
+		return nil, NotOpenError(fund.Status)
+	}
+	if err := s.checkBalance(ctx, tx, fund.ID, fund.TotalUnits); err != nil {
+		return nil, err
+	}
+
+	class, err := s.ownershipRepo.FindShareClassTx(ctx, tx, fund.ID, req.ClassID)
+	if errors.Is(err, ownership.ErrShareClassNotFound) {
+		return nil, ErrShareClassNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("find share class: %w", err)
+	}
+
+	o, err := s.resolveUnitOwner(ctx, tx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	event := &UnitEvent{
+		ID:         uuid.New(),
+		FundID:     fund.ID,
+		OwnerID:    o.ID,
+		ClassID:    class.ID,
+		ClassName:  class.Name,
+		Kind:       kind,
+		Units:      req.Units,
+		OccurredAt: time.Now(),
+	}
+	switch kind {
+	case KindIssuance:
+		if fund.TotalUnits > validation.MaxUnits-req.Units {
+			return nil, ErrTotalUnitsExceeded
+		}
+		if req.Units > class.UnissuedUnits() {
+			return nil, AuthorizedUnitsExceededError(class.UnissuedUnits())
+		}
+		if err := s.ownershipRepo.IncrementOrCreateTx(ctx, tx, fund.ID, &class.ID, o.ID, req.Units); err != nil {
+			if errors.Is(err, ownership.ErrOwnerNameTaken) {
+				return nil, fmt.Errorf("%w: %w", ErrOwnerConflict, err)
+			}
+			return nil, fmt.Errorf("credit owner %s: %w", o.ID, err)
+		}
+	case KindRedemption:
+		if err := s.debitOwner(ctx, tx, fund, class.ID, o.ID, req.Units); err != nil {
+			return nil, err
+		}
+	}
+	event.TotalUnits = fund.TotalUnits + event.Delta()
+
+	if err := s.repo.UpdateTotalUnitsTx(ctx, tx, fund.ID, event.TotalUnits); err != nil {
+		return nil, err
+	}
+	if err := s.checkBalance(ctx, tx, fund.ID, event.TotalUnits); err != nil {
+		return nil, err
+	}
+
+	entry, err := s.ownershipRepo.FindByFundAndOwnerIDForUpdateTx(ctx, tx, fund.ID, &class.ID, o.ID)
+	if err != nil {
+		return nil, fmt.Errorf("read balance of owner %s: %w", o.ID, err)
+	}
+	event.OwnerName = entry.OwnerName
+
+	if err := s.repo.CreateUnitEventTx(ctx, tx, event); err != nil {
+		return nil, err
+	}
+	if err := s.publishUnitEvent(ctx, tx, event, entry); err != nil {
+		return nil, err
+	}
+
+	if s.audit != nil {
+		operation := audit.OperationUnitsIssue
+		if kind == KindRedemption {
+			operation = audit.OperationUnitsRedeem
+		}
+		record := audit.NewEvent(ctx, operation).ForFund(fund.ID)
+		record.Before = map[string]int{entry.OwnerName: entry.Units - event.Delta()}
+		record.After = map[string]int{entry.OwnerName: entry.Units}
+		record.Details = map[string]any{
+			"unitEventId":      event.ID,
+			"ownerId":          event.OwnerID,
+			"classId":          event.ClassID,
+			"units":            event.Units,
+			"totalUnitsBefore": fund.TotalUnits,
+			"totalUnits":       event.TotalUnits,
+		}
+		if err := s.audit.AppendTx(ctx, tx, record); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+
+	return event, nil
+}
+
+func (s *Service) resolveUnitOwner(ctx context.Context, tx pgx.Tx, req UnitEventRequest) (*owner.Owner, error) {
+	var (
+		o   *owner.Owner
+		err error
+	)
+	if req.OwnerID != nil {
+		o, err = s.ownerRepo.FindByIDTx(ctx, tx, *req.OwnerID)
+	} else {
+		o, err = s.ownerRepo.ResolveTx(ctx, tx, req.FundID, strings.TrimSpace(req.Owner))
+	}
+	if errors.Is(err, owner.ErrNotFound) {
+		return nil, ErrOwnerNotFound
+	}
+	if errors.Is(err, owner.ErrAmbiguousName) {
+		return nil, fmt.Errorf("%w: %w", ErrOwnerConflict, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("resolve owner: %w", err)
+	}
+	return o, nil
+}
+
+func (s *Service) debitOwner(ctx context.Context, tx pgx.Tx, fund *Fund, classID, ownerID uuid.UUID, units int) error {
+	entry, err := s.ownershipRepo.FindByFundAndOwnerIDForUpdateTx(ctx, tx, fund.ID, &classID, ownerID)
+	if errors.Is(err, ownership.ErrOwnerNotFound) {
+		return ErrOwnerNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("lock owner %s: %w", ownerID, err)
+	}
+
+	reserved, err := s.repo.ReservedUnitsTx(ctx, tx, fund.ID, classID, ownerID)
+	if err != nil {
+		return err
+	}
+	if entry.Units-reserved < units {
+		return ErrInsufficientUnits
+	}
+	if units >= fund.TotalUnits {
+		return ErrLastUnits
+	}
+
+	if err := s.ownershipRepo.DecrementUnitsTx(ctx, tx, entry.ID, units); err != nil {
+		return fmt.Errorf("debit owner %s: %w", ownerID, err)
+	}
+	return nil
+}
+
+func (s *Service) checkBalance(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, totalUnits int) error {
+	held, err := s.repo.SumHoldingsTx(ctx, tx, fundID)
+	if err != nil {
+		return err
+	}
+	if held != totalUnits {
+		return fmt.Errorf("%w: fund %s has %d total units but its cap table holds %d", ErrUnitsImbalance, fundID, totalUnits, held)
+	}
+	return nil
+}
+
+func (s *Service) publishUnitEvent(ctx context.Context, tx pgx.Tx, event *UnitEvent, entry *ownership.Entry) error {
+	if s.outbox == nil {
+		return nil
+	}
+
+	eventType := outbox.EventUnitsIssued
+	if event.Kind == KindRedemption {
+		eventType = outbox.EventUnitsRedeemed
+	}
+	changed, err := outbox.NewEvent(eventType, event.FundID, unitsChangedEvent{
+		ID:         event.ID,
+		FundID:     event.FundID,
+		OwnerID:    event.OwnerID,
+		OwnerName:  event.OwnerName,
+		ClassID:    event.ClassID,
+		Units:      event.Units,
+		TotalUnits: event.TotalUnits,
+		OccurredAt: event.OccurredAt,
+	})
+	if err != nil {
+		return err
+	}
+	if err := s.outbox.AppendTx(ctx, tx, changed); err != nil {
+		return err
+	}
+
+	balance, err := outbox.NewEvent(outbox.EventBalanceChanged, event.FundID, balanceChangedEvent{
+		OwnerID:     entry.OwnerID,
+		OwnerName:   entry.OwnerName,
+		ClassID:     entry.ClassID,
+		Units:       entry.Units,
+		Delta:       event.Delta(),
+		UnitEventID: event.ID,
+	})
+	if err != nil {
+		return err
+	}
+	return s.outbox.AppendTx(ctx, tx, balance)
+}
+
+func (s *Service) SetApprovalThreshold(ctx context.Context, id uuid.UUID, threshold *int) (*Fund, error) {
+	if err := ValidateApprovalThreshold(threshold); err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateApprovalThreshold(ctx, id, threshold); err != nil {
+		return nil, err
+	}
+	return s.repo.FindByID(ctx, id)
+}
+
+func (s *Service) ChangeStatus(ctx context.Context, change StatusChange) (*Fund, error) {
+	if err := change.Validate(); err != nil {
+		return nil, err
+	}
+	if s.pool == nil {
+		return nil, ErrPoolRequired
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	fund, err := s.repo.LockTx(ctx, tx, change.FundID)
+	if err != nil {
+		return nil, err
+	}
+	if fund.Status == change.Status {
+		return fund, nil
+	}
+	if !fund.Status.CanTransitionTo(change.Status) {
+		return nil, StatusTransitionError(fund.Status, change.Status)
+	}
+
+	from := fund.Status
+	fund.Status = change.Status
+	fund.StatusChangedAt = time.Now()
+	if err := s.repo.UpdateStatusTx(ctx, tx, fund); err != nil {
+		return nil, err
+	}
+
+	if s.outbox != nil {
+		event, err := outbox.NewEvent(outbox.EventFundStatusChanged, fund.ID, statusChangedEvent{
+			ID:        fund.ID,
+			From:      from,
+			To:        fund.Status,
+			Reason:    change.Reason,
+			ChangedAt: fund.StatusChangedAt,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := s.outbox.AppendTx(ctx, tx, event); err != nil {
+			return nil, err
+		}
+	}
+
+	if s.audit != nil {
+		event := audit.NewEvent(ctx, audit.OperationFundStatus).ForFund(fund.ID)
+		event.Details = map[string]any{"from": from, "to": fund.Status}
+		if change.Reason != "" {
+			event.Details["reason"] = change.Reason
+		}
+		if err := s.audit.AppendTx(ctx, tx, event); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+
+	return fund, nil
 }
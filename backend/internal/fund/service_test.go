@@ -3,7 +3,9 @@ package fund
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/arowden/augment-fund/internal/ownership"
 	"github.com/google/uuid"
@@ -16,7 +18,8 @@ type mockRepository struct {
 	createFunc   func(ctx context.Context, fund *Fund) error
 	createTxFunc func(ctx context.Context, tx pgx.Tx, fund *Fund) error
 	findByIDFunc func(ctx context.Context, id uuid.UUID) (*Fund, error)
-	listFunc     func(ctx context.Context, params ListParams) (*ListResult, error)
+	listFunc     func(ctx context.Context, status *Status, params ListParams) (*ListResult, error)
+	updateFunc   func(ctx context.Context, id uuid.UUID, threshold *int) error
 }
 
 func (m *mockRepository) Create(ctx context.Context, fund *Fund) error {
@@ -40,13 +43,48 @@ func (m *mockRepository) FindByID(ctx context.Context, id uuid.UUID) (*Fund, err
 	return nil, NotFoundError(id)
 }
 
-func (m *mockRepository) List(ctx context.Context, params ListParams) (*ListResult, error) {
+func (m *mockRepository) List(ctx context.Context, status *Status, params ListParams) (*ListResult, error) {
 	if m.listFunc != nil {
-		return m.listFunc(ctx, params)
+		return m.listFunc(ctx, status, params)
 	}
 	return &ListResult{Items: []*Fund{}}, nil
 }
 
+func (m *mockRepository) UpdateApprovalThreshold(ctx context.Context, id uuid.UUID, threshold *int) error {
+	if m.updateFunc != nil {
+		return m.updateFunc(ctx, id, threshold)
+	}
+	return nil
+}
+
+func (m *mockRepository) UpdateStatusTx(_ context.Context, _ pgx.Tx, _ *Fund) error {
+	return nil
+}
+
+func (m *mockRepository) LockTx(_ context.Context, _ pgx.Tx, id uuid.UUID) (*Fund, error) {
+	return nil, NotFoundError(id)
+}
+
+func (m *mockRepository) UpdateTotalUnitsTx(_ context.Context, _ pgx.Tx, _ uuid.UUID, _ int) error {
+	return nil
+}
+
+func (m *mockRepository) SumHoldingsTx(_ context.Context, _ pgx.Tx, _ uuid.UUID) (int, error) {
+	return 0, nil
+}
+
+func (m *mockRepository) ReservedUnitsTx(_ context.Context, _ pgx.Tx, _, _, _ uuid.UUID) (int, error) {
+	return 0, nil
+}
+
+func (m *mockRepository) CreateUnitEventTx(_ context.Context, _ pgx.Tx, _ *UnitEvent) error {
+	return nil
+}
+
+func (m *mockRepository) ListUnitEvents(_ context.Context, _ uuid.UUID, _ ListParams) (*UnitEventList, error) {
+	return &UnitEventList{Items: []*UnitEvent{}}, nil
+}
+
 type mockOwnershipRepository struct {
 	createTxFunc func(ctx context.Context, tx pgx.Tx, entry *ownership.Entry) error
 }
@@ -66,11 +104,23 @@ func (m *mockOwnershipRepository) FindByFundID(_ context.Context, _ uuid.UUID, _
 	return nil, nil
 }
 
-func (m *mockOwnershipRepository) FindByFundAndOwner(_ context.Context, _ uuid.UUID, _ string) (*ownership.Entry, error) {
+func (m *mockOwnershipRepository) StreamByFundID(_ context.Context, _ uuid.UUID, _ func(*ownership.Entry) error) error {
+	return nil
+}
+
+func (m *mockOwnershipRepository) FindHoldingsByOwnerID(_ context.Context, _ uuid.UUID) ([]*ownership.Holding, error) {
+	return nil, nil
+}
+
+func (m *mockOwnershipRepository) FindByFundAndOwner(_ context.Context, _ uuid.UUID, _ *uuid.UUID, _ string) (*ownership.Entry, error) {
 	return nil, nil
 }
 
-func (m *mockOwnershipRepository) FindByFundAndOwnerForUpdateTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, ownerName string) (*ownership.Entry, error) {
+func (m *mockOwnershipRepository) FindByFundAndOwnerForUpdateTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, classID *uuid.UUID, ownerName string) (*ownership.Entry, error) {
+	return nil, nil
+}
+
+func (m *mockOwnershipRepository) FindByFundAndOwnerIDForUpdateTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, classID *uuid.UUID, ownerID uuid.UUID) (*ownership.Entry, error) {
 	return nil, nil
 }
 
@@ -78,7 +128,7 @@ func (m *mockOwnershipRepository) DecrementUnitsTx(ctx context.Context, tx pgx.T
 	return nil
 }
 
-func (m *mockOwnershipRepository) IncrementOrCreateTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, ownerName string, units int) error {
+func (m *mockOwnershipRepository) IncrementOrCreateTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, classID *uuid.UUID, ownerID uuid.UUID, units int) error {
 	return nil
 }
 
@@ -90,6 +140,22 @@ func (m *mockOwnershipRepository) UpsertTx(ctx context.Context, tx pgx.Tx, entry
 	return nil
 }
 
+func (m *mockOwnershipRepository) FindLedger(ctx context.Context, fundID uuid.UUID, until time.Time) (*ownership.Ledger, error) {
+	return nil, ownership.ErrNotFound
+}
+
+func (m *mockOwnershipRepository) CreateShareClass(_ context.Context, _ *ownership.ShareClass) error {
+	return nil
+}
+
+func (m *mockOwnershipRepository) ListShareClasses(_ context.Context, _ uuid.UUID) ([]*ownership.ShareClass, error) {
+	return []*ownership.ShareClass{}, nil
+}
+
+func (m *mockOwnershipRepository) FindShareClassTx(_ context.Context, _ pgx.Tx, fundID uuid.UUID, _ *uuid.UUID) (*ownership.ShareClass, error) {
+	return nil, ownership.ErrShareClassNotFound
+}
+
 func TestNewService(t *testing.T) {
 	t.Run("returns error when repo is nil", func(t *testing.T) {
 		svc, err := NewService(nil)
@@ -165,7 +231,7 @@ func TestService_ListFunds(t *testing.T) {
 			Offset: 0,
 		}
 		repo := &mockRepository{
-			listFunc: func(ctx context.Context, params ListParams) (*ListResult, error) {
+			listFunc: func(ctx context.Context, status *Status, params ListParams) (*ListResult, error) {
 				return expectedResult, nil
 			},
 		}
@@ -173,7 +239,7 @@ func TestService_ListFunds(t *testing.T) {
 		svc, err := NewService(repo)
 		require.NoError(t, err)
 
-		result, err := svc.ListFunds(context.Background(), ListParams{})
+		result, err := svc.ListFunds(context.Background(), nil, ListParams{})
 		require.NoError(t, err)
 		assert.Equal(t, expectedResult, result)
 	})
@@ -181,7 +247,7 @@ func TestService_ListFunds(t *testing.T) {
 	t.Run("passes params to repository", func(t *testing.T) {
 		var receivedParams ListParams
 		repo := &mockRepository{
-			listFunc: func(ctx context.Context, params ListParams) (*ListResult, error) {
+			listFunc: func(ctx context.Context, status *Status, params ListParams) (*ListResult, error) {
 				receivedParams = params
 				return &ListResult{Items: []*Fund{}}, nil
 			},
@@ -191,10 +257,59 @@ func TestService_ListFunds(t *testing.T) {
 		require.NoError(t, err)
 
 		params := ListParams{Limit: 50, Offset: 10}
-		_, err = svc.ListFunds(context.Background(), params)
+		_, err = svc.ListFunds(context.Background(), nil, params)
 		require.NoError(t, err)
 		assert.Equal(t, params, receivedParams)
 	})
+
+	t.Run("passes status filter to repository", func(t *testing.T) {
+		var receivedStatus *Status
+		repo := &mockRepository{
+			listFunc: func(ctx context.Context, status *Status, params ListParams) (*ListResult, error) {
+				receivedStatus = status
+				return &ListResult{Items: []*Fund{}}, nil
+			},
+		}
+
+		svc, err := NewService(repo)
+		require.NoError(t, err)
+
+		status := StatusClosed
+		_, err = svc.ListFunds(context.Background(), &status, ListParams{})
+		require.NoError(t, err)
+		require.NotNil(t, receivedStatus)
+		assert.Equal(t, StatusClosed, *receivedStatus)
+	})
+
+	t.Run("rejects unknown status", func(t *testing.T) {
+		svc, err := NewService(&mockRepository{})
+		require.NoError(t, err)
+
+		status := Status("archived")
+		_, err = svc.ListFunds(context.Background(), &status, ListParams{})
+		assert.ErrorIs(t, err, ErrInvalidStatus)
+	})
+}
+
+func TestService_ChangeStatus(t *testing.T) {
+	svc, err := NewService(&mockRepository{})
+	require.NoError(t, err)
+
+	t.Run("rejects unknown status", func(t *testing.T) {
+		_, err := svc.ChangeStatus(context.Background(), StatusChange{FundID: uuid.New(), Status: "archived"})
+		assert.ErrorIs(t, err, ErrInvalidStatus)
+	})
+
+	t.Run("rejects overlong reason", func(t *testing.T) {
+		change := StatusChange{FundID: uuid.New(), Status: StatusClosed, Reason: strings.Repeat("x", MaxStatusReasonLength+1)}
+		_, err := svc.ChangeStatus(context.Background(), change)
+		assert.ErrorIs(t, err, ErrInvalidStatusReason)
+	})
+
+	t.Run("returns error when pool is nil", func(t *testing.T) {
+		_, err := svc.ChangeStatus(context.Background(), StatusChange{FundID: uuid.New(), Status: StatusClosed})
+		assert.ErrorIs(t, err, ErrPoolRequired)
+	})
 }
 
 func TestService_CreateFundWithInitialOwner(t *testing.T) {
@@ -255,3 +370,141 @@ func TestService_CreateFundWithInitialOwner(t *testing.T) {
 		assert.Contains(t, err.Error(), "invalid initial owner")
 	})
 }
+
+func TestService_CreateFundForOwner(t *testing.T) {
+	t.Run("returns validation error for invalid fund", func(t *testing.T) {
+		svc, err := NewService(&mockRepository{})
+		require.NoError(t, err)
+
+		fund, err := svc.CreateFundForOwner(context.Background(), "", 1000, uuid.New())
+		assert.Nil(t, fund)
+		assert.ErrorIs(t, err, ErrInvalidFund)
+	})
+
+	t.Run("returns error when pool is nil", func(t *testing.T) {
+		svc, err := NewService(&mockRepository{}, WithOwnershipRepository(&mockOwnershipRepository{}))
+		require.NoError(t, err)
+
+		fund, err := svc.CreateFundForOwner(context.Background(), "Test Fund", 1000, uuid.New())
+		assert.Nil(t, fund)
+		assert.ErrorIs(t, err, ErrPoolRequired)
+	})
+}
+
+func TestService_ImportFund(t *testing.T) {
+	rows := []ImportRow{{Owner: "Alice", Units: 600}, {Owner: "Bob", Units: 400}}
+
+	t.Run("returns validation error for invalid fund", func(t *testing.T) {
+		svc, err := NewService(&mockRepository{})
+		require.NoError(t, err)
+
+		fund, err := svc.ImportFund(context.Background(), ImportRequest{Name: "", TotalUnits: 1000, Rows: rows})
+		assert.Nil(t, fund)
+		assert.ErrorIs(t, err, ErrInvalidFund)
+	})
+
+	t.Run("requires at least one row", func(t *testing.T) {
+		svc, err := NewService(&mockRepository{})
+		require.NoError(t, err)
+
+		fund, err := svc.ImportFund(context.Background(), ImportRequest{Name: "Legacy Fund", TotalUnits: 1000})
+		assert.Nil(t, fund)
+		assert.ErrorIs(t, err, ErrEmptyImport)
+	})
+
+	t.Run("returns error when pool is nil", func(t *testing.T) {
+		svc, err := NewService(&mockRepository{}, WithOwnershipRepository(&mockOwnershipRepository{}))
+		require.NoError(t, err)
+
+		fund, err := svc.ImportFund(context.Background(), ImportRequest{Name: "Legacy Fund", TotalUnits: 1000, Rows: rows})
+		assert.Nil(t, fund)
+		assert.ErrorIs(t, err, ErrPoolRequired)
+	})
+}
+
+func TestService_AdjustUnits(t *testing.T) {
+	fundID := uuid.New()
+
+	t.Run("rejects non-positive units before touching the database", func(t *testing.T) {
+		svc, err := NewService(&mockRepository{})
+		require.NoError(t, err)
+
+		event, err := svc.IssueUnits(context.Background(), UnitEventRequest{FundID: fundID, Owner: "Alice", Units: 0})
+		assert.Nil(t, event)
+		assert.ErrorIs(t, err, ErrInvalidUnits)
+	})
+
+	t.Run("requires an owner", func(t *testing.T) {
+		svc, err := NewService(&mockRepository{})
+		require.NoError(t, err)
+
+		event, err := svc.RedeemUnits(context.Background(), UnitEventRequest{FundID: fundID, Owner: "  ", Units: 10})
+		assert.Nil(t, event)
+		assert.ErrorIs(t, err, ErrInvalidOwner)
+	})
+
+	t.Run("returns error when pool is nil", func(t *testing.T) {
+		svc, err := NewService(&mockRepository{}, WithOwnershipRepository(&mockOwnershipRepository{}))
+		require.NoError(t, err)
+
+		event, err := svc.IssueUnits(context.Background(), UnitEventRequest{FundID: fundID, Owner: "Alice", Units: 10})
+		assert.Nil(t, event)
+		assert.ErrorIs(t, err, ErrPoolRequired)
+	})
+}
+
+func TestService_SetApprovalThreshold(t *testing.T) {
+	t.Run("stores the threshold and returns the updated fund", func(t *testing.T) {
+		id := uuid.New()
+		var stored *int
+		repo := &mockRepository{
+			updateFunc: func(_ context.Context, gotID uuid.UUID, threshold *int) error {
+				assert.Equal(t, id, gotID)
+				stored = threshold
+				return nil
+			},
+			findByIDFunc: func(_ context.Context, gotID uuid.UUID) (*Fund, error) {
+				return &Fund{ID: gotID, ApprovalThreshold: stored}, nil
+			},
+		}
+
+		svc, err := NewService(repo)
+		require.NoError(t, err)
+
+		threshold := 500
+		fund, err := svc.SetApprovalThreshold(context.Background(), id, &threshold)
+		require.NoError(t, err)
+		require.NotNil(t, fund.ApprovalThreshold)
+		assert.Equal(t, 500, *fund.ApprovalThreshold)
+	})
+
+	t.Run("rejects non-positive thresholds", func(t *testing.T) {
+		repo := &mockRepository{
+			updateFunc: func(context.Context, uuid.UUID, *int) error {
+				t.Fatal("repository should not be called")
+				return nil
+			},
+		}
+
+		svc, err := NewService(repo)
+		require.NoError(t, err)
+
+		threshold := 0
+		_, err = svc.SetApprovalThreshold(context.Background(), uuid.New(), &threshold)
+		assert.ErrorIs(t, err, ErrInvalidApprovalThreshold)
+	})
+
+	t.Run("returns not found for unknown fund", func(t *testing.T) {
+		repo := &mockRepository{
+			updateFunc: func(_ context.Context, id uuid.UUID, _ *int) error {
+				return NotFoundError(id)
+			},
+		}
+
+		svc, err := NewService(repo)
+		require.NoError(t, err)
+
+		_, err = svc.SetApprovalThreshold(context.Background(), uuid.New(), nil)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
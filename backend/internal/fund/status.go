@@ -0,0 +1,35 @@
+package fund
+
+import (
+	"unicode/utf8"
+
+	"github.com/arowden/augment-fund/internal/fundstatus"
+	"github.com/google/uuid"
+)
+
+type Status = fundstatus.Status
+
+const (
+	StatusOpen       = fundstatus.Open
+	StatusClosed     = fundstatus.Closed
+	StatusSuspended  = fundstatus.Suspended
+	StatusLiquidated = fundstatus.Liquidated
+)
+
+const MaxStatusReasonLength = 500
+
+type StatusChange struct {
+	FundID uuid.UUID
+	Status Status
+	Reason string
+}
+
+func (c StatusChange) Validate() error {
+	if !c.Status.Valid() {
+		return ErrInvalidStatus
+	}
+	if utf8.RuneCountInString(c.Reason) > MaxStatusReasonLength {
+		return ErrInvalidStatusReason
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+package fund
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusChange_Validate(t *testing.T) {
+	assert.NoError(t, StatusChange{Status: StatusClosed, Reason: "final close"}.Validate())
+	assert.ErrorIs(t, StatusChange{}.Validate(), ErrInvalidStatus)
+	assert.ErrorIs(t, StatusChange{Status: "Open"}.Validate(), ErrInvalidStatus)
+}
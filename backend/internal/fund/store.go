@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/arowden/augment-fund/internal/validation"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -41,10 +43,10 @@ func (s *Store) create(ctx context.Context, db DB, fund *Fund) error {
 	}
 
 	const query = `
-		INSERT INTO funds (id, name, total_units, created_at)
-		VALUES ($1, $2, $3, $4)
+		INSERT INTO funds (id, name, total_units, created_at, status, status_changed_at, approval_threshold)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
 	`
-	_, err := db.Exec(ctx, query, fund.ID, fund.Name, fund.TotalUnits, fund.CreatedAt)
+	_, err := db.Exec(ctx, query, fund.ID, fund.Name, fund.TotalUnits, fund.CreatedAt, fund.Status, fund.StatusChangedAt, fund.ApprovalThreshold)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
@@ -57,7 +59,7 @@ func (s *Store) create(ctx context.Context, db DB, fund *Fund) error {
 
 func (s *Store) FindByID(ctx context.Context, id uuid.UUID) (*Fund, error) {
 	const query = `
-		SELECT id, name, total_units, created_at
+		SELECT id, name, total_units, created_at, status, status_changed_at, approval_threshold, ledger_sequence, ledger_head
 		FROM funds
 		WHERE id = $1
 	`
@@ -67,6 +69,11 @@ func (s *Store) FindByID(ctx context.Context, id uuid.UUID) (*Fund, error) {
 		&fund.Name,
 		&fund.TotalUnits,
 		&fund.CreatedAt,
+		&fund.Status,
+		&fund.StatusChangedAt,
+		&fund.ApprovalThreshold,
+		&fund.LedgerSequence,
+		&fund.LedgerHead,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -77,26 +84,53 @@ func (s *Store) FindByID(ctx context.Context, id uuid.UUID) (*Fund, error) {
 	return &fund, nil
 }
 
-func (s *Store) List(ctx context.Context, params ListParams) (*ListResult, error) {
+type fundCursor struct {
+	CreatedAt time.Time `json:"createdAt"`
+	ID        uuid.UUID `json:"id"`
+}
+
+func (s *Store) List(ctx context.Context, status *Status, params ListParams) (*ListResult, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
 	params = params.Normalize()
 
-	const query = `
-		SELECT id, name, total_units, created_at, COUNT(*) OVER() AS total
-		FROM funds
-		ORDER BY created_at DESC, id DESC
-		LIMIT $1 OFFSET $2
-	`
-	rows, err := s.db.Query(ctx, query, params.Limit, params.Offset)
+	var rows pgx.Rows
+	var err error
+	if params.Cursor != "" {
+		var after fundCursor
+		if err := validation.DecodeCursor("funds", params.Cursor, &after); err != nil {
+			return nil, err
+		}
+		const query = `
+			SELECT id, name, total_units, created_at, status, status_changed_at, approval_threshold, ledger_sequence, ledger_head, 0 AS total
+			FROM funds
+			WHERE (created_at, id) < ($1::timestamptz, $2::uuid)
+			  AND ($4::text IS NULL OR status = $4)
+			ORDER BY created_at DESC, id DESC
+			LIMIT $3
+		`
+		rows, err = s.db.Query(ctx, query, after.CreatedAt, after.ID, params.Limit+1, status)
+	} else {
+		const query = `
+			SELECT id, name, total_units, created_at, status, status_changed_at, approval_threshold, ledger_sequence, ledger_head, COUNT(*) OVER() AS total
+			FROM funds
+			WHERE $3::text IS NULL OR status = $3
+			ORDER BY created_at DESC, id DESC
+			LIMIT $1 OFFSET $2
+		`
+		rows, err = s.db.Query(ctx, query, params.Limit+1, params.Offset, status)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("list funds: %w", err)
 	}
 	defer rows.Close()
 
-	funds := make([]*Fund, 0, params.Limit)
+	funds := make([]*Fund, 0, params.Limit+1)
 	var total int
 	for rows.Next() {
 		var fund Fund
-		if err := rows.Scan(&fund.ID, &fund.Name, &fund.TotalUnits, &fund.CreatedAt, &total); err != nil {
+		if err := rows.Scan(&fund.ID, &fund.Name, &fund.TotalUnits, &fund.CreatedAt, &fund.Status, &fund.StatusChangedAt, &fund.ApprovalThreshold, &fund.LedgerSequence, &fund.LedgerHead, &total); err != nil {
 			return nil, fmt.Errorf("scan fund row: %w", err)
 		}
 		funds = append(funds, &fund)
@@ -106,16 +140,220 @@ func (s *Store) List(ctx context.Context, params ListParams) (*ListResult, error
 	}
 
 	if len(funds) == 0 && params.Offset > 0 {
-		const countQuery = `SELECT COUNT(*) FROM funds`
-		if err := s.db.QueryRow(ctx, countQuery).Scan(&total); err != nil {
+		const countQuery = `SELECT COUNT(*) FROM funds WHERE $1::text IS NULL OR status = $1`
+		if err := s.db.QueryRow(ctx, countQuery, status).Scan(&total); err != nil {
 			return nil, fmt.Errorf("count funds: %w", err)
 		}
 	}
 
+	funds, next, err := validation.NextPage("funds", funds, params.Limit, func(f *Fund) any {
+		return fundCursor{CreatedAt: f.CreatedAt, ID: f.ID}
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &ListResult{
-		Items:  funds,
-		Total:  total,
-		Limit:  params.Limit,
-		Offset: params.Offset,
+		Items:      funds,
+		Total:      total,
+		Limit:      params.Limit,
+		Offset:     params.Offset,
+		NextCursor: next,
+	}, nil
+}
+
+func (s *Store) UpdateApprovalThreshold(ctx context.Context, id uuid.UUID, threshold *int) error {
+	const query = `
+		UPDATE funds
+		SET approval_threshold = $2
+		WHERE id = $1
+	`
+	tag, err := s.db.Exec(ctx, query, id, threshold)
+	if err != nil {
+		return fmt.Errorf("update approval threshold for fund %s: %w", id, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return NotFoundError(id)
+	}
+	return nil
+}
+
+func (s *Store) UpdateStatusTx(ctx context.Context, tx pgx.Tx, fund *Fund) error {
+	const query = `
+		UPDATE funds
+		SET status = $2, status_changed_at = $3
+		WHERE id = $1
+	`
+	tag, err := tx.Exec(ctx, query, fund.ID, fund.Status, fund.StatusChangedAt)
+	if err != nil {
+		return fmt.Errorf("update status for fund %s: %w", fund.ID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return NotFoundError(fund.ID)
+	}
+	return nil
+}
+
+func (s *Store) LockTx(ctx context.Context, tx pgx.Tx, id uuid.UUID) (*Fund, error) {
+	const query = `
+		SELECT id, name, total_units, created_at, status, status_changed_at, approval_threshold, ledger_sequence, ledger_head
+		FROM funds
+		WHERE id = $1
+		FOR UPDATE
+	`
+	var fund Fund
+	err := tx.QueryRow(ctx, query, id).Scan(
+		&fund.ID,
+		&fund.Name,
+		&fund.TotalUnits,
+		&fund.CreatedAt,
+		&fund.Status,
+		&fund.StatusChangedAt,
+		&fund.ApprovalThreshold,
+		&fund.LedgerSequence,
+		&fund.LedgerHead,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, NotFoundError(id)
+		}
+		return nil, fmt.Errorf("lock fund %s: %w", id, err)
+	}
+	return &fund, nil
+}
+
+func (s *Store) UpdateTotalUnitsTx(ctx context.Context, tx pgx.Tx, id uuid.UUID, totalUnits int) error {
+	const query = `UPDATE funds SET total_units = $2 WHERE id = $1`
+	tag, err := tx.Exec(ctx, query, id, totalUnits)
+	if err != nil {
+		return fmt.Errorf("update total units for fund %s: %w", id, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return NotFoundError(id)
+	}
+	return nil
+}
+
+func (s *Store) SumHoldingsTx(ctx context.Context, tx pgx.Tx, id uuid.UUID) (int, error) {
+	const query = `
+		SELECT COALESCE(SUM(units), 0)
+		FROM cap_table_entries
+		WHERE fund_id = $1 AND deleted_at IS NULL
+	`
+	var units int
+	if err := tx.QueryRow(ctx, query, id).Scan(&units); err != nil {
+		return 0, fmt.Errorf("sum holdings for fund %s: %w", id, err)
+	}
+	return units, nil
+}
+
+func (s *Store) ReservedUnitsTx(ctx context.Context, tx pgx.Tx, fundID, classID, ownerID uuid.UUID) (int, error) {
+	const query = `
+		SELECT COALESCE(SUM(units), 0)
+		FROM transfers
+		WHERE fund_id = $1 AND class_id = $2 AND from_owner_id = $3 AND status = 'pending'
+	`
+	var units int
+	if err := tx.QueryRow(ctx, query, fundID, classID, ownerID).Scan(&units); err != nil {
+		return 0, fmt.Errorf("sum reserved units for owner %s in fund %s: %w", ownerID, fundID, err)
+	}
+	return units, nil
+}
+
+func (s *Store) CreateUnitEventTx(ctx context.Context, tx pgx.Tx, event *UnitEvent) error {
+	const query = `
+		INSERT INTO unit_events (id, fund_id, owner_id, class_id, kind, units, total_units, occurred_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING class_id
+	`
+	var classID *uuid.UUID
+	if event.ClassID != uuid.Nil {
+		classID = &event.ClassID
+	}
+	err := tx.QueryRow(ctx, query, event.ID, event.FundID, event.OwnerID, classID, string(event.Kind), event.Units, event.TotalUnits, event.OccurredAt).Scan(&event.ClassID)
+	if err != nil {
+		return fmt.Errorf("create unit event %s: %w", event.ID, err)
+	}
+	return nil
+}
+
+type unitEventCursor struct {
+	OccurredAt time.Time `json:"occurredAt"`
+	ID         uuid.UUID `json:"id"`
+}
+
+func (s *Store) ListUnitEvents(ctx context.Context, fundID uuid.UUID, params ListParams) (*UnitEventList, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	params = params.Normalize()
+
+	var rows pgx.Rows
+	var err error
+	if params.Cursor != "" {
+		var after unitEventCursor
+		if err := validation.DecodeCursor("unit_events", params.Cursor, &after); err != nil {
+			return nil, err
+		}
+		const query = `
+			SELECT u.id, u.fund_id, u.owner_id, e.owner_name, u.class_id, c.name, u.kind, u.units, u.total_units, u.occurred_at, 0 AS total
+			FROM unit_events u
+			JOIN cap_table_entries e ON e.fund_id = u.fund_id AND e.class_id = u.class_id AND e.owner_id = u.owner_id
+			JOIN share_classes c ON c.id = u.class_id
+			WHERE u.fund_id = $1 AND (u.occurred_at, u.id) > ($2::timestamptz, $3::uuid)
+			ORDER BY u.occurred_at ASC, u.id ASC
+			LIMIT $4
+		`
+		rows, err = s.db.Query(ctx, query, fundID, after.OccurredAt, after.ID, params.Limit+1)
+	} else {
+		const query = `
+			SELECT u.id, u.fund_id, u.owner_id, e.owner_name, u.class_id, c.name, u.kind, u.units, u.total_units, u.occurred_at, COUNT(*) OVER() AS total
+			FROM unit_events u
+			JOIN cap_table_entries e ON e.fund_id = u.fund_id AND e.class_id = u.class_id AND e.owner_id = u.owner_id
+			JOIN share_classes c ON c.id = u.class_id
+			WHERE u.fund_id = $1
+			ORDER BY u.occurred_at ASC, u.id ASC
+			LIMIT $2 OFFSET $3
+		`
+		rows, err = s.db.Query(ctx, query, fundID, params.Limit+1, params.Offset)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("list unit events for fund %s: %w", fundID, err)
+	}
+	defer rows.Close()
+
+	events := make([]*UnitEvent, 0, params.Limit+1)
+	var total int
+	for rows.Next() {
+		var event UnitEvent
+		if err := rows.Scan(&event.ID, &event.FundID, &event.OwnerID, &event.OwnerName, &event.ClassID, &event.ClassName, &event.Kind, &event.Units, &event.TotalUnits, &event.OccurredAt, &total); err != nil {
+			return nil, fmt.Errorf("scan unit event row: %w", err)
+		}
+		events = append(events, &event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate unit event rows: %w", err)
+	}
+
+	if len(events) == 0 && params.Offset > 0 {
+		const countQuery = `SELECT COUNT(*) FROM unit_events WHERE fund_id = $1`
+		if err := s.db.QueryRow(ctx, countQuery, fundID).Scan(&total); err != nil {
+			return nil, fmt.Errorf("count unit events for fund %s: %w", fundID, err)
+		}
+	}
+
+	events, next, err := validation.NextPage("unit_events", events, params.Limit, func(e *UnitEvent) any {
+		return unitEventCursor{OccurredAt: e.OccurredAt, ID: e.ID}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &UnitEventList{
+		Items:      events,
+		Total:      total,
+		Limit:      params.Limit,
+		Offset:     params.Offset,
+		NextCursor: next,
 	}, nil
 }
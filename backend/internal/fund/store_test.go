@@ -109,7 +109,7 @@ func TestStore(t *testing.T) {
 	t.Run("List returns empty result when no funds", func(t *testing.T) {
 		tc.Reset(ctx)
 
-		result, err := store.List(ctx, ListParams{})
+		result, err := store.List(ctx, nil, ListParams{})
 		require.NoError(t, err)
 		assert.NotNil(t, result.Items)
 		assert.Empty(t, result.Items)
@@ -132,7 +132,7 @@ func TestStore(t *testing.T) {
 		fund3, _ := NewFund("Third Fund", 300)
 		require.NoError(t, store.Create(ctx, fund3))
 
-		result, err := store.List(ctx, ListParams{Limit: 10})
+		result, err := store.List(ctx, nil, ListParams{Limit: 10})
 		require.NoError(t, err)
 		require.Len(t, result.Items, 3)
 		assert.Equal(t, 3, result.Total)
@@ -159,7 +159,7 @@ func TestStore(t *testing.T) {
 			time.Sleep(20 * time.Millisecond)
 		}
 
-		result, err := store.List(ctx, ListParams{Limit: 2})
+		result, err := store.List(ctx, nil, ListParams{Limit: 2})
 		require.NoError(t, err)
 		assert.Len(t, result.Items, 2)
 		assert.Equal(t, 5, result.Total)
@@ -177,7 +177,7 @@ func TestStore(t *testing.T) {
 			time.Sleep(20 * time.Millisecond)
 		}
 
-		result, err := store.List(ctx, ListParams{Limit: 2, Offset: 2})
+		result, err := store.List(ctx, nil, ListParams{Limit: 2, Offset: 2})
 		require.NoError(t, err)
 		assert.Len(t, result.Items, 2)
 		assert.Equal(t, 5, result.Total)
@@ -193,7 +193,7 @@ func TestStore(t *testing.T) {
 		fund, _ := NewFund("Only Fund", 100)
 		require.NoError(t, store.Create(ctx, fund))
 
-		result, err := store.List(ctx, ListParams{Offset: 100})
+		result, err := store.List(ctx, nil, ListParams{Offset: 100})
 		require.NoError(t, err)
 		assert.Empty(t, result.Items)
 		assert.Equal(t, 1, result.Total)
@@ -205,7 +205,7 @@ func TestStore(t *testing.T) {
 		fund, _ := NewFund("Test Fund", 100)
 		require.NoError(t, store.Create(ctx, fund))
 
-		result, err := store.List(ctx, ListParams{Limit: -1, Offset: -5})
+		result, err := store.List(ctx, nil, ListParams{Limit: -1, Offset: -5})
 		require.NoError(t, err)
 		assert.Equal(t, validation.DefaultLimit, result.Limit)
 		assert.Equal(t, 0, result.Offset)
@@ -217,11 +217,96 @@ func TestStore(t *testing.T) {
 		fund, _ := NewFund("Test Fund", 100)
 		require.NoError(t, store.Create(ctx, fund))
 
-		result, err := store.List(ctx, ListParams{Limit: 9999})
+		result, err := store.List(ctx, nil, ListParams{Limit: 9999})
 		require.NoError(t, err)
 		assert.Equal(t, validation.MaxLimit, result.Limit)
 	})
 
+	t.Run("List pages by cursor without repeating funds created mid-scan", func(t *testing.T) {
+		tc.Reset(ctx)
+		for _, name := range []string{"Fund A", "Fund B", "Fund C"} {
+			f, err := NewFund(name, 1000)
+			require.NoError(t, err)
+			require.NoError(t, store.Create(ctx, f))
+		}
+
+		first, err := store.List(ctx, nil, ListParams{Limit: 2})
+		require.NoError(t, err)
+		require.Len(t, first.Items, 2)
+		assert.Equal(t, "Fund C", first.Items[0].Name)
+		require.NotEmpty(t, first.NextCursor)
+
+		late, err := NewFund("Fund D", 1000)
+		require.NoError(t, err)
+		require.NoError(t, store.Create(ctx, late))
+
+		second, err := store.List(ctx, nil, ListParams{Limit: 2, Cursor: first.NextCursor})
+		require.NoError(t, err)
+		require.Len(t, second.Items, 1)
+		assert.Equal(t, "Fund A", second.Items[0].Name)
+		assert.Empty(t, second.NextCursor)
+
+		_, err = store.List(ctx, nil, ListParams{Cursor: first.NextCursor, Offset: 1})
+		assert.ErrorIs(t, err, validation.ErrInvalidCursor)
+		_, err = store.List(ctx, nil, ListParams{Cursor: "garbage"})
+		assert.ErrorIs(t, err, validation.ErrInvalidCursor)
+	})
+
+	t.Run("List filters by status and UpdateStatusTx records the change", func(t *testing.T) {
+		tc.Reset(ctx)
+		open, err := NewFund("Open Fund", 1000)
+		require.NoError(t, err)
+		require.NoError(t, store.Create(ctx, open))
+		closed, err := NewFund("Closed Fund", 1000)
+		require.NoError(t, err)
+		require.NoError(t, store.Create(ctx, closed))
+
+		tx, err := tc.Pool().Begin(ctx)
+		require.NoError(t, err)
+		closed.Status = StatusClosed
+		closed.StatusChangedAt = time.Now().Add(time.Hour)
+		require.NoError(t, store.UpdateStatusTx(ctx, tx, closed))
+		require.NoError(t, tx.Commit(ctx))
+
+		found, err := store.FindByID(ctx, closed.ID)
+		require.NoError(t, err)
+		assert.Equal(t, StatusClosed, found.Status)
+		assert.WithinDuration(t, closed.StatusChangedAt, found.StatusChangedAt, time.Millisecond)
+
+		status := StatusOpen
+		result, err := store.List(ctx, &status, ListParams{})
+		require.NoError(t, err)
+		require.Len(t, result.Items, 1)
+		assert.Equal(t, "Open Fund", result.Items[0].Name)
+		assert.Equal(t, 1, result.Total)
+
+		all, err := store.List(ctx, nil, ListParams{})
+		require.NoError(t, err)
+		assert.Equal(t, 2, all.Total)
+	})
+
+	t.Run("UpdateApprovalThreshold sets and clears the threshold", func(t *testing.T) {
+		tc.Reset(ctx)
+		fund, err := NewFund("Threshold Fund", 1000)
+		require.NoError(t, err)
+		require.NoError(t, store.Create(ctx, fund))
+
+		threshold := 250
+		require.NoError(t, store.UpdateApprovalThreshold(ctx, fund.ID, &threshold))
+		found, err := store.FindByID(ctx, fund.ID)
+		require.NoError(t, err)
+		require.NotNil(t, found.ApprovalThreshold)
+		assert.Equal(t, 250, *found.ApprovalThreshold)
+
+		require.NoError(t, store.UpdateApprovalThreshold(ctx, fund.ID, nil))
+		found, err = store.FindByID(ctx, fund.ID)
+		require.NoError(t, err)
+		assert.Nil(t, found.ApprovalThreshold)
+
+		err = store.UpdateApprovalThreshold(ctx, uuid.New(), &threshold)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
 	t.Run("NewStore returns nil for nil db", func(t *testing.T) {
 		store := NewStore(nil)
 		assert.Nil(t, store)
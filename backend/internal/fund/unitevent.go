@@ -0,0 +1,64 @@
+package fund
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/arowden/augment-fund/internal/validation"
+	"github.com/google/uuid"
+)
+
+type UnitEventKind string
+
+const (
+	KindIssuance   UnitEventKind = "issuance"
+	KindRedemption UnitEventKind = "redemption"
+)
+
+type UnitEvent struct {
+	ID         uuid.UUID
+	FundID     uuid.UUID
+	OwnerID    uuid.UUID
+	OwnerName  string
+	ClassID    uuid.UUID
+	ClassName  string
+	Kind       UnitEventKind
+	Units      int
+	TotalUnits int
+	OccurredAt time.Time
+}
+
+func (e *UnitEvent) Delta() int {
+	if e.Kind == KindRedemption {
+		return -e.Units
+	}
+	return e.Units
+}
+
+type UnitEventRequest struct {
+	FundID  uuid.UUID
+	Owner   string
+	OwnerID *uuid.UUID
+	ClassID *uuid.UUID
+	Units   int
+}
+
+func (r UnitEventRequest) Validate() error {
+	name := strings.TrimSpace(r.Owner)
+	if utf8.RuneCountInString(name) > validation.MaxNameLength || (r.OwnerID == nil && name == "") {
+		return ErrInvalidOwner
+	}
+	if r.Units <= 0 || r.Units > validation.MaxUnits {
+		return ErrInvalidUnits
+	}
+	return nil
+}
+
+type UnitEventList struct {
+	Items      []*UnitEvent
+	Total      int
+	Limit      int
+	Offset     int
+	NextCursor string
+}
@@ -0,0 +1,43 @@
+package fund
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/arowden/augment-fund/internal/validation"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitEventRequest_Validate(t *testing.T) {
+	ownerID := uuid.New()
+
+	tests := []struct {
+		name string
+		req  UnitEventRequest
+		want error
+	}{
+		{name: "owner by name", req: UnitEventRequest{Owner: "Alice", Units: 100}},
+		{name: "owner by ID", req: UnitEventRequest{OwnerID: &ownerID, Units: 100}},
+		{name: "maximum units", req: UnitEventRequest{Owner: "Alice", Units: validation.MaxUnits}},
+		{name: "missing owner", req: UnitEventRequest{Owner: " ", Units: 100}, want: ErrInvalidOwner},
+		{name: "owner name too long", req: UnitEventRequest{Owner: strings.Repeat("a", validation.MaxNameLength+1), Units: 100}, want: ErrInvalidOwner},
+		{name: "zero units", req: UnitEventRequest{Owner: "Alice"}, want: ErrInvalidUnits},
+		{name: "negative units", req: UnitEventRequest{Owner: "Alice", Units: -5}, want: ErrInvalidUnits},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if tt.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
+func TestUnitEvent_Delta(t *testing.T) {
+	assert.Equal(t, 25, (&UnitEvent{Kind: KindIssuance, Units: 25}).Delta())
+	assert.Equal(t, -25, (&UnitEvent{Kind: KindRedemption, Units: 25}).Delta())
+}
@@ -0,0 +1,37 @@
+package fundstatus
+
+type Status string
+
+const (
+	Open       Status = "open"
+	Closed     Status = "closed"
+	Suspended  Status = "suspended"
+	Liquidated Status = "liquidated"
+)
+
+var transitions = map[Status][]Status{
+	Open:      {Suspended, Closed},
+	Suspended: {Open, Closed},
+	Closed:    {Liquidated},
+}
+
+func (s Status) Valid() bool {
+	switch s {
+	case Open, Closed, Suspended, Liquidated:
+		return true
+	}
+	return false
+}
+
+func (s Status) CanTransitionTo(to Status) bool {
+	for _, next := range transitions[s] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+func (s Status) Transitions() []Status {
+	return transitions[s]
+}
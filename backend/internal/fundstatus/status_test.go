@@ -0,0 +1,41 @@
+package fundstatus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatus_CanTransitionTo(t *testing.T) {
+	tests := []struct {
+		from Status
+		to   Status
+		want bool
+	}{
+		{from: Open, to: Suspended, want: true},
+		{from: Open, to: Closed, want: true},
+		{from: Open, to: Liquidated},
+		{from: Suspended, to: Open, want: true},
+		{from: Suspended, to: Closed, want: true},
+		{from: Suspended, to: Liquidated},
+		{from: Closed, to: Liquidated, want: true},
+		{from: Closed, to: Open},
+		{from: Closed, to: Suspended},
+		{from: Liquidated, to: Open},
+		{from: Liquidated, to: Closed},
+		{from: Open, to: "archived"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.from)+" to "+string(tt.to), func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.from.CanTransitionTo(tt.to))
+		})
+	}
+}
+
+func TestStatus_Valid(t *testing.T) {
+	for _, s := range []Status{Open, Closed, Suspended, Liquidated} {
+		assert.True(t, s.Valid(), s)
+	}
+	assert.False(t, Status("Open").Valid())
+	assert.False(t, Status("").Valid())
+}
@@ -0,0 +1,165 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/arowden/augment-fund/internal/auth"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+const APIKeyHeader = "X-API-Key"
+
+const bearerPrefix = "bearer "
+
+type Authenticator interface {
+	Authenticate(ctx context.Context, credential string) (*auth.Principal, error)
+}
+
+type authMiddleware struct {
+	keys   Authenticator
+	tokens Authenticator
+}
+
+type AuthOption func(*authMiddleware)
+
+func WithAPIKeys(a Authenticator) AuthOption {
+	return func(m *authMiddleware) { m.keys = a }
+}
+
+func WithBearerTokens(a Authenticator) AuthOption {
+	return func(m *authMiddleware) { m.tokens = a }
+}
+
+var operationPermissions = map[string]auth.Permission{
+	"ListFunds":             auth.PermissionReadCapTable,
+	"GetFund":               auth.PermissionReadCapTable,
+	"GetCapTable":           auth.PermissionReadCapTable,
+	"ExportCapTable":        auth.PermissionReadCapTable,
+	"StreamFundEvents":      auth.PermissionReadCapTable,
+	"ListTransfers":         auth.PermissionReadCapTable,
+	"ExportTransfers":       auth.PermissionReadCapTable,
+	"ListPendingTransfers":  auth.PermissionReadCapTable,
+	"VerifyLedger":          auth.PermissionReadCapTable,
+	"ListUnitEvents":        auth.PermissionReadCapTable,
+	"ListShareClasses":      auth.PermissionReadCapTable,
+	"ListDistributions":     auth.PermissionReadCapTable,
+	"GetDistribution":       auth.PermissionReadCapTable,
+	"ExportDistribution":    auth.PermissionReadCapTable,
+	"ListCommitments":       auth.PermissionReadCapTable,
+	"ListCapitalCalls":      auth.PermissionReadCapTable,
+	"GetCapitalCall":        auth.PermissionReadCapTable,
+	"ListOwners":            auth.PermissionReadCapTable,
+	"GetOwner":              auth.PermissionReadCapTable,
+	"GetOwnerHoldings":      auth.PermissionReadCapTable,
+	"CreateTransfer":        auth.PermissionCreateTransfers,
+	"CreateTransferBatch":   auth.PermissionCreateTransfers,
+	"ApproveTransfer":       auth.PermissionCreateTransfers,
+	"RejectTransfer":        auth.PermissionCreateTransfers,
+	"ReverseTransfer":       auth.PermissionCreateTransfers,
+	"CreateOwner":           auth.PermissionCreateTransfers,
+	"CreateFund":            auth.PermissionCreateFunds,
+	"ImportFund":            auth.PermissionCreateFunds,
+	"SetApprovalThreshold":  auth.PermissionCreateFunds,
+	"IssueUnits":            auth.PermissionCreateFunds,
+	"RedeemUnits":           auth.PermissionCreateFunds,
+	"CreateShareClass":      auth.PermissionCreateFunds,
+	"CreateDistribution":    auth.PermissionCreateFunds,
+	"CreateCommitment":      auth.PermissionCreateFunds,
+	"CreateCapitalCall":     auth.PermissionCreateFunds,
+	"UpdateOwner":           auth.PermissionAdminister,
+	"ChangeFundStatus":      auth.PermissionAdminister,
+	"ResetDatabase":         auth.PermissionAdminister,
+	"GetReconciliation":     auth.PermissionAdminister,
+	"ListApiKeys":           auth.PermissionAdminister,
+	"CreateApiKey":          auth.PermissionAdminister,
+	"RevokeApiKey":          auth.PermissionAdminister,
+	"ListWebhooks":          auth.PermissionAdminister,
+	"CreateWebhook":         auth.PermissionAdminister,
+	"DeleteWebhook":         auth.PermissionAdminister,
+	"ListWebhookDeliveries": auth.PermissionAdminister,
+	"RetryWebhookDelivery":  auth.PermissionAdminister,
+	"ListAuditEvents":       auth.PermissionAdminister,
+}
+
+func OperationPermission(operationID string) auth.Permission {
+	if p, ok := operationPermissions[operationID]; ok {
+		return p
+	}
+	return auth.PermissionAdminister
+}
+
+func NewAuthMiddleware(opts ...AuthOption) StrictMiddlewareFunc {
+	m := &authMiddleware{}
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
+		perm := OperationPermission(operationID)
+
+		return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+			principal, err := m.authenticate(ctx, r)
+			if err != nil {
+				if errors.Is(err, auth.ErrUnauthenticated) {
+					if r.Header.Get("Authorization") != "" {
+						w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+					}
+					writeAuthError(ctx, w, http.StatusUnauthorized, UNAUTHENTICATED, err.Error(), nil)
+					return nil, nil
+				}
+				logError(ctx, "failed to authenticate request", err)
+				writeAuthError(ctx, w, http.StatusInternalServerError, INTERNALERROR, "failed to authenticate request", nil)
+				return nil, nil
+			}
+
+			allowed := principal.Can(perm)
+			details := map[string]interface{}{
+				"role":       string(principal.Role),
+				"permission": string(perm),
+			}
+			if fundID, ok := routeFundID(r); ok {
+				allowed = principal.CanOnFund(perm, fundID)
+				details["fundId"] = fundID.String()
+			}
+			if !allowed {
+				writeAuthError(ctx, w, http.StatusForbidden, FORBIDDEN, auth.ErrForbidden.Error(), details)
+				return nil, nil
+			}
+
+			return f(auth.WithPrincipal(ctx, principal), w, r, request)
+		}
+	}
+}
+
+func (m *authMiddleware) authenticate(ctx context.Context, r *http.Request) (*auth.Principal, error) {
+	if header := r.Header.Get("Authorization"); header != "" {
+		if m.tokens == nil || len(header) <= len(bearerPrefix) || !strings.EqualFold(header[:len(bearerPrefix)], bearerPrefix) {
+			return nil, auth.ErrUnauthenticated
+		}
+		return m.tokens.Authenticate(ctx, strings.TrimSpace(header[len(bearerPrefix):]))
+	}
+	if m.keys == nil {
+		return nil, auth.ErrUnauthenticated
+	}
+	return m.keys.Authenticate(ctx, r.Header.Get(APIKeyHeader))
+}
+
+func routeFundID(r *http.Request) (uuid.UUID, bool) {
+	id, err := uuid.Parse(chi.URLParam(r, "fundId"))
+	return id, err == nil
+}
+
+func writeAuthError(ctx context.Context, w http.ResponseWriter, status int, code ErrorCode, message string, extra map[string]interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(Error{
+		Code:    code,
+		Message: message,
+		Details: errorDetails(ctx, extra),
+	})
+}
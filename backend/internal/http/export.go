@@ -0,0 +1,250 @@
+package http
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/arowden/augment-fund/internal/distribution"
+	"github.com/arowden/augment-fund/internal/ownership"
+	"github.com/arowden/augment-fund/internal/transfer"
+	"github.com/google/uuid"
+)
+
+type exportFormat string
+
+const (
+	exportCSV    exportFormat = "text/csv"
+	exportNDJSON exportFormat = "application/x-ndjson"
+)
+
+func (f exportFormat) extension() string {
+	if f == exportNDJSON {
+		return "ndjson"
+	}
+	return "csv"
+}
+
+func negotiateExport(accept *string) (exportFormat, bool) {
+	if accept == nil || strings.TrimSpace(*accept) == "" {
+		return exportCSV, true
+	}
+
+	var best exportFormat
+	bestQ := 0.0
+	for _, part := range strings.Split(*accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if v, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(v, 64); err != nil {
+				continue
+			}
+		}
+
+		var format exportFormat
+		switch mediaType {
+		case string(exportCSV), "text/*", "*/*":
+			format = exportCSV
+		case string(exportNDJSON), "application/*":
+			format = exportNDJSON
+		default:
+			continue
+		}
+		if q > bestQ {
+			best, bestQ = format, q
+		}
+	}
+	return best, bestQ > 0
+}
+
+var capTableExportColumns = []string{"ownerId", "ownerName", "classId", "className", "units", "percentage", "classPercentage", "acquiredAt"}
+
+var transferExportColumns = []string{
+	"id", "fromOwnerId", "fromOwner", "toOwnerId", "toOwner", "classId", "className", "units", "status",
+	"transferredAt", "requestedAt", "batchId", "reversesTransferId", "ledgerSequence", "hash",
+}
+
+var distributionExportColumns = []string{"ownerId", "ownerName", "units", "percentage", "amount"}
+
+type exportWriter struct {
+	csv  *csv.Writer
+	json *json.Encoder
+}
+
+func newExportWriter(w http.ResponseWriter, format exportFormat, filename string, columns []string) (*exportWriter, error) {
+	w.Header().Set("Content-Type", string(format))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename + "." + format.extension()}))
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+
+	if format == exportNDJSON {
+		return &exportWriter{json: json.NewEncoder(w)}, nil
+	}
+	out := &exportWriter{csv: csv.NewWriter(w)}
+	return out, out.csv.Write(columns)
+}
+
+func (e *exportWriter) write(record []string, row any) error {
+	if e.csv != nil {
+		return e.csv.Write(record)
+	}
+	return e.json.Encode(row)
+}
+
+func (e *exportWriter) close() error {
+	if e.csv != nil {
+		e.csv.Flush()
+		return e.csv.Error()
+	}
+	return nil
+}
+
+func finishExport(ctx context.Context, out *exportWriter, err error, msg string, attrs ...slog.Attr) error {
+	if err == nil {
+		err = out.close()
+	}
+	if err == nil {
+		return nil
+	}
+	if ctx.Err() == nil {
+		logError(ctx, msg, err, attrs...)
+	}
+	panic(http.ErrAbortHandler)
+}
+
+type capTableExport struct {
+	ctx        context.Context
+	service    *ownership.Service
+	fundID     uuid.UUID
+	totalUnits int
+	classUnits map[uuid.UUID]int
+	format     exportFormat
+}
+
+func (e capTableExport) VisitExportCapTableResponse(w http.ResponseWriter) error {
+	out, err := newExportWriter(w, e.format, "cap-table-"+e.fundID.String(), capTableExportColumns)
+	if err == nil {
+		err = e.service.ExportCapTable(e.ctx, e.fundID, func(entry *ownership.Entry) error {
+			row := CapTableEntry{
+				OwnerId:         entry.OwnerID,
+				OwnerName:       entry.OwnerName,
+				ClassId:         entry.ClassID,
+				ClassName:       entry.ClassName,
+				Units:           entry.Units,
+				AcquiredAt:      entry.AcquiredAt,
+				Percentage:      ownership.Percentage(entry.Units, e.totalUnits),
+				ClassPercentage: ownership.Percentage(entry.Units, e.classUnits[entry.ClassID]),
+			}
+			return out.write(capTableRecord(row), row)
+		})
+	}
+	return finishExport(e.ctx, out, err, "cap table export failed", slog.String("fundId", e.fundID.String()))
+}
+
+func capTableRecord(e CapTableEntry) []string {
+	return []string{
+		e.OwnerId.String(),
+		csvText(e.OwnerName),
+		e.ClassId.String(),
+		csvText(e.ClassName),
+		strconv.Itoa(e.Units),
+		strconv.FormatFloat(e.Percentage, 'f', -1, 64),
+		strconv.FormatFloat(e.ClassPercentage, 'f', -1, 64),
+		e.AcquiredAt.Format(time.RFC3339Nano),
+	}
+}
+
+type transferExport struct {
+	ctx     context.Context
+	service *transfer.Service
+	fundID  uuid.UUID
+	filter  transfer.Filter
+	format  exportFormat
+}
+
+func (e transferExport) VisitExportTransfersResponse(w http.ResponseWriter) error {
+	out, err := newExportWriter(w, e.format, "transfers-"+e.fundID.String(), transferExportColumns)
+	if err == nil {
+		err = e.service.ExportTransfers(e.ctx, e.fundID, e.filter, func(t *transfer.Transfer) error {
+			row := toAPITransfer(t)
+			return out.write(transferRecord(row), row)
+		})
+	}
+	return finishExport(e.ctx, out, err, "transfer export failed", slog.String("fundId", e.fundID.String()))
+}
+
+func transferRecord(t Transfer) []string {
+	return []string{
+		t.Id.String(),
+		t.FromOwnerId.String(),
+		csvText(t.FromOwner),
+		t.ToOwnerId.String(),
+		csvText(t.ToOwner),
+		t.ClassId.String(),
+		csvText(t.ClassName),
+		strconv.Itoa(t.Units),
+		string(t.Status),
+		t.TransferredAt.Format(time.RFC3339Nano),
+		t.RequestedAt.Format(time.RFC3339Nano),
+		optionalString(t.BatchId),
+		optionalString(t.ReversesTransferId),
+		optionalString(t.LedgerSequence),
+		deref(t.Hash),
+	}
+}
+
+type distributionExport struct {
+	ctx          context.Context
+	service      *distribution.Service
+	distribution *distribution.Distribution
+	format       exportFormat
+}
+
+func (e distributionExport) VisitExportDistributionResponse(w http.ResponseWriter) error {
+	d := e.distribution
+	out, err := newExportWriter(w, e.format, "distribution-"+d.ID.String(), distributionExportColumns)
+	if err == nil {
+		err = e.service.ExportLineItems(e.ctx, d.ID, func(item *distribution.LineItem) error {
+			row := toAPILineItem(item, d.TotalUnits)
+			return out.write(lineItemRecord(row), row)
+		})
+	}
+	return finishExport(e.ctx, out, err, "distribution export failed",
+		slog.String("fundId", d.FundID.String()),
+		slog.String("distributionId", d.ID.String()),
+	)
+}
+
+func lineItemRecord(item DistributionLineItem) []string {
+	return []string{
+		item.OwnerId.String(),
+		csvText(item.OwnerName),
+		strconv.Itoa(item.Units),
+		strconv.FormatFloat(item.Percentage, 'f', -1, 64),
+		strconv.FormatInt(item.Amount, 10),
+	}
+}
+
+func optionalString[T any](v *T) string {
+	if v == nil {
+		return ""
+	}
+	return fmt.Sprint(*v)
+}
+
+func csvText(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
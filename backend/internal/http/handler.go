@@ -2,14 +2,26 @@ package http
 
 import (
 	"context"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"net/http"
 
+	"github.com/arowden/augment-fund/internal/audit"
+	"github.com/arowden/augment-fund/internal/auth"
+	"github.com/arowden/augment-fund/internal/commitment"
+	"github.com/arowden/augment-fund/internal/distribution"
 	"github.com/arowden/augment-fund/internal/fund"
+	"github.com/arowden/augment-fund/internal/outbox"
+	"github.com/arowden/augment-fund/internal/owner"
 	"github.com/arowden/augment-fund/internal/ownership"
+	"github.com/arowden/augment-fund/internal/reconciliation"
 	"github.com/arowden/augment-fund/internal/transfer"
 	"github.com/arowden/augment-fund/internal/validation"
+	"github.com/arowden/augment-fund/internal/webhook"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -43,10 +55,18 @@ func errorDetails(ctx context.Context, extra map[string]interface{}) *map[string
 }
 
 type APIHandler struct {
-	fundService      *fund.Service
-	ownershipService *ownership.Service
-	transferService  *transfer.Service
-	pool             *pgxpool.Pool
+	fundService         *fund.Service
+	ownershipService    *ownership.Service
+	ownerService        *owner.Service
+	transferService     *transfer.Service
+	distributionService *distribution.Service
+	commitmentService   *commitment.Service
+	reconciliation      *reconciliation.Service
+	webhookService      *webhook.Service
+	eventService        *outbox.Service
+	authService         *auth.Service
+	auditService        *audit.Service
+	pool                *pgxpool.Pool
 }
 
 type APIHandlerOption func(*APIHandler)
@@ -63,12 +83,60 @@ func WithOwnershipService(svc *ownership.Service) APIHandlerOption {
 	}
 }
 
+func WithOwnerService(svc *owner.Service) APIHandlerOption {
+	return func(h *APIHandler) {
+		h.ownerService = svc
+	}
+}
+
 func WithTransferService(svc *transfer.Service) APIHandlerOption {
 	return func(h *APIHandler) {
 		h.transferService = svc
 	}
 }
 
+func WithDistributionService(svc *distribution.Service) APIHandlerOption {
+	return func(h *APIHandler) {
+		h.distributionService = svc
+	}
+}
+
+func WithCommitmentService(svc *commitment.Service) APIHandlerOption {
+	return func(h *APIHandler) {
+		h.commitmentService = svc
+	}
+}
+
+func WithReconciliationService(svc *reconciliation.Service) APIHandlerOption {
+	return func(h *APIHandler) {
+		h.reconciliation = svc
+	}
+}
+
+func WithWebhookService(svc *webhook.Service) APIHandlerOption {
+	return func(h *APIHandler) {
+		h.webhookService = svc
+	}
+}
+
+func WithEventService(svc *outbox.Service) APIHandlerOption {
+	return func(h *APIHandler) {
+		h.eventService = svc
+	}
+}
+
+func WithAuthService(svc *auth.Service) APIHandlerOption {
+	return func(h *APIHandler) {
+		h.authService = svc
+	}
+}
+
+func WithAuditService(svc *audit.Service) APIHandlerOption {
+	return func(h *APIHandler) {
+		h.auditService = svc
+	}
+}
+
 func WithPool(p *pgxpool.Pool) APIHandlerOption {
 	return func(h *APIHandler) {
 		h.pool = p
@@ -118,16 +186,30 @@ func (h *APIHandler) ListFunds(ctx context.Context, request ListFundsRequestObje
 		}, nil
 	}
 
-	params := fund.ListParams{}
+	params := fund.ListParams{Cursor: deref(request.Params.Cursor)}
 	if request.Params.Limit != nil {
 		params.Limit = *request.Params.Limit
 	}
 	if request.Params.Offset != nil {
 		params.Offset = *request.Params.Offset
 	}
+	var status *fund.Status
+	if request.Params.Status != nil {
+		s := fund.Status(*request.Params.Status)
+		status = &s
+	}
 
-	result, err := h.fundService.ListFunds(ctx, params)
+	result, err := h.fundService.ListFunds(ctx, status, params)
 	if err != nil {
+		if errors.Is(err, validation.ErrInvalidCursor) || errors.Is(err, fund.ErrInvalidStatus) {
+			return ListFunds400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Code:    INVALIDREQUEST,
+					Message: err.Error(),
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
 		logError(ctx, "failed to list funds", err)
 		return ListFunds500JSONResponse{
 			InternalErrorJSONResponse: InternalErrorJSONResponse{
@@ -140,19 +222,15 @@ func (h *APIHandler) ListFunds(ctx context.Context, request ListFundsRequestObje
 
 	funds := make([]Fund, len(result.Items))
 	for i, f := range result.Items {
-		funds[i] = Fund{
-			Id:         f.ID,
-			Name:       f.Name,
-			TotalUnits: f.TotalUnits,
-			CreatedAt:  f.CreatedAt,
-		}
+		funds[i] = toAPIFund(f)
 	}
 
 	return ListFunds200JSONResponse(FundList{
-		Funds:  funds,
-		Total:  result.Total,
-		Limit:  result.Limit,
-		Offset: result.Offset,
+		Funds:      funds,
+		Total:      pageTotal(params, result.Total),
+		Limit:      result.Limit,
+		Offset:     result.Offset,
+		NextCursor: nonEmpty(result.NextCursor),
 	}), nil
 }
 
@@ -177,12 +255,24 @@ func (h *APIHandler) CreateFund(ctx context.Context, request CreateFundRequestOb
 		}, nil
 	}
 
-	f, err := h.fundService.CreateFundWithInitialOwner(
-		ctx,
-		request.Body.Name,
-		request.Body.TotalUnits,
-		request.Body.InitialOwner,
+	var (
+		f   *fund.Fund
+		err error
 	)
+	switch {
+	case request.Body.InitialOwnerId != nil:
+		f, err = h.fundService.CreateFundForOwner(ctx, request.Body.Name, request.Body.TotalUnits, *request.Body.InitialOwnerId)
+	case request.Body.InitialOwner != nil:
+		f, err = h.fundService.CreateFundWithInitialOwner(ctx, request.Body.Name, request.Body.TotalUnits, *request.Body.InitialOwner)
+	default:
+		return CreateFund400JSONResponse{
+			BadRequestJSONResponse: BadRequestJSONResponse{
+				Code:    INVALIDREQUEST,
+				Message: "initialOwnerId or initialOwner is required",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
 	if err != nil {
 		if errors.Is(err, fund.ErrInvalidFund) {
 			return CreateFund400JSONResponse{
@@ -202,6 +292,33 @@ func (h *APIHandler) CreateFund(ctx context.Context, request CreateFundRequestOb
 				},
 			}, nil
 		}
+		if errors.Is(err, owner.ErrInvalidName) {
+			return CreateFund400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Code:    INVALIDOWNER,
+					Message: err.Error(),
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+		if errors.Is(err, owner.ErrNotFound) {
+			return CreateFund404JSONResponse{
+				OwnerNotFoundJSONResponse: OwnerNotFoundJSONResponse{
+					Code:    OWNERNOTFOUND,
+					Message: err.Error(),
+					Details: errorDetails(ctx, map[string]interface{}{"ownerId": request.Body.InitialOwnerId}),
+				},
+			}, nil
+		}
+		if errors.Is(err, owner.ErrAmbiguousName) {
+			return CreateFund409JSONResponse{
+				OwnerConflictJSONResponse: OwnerConflictJSONResponse{
+					Code:    OWNERCONFLICT,
+					Message: err.Error(),
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
 		logError(ctx, "failed to create fund", err)
 		return CreateFund500JSONResponse{
 			InternalErrorJSONResponse: InternalErrorJSONResponse{
@@ -212,12 +329,91 @@ func (h *APIHandler) CreateFund(ctx context.Context, request CreateFundRequestOb
 		}, nil
 	}
 
-	return CreateFund201JSONResponse(Fund{
-		Id:         f.ID,
-		Name:       f.Name,
-		TotalUnits: f.TotalUnits,
-		CreatedAt:  f.CreatedAt,
-	}), nil
+	return CreateFund201JSONResponse(toAPIFund(f)), nil
+}
+
+func (h *APIHandler) ImportFund(ctx context.Context, request ImportFundRequestObject) (ImportFundResponseObject, error) {
+	if h.fundService == nil {
+		return ImportFund500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "fund service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	var req fund.ImportRequest
+	switch {
+	case request.JSONBody != nil:
+		req.Name = request.JSONBody.Name
+		req.TotalUnits = request.JSONBody.TotalUnits
+		req.Rows = make([]fund.ImportRow, len(request.JSONBody.Rows))
+		for i, row := range request.JSONBody.Rows {
+			req.Rows[i] = fund.ImportRow{Owner: deref(row.Owner), OwnerID: row.OwnerId, Units: row.Units}
+		}
+	case request.Body != nil:
+		if request.Params.Name == nil || request.Params.TotalUnits == nil {
+			return ImportFund400JSONResponse{
+				Code:    INVALIDREQUEST,
+				Message: "name and totalUnits query parameters are required for a CSV import",
+				Details: errorDetails(ctx, nil),
+			}, nil
+		}
+		rows, err := fund.ReadImportCSV(request.Body)
+		if err != nil {
+			return importFundError(ctx, err)
+		}
+		req = fund.ImportRequest{Name: *request.Params.Name, TotalUnits: *request.Params.TotalUnits, Rows: rows}
+	default:
+		return ImportFund400JSONResponse{
+			Code:    INVALIDREQUEST,
+			Message: "request body must be application/json or text/csv",
+			Details: errorDetails(ctx, nil),
+		}, nil
+	}
+
+	f, err := h.fundService.ImportFund(ctx, req)
+	if err != nil {
+		return importFundError(ctx, err)
+	}
+	return ImportFund201JSONResponse(toAPIFund(f)), nil
+}
+
+func importFundError(ctx context.Context, err error) (ImportFundResponseObject, error) {
+	var importErr *fund.ImportError
+	switch {
+	case errors.As(err, &importErr):
+		rows := make([]map[string]interface{}, len(importErr.Rows))
+		for i, rowErr := range importErr.Rows {
+			rows[i] = map[string]interface{}{"row": rowErr.Row, "error": rowErr.Err.Error()}
+		}
+		return ImportFund400JSONResponse{
+			Code:    INVALIDIMPORT,
+			Message: importErr.Error(),
+			Details: errorDetails(ctx, map[string]interface{}{"rows": rows}),
+		}, nil
+	case errors.Is(err, fund.ErrInvalidImport):
+		return ImportFund400JSONResponse{
+			Code:    INVALIDIMPORT,
+			Message: err.Error(),
+			Details: errorDetails(ctx, nil),
+		}, nil
+	case errors.Is(err, fund.ErrInvalidFund), errors.Is(err, fund.ErrDuplicateFundName):
+		return ImportFund400JSONResponse{
+			Code:    INVALIDFUND,
+			Message: err.Error(),
+			Details: errorDetails(ctx, nil),
+		}, nil
+	}
+	logError(ctx, "failed to import fund", err)
+	return ImportFund500JSONResponse{
+		InternalErrorJSONResponse: InternalErrorJSONResponse{
+			Code:    INTERNALERROR,
+			Message: "failed to import fund",
+			Details: errorDetails(ctx, nil),
+		},
+	}, nil
 }
 
 func (h *APIHandler) GetFund(ctx context.Context, request GetFundRequestObject) (GetFundResponseObject, error) {
@@ -252,182 +448,163 @@ func (h *APIHandler) GetFund(ctx context.Context, request GetFundRequestObject)
 		}, nil
 	}
 
-	return GetFund200JSONResponse(Fund{
-		Id:         f.ID,
-		Name:       f.Name,
-		TotalUnits: f.TotalUnits,
-		CreatedAt:  f.CreatedAt,
-	}), nil
+	return GetFund200JSONResponse(toAPIFund(f)), nil
 }
 
-func (h *APIHandler) GetCapTable(ctx context.Context, request GetCapTableRequestObject) (GetCapTableResponseObject, error) {
-	if h.ownershipService == nil {
-		return GetCapTable500JSONResponse{
+func (h *APIHandler) SetApprovalThreshold(ctx context.Context, request SetApprovalThresholdRequestObject) (SetApprovalThresholdResponseObject, error) {
+	if h.fundService == nil {
+		return SetApprovalThreshold500JSONResponse{
 			InternalErrorJSONResponse: InternalErrorJSONResponse{
 				Code:    INTERNALERROR,
-				Message: "ownership service not configured",
+				Message: "fund service not configured",
 				Details: errorDetails(ctx, nil),
 			},
 		}, nil
 	}
 
-	var fundTotalUnits int
-	if h.fundService != nil {
-		f, err := h.fundService.GetFund(ctx, request.FundId)
-		if err != nil {
-			if errors.Is(err, fund.ErrNotFound) {
-				return GetCapTable404JSONResponse{
-					FundNotFoundJSONResponse: FundNotFoundJSONResponse{
-						Code:    FUNDNOTFOUND,
-						Message: "fund not found",
-						Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
-					},
-				}, nil
-			}
-			logError(ctx, "failed to verify fund", err, slog.String("fundId", request.FundId.String()))
-			return GetCapTable500JSONResponse{
+	if request.Body == nil {
+		return SetApprovalThreshold400JSONResponse{
+			BadRequestJSONResponse: BadRequestJSONResponse{
+				Code:    INVALIDREQUEST,
+				Message: "request body is required",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	f, err := h.fundService.SetApprovalThreshold(ctx, request.FundId, request.Body.Threshold)
+	if err != nil {
+		switch {
+		case errors.Is(err, fund.ErrInvalidApprovalThreshold):
+			return SetApprovalThreshold400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Code:    INVALIDREQUEST,
+					Message: err.Error(),
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		case errors.Is(err, fund.ErrNotFound):
+			return SetApprovalThreshold404JSONResponse{
+				FundNotFoundJSONResponse: FundNotFoundJSONResponse{
+					Code:    FUNDNOTFOUND,
+					Message: "fund not found",
+					Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+				},
+			}, nil
+		default:
+			logError(ctx, "failed to set approval threshold", err, slog.String("fundId", request.FundId.String()))
+			return SetApprovalThreshold500JSONResponse{
 				InternalErrorJSONResponse: InternalErrorJSONResponse{
 					Code:    INTERNALERROR,
-					Message: "failed to verify fund",
+					Message: "failed to set approval threshold",
 					Details: errorDetails(ctx, nil),
 				},
 			}, nil
 		}
-		fundTotalUnits = f.TotalUnits
 	}
 
-	params := ownership.ListParams{}
-	if request.Params.Limit != nil {
-		params.Limit = *request.Params.Limit
-	}
-	if request.Params.Offset != nil {
-		params.Offset = *request.Params.Offset
-	}
+	return SetApprovalThreshold200JSONResponse(toAPIFund(f)), nil
+}
 
-	view, err := h.ownershipService.GetCapTable(ctx, request.FundId, params)
-	if err != nil {
-		logError(ctx, "failed to get cap table", err, slog.String("fundId", request.FundId.String()))
-		return GetCapTable500JSONResponse{
+func (h *APIHandler) ChangeFundStatus(ctx context.Context, request ChangeFundStatusRequestObject) (ChangeFundStatusResponseObject, error) {
+	if h.fundService == nil {
+		return ChangeFundStatus500JSONResponse{
 			InternalErrorJSONResponse: InternalErrorJSONResponse{
 				Code:    INTERNALERROR,
-				Message: "failed to get cap table",
+				Message: "fund service not configured",
 				Details: errorDetails(ctx, nil),
 			},
 		}, nil
 	}
 
-	entries := make([]CapTableEntry, len(view.Entries))
-	for i, e := range view.Entries {
-		var percentage float64
-		if fundTotalUnits > 0 {
-			percentage = float64(e.Units) / float64(fundTotalUnits) * validation.PercentageMultiplier
-		}
-		entries[i] = CapTableEntry{
-			OwnerName:  e.OwnerName,
-			Units:      e.Units,
-			AcquiredAt: e.AcquiredAt,
-			Percentage: percentage,
-		}
-	}
-
-	return GetCapTable200JSONResponse(CapTable{
-		FundId:  request.FundId,
-		Entries: entries,
-		Total:   view.TotalCount,
-		Limit:   view.Limit,
-		Offset:  view.Offset,
-	}), nil
-}
-
-func (h *APIHandler) ListTransfers(ctx context.Context, request ListTransfersRequestObject) (ListTransfersResponseObject, error) {
-	if h.transferService == nil {
-		return ListTransfers500JSONResponse{
-			InternalErrorJSONResponse: InternalErrorJSONResponse{
-				Code:    INTERNALERROR,
-				Message: "transfer service not configured",
+	if request.Body == nil {
+		return ChangeFundStatus400JSONResponse{
+			BadRequestJSONResponse: BadRequestJSONResponse{
+				Code:    INVALIDREQUEST,
+				Message: "request body is required",
 				Details: errorDetails(ctx, nil),
 			},
 		}, nil
 	}
 
-	if h.fundService != nil {
-		_, err := h.fundService.GetFund(ctx, request.FundId)
-		if err != nil {
-			if errors.Is(err, fund.ErrNotFound) {
-				return ListTransfers404JSONResponse{
-					FundNotFoundJSONResponse: FundNotFoundJSONResponse{
-						Code:    FUNDNOTFOUND,
-						Message: "fund not found",
-						Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
-					},
-				}, nil
-			}
-			logError(ctx, "failed to verify fund", err, slog.String("fundId", request.FundId.String()))
-			return ListTransfers500JSONResponse{
+	f, err := h.fundService.ChangeStatus(ctx, fund.StatusChange{
+		FundID: request.FundId,
+		Status: fund.Status(request.Body.Status),
+		Reason: deref(request.Body.Reason),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, fund.ErrInvalidStatus), errors.Is(err, fund.ErrInvalidStatusReason):
+			return ChangeFundStatus400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Code:    INVALIDREQUEST,
+					Message: err.Error(),
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		case errors.Is(err, fund.ErrNotFound):
+			return ChangeFundStatus404JSONResponse{
+				FundNotFoundJSONResponse: FundNotFoundJSONResponse{
+					Code:    FUNDNOTFOUND,
+					Message: "fund not found",
+					Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+				},
+			}, nil
+		case errors.Is(err, fund.ErrStatusTransition):
+			return ChangeFundStatus409JSONResponse{
+				Code:    INVALIDSTATUSTRANSITION,
+				Message: err.Error(),
+				Details: errorDetails(ctx, map[string]interface{}{
+					"fundId":    request.FundId.String(),
+					"requested": request.Body.Status,
+				}),
+			}, nil
+		default:
+			logError(ctx, "failed to change fund status", err,
+				slog.String("fundId", request.FundId.String()),
+				slog.String("status", string(request.Body.Status)),
+			)
+			return ChangeFundStatus500JSONResponse{
 				InternalErrorJSONResponse: InternalErrorJSONResponse{
 					Code:    INTERNALERROR,
-					Message: "failed to verify fund",
+					Message: "failed to change fund status",
 					Details: errorDetails(ctx, nil),
 				},
 			}, nil
 		}
 	}
 
-	params := transfer.ListParams{}
-	if request.Params.Limit != nil {
-		params.Limit = *request.Params.Limit
-	}
-	if request.Params.Offset != nil {
-		params.Offset = *request.Params.Offset
-	}
-
-	list, err := h.transferService.ListTransfers(ctx, request.FundId, params)
-	if err != nil {
-		logError(ctx, "failed to list transfers", err, slog.String("fundId", request.FundId.String()))
-		return ListTransfers500JSONResponse{
-			InternalErrorJSONResponse: InternalErrorJSONResponse{
-				Code:    INTERNALERROR,
-				Message: "failed to list transfers",
-				Details: errorDetails(ctx, nil),
-			},
-		}, nil
-	}
+	return ChangeFundStatus200JSONResponse(toAPIFund(f)), nil
+}
 
-	transfers := make([]Transfer, len(list.Transfers))
-	for i, t := range list.Transfers {
-		transfers[i] = Transfer{
-			Id:            t.ID,
-			FundId:        t.FundID,
-			FromOwner:     t.FromOwner,
-			ToOwner:       t.ToOwner,
-			Units:         t.Units,
-			TransferredAt: t.TransferredAt,
-		}
+func toAPIFund(f *fund.Fund) Fund {
+	return Fund{
+		Id:                f.ID,
+		Name:              f.Name,
+		TotalUnits:        f.TotalUnits,
+		CreatedAt:         f.CreatedAt,
+		Status:            FundStatus(f.Status),
+		StatusChangedAt:   f.StatusChangedAt,
+		ApprovalThreshold: f.ApprovalThreshold,
+		LedgerSequence:    ptr(f.LedgerSequence),
+		LedgerHead:        hexHash(f.LedgerHead),
 	}
-
-	return ListTransfers200JSONResponse(TransferList{
-		FundId:    request.FundId,
-		Transfers: transfers,
-		Total:     list.TotalCount,
-		Limit:     list.Limit,
-		Offset:    list.Offset,
-	}), nil
 }
 
-func (h *APIHandler) CreateTransfer(ctx context.Context, request CreateTransferRequestObject) (CreateTransferResponseObject, error) {
-	if h.transferService == nil {
-		return CreateTransfer500JSONResponse{
+func (h *APIHandler) IssueUnits(ctx context.Context, request IssueUnitsRequestObject) (IssueUnitsResponseObject, error) {
+	if h.fundService == nil {
+		return IssueUnits500JSONResponse{
 			InternalErrorJSONResponse: InternalErrorJSONResponse{
 				Code:    INTERNALERROR,
-				Message: "transfer service not configured",
+				Message: "fund service not configured",
 				Details: errorDetails(ctx, nil),
 			},
 		}, nil
 	}
 
 	if request.Body == nil {
-		return CreateTransfer400JSONResponse{
-			TransferBadRequestJSONResponse: TransferBadRequestJSONResponse{
+		return IssueUnits400JSONResponse{
+			UnitEventBadRequestJSONResponse: UnitEventBadRequestJSONResponse{
 				Code:    INVALIDREQUEST,
 				Message: "request body is required",
 				Details: errorDetails(ctx, nil),
@@ -435,198 +612,3426 @@ func (h *APIHandler) CreateTransfer(ctx context.Context, request CreateTransferR
 		}, nil
 	}
 
-	if h.fundService != nil {
-		_, err := h.fundService.GetFund(ctx, request.FundId)
-		if err != nil {
-			if errors.Is(err, fund.ErrNotFound) {
-				return CreateTransfer404JSONResponse{
-					TransferNotFoundJSONResponse: TransferNotFoundJSONResponse{
-						Code:    FUNDNOTFOUND,
-						Message: "fund not found",
-						Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
-					},
-				}, nil
-			}
-			logError(ctx, "failed to verify fund for transfer", err, slog.String("fundId", request.FundId.String()))
-			return CreateTransfer500JSONResponse{
+	req := fund.UnitEventRequest{
+		FundID:  request.FundId,
+		Owner:   deref(request.Body.Owner),
+		OwnerID: request.Body.OwnerId,
+		ClassID: request.Body.ClassId,
+		Units:   request.Body.Units,
+	}
+	ownerName := ownerRef(req.OwnerID, req.Owner)
+
+	event, err := h.fundService.IssueUnits(ctx, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, fund.ErrInvalidOwner), errors.Is(err, fund.ErrInvalidUnits), errors.Is(err, fund.ErrTotalUnitsExceeded), errors.Is(err, fund.ErrLastUnits):
+			return IssueUnits400JSONResponse{
+				UnitEventBadRequestJSONResponse: UnitEventBadRequestJSONResponse{
+					Code:    INVALIDREQUEST,
+					Message: err.Error(),
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		case errors.Is(err, fund.ErrInsufficientUnits):
+			return IssueUnits400JSONResponse{
+				UnitEventBadRequestJSONResponse: UnitEventBadRequestJSONResponse{
+					Code:    INSUFFICIENTUNITS,
+					Message: err.Error(),
+					Details: errorDetails(ctx, map[string]interface{}{
+						"ownerName":      ownerName,
+						"requestedUnits": request.Body.Units,
+					}),
+				},
+			}, nil
+		case errors.Is(err, fund.ErrAuthorizedUnitsExceeded):
+			return IssueUnits400JSONResponse{
+				UnitEventBadRequestJSONResponse: UnitEventBadRequestJSONResponse{
+					Code:    AUTHORIZEDUNITSEXCEEDED,
+					Message: err.Error(),
+					Details: errorDetails(ctx, map[string]interface{}{
+						"classId":        classRef(request.Body.ClassId),
+						"requestedUnits": request.Body.Units,
+					}),
+				},
+			}, nil
+		case errors.Is(err, fund.ErrShareClassNotFound):
+			return IssueUnits404JSONResponse{
+				TransferNotFoundJSONResponse: TransferNotFoundJSONResponse{
+					Code:    SHARECLASSNOTFOUND,
+					Message: err.Error(),
+					Details: errorDetails(ctx, map[string]interface{}{
+						"classId": classRef(request.Body.ClassId),
+						"fundId":  request.FundId.String(),
+					}),
+				},
+			}, nil
+		case errors.Is(err, fund.ErrNotFound):
+			return IssueUnits404JSONResponse{
+				TransferNotFoundJSONResponse: TransferNotFoundJSONResponse{
+					Code:    FUNDNOTFOUND,
+					Message: "fund not found",
+					Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+				},
+			}, nil
+		case errors.Is(err, fund.ErrOwnerNotFound):
+			return IssueUnits404JSONResponse{
+				TransferNotFoundJSONResponse: TransferNotFoundJSONResponse{
+					Code:    OWNERNOTFOUND,
+					Message: err.Error(),
+					Details: errorDetails(ctx, map[string]interface{}{
+						"ownerName": ownerName,
+						"fundId":    request.FundId.String(),
+					}),
+				},
+			}, nil
+		case errors.Is(err, fund.ErrOwnerConflict):
+			return IssueUnits409JSONResponse{
+				UnitEventConflictJSONResponse: UnitEventConflictJSONResponse{
+					Code:    OWNERCONFLICT,
+					Message: err.Error(),
+					Details: errorDetails(ctx, map[string]interface{}{"ownerName": ownerName}),
+				},
+			}, nil
+		case errors.Is(err, fund.ErrFundNotOpen):
+			return IssueUnits409JSONResponse{
+				UnitEventConflictJSONResponse: UnitEventConflictJSONResponse{
+					Code:    FUNDNOTOPEN,
+					Message: err.Error(),
+					Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+				},
+			}, nil
+		default:
+			logError(ctx, "failed to issue units", err,
+				slog.String("fundId", request.FundId.String()),
+				slog.String("owner", ownerName),
+				slog.Int("units", request.Body.Units),
+			)
+			return IssueUnits500JSONResponse{
 				InternalErrorJSONResponse: InternalErrorJSONResponse{
 					Code:    INTERNALERROR,
-					Message: "failed to verify fund",
+					Message: "failed to issue units",
 					Details: errorDetails(ctx, nil),
 				},
 			}, nil
 		}
 	}
 
-	req := transfer.Request{
-		FundID:    request.FundId,
-		FromOwner: request.Body.FromOwner,
-		ToOwner:   request.Body.ToOwner,
-		Units:     request.Body.Units,
+	return IssueUnits201JSONResponse(toAPIUnitEvent(event)), nil
+}
+
+func (h *APIHandler) RedeemUnits(ctx context.Context, request RedeemUnitsRequestObject) (RedeemUnitsResponseObject, error) {
+	if h.fundService == nil {
+		return RedeemUnits500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "fund service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
 	}
-	if request.Body.IdempotencyKey != nil {
-		key := uuid.UUID(*request.Body.IdempotencyKey)
-		req.IdempotencyKey = &key
+
+	if request.Body == nil {
+		return RedeemUnits400JSONResponse{
+			UnitEventBadRequestJSONResponse: UnitEventBadRequestJSONResponse{
+				Code:    INVALIDREQUEST,
+				Message: "request body is required",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
 	}
 
-	t, err := h.transferService.ExecuteTransfer(ctx, req)
+	req := fund.UnitEventRequest{
+		FundID:  request.FundId,
+		Owner:   deref(request.Body.Owner),
+		OwnerID: request.Body.OwnerId,
+		ClassID: request.Body.ClassId,
+		Units:   request.Body.Units,
+	}
+	ownerName := ownerRef(req.OwnerID, req.Owner)
+
+	event, err := h.fundService.RedeemUnits(ctx, req)
 	if err != nil {
 		switch {
-		case errors.Is(err, transfer.ErrInvalidOwner):
-			return CreateTransfer400JSONResponse{
-				TransferBadRequestJSONResponse: TransferBadRequestJSONResponse{
+		case errors.Is(err, fund.ErrInvalidOwner), errors.Is(err, fund.ErrInvalidUnits), errors.Is(err, fund.ErrTotalUnitsExceeded), errors.Is(err, fund.ErrLastUnits):
+			return RedeemUnits400JSONResponse{
+				UnitEventBadRequestJSONResponse: UnitEventBadRequestJSONResponse{
 					Code:    INVALIDREQUEST,
 					Message: err.Error(),
 					Details: errorDetails(ctx, nil),
 				},
 			}, nil
-		case errors.Is(err, transfer.ErrInvalidUnits):
-			return CreateTransfer400JSONResponse{
-				TransferBadRequestJSONResponse: TransferBadRequestJSONResponse{
-					Code:    INVALIDREQUEST,
+		case errors.Is(err, fund.ErrInsufficientUnits):
+			return RedeemUnits400JSONResponse{
+				UnitEventBadRequestJSONResponse: UnitEventBadRequestJSONResponse{
+					Code:    INSUFFICIENTUNITS,
 					Message: err.Error(),
-					Details: errorDetails(ctx, nil),
+					Details: errorDetails(ctx, map[string]interface{}{
+						"ownerName":      ownerName,
+						"requestedUnits": request.Body.Units,
+					}),
 				},
 			}, nil
-		case errors.Is(err, transfer.ErrSelfTransfer):
-			return CreateTransfer400JSONResponse{
-				TransferBadRequestJSONResponse: TransferBadRequestJSONResponse{
-					Code:    INVALIDREQUEST,
-					Message: fmt.Sprintf("Cannot transfer units to yourself. Both fromOwner and toOwner are '%s'.", request.Body.FromOwner),
+		case errors.Is(err, fund.ErrAuthorizedUnitsExceeded):
+			return RedeemUnits400JSONResponse{
+				UnitEventBadRequestJSONResponse: UnitEventBadRequestJSONResponse{
+					Code:    AUTHORIZEDUNITSEXCEEDED,
+					Message: err.Error(),
 					Details: errorDetails(ctx, map[string]interface{}{
-						"fromOwner": request.Body.FromOwner,
-						"toOwner":   request.Body.ToOwner,
+						"classId":        classRef(request.Body.ClassId),
+						"requestedUnits": request.Body.Units,
 					}),
 				},
 			}, nil
-		case errors.Is(err, transfer.ErrOwnerNotFound):
-			return CreateTransfer404JSONResponse{
+		case errors.Is(err, fund.ErrShareClassNotFound):
+			return RedeemUnits404JSONResponse{
 				TransferNotFoundJSONResponse: TransferNotFoundJSONResponse{
-					Code:    OWNERNOTFOUND,
-					Message: fmt.Sprintf("Owner '%s' does not own any units in this fund. Check the cap table to see current owners.", request.Body.FromOwner),
+					Code:    SHARECLASSNOTFOUND,
+					Message: err.Error(),
 					Details: errorDetails(ctx, map[string]interface{}{
-						"ownerName": request.Body.FromOwner,
-						"fundId":    request.FundId.String(),
-						"hint":      "The fromOwner must be an existing owner in the fund's cap table",
+						"classId": classRef(request.Body.ClassId),
+						"fundId":  request.FundId.String(),
 					}),
 				},
 			}, nil
-		case errors.Is(err, transfer.ErrInsufficientUnits):
-			return CreateTransfer400JSONResponse{
-				TransferBadRequestJSONResponse: TransferBadRequestJSONResponse{
-					Code:    INSUFFICIENTUNITS,
-					Message: fmt.Sprintf("Owner '%s' does not have enough units for this transfer. They need at least %d units.", request.Body.FromOwner, request.Body.Units),
+		case errors.Is(err, fund.ErrNotFound):
+			return RedeemUnits404JSONResponse{
+				TransferNotFoundJSONResponse: TransferNotFoundJSONResponse{
+					Code:    FUNDNOTFOUND,
+					Message: "fund not found",
+					Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+				},
+			}, nil
+		case errors.Is(err, fund.ErrOwnerNotFound):
+			return RedeemUnits404JSONResponse{
+				TransferNotFoundJSONResponse: TransferNotFoundJSONResponse{
+					Code:    OWNERNOTFOUND,
+					Message: err.Error(),
 					Details: errorDetails(ctx, map[string]interface{}{
-						"ownerName":      request.Body.FromOwner,
-						"requestedUnits": request.Body.Units,
-						"hint":           "Check the cap table to see how many units this owner currently holds",
+						"ownerName": ownerName,
+						"fundId":    request.FundId.String(),
 					}),
 				},
 			}, nil
-		case errors.Is(err, transfer.ErrDuplicateIdempotencyKey):
-			return CreateTransfer409JSONResponse{
-				DuplicateTransferJSONResponse: DuplicateTransferJSONResponse{
-					Code:    DUPLICATETRANSFER,
+		case errors.Is(err, fund.ErrOwnerConflict):
+			return RedeemUnits409JSONResponse{
+				UnitEventConflictJSONResponse: UnitEventConflictJSONResponse{
+					Code:    OWNERCONFLICT,
 					Message: err.Error(),
-					Details: errorDetails(ctx, nil),
+					Details: errorDetails(ctx, map[string]interface{}{"ownerName": ownerName}),
+				},
+			}, nil
+		case errors.Is(err, fund.ErrFundNotOpen):
+			return RedeemUnits409JSONResponse{
+				UnitEventConflictJSONResponse: UnitEventConflictJSONResponse{
+					Code:    FUNDNOTOPEN,
+					Message: err.Error(),
+					Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
 				},
 			}, nil
 		default:
-			logError(ctx, "failed to execute transfer", err,
+			logError(ctx, "failed to redeem units", err,
 				slog.String("fundId", request.FundId.String()),
-				slog.String("fromOwner", request.Body.FromOwner),
-				slog.String("toOwner", request.Body.ToOwner),
+				slog.String("owner", ownerName),
 				slog.Int("units", request.Body.Units),
 			)
-			return CreateTransfer500JSONResponse{
+			return RedeemUnits500JSONResponse{
 				InternalErrorJSONResponse: InternalErrorJSONResponse{
 					Code:    INTERNALERROR,
-					Message: "failed to execute transfer",
+					Message: "failed to redeem units",
 					Details: errorDetails(ctx, nil),
 				},
 			}, nil
 		}
 	}
 
-	return CreateTransfer201JSONResponse(Transfer{
-		Id:            t.ID,
-		FundId:        t.FundID,
-		FromOwner:     t.FromOwner,
-		ToOwner:       t.ToOwner,
-		Units:         t.Units,
-		TransferredAt: t.TransferredAt,
-	}), nil
+	return RedeemUnits201JSONResponse(toAPIUnitEvent(event)), nil
 }
 
-func (h *APIHandler) ResetDatabase(ctx context.Context, _ ResetDatabaseRequestObject) (ResetDatabaseResponseObject, error) {
-	if h.pool == nil {
-		return ResetDatabase500JSONResponse{
+func (h *APIHandler) ListUnitEvents(ctx context.Context, request ListUnitEventsRequestObject) (ListUnitEventsResponseObject, error) {
+	if h.fundService == nil {
+		return ListUnitEvents500JSONResponse{
 			InternalErrorJSONResponse: InternalErrorJSONResponse{
 				Code:    INTERNALERROR,
-				Message: "database pool not configured",
+				Message: "fund service not configured",
 				Details: errorDetails(ctx, nil),
 			},
 		}, nil
 	}
 
+	if _, err := h.fundService.GetFund(ctx, request.FundId); err != nil {
+		if errors.Is(err, fund.ErrNotFound) {
+			return ListUnitEvents404JSONResponse{
+				FundNotFoundJSONResponse: FundNotFoundJSONResponse{
+					Code:    FUNDNOTFOUND,
+					Message: "fund not found",
+					Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+				},
+			}, nil
+		}
+		logError(ctx, "failed to verify fund", err, slog.String("fundId", request.FundId.String()))
+		return ListUnitEvents500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "failed to verify fund",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
 
-	var deletedTransfers, deletedOwnership, deletedFunds int
+	params := fund.ListParams{Cursor: deref(request.Params.Cursor)}
+	if request.Params.Limit != nil {
+		params.Limit = *request.Params.Limit
+	}
+	if request.Params.Offset != nil {
+		params.Offset = *request.Params.Offset
+	}
 
-	result, err := h.pool.Exec(ctx, "DELETE FROM transfers")
+	result, err := h.fundService.ListUnitEvents(ctx, request.FundId, params)
 	if err != nil {
-		logError(ctx, "failed to delete transfers", err)
-		return ResetDatabase500JSONResponse{
+		if errors.Is(err, validation.ErrInvalidCursor) {
+			return ListUnitEvents400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Code:    INVALIDREQUEST,
+					Message: err.Error(),
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+		logError(ctx, "failed to list unit events", err, slog.String("fundId", request.FundId.String()))
+		return ListUnitEvents500JSONResponse{
 			InternalErrorJSONResponse: InternalErrorJSONResponse{
 				Code:    INTERNALERROR,
-				Message: "failed to reset database",
+				Message: "failed to list unit events",
 				Details: errorDetails(ctx, nil),
 			},
 		}, nil
 	}
-	deletedTransfers = int(result.RowsAffected())
 
-	result, err = h.pool.Exec(ctx, "DELETE FROM cap_table_entries")
-	if err != nil {
-		logError(ctx, "failed to delete ownership entries", err)
-		return ResetDatabase500JSONResponse{
+	events := make([]UnitEvent, len(result.Items))
+	for i, e := range result.Items {
+		events[i] = toAPIUnitEvent(e)
+	}
+
+	return ListUnitEvents200JSONResponse(UnitEventList{
+		FundId:     request.FundId,
+		Events:     events,
+		Total:      pageTotal(params, result.Total),
+		Limit:      result.Limit,
+		Offset:     result.Offset,
+		NextCursor: nonEmpty(result.NextCursor),
+	}), nil
+}
+
+func toAPIUnitEvent(e *fund.UnitEvent) UnitEvent {
+	return UnitEvent{
+		Id:         e.ID,
+		FundId:     e.FundID,
+		OwnerId:    e.OwnerID,
+		OwnerName:  e.OwnerName,
+		ClassId:    e.ClassID,
+		ClassName:  e.ClassName,
+		Kind:       UnitEventKind(e.Kind),
+		Units:      e.Units,
+		TotalUnits: e.TotalUnits,
+		OccurredAt: e.OccurredAt,
+	}
+}
+
+func (h *APIHandler) GetCapTable(ctx context.Context, request GetCapTableRequestObject) (GetCapTableResponseObject, error) {
+	if h.ownershipService == nil {
+		return GetCapTable500JSONResponse{
 			InternalErrorJSONResponse: InternalErrorJSONResponse{
 				Code:    INTERNALERROR,
-				Message: "failed to reset database",
+				Message: "ownership service not configured",
 				Details: errorDetails(ctx, nil),
 			},
 		}, nil
 	}
-	deletedOwnership = int(result.RowsAffected())
 
-	result, err = h.pool.Exec(ctx, "DELETE FROM funds")
+	var fundTotalUnits int
+	if h.fundService != nil {
+		f, err := h.fundService.GetFund(ctx, request.FundId)
+		if err != nil {
+			if errors.Is(err, fund.ErrNotFound) {
+				return GetCapTable404JSONResponse{
+					FundNotFoundJSONResponse: FundNotFoundJSONResponse{
+						Code:    FUNDNOTFOUND,
+						Message: "fund not found",
+						Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+					},
+				}, nil
+			}
+			logError(ctx, "failed to verify fund", err, slog.String("fundId", request.FundId.String()))
+			return GetCapTable500JSONResponse{
+				InternalErrorJSONResponse: InternalErrorJSONResponse{
+					Code:    INTERNALERROR,
+					Message: "failed to verify fund",
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+		fundTotalUnits = f.TotalUnits
+	}
+
+	params := ownership.ListParams{Cursor: deref(request.Params.Cursor)}
+	if request.Params.Limit != nil {
+		params.Limit = *request.Params.Limit
+	}
+	if request.Params.Offset != nil {
+		params.Offset = *request.Params.Offset
+	}
+
+	var view *ownership.CapTableView
+	var err error
+	if request.Params.AsOf != nil {
+		view, err = h.ownershipService.GetCapTableAsOf(ctx, request.FundId, *request.Params.AsOf, params)
+		if err == nil {
+			fundTotalUnits = view.UnitsOutstanding
+		}
+	} else {
+		view, err = h.ownershipService.GetCapTable(ctx, request.FundId, params)
+	}
 	if err != nil {
-		logError(ctx, "failed to delete funds", err)
-		return ResetDatabase500JSONResponse{
+		if errors.Is(err, validation.ErrInvalidCursor) {
+			return GetCapTable400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Code:    INVALIDREQUEST,
+					Message: err.Error(),
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+		logError(ctx, "failed to get cap table", err, slog.String("fundId", request.FundId.String()))
+		return GetCapTable500JSONResponse{
 			InternalErrorJSONResponse: InternalErrorJSONResponse{
 				Code:    INTERNALERROR,
-				Message: "failed to reset database",
+				Message: "failed to get cap table",
 				Details: errorDetails(ctx, nil),
 			},
 		}, nil
 	}
-	deletedFunds = int(result.RowsAffected())
 
-	slog.InfoContext(ctx, "database reset completed",
-		slog.Int("deletedFunds", deletedFunds),
-		slog.Int("deletedTransfers", deletedTransfers),
-		slog.Int("deletedOwnership", deletedOwnership),
-	)
+	entries := make([]CapTableEntry, len(view.Entries))
+	for i, e := range view.Entries {
+		var classUnits int
+		if class := view.FindClass(e.ClassID); class != nil {
+			classUnits = class.OutstandingUnits
+		}
+		entries[i] = CapTableEntry{
+			OwnerId:         e.OwnerID,
+			OwnerName:       e.OwnerName,
+			ClassId:         e.ClassID,
+			ClassName:       e.ClassName,
+			Units:           e.Units,
+			AcquiredAt:      e.AcquiredAt,
+			Percentage:      ownership.Percentage(e.Units, fundTotalUnits),
+			ClassPercentage: ownership.Percentage(e.Units, classUnits),
+		}
+	}
 
-	return ResetDatabase200JSONResponse{
-		Message:          ptr("Database reset successfully"),
-		DeletedFunds:     ptr(deletedFunds),
-		DeletedTransfers: ptr(deletedTransfers),
+	return GetCapTable200JSONResponse(CapTable{
+		FundId:     request.FundId,
+		Classes:    toAPIShareClasses(view.Classes),
+		Entries:    entries,
+		Total:      pageTotal(params, view.TotalCount),
+		Limit:      view.Limit,
+		Offset:     view.Offset,
+		NextCursor: nonEmpty(view.NextCursor),
+	}), nil
+}
+
+func (h *APIHandler) ExportCapTable(ctx context.Context, request ExportCapTableRequestObject) (ExportCapTableResponseObject, error) {
+	if h.ownershipService == nil {
+		return ExportCapTable500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "ownership service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	format, ok := negotiateExport(request.Params.Accept)
+	if !ok {
+		return ExportCapTable406JSONResponse{
+			NotAcceptableJSONResponse: NotAcceptableJSONResponse{
+				Code:    NOTACCEPTABLE,
+				Message: "export is available as text/csv or application/x-ndjson",
+				Details: errorDetails(ctx, map[string]interface{}{"accept": deref(request.Params.Accept)}),
+			},
+		}, nil
+	}
+
+	var fundTotalUnits int
+	if h.fundService != nil {
+		f, err := h.fundService.GetFund(ctx, request.FundId)
+		if err != nil {
+			if errors.Is(err, fund.ErrNotFound) {
+				return ExportCapTable404JSONResponse{
+					FundNotFoundJSONResponse: FundNotFoundJSONResponse{
+						Code:    FUNDNOTFOUND,
+						Message: "fund not found",
+						Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+					},
+				}, nil
+			}
+			logError(ctx, "failed to verify fund for export", err, slog.String("fundId", request.FundId.String()))
+			return ExportCapTable500JSONResponse{
+				InternalErrorJSONResponse: InternalErrorJSONResponse{
+					Code:    INTERNALERROR,
+					Message: "failed to verify fund",
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+		fundTotalUnits = f.TotalUnits
+	}
+
+	classes, err := h.ownershipService.ListShareClasses(ctx, request.FundId)
+	if err != nil {
+		logError(ctx, "failed to list share classes for export", err, slog.String("fundId", request.FundId.String()))
+		return ExportCapTable500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "failed to list share classes",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+	classUnits := make(map[uuid.UUID]int, len(classes))
+	for _, c := range classes {
+		classUnits[c.ID] = c.OutstandingUnits
+	}
+
+	return capTableExport{
+		ctx:        ctx,
+		service:    h.ownershipService,
+		fundID:     request.FundId,
+		totalUnits: fundTotalUnits,
+		classUnits: classUnits,
+		format:     format,
+	}, nil
+}
+
+func (h *APIHandler) ListShareClasses(ctx context.Context, request ListShareClassesRequestObject) (ListShareClassesResponseObject, error) {
+	if h.ownershipService == nil {
+		return ListShareClasses500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "ownership service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	if h.fundService != nil {
+		if _, err := h.fundService.GetFund(ctx, request.FundId); err != nil {
+			if errors.Is(err, fund.ErrNotFound) {
+				return ListShareClasses404JSONResponse{
+					FundNotFoundJSONResponse: FundNotFoundJSONResponse{
+						Code:    FUNDNOTFOUND,
+						Message: "fund not found",
+						Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+					},
+				}, nil
+			}
+			logError(ctx, "failed to verify fund", err, slog.String("fundId", request.FundId.String()))
+			return ListShareClasses500JSONResponse{
+				InternalErrorJSONResponse: InternalErrorJSONResponse{
+					Code:    INTERNALERROR,
+					Message: "failed to verify fund",
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+	}
+
+	classes, err := h.ownershipService.ListShareClasses(ctx, request.FundId)
+	if err != nil {
+		logError(ctx, "failed to list share classes", err, slog.String("fundId", request.FundId.String()))
+		return ListShareClasses500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "failed to list share classes",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	return ListShareClasses200JSONResponse(ShareClassList{
+		FundId:  request.FundId,
+		Classes: toAPIShareClasses(classes),
+	}), nil
+}
+
+func (h *APIHandler) CreateShareClass(ctx context.Context, request CreateShareClassRequestObject) (CreateShareClassResponseObject, error) {
+	if h.ownershipService == nil {
+		return CreateShareClass500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "ownership service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	if request.Body == nil {
+		return CreateShareClass400JSONResponse{
+			BadRequestJSONResponse: BadRequestJSONResponse{
+				Code:    INVALIDREQUEST,
+				Message: "request body is required",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	if h.fundService != nil {
+		if _, err := h.fundService.GetFund(ctx, request.FundId); err != nil {
+			if errors.Is(err, fund.ErrNotFound) {
+				return CreateShareClass404JSONResponse{
+					FundNotFoundJSONResponse: FundNotFoundJSONResponse{
+						Code:    FUNDNOTFOUND,
+						Message: "fund not found",
+						Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+					},
+				}, nil
+			}
+			logError(ctx, "failed to verify fund for share class", err, slog.String("fundId", request.FundId.String()))
+			return CreateShareClass500JSONResponse{
+				InternalErrorJSONResponse: InternalErrorJSONResponse{
+					Code:    INTERNALERROR,
+					Message: "failed to verify fund",
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+	}
+
+	class, err := h.ownershipService.CreateShareClass(ctx, request.FundId, request.Body.Name, request.Body.AuthorizedUnits)
+	if err != nil {
+		switch {
+		case errors.Is(err, ownership.ErrInvalidShareClass):
+			return CreateShareClass400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Code:    INVALIDREQUEST,
+					Message: err.Error(),
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		case errors.Is(err, ownership.ErrDuplicateShareClass):
+			return CreateShareClass409JSONResponse{
+				Code:    DUPLICATESHARECLASS,
+				Message: err.Error(),
+				Details: errorDetails(ctx, map[string]interface{}{"name": request.Body.Name}),
+			}, nil
+		default:
+			logError(ctx, "failed to create share class", err,
+				slog.String("fundId", request.FundId.String()),
+				slog.String("name", request.Body.Name),
+			)
+			return CreateShareClass500JSONResponse{
+				InternalErrorJSONResponse: InternalErrorJSONResponse{
+					Code:    INTERNALERROR,
+					Message: "failed to create share class",
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+	}
+
+	return CreateShareClass201JSONResponse(toAPIShareClass(class)), nil
+}
+
+func toAPIShareClass(c *ownership.ShareClass) ShareClass {
+	return ShareClass{
+		Id:               c.ID,
+		FundId:           c.FundID,
+		Name:             c.Name,
+		AuthorizedUnits:  c.AuthorizedUnits,
+		OutstandingUnits: c.OutstandingUnits,
+		Default:          c.Default,
+		CreatedAt:        c.CreatedAt,
+	}
+}
+
+func toAPIShareClasses(classes []*ownership.ShareClass) []ShareClass {
+	out := make([]ShareClass, len(classes))
+	for i, c := range classes {
+		out[i] = toAPIShareClass(c)
+	}
+	return out
+}
+
+func (h *APIHandler) ListDistributions(ctx context.Context, request ListDistributionsRequestObject) (ListDistributionsResponseObject, error) {
+	if h.distributionService == nil {
+		return ListDistributions500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "distribution service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	if h.fundService != nil {
+		if _, err := h.fundService.GetFund(ctx, request.FundId); err != nil {
+			if errors.Is(err, fund.ErrNotFound) {
+				return ListDistributions404JSONResponse{
+					FundNotFoundJSONResponse: FundNotFoundJSONResponse{
+						Code:    FUNDNOTFOUND,
+						Message: "fund not found",
+						Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+					},
+				}, nil
+			}
+			logError(ctx, "failed to verify fund", err, slog.String("fundId", request.FundId.String()))
+			return ListDistributions500JSONResponse{
+				InternalErrorJSONResponse: InternalErrorJSONResponse{
+					Code:    INTERNALERROR,
+					Message: "failed to verify fund",
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+	}
+
+	params := distribution.ListParams{Cursor: deref(request.Params.Cursor)}
+	if request.Params.Limit != nil {
+		params.Limit = *request.Params.Limit
+	}
+	if request.Params.Offset != nil {
+		params.Offset = *request.Params.Offset
+	}
+
+	result, err := h.distributionService.ListDistributions(ctx, request.FundId, params)
+	if err != nil {
+		if errors.Is(err, validation.ErrInvalidCursor) {
+			return ListDistributions400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Code:    INVALIDREQUEST,
+					Message: err.Error(),
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+		logError(ctx, "failed to list distributions", err, slog.String("fundId", request.FundId.String()))
+		return ListDistributions500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "failed to list distributions",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	distributions := make([]Distribution, len(result.Items))
+	for i, d := range result.Items {
+		distributions[i] = toAPIDistribution(d)
+	}
+
+	return ListDistributions200JSONResponse(DistributionList{
+		FundId:        request.FundId,
+		Distributions: distributions,
+		Total:         pageTotal(params, result.Total),
+		Limit:         result.Limit,
+		Offset:        result.Offset,
+		NextCursor:    nonEmpty(result.NextCursor),
+	}), nil
+}
+
+func (h *APIHandler) CreateDistribution(ctx context.Context, request CreateDistributionRequestObject) (CreateDistributionResponseObject, error) {
+	if h.distributionService == nil {
+		return CreateDistribution500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "distribution service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	if request.Body == nil {
+		return CreateDistribution400JSONResponse{
+			BadRequestJSONResponse: BadRequestJSONResponse{
+				Code:    INVALIDREQUEST,
+				Message: "request body is required",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	d, err := h.distributionService.CreateDistribution(ctx, distribution.Request{
+		FundID:      request.FundId,
+		TotalAmount: request.Body.TotalAmount,
+		RecordDate:  request.Body.RecordDate,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, distribution.ErrInvalidAmount), errors.Is(err, distribution.ErrFutureRecordDate), errors.Is(err, distribution.ErrNoUnitsOutstanding):
+			return CreateDistribution400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Code:    INVALIDREQUEST,
+					Message: err.Error(),
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		case errors.Is(err, distribution.ErrFundNotFound):
+			return CreateDistribution404JSONResponse{
+				FundNotFoundJSONResponse: FundNotFoundJSONResponse{
+					Code:    FUNDNOTFOUND,
+					Message: "fund not found",
+					Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+				},
+			}, nil
+		default:
+			logError(ctx, "failed to create distribution", err,
+				slog.String("fundId", request.FundId.String()),
+				slog.Int64("totalAmount", request.Body.TotalAmount),
+			)
+			return CreateDistribution500JSONResponse{
+				InternalErrorJSONResponse: InternalErrorJSONResponse{
+					Code:    INTERNALERROR,
+					Message: "failed to create distribution",
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+	}
+
+	return CreateDistribution201JSONResponse(toAPIDistribution(d)), nil
+}
+
+func (h *APIHandler) GetDistribution(ctx context.Context, request GetDistributionRequestObject) (GetDistributionResponseObject, error) {
+	if h.distributionService == nil {
+		return GetDistribution500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "distribution service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	d, err := h.distributionService.GetDistribution(ctx, request.FundId, request.DistributionId)
+	if err != nil {
+		if errors.Is(err, distribution.ErrNotFound) {
+			return GetDistribution404JSONResponse{
+				DistributionNotFoundJSONResponse: DistributionNotFoundJSONResponse{
+					Code:    DISTRIBUTIONNOTFOUND,
+					Message: "distribution not found",
+					Details: errorDetails(ctx, map[string]interface{}{
+						"distributionId": request.DistributionId.String(),
+						"fundId":         request.FundId.String(),
+					}),
+				},
+			}, nil
+		}
+		logError(ctx, "failed to get distribution", err,
+			slog.String("fundId", request.FundId.String()),
+			slog.String("distributionId", request.DistributionId.String()),
+		)
+		return GetDistribution500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "failed to get distribution",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	return GetDistribution200JSONResponse(toAPIDistribution(d)), nil
+}
+
+func (h *APIHandler) ExportDistribution(ctx context.Context, request ExportDistributionRequestObject) (ExportDistributionResponseObject, error) {
+	if h.distributionService == nil {
+		return ExportDistribution500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "distribution service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	format, ok := negotiateExport(request.Params.Accept)
+	if !ok {
+		return ExportDistribution406JSONResponse{
+			NotAcceptableJSONResponse: NotAcceptableJSONResponse{
+				Code:    NOTACCEPTABLE,
+				Message: "export is available as text/csv or application/x-ndjson",
+				Details: errorDetails(ctx, map[string]interface{}{"accept": deref(request.Params.Accept)}),
+			},
+		}, nil
+	}
+
+	d, err := h.distributionService.GetSummary(ctx, request.FundId, request.DistributionId)
+	if err != nil {
+		if errors.Is(err, distribution.ErrNotFound) {
+			return ExportDistribution404JSONResponse{
+				DistributionNotFoundJSONResponse: DistributionNotFoundJSONResponse{
+					Code:    DISTRIBUTIONNOTFOUND,
+					Message: "distribution not found",
+					Details: errorDetails(ctx, map[string]interface{}{
+						"distributionId": request.DistributionId.String(),
+						"fundId":         request.FundId.String(),
+					}),
+				},
+			}, nil
+		}
+		logError(ctx, "failed to get distribution for export", err,
+			slog.String("fundId", request.FundId.String()),
+			slog.String("distributionId", request.DistributionId.String()),
+		)
+		return ExportDistribution500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "failed to get distribution",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	return distributionExport{
+		ctx:          ctx,
+		service:      h.distributionService,
+		distribution: d,
+		format:       format,
+	}, nil
+}
+
+func toAPIDistribution(d *distribution.Distribution) Distribution {
+	out := Distribution{
+		Id:          d.ID,
+		FundId:      d.FundID,
+		TotalAmount: d.TotalAmount,
+		RecordDate:  d.RecordDate,
+		TotalUnits:  d.TotalUnits,
+		Holders:     d.Holders,
+		CreatedAt:   d.CreatedAt,
+	}
+	if d.LineItems != nil {
+		items := make([]DistributionLineItem, len(d.LineItems))
+		for i, item := range d.LineItems {
+			items[i] = toAPILineItem(item, d.TotalUnits)
+		}
+		out.LineItems = &items
+	}
+	return out
+}
+
+func toAPILineItem(item *distribution.LineItem, totalUnits int) DistributionLineItem {
+	return DistributionLineItem{
+		OwnerId:    item.OwnerID,
+		OwnerName:  item.OwnerName,
+		Units:      item.Units,
+		Percentage: ownership.Percentage(item.Units, totalUnits),
+		Amount:     item.Amount,
+	}
+}
+
+func (h *APIHandler) ListCommitments(ctx context.Context, request ListCommitmentsRequestObject) (ListCommitmentsResponseObject, error) {
+	if h.commitmentService == nil {
+		return ListCommitments500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "commitment service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	if h.fundService != nil {
+		if _, err := h.fundService.GetFund(ctx, request.FundId); err != nil {
+			if errors.Is(err, fund.ErrNotFound) {
+				return ListCommitments404JSONResponse{
+					FundNotFoundJSONResponse: FundNotFoundJSONResponse{
+						Code:    FUNDNOTFOUND,
+						Message: "fund not found",
+						Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+					},
+				}, nil
+			}
+			logError(ctx, "failed to verify fund", err, slog.String("fundId", request.FundId.String()))
+			return ListCommitments500JSONResponse{
+				InternalErrorJSONResponse: InternalErrorJSONResponse{
+					Code:    INTERNALERROR,
+					Message: "failed to verify fund",
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+	}
+
+	params := commitment.ListParams{Cursor: deref(request.Params.Cursor)}
+	if request.Params.Limit != nil {
+		params.Limit = *request.Params.Limit
+	}
+	if request.Params.Offset != nil {
+		params.Offset = *request.Params.Offset
+	}
+
+	result, err := h.commitmentService.ListCommitments(ctx, request.FundId, params)
+	if err != nil {
+		if errors.Is(err, validation.ErrInvalidCursor) {
+			return ListCommitments400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Code:    INVALIDREQUEST,
+					Message: err.Error(),
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+		logError(ctx, "failed to list commitments", err, slog.String("fundId", request.FundId.String()))
+		return ListCommitments500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "failed to list commitments",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	commitments := make([]Commitment, len(result.Items))
+	for i, c := range result.Items {
+		commitments[i] = toAPICommitment(c)
+	}
+
+	return ListCommitments200JSONResponse(CommitmentList{
+		FundId:      request.FundId,
+		Commitments: commitments,
+		Total:       pageTotal(params, result.Total),
+		Limit:       result.Limit,
+		Offset:      result.Offset,
+		NextCursor:  nonEmpty(result.NextCursor),
+	}), nil
+}
+
+func (h *APIHandler) CreateCommitment(ctx context.Context, request CreateCommitmentRequestObject) (CreateCommitmentResponseObject, error) {
+	if h.commitmentService == nil {
+		return CreateCommitment500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "commitment service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	if request.Body == nil {
+		return CreateCommitment400JSONResponse{
+			BadRequestJSONResponse: BadRequestJSONResponse{
+				Code:    INVALIDREQUEST,
+				Message: "request body is required",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	req := commitment.Request{
+		FundID:  request.FundId,
+		Owner:   deref(request.Body.Owner),
+		OwnerID: request.Body.OwnerId,
+		Amount:  request.Body.Amount,
+	}
+	ownerName := ownerRef(req.OwnerID, req.Owner)
+
+	c, err := h.commitmentService.CreateCommitment(ctx, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, commitment.ErrInvalidOwner), errors.Is(err, commitment.ErrInvalidAmount), errors.Is(err, commitment.ErrCommitmentLimit):
+			return CreateCommitment400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Code:    INVALIDREQUEST,
+					Message: err.Error(),
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		case errors.Is(err, commitment.ErrFundNotFound):
+			return CreateCommitment404JSONResponse{
+				TransferNotFoundJSONResponse: TransferNotFoundJSONResponse{
+					Code:    FUNDNOTFOUND,
+					Message: "fund not found",
+					Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+				},
+			}, nil
+		case errors.Is(err, commitment.ErrOwnerNotFound):
+			return CreateCommitment404JSONResponse{
+				TransferNotFoundJSONResponse: TransferNotFoundJSONResponse{
+					Code:    OWNERNOTFOUND,
+					Message: err.Error(),
+					Details: errorDetails(ctx, map[string]interface{}{
+						"ownerName": ownerName,
+						"fundId":    request.FundId.String(),
+					}),
+				},
+			}, nil
+		case errors.Is(err, commitment.ErrDuplicateCommitment):
+			return CreateCommitment409JSONResponse{
+				CommitmentConflictJSONResponse: CommitmentConflictJSONResponse{
+					Code:    DUPLICATECOMMITMENT,
+					Message: commitment.ErrDuplicateCommitment.Error(),
+					Details: errorDetails(ctx, map[string]interface{}{"ownerName": ownerName}),
+				},
+			}, nil
+		case errors.Is(err, commitment.ErrOwnerConflict):
+			return CreateCommitment409JSONResponse{
+				CommitmentConflictJSONResponse: CommitmentConflictJSONResponse{
+					Code:    OWNERCONFLICT,
+					Message: err.Error(),
+					Details: errorDetails(ctx, map[string]interface{}{"ownerName": ownerName}),
+				},
+			}, nil
+		case errors.Is(err, commitment.ErrFundNotOpen):
+			return CreateCommitment409JSONResponse{
+				CommitmentConflictJSONResponse: CommitmentConflictJSONResponse{
+					Code:    FUNDNOTOPEN,
+					Message: err.Error(),
+					Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+				},
+			}, nil
+		default:
+			logError(ctx, "failed to create commitment", err,
+				slog.String("fundId", request.FundId.String()),
+				slog.String("owner", ownerName),
+				slog.Int64("amount", request.Body.Amount),
+			)
+			return CreateCommitment500JSONResponse{
+				InternalErrorJSONResponse: InternalErrorJSONResponse{
+					Code:    INTERNALERROR,
+					Message: "failed to create commitment",
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+	}
+
+	return CreateCommitment201JSONResponse(toAPICommitment(c)), nil
+}
+
+func (h *APIHandler) ListCapitalCalls(ctx context.Context, request ListCapitalCallsRequestObject) (ListCapitalCallsResponseObject, error) {
+	if h.commitmentService == nil {
+		return ListCapitalCalls500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "commitment service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	if h.fundService != nil {
+		if _, err := h.fundService.GetFund(ctx, request.FundId); err != nil {
+			if errors.Is(err, fund.ErrNotFound) {
+				return ListCapitalCalls404JSONResponse{
+					FundNotFoundJSONResponse: FundNotFoundJSONResponse{
+						Code:    FUNDNOTFOUND,
+						Message: "fund not found",
+						Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+					},
+				}, nil
+			}
+			logError(ctx, "failed to verify fund", err, slog.String("fundId", request.FundId.String()))
+			return ListCapitalCalls500JSONResponse{
+				InternalErrorJSONResponse: InternalErrorJSONResponse{
+					Code:    INTERNALERROR,
+					Message: "failed to verify fund",
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+	}
+
+	params := commitment.ListParams{Cursor: deref(request.Params.Cursor)}
+	if request.Params.Limit != nil {
+		params.Limit = *request.Params.Limit
+	}
+	if request.Params.Offset != nil {
+		params.Offset = *request.Params.Offset
+	}
+
+	result, err := h.commitmentService.ListCapitalCalls(ctx, request.FundId, params)
+	if err != nil {
+		if errors.Is(err, validation.ErrInvalidCursor) {
+			return ListCapitalCalls400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Code:    INVALIDREQUEST,
+					Message: err.Error(),
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+		logError(ctx, "failed to list capital calls", err, slog.String("fundId", request.FundId.String()))
+		return ListCapitalCalls500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "failed to list capital calls",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	calls := make([]CapitalCall, len(result.Items))
+	for i, call := range result.Items {
+		calls[i] = toAPICapitalCall(call)
+	}
+
+	return ListCapitalCalls200JSONResponse(CapitalCallList{
+		FundId:       request.FundId,
+		CapitalCalls: calls,
+		Total:        pageTotal(params, result.Total),
+		Limit:        result.Limit,
+		Offset:       result.Offset,
+		NextCursor:   nonEmpty(result.NextCursor),
+	}), nil
+}
+
+func (h *APIHandler) CreateCapitalCall(ctx context.Context, request CreateCapitalCallRequestObject) (CreateCapitalCallResponseObject, error) {
+	if h.commitmentService == nil {
+		return CreateCapitalCall500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "commitment service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	if request.Body == nil {
+		return CreateCapitalCall400JSONResponse{
+			CapitalCallBadRequestJSONResponse: CapitalCallBadRequestJSONResponse{
+				Code:    INVALIDREQUEST,
+				Message: "request body is required",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	call, err := h.commitmentService.CreateCapitalCall(ctx, commitment.CallRequest{
+		FundID:      request.FundId,
+		TotalAmount: request.Body.TotalAmount,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, commitment.ErrInvalidAmount):
+			return CreateCapitalCall400JSONResponse{
+				CapitalCallBadRequestJSONResponse: CapitalCallBadRequestJSONResponse{
+					Code:    INVALIDREQUEST,
+					Message: err.Error(),
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		case errors.Is(err, commitment.ErrCallExceedsUnfunded), errors.Is(err, commitment.ErrNoUnfundedCommitments):
+			return CreateCapitalCall400JSONResponse{
+				CapitalCallBadRequestJSONResponse: CapitalCallBadRequestJSONResponse{
+					Code:    UNFUNDEDCOMMITMENTEXCEEDED,
+					Message: err.Error(),
+					Details: errorDetails(ctx, map[string]interface{}{
+						"fundId":          request.FundId.String(),
+						"requestedAmount": request.Body.TotalAmount,
+					}),
+				},
+			}, nil
+		case errors.Is(err, commitment.ErrFundNotFound):
+			return CreateCapitalCall404JSONResponse{
+				FundNotFoundJSONResponse: FundNotFoundJSONResponse{
+					Code:    FUNDNOTFOUND,
+					Message: "fund not found",
+					Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+				},
+			}, nil
+		case errors.Is(err, commitment.ErrFundNotOpen):
+			return CreateCapitalCall409JSONResponse{
+				Code:    FUNDNOTOPEN,
+				Message: err.Error(),
+				Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+			}, nil
+		default:
+			logError(ctx, "failed to create capital call", err,
+				slog.String("fundId", request.FundId.String()),
+				slog.Int64("totalAmount", request.Body.TotalAmount),
+			)
+			return CreateCapitalCall500JSONResponse{
+				InternalErrorJSONResponse: InternalErrorJSONResponse{
+					Code:    INTERNALERROR,
+					Message: "failed to create capital call",
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+	}
+
+	return CreateCapitalCall201JSONResponse(toAPICapitalCall(call)), nil
+}
+
+func (h *APIHandler) GetCapitalCall(ctx context.Context, request GetCapitalCallRequestObject) (GetCapitalCallResponseObject, error) {
+	if h.commitmentService == nil {
+		return GetCapitalCall500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "commitment service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	call, err := h.commitmentService.GetCapitalCall(ctx, request.FundId, request.CapitalCallId)
+	if err != nil {
+		if errors.Is(err, commitment.ErrCapitalCallNotFound) {
+			return GetCapitalCall404JSONResponse{
+				CapitalCallNotFoundJSONResponse: CapitalCallNotFoundJSONResponse{
+					Code:    CAPITALCALLNOTFOUND,
+					Message: "capital call not found",
+					Details: errorDetails(ctx, map[string]interface{}{
+						"capitalCallId": request.CapitalCallId.String(),
+						"fundId":        request.FundId.String(),
+					}),
+				},
+			}, nil
+		}
+		logError(ctx, "failed to get capital call", err,
+			slog.String("fundId", request.FundId.String()),
+			slog.String("capitalCallId", request.CapitalCallId.String()),
+		)
+		return GetCapitalCall500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "failed to get capital call",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	return GetCapitalCall200JSONResponse(toAPICapitalCall(call)), nil
+}
+
+func toAPICommitment(c *commitment.Commitment) Commitment {
+	return Commitment{
+		Id:             c.ID,
+		FundId:         c.FundID,
+		OwnerId:        c.OwnerID,
+		OwnerName:      c.OwnerName,
+		Amount:         c.Amount,
+		CalledAmount:   c.CalledAmount,
+		UnfundedAmount: c.Unfunded(),
+		Units:          c.Units,
+		CreatedAt:      c.CreatedAt,
+		UpdatedAt:      c.UpdatedAt,
+	}
+}
+
+func toAPICapitalCall(call *commitment.CapitalCall) CapitalCall {
+	out := CapitalCall{
+		Id:          call.ID,
+		FundId:      call.FundID,
+		TotalAmount: call.TotalAmount,
+		Investors:   call.Investors,
+		CreatedAt:   call.CreatedAt,
+	}
+	if call.LineItems != nil {
+		items := make([]CapitalCallLineItem, len(call.LineItems))
+		for i, item := range call.LineItems {
+			items[i] = CapitalCallLineItem{
+				OwnerId:        item.OwnerID,
+				OwnerName:      item.OwnerName,
+				Commitment:     item.Commitment,
+				Amount:         item.Amount,
+				UnfundedAmount: item.Unfunded,
+			}
+		}
+		out.LineItems = &items
+	}
+	return out
+}
+
+func (h *APIHandler) StreamFundEvents(ctx context.Context, request StreamFundEventsRequestObject) (StreamFundEventsResponseObject, error) {
+	if h.eventService == nil {
+		return StreamFundEvents500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "event service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	if h.fundService != nil {
+		_, err := h.fundService.GetFund(ctx, request.FundId)
+		if err != nil {
+			if errors.Is(err, fund.ErrNotFound) {
+				return StreamFundEvents404JSONResponse{
+					FundNotFoundJSONResponse: FundNotFoundJSONResponse{
+						Code:    FUNDNOTFOUND,
+						Message: "fund not found",
+						Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+					},
+				}, nil
+			}
+			logError(ctx, "failed to verify fund for event stream", err, slog.String("fundId", request.FundId.String()))
+			return StreamFundEvents500JSONResponse{
+				InternalErrorJSONResponse: InternalErrorJSONResponse{
+					Code:    INTERNALERROR,
+					Message: "failed to verify fund",
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+	}
+
+	return fundEventStream{
+		ctx:     ctx,
+		service: h.eventService,
+		fundID:  request.FundId,
+		after:   request.Params.LastEventID,
+	}, nil
+}
+
+type fundEventStream struct {
+	ctx     context.Context
+	service *outbox.Service
+	fundID  uuid.UUID
+	after   *int64
+}
+
+func (s fundEventStream) VisitStreamFundEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	sink := &sseWriter{w: w, rc: http.NewResponseController(w)}
+	if err := sink.flush(); err != nil {
+		return nil
+	}
+
+	if err := s.service.Follow(s.ctx, s.fundID, s.after, sink); err != nil && s.ctx.Err() == nil {
+		logError(s.ctx, "event stream ended", err, slog.String("fundId", s.fundID.String()))
+	}
+	return nil
+}
+
+type sseWriter struct {
+	w  io.Writer
+	rc *http.ResponseController
+}
+
+func (s *sseWriter) Send(event *outbox.Event) error {
+	data, err := json.Marshal(event.Envelope())
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(s.w, "id: %d\nevent: %s\ndata: %s\n\n", event.Sequence, event.Type, data); err != nil {
+		return err
+	}
+	return s.flush()
+}
+
+func (s *sseWriter) Heartbeat() error {
+	if _, err := io.WriteString(s.w, ": heartbeat\n\n"); err != nil {
+		return err
+	}
+	return s.flush()
+}
+
+func (s *sseWriter) flush() error {
+	return s.rc.Flush()
+}
+
+func (h *APIHandler) ListTransfers(ctx context.Context, request ListTransfersRequestObject) (ListTransfersResponseObject, error) {
+	if h.transferService == nil {
+		return ListTransfers500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "transfer service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	if h.fundService != nil {
+		_, err := h.fundService.GetFund(ctx, request.FundId)
+		if err != nil {
+			if errors.Is(err, fund.ErrNotFound) {
+				return ListTransfers404JSONResponse{
+					FundNotFoundJSONResponse: FundNotFoundJSONResponse{
+						Code:    FUNDNOTFOUND,
+						Message: "fund not found",
+						Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+					},
+				}, nil
+			}
+			logError(ctx, "failed to verify fund", err, slog.String("fundId", request.FundId.String()))
+			return ListTransfers500JSONResponse{
+				InternalErrorJSONResponse: InternalErrorJSONResponse{
+					Code:    INTERNALERROR,
+					Message: "failed to verify fund",
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+	}
+
+	params := transfer.ListParams{Cursor: deref(request.Params.Cursor)}
+	if request.Params.Limit != nil {
+		params.Limit = *request.Params.Limit
+	}
+	if request.Params.Offset != nil {
+		params.Offset = *request.Params.Offset
+	}
+
+	filter := transfer.Filter{
+		OwnerID:     request.Params.OwnerId,
+		FromOwnerID: request.Params.FromOwnerId,
+		ToOwnerID:   request.Params.ToOwnerId,
+		Since:       request.Params.Since,
+		Until:       request.Params.Until,
+		MinUnits:    request.Params.MinUnits,
+		MaxUnits:    request.Params.MaxUnits,
+	}
+	if request.Params.Sort != nil {
+		filter.Sort = transfer.SortOrder(*request.Params.Sort)
+	}
+
+	list, err := h.transferService.ListTransfers(ctx, request.FundId, filter, params)
+	if err != nil {
+		if errors.Is(err, transfer.ErrInvalidFilter) || errors.Is(err, validation.ErrInvalidCursor) {
+			return ListTransfers400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Code:    INVALIDREQUEST,
+					Message: err.Error(),
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+		logError(ctx, "failed to list transfers", err, slog.String("fundId", request.FundId.String()))
+		return ListTransfers500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "failed to list transfers",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	transfers := make([]Transfer, len(list.Transfers))
+	for i, t := range list.Transfers {
+		transfers[i] = toAPITransfer(t)
+	}
+
+	return ListTransfers200JSONResponse(TransferList{
+		FundId:     request.FundId,
+		Transfers:  transfers,
+		Total:      pageTotal(params, list.TotalCount),
+		Limit:      list.Limit,
+		Offset:     list.Offset,
+		NextCursor: nonEmpty(list.NextCursor),
+	}), nil
+}
+
+func (h *APIHandler) ExportTransfers(ctx context.Context, request ExportTransfersRequestObject) (ExportTransfersResponseObject, error) {
+	if h.transferService == nil {
+		return ExportTransfers500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "transfer service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	format, ok := negotiateExport(request.Params.Accept)
+	if !ok {
+		return ExportTransfers406JSONResponse{
+			NotAcceptableJSONResponse: NotAcceptableJSONResponse{
+				Code:    NOTACCEPTABLE,
+				Message: "export is available as text/csv or application/x-ndjson",
+				Details: errorDetails(ctx, map[string]interface{}{"accept": deref(request.Params.Accept)}),
+			},
+		}, nil
+	}
+
+	filter := transfer.Filter{
+		OwnerID:     request.Params.OwnerId,
+		FromOwnerID: request.Params.FromOwnerId,
+		ToOwnerID:   request.Params.ToOwnerId,
+		Since:       request.Params.Since,
+		Until:       request.Params.Until,
+		MinUnits:    request.Params.MinUnits,
+		MaxUnits:    request.Params.MaxUnits,
+	}
+	if request.Params.Sort != nil {
+		filter.Sort = transfer.SortOrder(*request.Params.Sort)
+	}
+	if err := filter.Validate(); err != nil {
+		return ExportTransfers400JSONResponse{
+			BadRequestJSONResponse: BadRequestJSONResponse{
+				Code:    INVALIDREQUEST,
+				Message: err.Error(),
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	if h.fundService != nil {
+		_, err := h.fundService.GetFund(ctx, request.FundId)
+		if err != nil {
+			if errors.Is(err, fund.ErrNotFound) {
+				return ExportTransfers404JSONResponse{
+					FundNotFoundJSONResponse: FundNotFoundJSONResponse{
+						Code:    FUNDNOTFOUND,
+						Message: "fund not found",
+						Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+					},
+				}, nil
+			}
+			logError(ctx, "failed to verify fund for export", err, slog.String("fundId", request.FundId.String()))
+			return ExportTransfers500JSONResponse{
+				InternalErrorJSONResponse: InternalErrorJSONResponse{
+					Code:    INTERNALERROR,
+					Message: "failed to verify fund",
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+	}
+
+	return transferExport{
+		ctx:     ctx,
+		service: h.transferService,
+		fundID:  request.FundId,
+		filter:  filter,
+		format:  format,
+	}, nil
+}
+
+func (h *APIHandler) CreateTransfer(ctx context.Context, request CreateTransferRequestObject) (CreateTransferResponseObject, error) {
+	if h.transferService == nil {
+		return CreateTransfer500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "transfer service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	if request.Body == nil {
+		return CreateTransfer400JSONResponse{
+			TransferBadRequestJSONResponse: TransferBadRequestJSONResponse{
+				Code:    INVALIDREQUEST,
+				Message: "request body is required",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	if h.fundService != nil {
+		_, err := h.fundService.GetFund(ctx, request.FundId)
+		if err != nil {
+			if errors.Is(err, fund.ErrNotFound) {
+				return CreateTransfer404JSONResponse{
+					TransferNotFoundJSONResponse: TransferNotFoundJSONResponse{
+						Code:    FUNDNOTFOUND,
+						Message: "fund not found",
+						Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+					},
+				}, nil
+			}
+			logError(ctx, "failed to verify fund for transfer", err, slog.String("fundId", request.FundId.String()))
+			return CreateTransfer500JSONResponse{
+				InternalErrorJSONResponse: InternalErrorJSONResponse{
+					Code:    INTERNALERROR,
+					Message: "failed to verify fund",
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+	}
+
+	req := transfer.Request{
+		FundID:      request.FundId,
+		FromOwner:   deref(request.Body.FromOwner),
+		FromOwnerID: request.Body.FromOwnerId,
+		ToOwner:     deref(request.Body.ToOwner),
+		ToOwnerID:   request.Body.ToOwnerId,
+		ClassID:     request.Body.ClassId,
+		Units:       request.Body.Units,
+		RequestedBy: deref(request.Body.RequestedBy),
+	}
+	fromOwner := ownerRef(req.FromOwnerID, req.FromOwner)
+	toOwner := ownerRef(req.ToOwnerID, req.ToOwner)
+	if request.Body.IdempotencyKey != nil {
+		key := uuid.UUID(*request.Body.IdempotencyKey)
+		req.IdempotencyKey = &key
+	}
+
+	t, err := h.transferService.ExecuteTransfer(ctx, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, transfer.ErrInvalidOwner):
+			return CreateTransfer400JSONResponse{
+				TransferBadRequestJSONResponse: TransferBadRequestJSONResponse{
+					Code:    INVALIDREQUEST,
+					Message: err.Error(),
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		case errors.Is(err, transfer.ErrInvalidUnits):
+			return CreateTransfer400JSONResponse{
+				TransferBadRequestJSONResponse: TransferBadRequestJSONResponse{
+					Code:    INVALIDREQUEST,
+					Message: err.Error(),
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		case errors.Is(err, transfer.ErrInvalidRequester):
+			return CreateTransfer400JSONResponse{
+				TransferBadRequestJSONResponse: TransferBadRequestJSONResponse{
+					Code:    INVALIDREQUEST,
+					Message: err.Error(),
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		case errors.Is(err, transfer.ErrSelfTransfer):
+			return CreateTransfer400JSONResponse{
+				TransferBadRequestJSONResponse: TransferBadRequestJSONResponse{
+					Code:    INVALIDREQUEST,
+					Message: fmt.Sprintf("Cannot transfer units to yourself. Both fromOwner and toOwner are '%s'.", fromOwner),
+					Details: errorDetails(ctx, map[string]interface{}{
+						"fromOwner": fromOwner,
+						"toOwner":   toOwner,
+					}),
+				},
+			}, nil
+		case errors.Is(err, transfer.ErrFundNotFound):
+			return CreateTransfer404JSONResponse{
+				TransferNotFoundJSONResponse: TransferNotFoundJSONResponse{
+					Code:    FUNDNOTFOUND,
+					Message: "fund not found",
+					Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+				},
+			}, nil
+		case errors.Is(err, transfer.ErrShareClassNotFound):
+			return CreateTransfer404JSONResponse{
+				TransferNotFoundJSONResponse: TransferNotFoundJSONResponse{
+					Code:    SHARECLASSNOTFOUND,
+					Message: err.Error(),
+					Details: errorDetails(ctx, map[string]interface{}{
+						"classId": classRef(req.ClassID),
+						"fundId":  request.FundId.String(),
+					}),
+				},
+			}, nil
+		case errors.Is(err, transfer.ErrOwnerNotFound):
+			return CreateTransfer404JSONResponse{
+				TransferNotFoundJSONResponse: TransferNotFoundJSONResponse{
+					Code:    OWNERNOTFOUND,
+					Message: fmt.Sprintf("Owner '%s' does not own any units in this fund. Check the cap table to see current owners.", fromOwner),
+					Details: errorDetails(ctx, map[string]interface{}{
+						"ownerName": fromOwner,
+						"fundId":    request.FundId.String(),
+						"hint":      "The fromOwner must be an existing owner in the fund's cap table",
+					}),
+				},
+			}, nil
+		case errors.Is(err, transfer.ErrInsufficientUnits):
+			return CreateTransfer400JSONResponse{
+				TransferBadRequestJSONResponse: TransferBadRequestJSONResponse{
+					Code:    INSUFFICIENTUNITS,
+					Message: fmt.Sprintf("Owner '%s' does not have enough units for this transfer. They need at least %d units.", fromOwner, request.Body.Units),
+					Details: errorDetails(ctx, map[string]interface{}{
+						"ownerName":      fromOwner,
+						"requestedUnits": request.Body.Units,
+						"hint":           "Check the cap table to see how many units this owner currently holds",
+					}),
+				},
+			}, nil
+		case errors.Is(err, transfer.ErrOwnerConflict):
+			return CreateTransfer409JSONResponse{
+				DuplicateTransferJSONResponse: DuplicateTransferJSONResponse{
+					Code:    OWNERCONFLICT,
+					Message: err.Error(),
+					Details: errorDetails(ctx, map[string]interface{}{
+						"fromOwner": fromOwner,
+						"toOwner":   toOwner,
+					}),
+				},
+			}, nil
+		case errors.Is(err, transfer.ErrFundNotOpen):
+			return CreateTransfer409JSONResponse{
+				DuplicateTransferJSONResponse: DuplicateTransferJSONResponse{
+					Code:    FUNDNOTOPEN,
+					Message: err.Error(),
+					Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+				},
+			}, nil
+		case errors.Is(err, transfer.ErrDuplicateIdempotencyKey):
+			return CreateTransfer409JSONResponse{
+				DuplicateTransferJSONResponse: DuplicateTransferJSONResponse{
+					Code:    DUPLICATETRANSFER,
+					Message: err.Error(),
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		default:
+			logError(ctx, "failed to execute transfer", err,
+				slog.String("fundId", request.FundId.String()),
+				slog.String("fromOwner", fromOwner),
+				slog.String("toOwner", toOwner),
+				slog.Int("units", request.Body.Units),
+			)
+			return CreateTransfer500JSONResponse{
+				InternalErrorJSONResponse: InternalErrorJSONResponse{
+					Code:    INTERNALERROR,
+					Message: "failed to execute transfer",
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+	}
+
+	if t.Status == transfer.StatusPending {
+		return CreateTransfer202JSONResponse(toAPITransfer(t)), nil
+	}
+	return CreateTransfer201JSONResponse(toAPITransfer(t)), nil
+}
+
+func (h *APIHandler) CreateTransferBatch(ctx context.Context, request CreateTransferBatchRequestObject) (CreateTransferBatchResponseObject, error) {
+	if h.transferService == nil {
+		return CreateTransferBatch500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "transfer service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	if request.Body == nil {
+		return CreateTransferBatch400JSONResponse{
+			TransferBadRequestJSONResponse: TransferBadRequestJSONResponse{
+				Code:    INVALIDREQUEST,
+				Message: "request body is required",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	if h.fundService != nil {
+		_, err := h.fundService.GetFund(ctx, request.FundId)
+		if err != nil {
+			if errors.Is(err, fund.ErrNotFound) {
+				return CreateTransferBatch404JSONResponse{
+					TransferNotFoundJSONResponse: TransferNotFoundJSONResponse{
+						Code:    FUNDNOTFOUND,
+						Message: "fund not found",
+						Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+					},
+				}, nil
+			}
+			logError(ctx, "failed to verify fund for transfer batch", err, slog.String("fundId", request.FundId.String()))
+			return CreateTransferBatch500JSONResponse{
+				InternalErrorJSONResponse: InternalErrorJSONResponse{
+					Code:    INTERNALERROR,
+					Message: "failed to verify fund",
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+	}
+
+	req := transfer.BatchRequest{
+		FundID: request.FundId,
+		Legs:   make([]transfer.Leg, len(request.Body.Legs)),
+	}
+	for i, leg := range request.Body.Legs {
+		req.Legs[i] = transfer.Leg{
+			FromOwner:   deref(leg.FromOwner),
+			FromOwnerID: leg.FromOwnerId,
+			ToOwner:     deref(leg.ToOwner),
+			ToOwnerID:   leg.ToOwnerId,
+			ClassID:     leg.ClassId,
+			Units:       leg.Units,
+		}
+	}
+	if request.Body.IdempotencyKey != nil {
+		key := uuid.UUID(*request.Body.IdempotencyKey)
+		req.IdempotencyKey = &key
+	}
+
+	batch, err := h.transferService.ExecuteBatch(ctx, req)
+	if err != nil {
+		extra := map[string]interface{}{}
+		var legErr *transfer.LegError
+		if errors.As(err, &legErr) {
+			leg := req.Legs[legErr.Index]
+			extra["legIndex"] = legErr.Index
+			extra["fromOwner"] = ownerRef(leg.FromOwnerID, leg.FromOwner)
+			extra["toOwner"] = ownerRef(leg.ToOwnerID, leg.ToOwner)
+			extra["classId"] = classRef(leg.ClassID)
+			extra["requestedUnits"] = leg.Units
+		}
+
+		switch {
+		case errors.Is(err, transfer.ErrEmptyBatch),
+			errors.Is(err, transfer.ErrTooManyLegs),
+			errors.Is(err, transfer.ErrInvalidOwner),
+			errors.Is(err, transfer.ErrInvalidUnits),
+			errors.Is(err, transfer.ErrSelfTransfer):
+			return CreateTransferBatch400JSONResponse{
+				TransferBadRequestJSONResponse: TransferBadRequestJSONResponse{
+					Code:    INVALIDREQUEST,
+					Message: err.Error(),
+					Details: errorDetails(ctx, extra),
+				},
+			}, nil
+		case errors.Is(err, transfer.ErrApprovalRequired):
+			return CreateTransferBatch400JSONResponse{
+				TransferBadRequestJSONResponse: TransferBadRequestJSONResponse{
+					Code:    APPROVALREQUIRED,
+					Message: err.Error(),
+					Details: errorDetails(ctx, extra),
+				},
+			}, nil
+		case errors.Is(err, transfer.ErrFundNotFound):
+			return CreateTransferBatch404JSONResponse{
+				TransferNotFoundJSONResponse: TransferNotFoundJSONResponse{
+					Code:    FUNDNOTFOUND,
+					Message: "fund not found",
+					Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+				},
+			}, nil
+		case errors.Is(err, transfer.ErrShareClassNotFound):
+			extra["fundId"] = request.FundId.String()
+			return CreateTransferBatch404JSONResponse{
+				TransferNotFoundJSONResponse: TransferNotFoundJSONResponse{
+					Code:    SHARECLASSNOTFOUND,
+					Message: err.Error(),
+					Details: errorDetails(ctx, extra),
+				},
+			}, nil
+		case errors.Is(err, transfer.ErrOwnerNotFound):
+			extra["fundId"] = request.FundId.String()
+			return CreateTransferBatch404JSONResponse{
+				TransferNotFoundJSONResponse: TransferNotFoundJSONResponse{
+					Code:    OWNERNOTFOUND,
+					Message: err.Error(),
+					Details: errorDetails(ctx, extra),
+				},
+			}, nil
+		case errors.Is(err, transfer.ErrInsufficientUnits):
+			return CreateTransferBatch400JSONResponse{
+				TransferBadRequestJSONResponse: TransferBadRequestJSONResponse{
+					Code:    INSUFFICIENTUNITS,
+					Message: err.Error(),
+					Details: errorDetails(ctx, extra),
+				},
+			}, nil
+		case errors.Is(err, transfer.ErrOwnerConflict):
+			return CreateTransferBatch409JSONResponse{
+				DuplicateTransferJSONResponse: DuplicateTransferJSONResponse{
+					Code:    OWNERCONFLICT,
+					Message: err.Error(),
+					Details: errorDetails(ctx, extra),
+				},
+			}, nil
+		case errors.Is(err, transfer.ErrFundNotOpen):
+			return CreateTransferBatch409JSONResponse{
+				DuplicateTransferJSONResponse: DuplicateTransferJSONResponse{
+					Code:    FUNDNOTOPEN,
+					Message: err.Error(),
+					Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+				},
+			}, nil
+		case errors.Is(err, transfer.ErrDuplicateIdempotencyKey):
+			return CreateTransferBatch409JSONResponse{
+				DuplicateTransferJSONResponse: DuplicateTransferJSONResponse{
+					Code:    DUPLICATETRANSFER,
+					Message: err.Error(),
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		default:
+			logError(ctx, "failed to execute transfer batch", err,
+				slog.String("fundId", request.FundId.String()),
+				slog.Int("legs", len(req.Legs)),
+			)
+			return CreateTransferBatch500JSONResponse{
+				InternalErrorJSONResponse: InternalErrorJSONResponse{
+					Code:    INTERNALERROR,
+					Message: "failed to execute transfer batch",
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+	}
+
+	transfers := make([]Transfer, len(batch.Transfers))
+	for i, t := range batch.Transfers {
+		transfers[i] = toAPITransfer(t)
+	}
+
+	return CreateTransferBatch201JSONResponse(TransferBatch{
+		Id:             batch.ID,
+		FundId:         batch.FundID,
+		IdempotencyKey: batch.IdempotencyKey,
+		CreatedAt:      batch.CreatedAt,
+		Transfers:      transfers,
+	}), nil
+}
+
+func (h *APIHandler) ListPendingTransfers(ctx context.Context, request ListPendingTransfersRequestObject) (ListPendingTransfersResponseObject, error) {
+	if h.transferService == nil {
+		return ListPendingTransfers500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "transfer service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	if h.fundService != nil {
+		_, err := h.fundService.GetFund(ctx, request.FundId)
+		if err != nil {
+			if errors.Is(err, fund.ErrNotFound) {
+				return ListPendingTransfers404JSONResponse{
+					FundNotFoundJSONResponse: FundNotFoundJSONResponse{
+						Code:    FUNDNOTFOUND,
+						Message: "fund not found",
+						Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+					},
+				}, nil
+			}
+			logError(ctx, "failed to verify fund", err, slog.String("fundId", request.FundId.String()))
+			return ListPendingTransfers500JSONResponse{
+				InternalErrorJSONResponse: InternalErrorJSONResponse{
+					Code:    INTERNALERROR,
+					Message: "failed to verify fund",
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+	}
+
+	params := transfer.ListParams{}
+	if request.Params.Limit != nil {
+		params.Limit = *request.Params.Limit
+	}
+	if request.Params.Offset != nil {
+		params.Offset = *request.Params.Offset
+	}
+
+	list, err := h.transferService.ListPendingTransfers(ctx, request.FundId, params)
+	if err != nil {
+		logError(ctx, "failed to list pending transfers", err, slog.String("fundId", request.FundId.String()))
+		return ListPendingTransfers500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "failed to list pending transfers",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	transfers := make([]Transfer, len(list.Transfers))
+	for i, t := range list.Transfers {
+		transfers[i] = toAPITransfer(t)
+	}
+
+	return ListPendingTransfers200JSONResponse(TransferList{
+		FundId:    request.FundId,
+		Transfers: transfers,
+		Total:     ptr(list.TotalCount),
+		Limit:     list.Limit,
+		Offset:    list.Offset,
+	}), nil
+}
+
+func (h *APIHandler) ApproveTransfer(ctx context.Context, request ApproveTransferRequestObject) (ApproveTransferResponseObject, error) {
+	if h.transferService == nil {
+		return ApproveTransfer500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "transfer service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	if request.Body == nil {
+		return ApproveTransfer400JSONResponse{
+			BadRequestJSONResponse: BadRequestJSONResponse{
+				Code:    INVALIDREQUEST,
+				Message: "request body is required",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	if h.fundService != nil {
+		_, err := h.fundService.GetFund(ctx, request.FundId)
+		if err != nil {
+			if errors.Is(err, fund.ErrNotFound) {
+				return ApproveTransfer404JSONResponse{
+					TransferReviewNotFoundJSONResponse: TransferReviewNotFoundJSONResponse{
+						Code:    FUNDNOTFOUND,
+						Message: "fund not found",
+						Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+					},
+				}, nil
+			}
+			logError(ctx, "failed to verify fund for approval", err, slog.String("fundId", request.FundId.String()))
+			return ApproveTransfer500JSONResponse{
+				InternalErrorJSONResponse: InternalErrorJSONResponse{
+					Code:    INTERNALERROR,
+					Message: "failed to verify fund",
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+	}
+
+	t, err := h.transferService.ApproveTransfer(ctx, transfer.Review{
+		FundID:     request.FundId,
+		TransferID: request.TransferId,
+		Reviewer:   deref(request.Body.ReviewedBy),
+	})
+	if err != nil {
+		details := map[string]interface{}{"transferId": request.TransferId.String()}
+		switch {
+		case errors.Is(err, transfer.ErrInvalidReviewer),
+			errors.Is(err, transfer.ErrInvalidReason):
+			return ApproveTransfer400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Code:    INVALIDREQUEST,
+					Message: err.Error(),
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		case errors.Is(err, transfer.ErrInsufficientUnits):
+			return ApproveTransfer400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Code:    INSUFFICIENTUNITS,
+					Message: err.Error(),
+					Details: errorDetails(ctx, details),
+				},
+			}, nil
+		case errors.Is(err, transfer.ErrOwnerNotFound):
+			return ApproveTransfer404JSONResponse{
+				TransferReviewNotFoundJSONResponse: TransferReviewNotFoundJSONResponse{
+					Code:    OWNERNOTFOUND,
+					Message: err.Error(),
+					Details: errorDetails(ctx, details),
+				},
+			}, nil
+		case errors.Is(err, transfer.ErrTransferNotFound):
+			return ApproveTransfer404JSONResponse{
+				TransferReviewNotFoundJSONResponse: TransferReviewNotFoundJSONResponse{
+					Code:    TRANSFERNOTFOUND,
+					Message: err.Error(),
+					Details: errorDetails(ctx, details),
+				},
+			}, nil
+		case errors.Is(err, transfer.ErrFundNotOpen):
+			return ApproveTransfer409JSONResponse{
+				TransferNotPendingJSONResponse: TransferNotPendingJSONResponse{
+					Code:    FUNDNOTOPEN,
+					Message: err.Error(),
+					Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+				},
+			}, nil
+		case errors.Is(err, transfer.ErrNotPending):
+			return ApproveTransfer409JSONResponse{
+				TransferNotPendingJSONResponse: TransferNotPendingJSONResponse{
+					Code:    TRANSFERNOTPENDING,
+					Message: err.Error(),
+					Details: errorDetails(ctx, details),
+				},
+			}, nil
+		case errors.Is(err, transfer.ErrSelfReview):
+			return ApproveTransfer409JSONResponse{
+				TransferNotPendingJSONResponse: TransferNotPendingJSONResponse{
+					Code:    SELFREVIEW,
+					Message: err.Error(),
+					Details: errorDetails(ctx, details),
+				},
+			}, nil
+		case errors.Is(err, transfer.ErrUnknownRequester):
+			return ApproveTransfer409JSONResponse{
+				TransferNotPendingJSONResponse: TransferNotPendingJSONResponse{
+					Code:    UNKNOWNREQUESTER,
+					Message: err.Error(),
+					Details: errorDetails(ctx, details),
+				},
+			}, nil
+		default:
+			logError(ctx, "failed to approve transfer", err,
+				slog.String("fundId", request.FundId.String()),
+				slog.String("transferId", request.TransferId.String()),
+			)
+			return ApproveTransfer500JSONResponse{
+				InternalErrorJSONResponse: InternalErrorJSONResponse{
+					Code:    INTERNALERROR,
+					Message: "failed to approve transfer",
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+	}
+
+	return ApproveTransfer200JSONResponse(toAPITransfer(t)), nil
+}
+
+func (h *APIHandler) RejectTransfer(ctx context.Context, request RejectTransferRequestObject) (RejectTransferResponseObject, error) {
+	if h.transferService == nil {
+		return RejectTransfer500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "transfer service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	if request.Body == nil {
+		return RejectTransfer400JSONResponse{
+			BadRequestJSONResponse: BadRequestJSONResponse{
+				Code:    INVALIDREQUEST,
+				Message: "request body is required",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	if h.fundService != nil {
+		_, err := h.fundService.GetFund(ctx, request.FundId)
+		if err != nil {
+			if errors.Is(err, fund.ErrNotFound) {
+				return RejectTransfer404JSONResponse{
+					TransferReviewNotFoundJSONResponse: TransferReviewNotFoundJSONResponse{
+						Code:    FUNDNOTFOUND,
+						Message: "fund not found",
+						Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+					},
+				}, nil
+			}
+			logError(ctx, "failed to verify fund for rejection", err, slog.String("fundId", request.FundId.String()))
+			return RejectTransfer500JSONResponse{
+				InternalErrorJSONResponse: InternalErrorJSONResponse{
+					Code:    INTERNALERROR,
+					Message: "failed to verify fund",
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+	}
+
+	t, err := h.transferService.RejectTransfer(ctx, transfer.Review{
+		FundID:     request.FundId,
+		TransferID: request.TransferId,
+		Reviewer:   deref(request.Body.ReviewedBy),
+		Reason:     request.Body.Reason,
+	})
+	if err != nil {
+		details := map[string]interface{}{"transferId": request.TransferId.String()}
+		switch {
+		case errors.Is(err, transfer.ErrInvalidReviewer),
+			errors.Is(err, transfer.ErrInvalidReason):
+			return RejectTransfer400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Code:    INVALIDREQUEST,
+					Message: err.Error(),
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		case errors.Is(err, transfer.ErrTransferNotFound):
+			return RejectTransfer404JSONResponse{
+				TransferReviewNotFoundJSONResponse: TransferReviewNotFoundJSONResponse{
+					Code:    TRANSFERNOTFOUND,
+					Message: err.Error(),
+					Details: errorDetails(ctx, details),
+				},
+			}, nil
+		case errors.Is(err, transfer.ErrNotPending):
+			return RejectTransfer409JSONResponse{
+				TransferNotPendingJSONResponse: TransferNotPendingJSONResponse{
+					Code:    TRANSFERNOTPENDING,
+					Message: err.Error(),
+					Details: errorDetails(ctx, details),
+				},
+			}, nil
+		case errors.Is(err, transfer.ErrSelfReview):
+			return RejectTransfer409JSONResponse{
+				TransferNotPendingJSONResponse: TransferNotPendingJSONResponse{
+					Code:    SELFREVIEW,
+					Message: err.Error(),
+					Details: errorDetails(ctx, details),
+				},
+			}, nil
+		default:
+			logError(ctx, "failed to reject transfer", err,
+				slog.String("fundId", request.FundId.String()),
+				slog.String("transferId", request.TransferId.String()),
+			)
+			return RejectTransfer500JSONResponse{
+				InternalErrorJSONResponse: InternalErrorJSONResponse{
+					Code:    INTERNALERROR,
+					Message: "failed to reject transfer",
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+	}
+
+	return RejectTransfer200JSONResponse(toAPITransfer(t)), nil
+}
+
+func (h *APIHandler) ReverseTransfer(ctx context.Context, request ReverseTransferRequestObject) (ReverseTransferResponseObject, error) {
+	if h.transferService == nil {
+		return ReverseTransfer500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "transfer service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	if h.fundService != nil {
+		_, err := h.fundService.GetFund(ctx, request.FundId)
+		if err != nil {
+			if errors.Is(err, fund.ErrNotFound) {
+				return ReverseTransfer404JSONResponse{
+					Code:    FUNDNOTFOUND,
+					Message: "fund not found",
+					Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+				}, nil
+			}
+			logError(ctx, "failed to verify fund for reversal", err, slog.String("fundId", request.FundId.String()))
+			return ReverseTransfer500JSONResponse{
+				InternalErrorJSONResponse: InternalErrorJSONResponse{
+					Code:    INTERNALERROR,
+					Message: "failed to verify fund",
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+	}
+
+	reversal, err := h.transferService.ReverseTransfer(ctx, request.FundId, request.TransferId)
+	if err != nil {
+		details := map[string]interface{}{"transferId": request.TransferId.String()}
+		switch {
+		case errors.Is(err, transfer.ErrTransferNotFound):
+			return ReverseTransfer404JSONResponse{
+				Code:    TRANSFERNOTFOUND,
+				Message: err.Error(),
+				Details: errorDetails(ctx, details),
+			}, nil
+		case errors.Is(err, transfer.ErrInsufficientUnits):
+			return ReverseTransfer400JSONResponse{
+				Code:    INSUFFICIENTUNITS,
+				Message: err.Error(),
+				Details: errorDetails(ctx, details),
+			}, nil
+		case errors.Is(err, transfer.ErrFundNotOpen):
+			return ReverseTransfer409JSONResponse{
+				Code:    FUNDNOTOPEN,
+				Message: err.Error(),
+				Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+			}, nil
+		case errors.Is(err, transfer.ErrAlreadyReversed):
+			return ReverseTransfer409JSONResponse{
+				Code:    ALREADYREVERSED,
+				Message: err.Error(),
+				Details: errorDetails(ctx, details),
+			}, nil
+		case errors.Is(err, transfer.ErrNotApproved):
+			return ReverseTransfer409JSONResponse{
+				Code:    TRANSFERNOTAPPROVED,
+				Message: err.Error(),
+				Details: errorDetails(ctx, details),
+			}, nil
+		default:
+			logError(ctx, "failed to reverse transfer", err,
+				slog.String("fundId", request.FundId.String()),
+				slog.String("transferId", request.TransferId.String()),
+			)
+			return ReverseTransfer500JSONResponse{
+				InternalErrorJSONResponse: InternalErrorJSONResponse{
+					Code:    INTERNALERROR,
+					Message: "failed to reverse transfer",
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+	}
+
+	return ReverseTransfer201JSONResponse(toAPITransfer(reversal)), nil
+}
+
+func toAPITransfer(t *transfer.Transfer) Transfer {
+	return Transfer{
+		Id:                   t.ID,
+		FundId:               t.FundID,
+		FromOwner:            t.FromOwner,
+		FromOwnerId:          t.FromOwnerID,
+		ToOwner:              t.ToOwner,
+		ToOwnerId:            t.ToOwnerID,
+		ClassId:              t.ClassID,
+		ClassName:            t.ClassName,
+		Units:                t.Units,
+		TransferredAt:        t.TransferredAt,
+		BatchId:              t.BatchID,
+		LegIndex:             t.LegIndex,
+		ReversesTransferId:   t.ReversesTransferID,
+		ReversedByTransferId: t.ReversedByTransferID,
+		Status:               TransferStatus(t.Status),
+		RequestedAt:          t.RequestedAt,
+		RequestedBy:          t.RequestedBy,
+		ReviewedBy:           t.ReviewedBy,
+		ReviewedAt:           t.ReviewedAt,
+		RejectionReason:      t.RejectionReason,
+		LedgerSequence:       t.LedgerSequence,
+		Hash:                 hexHash(t.Hash),
+	}
+}
+
+func (h *APIHandler) VerifyLedger(ctx context.Context, request VerifyLedgerRequestObject) (VerifyLedgerResponseObject, error) {
+	if h.transferService == nil {
+		return VerifyLedger500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "transfer service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	result, err := h.transferService.VerifyLedger(ctx, request.FundId)
+	if err != nil {
+		if errors.Is(err, transfer.ErrFundNotFound) {
+			return VerifyLedger404JSONResponse{
+				FundNotFoundJSONResponse: FundNotFoundJSONResponse{
+					Code:    FUNDNOTFOUND,
+					Message: "fund not found",
+					Details: errorDetails(ctx, map[string]interface{}{"fundId": request.FundId.String()}),
+				},
+			}, nil
+		}
+		logError(ctx, "failed to verify ledger", err, slog.String("fundId", request.FundId.String()))
+		return VerifyLedger500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "failed to verify ledger",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	resp := VerifyLedger200JSONResponse{
+		FundId:       request.FundId,
+		Valid:        result.Valid(),
+		Checked:      result.Checked,
+		HeadSequence: result.Head.Sequence,
+		HeadHash:     hexHash(result.Head.Hash),
+	}
+	if b := result.Break; b != nil {
+		resp.FirstBrokenLink = &LedgerBreak{
+			Sequence:     b.Sequence,
+			TransferId:   b.TransferID,
+			Reason:       LedgerBreakReason(b.Reason),
+			ExpectedHash: hexHash(b.ExpectedHash),
+			ActualHash:   hexHash(b.ActualHash),
+		}
+	}
+	return resp, nil
+}
+
+func hexHash(h []byte) *string {
+	if h == nil {
+		return nil
+	}
+	return ptr(hex.EncodeToString(h))
+}
+
+func (h *APIHandler) ListOwners(ctx context.Context, request ListOwnersRequestObject) (ListOwnersResponseObject, error) {
+	if h.ownerService == nil {
+		return ListOwners500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "owner service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	params := owner.ListParams{}
+	if request.Params.Limit != nil {
+		params.Limit = *request.Params.Limit
+	}
+	if request.Params.Offset != nil {
+		params.Offset = *request.Params.Offset
+	}
+
+	result, err := h.ownerService.ListOwners(ctx, params)
+	if err != nil {
+		logError(ctx, "failed to list owners", err)
+		return ListOwners500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "failed to list owners",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	owners := make([]Owner, len(result.Items))
+	for i, o := range result.Items {
+		owners[i] = toAPIOwner(o)
+	}
+
+	return ListOwners200JSONResponse(OwnerList{
+		Owners: owners,
+		Total:  result.Total,
+		Limit:  result.Limit,
+		Offset: result.Offset,
+	}), nil
+}
+
+func (h *APIHandler) CreateOwner(ctx context.Context, request CreateOwnerRequestObject) (CreateOwnerResponseObject, error) {
+	if h.ownerService == nil {
+		return CreateOwner500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "owner service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	if request.Body == nil {
+		return CreateOwner400JSONResponse{
+			BadRequestJSONResponse: BadRequestJSONResponse{
+				Code:    INVALIDREQUEST,
+				Message: "request body is required",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	o, err := h.ownerService.CreateOwner(ctx, request.Body.LegalName, owner.Type(deref(request.Body.Type)), request.Body.ExternalRef)
+	if err != nil {
+		switch {
+		case errors.Is(err, owner.ErrInvalidName),
+			errors.Is(err, owner.ErrInvalidType),
+			errors.Is(err, owner.ErrInvalidExternalRef):
+			return CreateOwner400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Code:    INVALIDOWNER,
+					Message: err.Error(),
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		case errors.Is(err, owner.ErrDuplicateExternalRef):
+			return CreateOwner409JSONResponse{
+				OwnerConflictJSONResponse: OwnerConflictJSONResponse{
+					Code:    OWNERCONFLICT,
+					Message: err.Error(),
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+		logError(ctx, "failed to create owner", err)
+		return CreateOwner500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "failed to create owner",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	return CreateOwner201JSONResponse(toAPIOwner(o)), nil
+}
+
+func (h *APIHandler) GetOwner(ctx context.Context, request GetOwnerRequestObject) (GetOwnerResponseObject, error) {
+	if h.ownerService == nil {
+		return GetOwner500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "owner service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	o, err := h.ownerService.GetOwner(ctx, request.OwnerId)
+	if err != nil {
+		if errors.Is(err, owner.ErrNotFound) {
+			return GetOwner404JSONResponse{
+				OwnerNotFoundJSONResponse: OwnerNotFoundJSONResponse{
+					Code:    OWNERNOTFOUND,
+					Message: "owner not found",
+					Details: errorDetails(ctx, map[string]interface{}{"ownerId": request.OwnerId.String()}),
+				},
+			}, nil
+		}
+		logError(ctx, "failed to get owner", err, slog.String("ownerId", request.OwnerId.String()))
+		return GetOwner500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "failed to get owner",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	return GetOwner200JSONResponse(toAPIOwner(o)), nil
+}
+
+func (h *APIHandler) GetOwnerHoldings(ctx context.Context, request GetOwnerHoldingsRequestObject) (GetOwnerHoldingsResponseObject, error) {
+	if h.ownerService == nil || h.ownershipService == nil || h.transferService == nil {
+		return GetOwnerHoldings500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "owner, ownership or transfer service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	o, err := h.ownerService.GetOwner(ctx, request.OwnerId)
+	if err != nil {
+		if errors.Is(err, owner.ErrNotFound) {
+			return GetOwnerHoldings404JSONResponse{
+				OwnerNotFoundJSONResponse: OwnerNotFoundJSONResponse{
+					Code:    OWNERNOTFOUND,
+					Message: "owner not found",
+					Details: errorDetails(ctx, map[string]interface{}{"ownerId": request.OwnerId.String()}),
+				},
+			}, nil
+		}
+		logError(ctx, "failed to get owner", err, slog.String("ownerId", request.OwnerId.String()))
+		return GetOwnerHoldings500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "failed to get owner",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	holdings, err := h.ownershipService.GetHoldings(ctx, o.ID)
+	if err != nil {
+		logError(ctx, "failed to get holdings", err, slog.String("ownerId", o.ID.String()))
+		return GetOwnerHoldings500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "failed to get holdings",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	params := transfer.ListParams{}
+	if request.Params.Limit != nil {
+		params.Limit = *request.Params.Limit
+	}
+	if request.Params.Offset != nil {
+		params.Offset = *request.Params.Offset
+	}
+
+	list, err := h.transferService.ListOwnerTransfers(ctx, o.ID, params)
+	if err != nil {
+		logError(ctx, "failed to list owner transfers", err, slog.String("ownerId", o.ID.String()))
+		return GetOwnerHoldings500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "failed to list owner transfers",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	apiHoldings := make([]OwnerHolding, len(holdings))
+	for i, hd := range holdings {
+		apiHoldings[i] = OwnerHolding{
+			FundId:     hd.FundID,
+			FundName:   hd.FundName,
+			ClassId:    hd.ClassID,
+			ClassName:  hd.ClassName,
+			Units:      hd.Units,
+			Percentage: hd.Percentage(),
+			AcquiredAt: hd.AcquiredAt,
+		}
+	}
+
+	transfers := make([]Transfer, len(list.Transfers))
+	for i, t := range list.Transfers {
+		transfers[i] = toAPITransfer(t)
+	}
+
+	return GetOwnerHoldings200JSONResponse(OwnerPortfolio{
+		Owner:     toAPIOwner(o),
+		Holdings:  apiHoldings,
+		Transfers: transfers,
+		Total:     list.TotalCount,
+		Limit:     list.Limit,
+		Offset:    list.Offset,
+	}), nil
+}
+
+func (h *APIHandler) UpdateOwner(ctx context.Context, request UpdateOwnerRequestObject) (UpdateOwnerResponseObject, error) {
+	if h.ownerService == nil {
+		return UpdateOwner500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "owner service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	if request.Body == nil {
+		return UpdateOwner400JSONResponse{
+			BadRequestJSONResponse: BadRequestJSONResponse{
+				Code:    INVALIDREQUEST,
+				Message: "request body is required",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	update := owner.Update{
+		LegalName:   request.Body.LegalName,
+		ExternalRef: request.Body.ExternalRef,
+	}
+	if request.Body.Type != nil {
+		update.Type = ptr(owner.Type(*request.Body.Type))
+	}
+
+	o, err := h.ownerService.UpdateOwner(ctx, request.OwnerId, update)
+	if err != nil {
+		switch {
+		case errors.Is(err, owner.ErrNotFound):
+			return UpdateOwner404JSONResponse{
+				OwnerNotFoundJSONResponse: OwnerNotFoundJSONResponse{
+					Code:    OWNERNOTFOUND,
+					Message: "owner not found",
+					Details: errorDetails(ctx, map[string]interface{}{"ownerId": request.OwnerId.String()}),
+				},
+			}, nil
+		case errors.Is(err, owner.ErrInvalidName),
+			errors.Is(err, owner.ErrInvalidType),
+			errors.Is(err, owner.ErrInvalidExternalRef):
+			return UpdateOwner400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Code:    INVALIDOWNER,
+					Message: err.Error(),
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		case errors.Is(err, owner.ErrDuplicateExternalRef),
+			errors.Is(err, owner.ErrNameConflict):
+			return UpdateOwner409JSONResponse{
+				OwnerConflictJSONResponse: OwnerConflictJSONResponse{
+					Code:    OWNERCONFLICT,
+					Message: err.Error(),
+					Details: errorDetails(ctx, map[string]interface{}{"ownerId": request.OwnerId.String()}),
+				},
+			}, nil
+		}
+		logError(ctx, "failed to update owner", err, slog.String("ownerId", request.OwnerId.String()))
+		return UpdateOwner500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "failed to update owner",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	return UpdateOwner200JSONResponse(toAPIOwner(o)), nil
+}
+
+func toAPIOwner(o *owner.Owner) Owner {
+	return Owner{
+		Id:          o.ID,
+		LegalName:   o.LegalName,
+		Type:        OwnerType(o.Type),
+		ExternalRef: o.ExternalRef,
+		CreatedAt:   o.CreatedAt,
+		UpdatedAt:   o.UpdatedAt,
+	}
+}
+
+func (h *APIHandler) ResetDatabase(ctx context.Context, _ ResetDatabaseRequestObject) (ResetDatabaseResponseObject, error) {
+	if h.pool == nil {
+		return ResetDatabase500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "database pool not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+
+	resetFailed := ResetDatabase500JSONResponse{
+		InternalErrorJSONResponse: InternalErrorJSONResponse{
+			Code:    INTERNALERROR,
+			Message: "failed to reset database",
+			Details: errorDetails(ctx, nil),
+		},
+	}
+
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		logError(ctx, "failed to begin reset transaction", err)
+		return resetFailed, nil
+	}
+	defer tx.Rollback(ctx)
+
+	var deletedTransfers, deletedUnitEvents, deletedOwnership, deletedFunds, deletedOwners int
+
+	result, err := tx.Exec(ctx, "DELETE FROM transfers")
+	if err != nil {
+		logError(ctx, "failed to delete transfers", err)
+		return resetFailed, nil
+	}
+	deletedTransfers = int(result.RowsAffected())
+
+	result, err = tx.Exec(ctx, "DELETE FROM unit_events")
+	if err != nil {
+		logError(ctx, "failed to delete unit events", err)
+		return resetFailed, nil
+	}
+	deletedUnitEvents = int(result.RowsAffected())
+
+	result, err = tx.Exec(ctx, "DELETE FROM cap_table_entries")
+	if err != nil {
+		logError(ctx, "failed to delete ownership entries", err)
+		return resetFailed, nil
+	}
+	deletedOwnership = int(result.RowsAffected())
+
+	result, err = tx.Exec(ctx, "DELETE FROM funds")
+	if err != nil {
+		logError(ctx, "failed to delete funds", err)
+		return resetFailed, nil
+	}
+	deletedFunds = int(result.RowsAffected())
+
+	result, err = tx.Exec(ctx, "DELETE FROM owners")
+	if err != nil {
+		logError(ctx, "failed to delete owners", err)
+		return resetFailed, nil
+	}
+	deletedOwners = int(result.RowsAffected())
+
+	if h.auditService != nil {
+		event := audit.NewEvent(ctx, audit.OperationDatabaseReset)
+		event.Details = map[string]any{
+			"deletedFunds":      deletedFunds,
+			"deletedTransfers":  deletedTransfers,
+			"deletedUnitEvents": deletedUnitEvents,
+			"deletedOwnership":  deletedOwnership,
+			"deletedOwners":     deletedOwners,
+		}
+		if err := h.auditService.AppendTx(ctx, tx, event); err != nil {
+			logError(ctx, "failed to record reset audit event", err)
+			return resetFailed, nil
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logError(ctx, "failed to commit reset", err)
+		return resetFailed, nil
+	}
+
+	slog.InfoContext(ctx, "database reset completed",
+		slog.Int("deletedFunds", deletedFunds),
+		slog.Int("deletedTransfers", deletedTransfers),
+		slog.Int("deletedUnitEvents", deletedUnitEvents),
+		slog.Int("deletedOwnership", deletedOwnership),
+		slog.Int("deletedOwners", deletedOwners),
+	)
+
+	return ResetDatabase200JSONResponse{
+		Message:          ptr("Database reset successfully"),
+		DeletedFunds:     ptr(deletedFunds),
+		DeletedTransfers: ptr(deletedTransfers),
 		DeletedOwnership: ptr(deletedOwnership),
 	}, nil
 }
 
+func (h *APIHandler) GetReconciliation(ctx context.Context, _ GetReconciliationRequestObject) (GetReconciliationResponseObject, error) {
+	if h.reconciliation == nil {
+		return GetReconciliation500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "reconciliation service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	report, err := h.reconciliation.Run(ctx)
+	if err != nil {
+		logError(ctx, "failed to run reconciliation", err)
+		return GetReconciliation500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "failed to run reconciliation",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	drifted := make([]FundDrift, len(report.Drifted))
+	for i, f := range report.Drifted {
+		owners := make([]OwnerDrift, len(f.Owners))
+		for j, o := range f.Owners {
+			owners[j] = OwnerDrift{
+				OwnerId:       o.OwnerID,
+				OwnerName:     o.OwnerName,
+				ClassId:       o.ClassID,
+				RecordedUnits: o.RecordedUnits,
+				ReplayedUnits: o.ReplayedUnits,
+			}
+		}
+		drifted[i] = FundDrift{
+			FundId:        f.FundID,
+			FundName:      f.FundName,
+			TotalUnits:    f.TotalUnits,
+			RecordedUnits: f.RecordedUnits,
+			Owners:        owners,
+		}
+	}
+
+	return GetReconciliation200JSONResponse{
+		StartedAt:    report.StartedAt,
+		CompletedAt:  report.CompletedAt,
+		FundsChecked: report.FundsChecked,
+		Balanced:     report.Balanced(),
+		Drifted:      drifted,
+	}, nil
+}
+
+func (h *APIHandler) ListWebhooks(ctx context.Context, _ ListWebhooksRequestObject) (ListWebhooksResponseObject, error) {
+	if h.webhookService == nil {
+		return ListWebhooks500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "webhook service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	endpoints, err := h.webhookService.ListEndpoints(ctx)
+	if err != nil {
+		logError(ctx, "failed to list webhooks", err)
+		return ListWebhooks500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "failed to list webhooks",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	webhooks := make([]Webhook, len(endpoints))
+	for i, e := range endpoints {
+		webhooks[i] = toAPIWebhook(e)
+	}
+
+	return ListWebhooks200JSONResponse{Webhooks: webhooks}, nil
+}
+
+func (h *APIHandler) CreateWebhook(ctx context.Context, request CreateWebhookRequestObject) (CreateWebhookResponseObject, error) {
+	if h.webhookService == nil {
+		return CreateWebhook500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "webhook service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	if request.Body == nil {
+		return CreateWebhook400JSONResponse{
+			BadRequestJSONResponse: BadRequestJSONResponse{
+				Code:    INVALIDREQUEST,
+				Message: "request body is required",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	var eventTypes []string
+	if request.Body.EventTypes != nil {
+		for _, t := range *request.Body.EventTypes {
+			eventTypes = append(eventTypes, string(t))
+		}
+	}
+
+	endpoint, err := h.webhookService.RegisterEndpoint(ctx, request.Body.Url, eventTypes)
+	if err != nil {
+		if errors.Is(err, webhook.ErrInvalidURL) || errors.Is(err, webhook.ErrInvalidEventType) {
+			return CreateWebhook400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Code:    INVALIDREQUEST,
+					Message: err.Error(),
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+		logError(ctx, "failed to register webhook", err)
+		return CreateWebhook500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "failed to register webhook",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	resp := toAPIWebhook(endpoint)
+	resp.Secret = &endpoint.Secret
+	return CreateWebhook201JSONResponse(resp), nil
+}
+
+func (h *APIHandler) DeleteWebhook(ctx context.Context, request DeleteWebhookRequestObject) (DeleteWebhookResponseObject, error) {
+	if h.webhookService == nil {
+		return DeleteWebhook500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "webhook service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	if err := h.webhookService.DeleteEndpoint(ctx, request.WebhookId); err != nil {
+		if errors.Is(err, webhook.ErrEndpointNotFound) {
+			return DeleteWebhook404JSONResponse{
+				WebhookNotFoundJSONResponse: WebhookNotFoundJSONResponse{
+					Code:    WEBHOOKNOTFOUND,
+					Message: err.Error(),
+					Details: errorDetails(ctx, map[string]interface{}{"webhookId": request.WebhookId.String()}),
+				},
+			}, nil
+		}
+		logError(ctx, "failed to delete webhook", err, slog.String("webhookId", request.WebhookId.String()))
+		return DeleteWebhook500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "failed to delete webhook",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	return DeleteWebhook204Response{}, nil
+}
+
+func (h *APIHandler) ListWebhookDeliveries(ctx context.Context, request ListWebhookDeliveriesRequestObject) (ListWebhookDeliveriesResponseObject, error) {
+	if h.webhookService == nil {
+		return ListWebhookDeliveries500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "webhook service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	params := webhook.ListParams{}
+	if request.Params.Limit != nil {
+		params.Limit = *request.Params.Limit
+	}
+	if request.Params.Offset != nil {
+		params.Offset = *request.Params.Offset
+	}
+	var status *webhook.DeliveryStatus
+	if request.Params.Status != nil {
+		s := webhook.DeliveryStatus(*request.Params.Status)
+		status = &s
+	}
+
+	result, err := h.webhookService.ListDeliveries(ctx, request.WebhookId, status, params)
+	if err != nil {
+		if errors.Is(err, webhook.ErrEndpointNotFound) {
+			return ListWebhookDeliveries404JSONResponse{
+				WebhookNotFoundJSONResponse: WebhookNotFoundJSONResponse{
+					Code:    WEBHOOKNOTFOUND,
+					Message: err.Error(),
+					Details: errorDetails(ctx, map[string]interface{}{"webhookId": request.WebhookId.String()}),
+				},
+			}, nil
+		}
+		logError(ctx, "failed to list webhook deliveries", err, slog.String("webhookId", request.WebhookId.String()))
+		return ListWebhookDeliveries500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "failed to list webhook deliveries",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	deliveries := make([]WebhookDelivery, len(result.Deliveries))
+	for i, d := range result.Deliveries {
+		deliveries[i] = toAPIWebhookDelivery(d)
+	}
+
+	return ListWebhookDeliveries200JSONResponse{
+		Deliveries: deliveries,
+		Total:      result.TotalCount,
+		Limit:      result.Limit,
+		Offset:     result.Offset,
+	}, nil
+}
+
+func (h *APIHandler) RetryWebhookDelivery(ctx context.Context, request RetryWebhookDeliveryRequestObject) (RetryWebhookDeliveryResponseObject, error) {
+	if h.webhookService == nil {
+		return RetryWebhookDelivery500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "webhook service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	delivery, err := h.webhookService.RetryDelivery(ctx, request.WebhookId, request.DeliveryId)
+	if err != nil {
+		details := map[string]interface{}{
+			"webhookId":  request.WebhookId.String(),
+			"deliveryId": request.DeliveryId.String(),
+		}
+		switch {
+		case errors.Is(err, webhook.ErrDeliveryNotFound):
+			return RetryWebhookDelivery404JSONResponse{
+				WebhookNotFoundJSONResponse: WebhookNotFoundJSONResponse{
+					Code:    DELIVERYNOTFOUND,
+					Message: err.Error(),
+					Details: errorDetails(ctx, details),
+				},
+			}, nil
+		case errors.Is(err, webhook.ErrDeliveryNotDead):
+			return RetryWebhookDelivery409JSONResponse{
+				Code:    DELIVERYNOTDEAD,
+				Message: err.Error(),
+				Details: errorDetails(ctx, details),
+			}, nil
+		default:
+			logError(ctx, "failed to retry webhook delivery", err,
+				slog.String("webhookId", request.WebhookId.String()),
+				slog.String("deliveryId", request.DeliveryId.String()),
+			)
+			return RetryWebhookDelivery500JSONResponse{
+				InternalErrorJSONResponse: InternalErrorJSONResponse{
+					Code:    INTERNALERROR,
+					Message: "failed to retry webhook delivery",
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+	}
+
+	return RetryWebhookDelivery200JSONResponse(toAPIWebhookDelivery(delivery)), nil
+}
+
+func toAPIWebhook(e *webhook.Endpoint) Webhook {
+	eventTypes := make([]WebhookEventType, len(e.EventTypes))
+	for i, t := range e.EventTypes {
+		eventTypes[i] = WebhookEventType(t)
+	}
+	return Webhook{
+		Id:         e.ID,
+		Url:        e.URL,
+		EventTypes: eventTypes,
+		CreatedAt:  e.CreatedAt,
+	}
+}
+
+func toAPIWebhookDelivery(d *webhook.Delivery) WebhookDelivery {
+	return WebhookDelivery{
+		Id:             d.ID,
+		WebhookId:      d.EndpointID,
+		EventId:        d.Event.ID,
+		EventType:      WebhookEventType(d.Event.Type),
+		EventSequence:  d.Event.Sequence,
+		Status:         WebhookDeliveryStatus(d.Status),
+		Attempts:       d.Attempts,
+		NextAttemptAt:  d.NextAttemptAt,
+		LastStatusCode: d.LastStatusCode,
+		LastError:      d.LastError,
+		DeliveredAt:    d.DeliveredAt,
+		CreatedAt:      d.CreatedAt,
+	}
+}
+
+func (h *APIHandler) ListApiKeys(ctx context.Context, _ ListApiKeysRequestObject) (ListApiKeysResponseObject, error) {
+	if h.authService == nil {
+		return ListApiKeys500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "auth service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	keys, err := h.authService.ListKeys(ctx)
+	if err != nil {
+		logError(ctx, "failed to list api keys", err)
+		return ListApiKeys500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "failed to list api keys",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	apiKeys := make([]ApiKey, len(keys))
+	for i, k := range keys {
+		apiKeys[i] = toAPIApiKey(k)
+	}
+
+	return ListApiKeys200JSONResponse{ApiKeys: apiKeys}, nil
+}
+
+func (h *APIHandler) CreateApiKey(ctx context.Context, request CreateApiKeyRequestObject) (CreateApiKeyResponseObject, error) {
+	if h.authService == nil {
+		return CreateApiKey500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "auth service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	if request.Body == nil {
+		return CreateApiKey400JSONResponse{
+			BadRequestJSONResponse: BadRequestJSONResponse{
+				Code:    INVALIDREQUEST,
+				Message: "request body is required",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	key, raw, err := h.authService.CreateKey(ctx, request.Body.Name, auth.Role(request.Body.Role))
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidKeyName) || errors.Is(err, auth.ErrInvalidRole) {
+			return CreateApiKey400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Code:    INVALIDREQUEST,
+					Message: err.Error(),
+					Details: errorDetails(ctx, nil),
+				},
+			}, nil
+		}
+		logError(ctx, "failed to create api key", err)
+		return CreateApiKey500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "failed to create api key",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	slog.InfoContext(ctx, "api key created",
+		slog.String("keyId", key.ID.String()),
+		slog.String("role", string(key.Role)),
+	)
+
+	resp := toAPIApiKey(key)
+	resp.Key = &raw
+	return CreateApiKey201JSONResponse(resp), nil
+}
+
+func (h *APIHandler) RevokeApiKey(ctx context.Context, request RevokeApiKeyRequestObject) (RevokeApiKeyResponseObject, error) {
+	if h.authService == nil {
+		return RevokeApiKey500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "auth service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	if err := h.authService.RevokeKey(ctx, request.KeyId); err != nil {
+		if errors.Is(err, auth.ErrKeyNotFound) {
+			return RevokeApiKey404JSONResponse{
+				ApiKeyNotFoundJSONResponse: ApiKeyNotFoundJSONResponse{
+					Code:    APIKEYNOTFOUND,
+					Message: err.Error(),
+					Details: errorDetails(ctx, map[string]interface{}{"keyId": request.KeyId.String()}),
+				},
+			}, nil
+		}
+		logError(ctx, "failed to revoke api key", err, slog.String("keyId", request.KeyId.String()))
+		return RevokeApiKey500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "failed to revoke api key",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	return RevokeApiKey204Response{}, nil
+}
+
+func toAPIApiKey(k *auth.APIKey) ApiKey {
+	return ApiKey{
+		Id:        k.ID,
+		Name:      k.Name,
+		Prefix:    k.Prefix,
+		Role:      ApiKeyRole(k.Role),
+		CreatedAt: k.CreatedAt,
+		RevokedAt: k.RevokedAt,
+	}
+}
+
+func (h *APIHandler) ListAuditEvents(ctx context.Context, request ListAuditEventsRequestObject) (ListAuditEventsResponseObject, error) {
+	if h.auditService == nil {
+		return ListAuditEvents500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "audit service not configured",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	params := audit.ListParams{}
+	if request.Params.Limit != nil {
+		params.Limit = *request.Params.Limit
+	}
+	if request.Params.Offset != nil {
+		params.Offset = *request.Params.Offset
+	}
+	filter := audit.Filter{
+		FundID: request.Params.FundId,
+		Actor:  request.Params.Actor,
+		From:   request.Params.From,
+		To:     request.Params.To,
+	}
+
+	result, err := h.auditService.ListEvents(ctx, filter, params)
+	if err != nil {
+		if errors.Is(err, audit.ErrInvalidTimeRange) {
+			return ListAuditEvents400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Code:    INVALIDREQUEST,
+					Message: err.Error(),
+					Details: errorDetails(ctx, map[string]interface{}{"from": request.Params.From, "to": request.Params.To}),
+				},
+			}, nil
+		}
+		logError(ctx, "failed to list audit events", err)
+		return ListAuditEvents500JSONResponse{
+			InternalErrorJSONResponse: InternalErrorJSONResponse{
+				Code:    INTERNALERROR,
+				Message: "failed to list audit events",
+				Details: errorDetails(ctx, nil),
+			},
+		}, nil
+	}
+
+	events := make([]AuditEvent, len(result.Events))
+	for i, e := range result.Events {
+		events[i] = toAPIAuditEvent(e)
+	}
+
+	return ListAuditEvents200JSONResponse{
+		Events: events,
+		Total:  result.Total,
+		Limit:  result.Limit,
+		Offset: result.Offset,
+	}, nil
+}
+
+func toAPIAuditEvent(e *audit.Event) AuditEvent {
+	event := AuditEvent{
+		Id:         e.ID,
+		OccurredAt: e.OccurredAt,
+		Actor:      e.Actor,
+		Operation:  AuditEventOperation(e.Operation),
+		FundId:     e.FundID,
+		TransferId: e.TransferID,
+	}
+	if e.ActorName != "" {
+		event.ActorName = ptr(e.ActorName)
+	}
+	if e.RequestID != "" {
+		event.RequestId = ptr(e.RequestID)
+	}
+	if e.ClientIP != "" {
+		event.ClientIp = ptr(e.ClientIP)
+	}
+	if e.Before != nil {
+		event.Before = &e.Before
+	}
+	if e.After != nil {
+		event.After = &e.After
+	}
+	if e.Details != nil {
+		event.Details = &e.Details
+	}
+	return event
+}
+
 func ptr[T any](v T) *T {
 	return &v
 }
 
+func deref[T any](p *T) T {
+	var zero T
+	if p == nil {
+		return zero
+	}
+	return *p
+}
+
+func nonEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func pageTotal(params validation.ListParams, total int) *int {
+	if params.Cursor != "" {
+		return nil
+	}
+	return &total
+}
+
+func ownerRef(id *uuid.UUID, name string) string {
+	if id != nil {
+		return id.String()
+	}
+	return name
+}
+
+func classRef(id *uuid.UUID) string {
+	if id != nil {
+		return id.String()
+	}
+	return "default"
+}
+
 var _ StrictServerInterface = (*APIHandler)(nil)
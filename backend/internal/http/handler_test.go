@@ -3,9 +3,17 @@ package http
 
 import (
 	"context"
+	"encoding/csv"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/arowden/augment-fund/internal/auth"
+	"github.com/arowden/augment-fund/internal/commitment"
+	"github.com/arowden/augment-fund/internal/distribution"
 	"github.com/arowden/augment-fund/internal/fund"
+	"github.com/arowden/augment-fund/internal/owner"
 	"github.com/arowden/augment-fund/internal/ownership"
 	"github.com/arowden/augment-fund/internal/postgres"
 	"github.com/arowden/augment-fund/internal/transfer"
@@ -27,11 +35,13 @@ func TestAPIHandler(t *testing.T) {
 
 	fundStore := fund.NewStore(tc.Pool())
 	ownershipStore := ownership.NewStore(tc.Pool())
+	ownerStore := owner.NewStore(tc.Pool())
 
 	fundService, err := fund.NewService(
 		fundStore,
 		fund.WithPool(tc.Pool()),
 		fund.WithOwnershipRepository(ownershipStore),
+		fund.WithOwnerRepository(ownerStore),
 	)
 	require.NoError(t, err)
 
@@ -52,7 +62,7 @@ func TestAPIHandler(t *testing.T) {
 		fundList, ok := resp.(ListFunds200JSONResponse)
 		require.True(t, ok)
 		assert.Empty(t, fundList.Funds)
-		assert.Equal(t, 0, fundList.Total)
+		assert.Equal(t, ptr(0), fundList.Total)
 		assert.Equal(t, 100, fundList.Limit)
 		assert.Equal(t, 0, fundList.Offset)
 	})
@@ -64,7 +74,7 @@ func TestAPIHandler(t *testing.T) {
 			Body: &CreateFundJSONRequestBody{
 				Name:         "Test Fund",
 				TotalUnits:   1000,
-				InitialOwner: "Founder LLC",
+				InitialOwner: ptr("Founder LLC"),
 			},
 		})
 		require.NoError(t, err)
@@ -83,7 +93,7 @@ func TestAPIHandler(t *testing.T) {
 			Body: &CreateFundJSONRequestBody{
 				Name:         "",
 				TotalUnits:   1000,
-				InitialOwner: "Founder LLC",
+				InitialOwner: ptr("Founder LLC"),
 			},
 		})
 		require.NoError(t, err)
@@ -124,7 +134,7 @@ func TestAPIHandler(t *testing.T) {
 			Body: &CreateFundJSONRequestBody{
 				Name:         "Lookup Fund",
 				TotalUnits:   500,
-				InitialOwner: "Founder LLC",
+				InitialOwner: ptr("Founder LLC"),
 			},
 		})
 		require.NoError(t, err)
@@ -161,7 +171,7 @@ func TestAPIHandler(t *testing.T) {
 			Body: &CreateFundJSONRequestBody{
 				Name:         "Initial Owner Fund",
 				TotalUnits:   1000,
-				InitialOwner: "Founder LLC",
+				InitialOwner: ptr("Founder LLC"),
 			},
 		})
 		require.NoError(t, err)
@@ -177,7 +187,7 @@ func TestAPIHandler(t *testing.T) {
 		require.True(t, ok)
 		assert.Equal(t, created.Id, capTable.FundId)
 		require.Len(t, capTable.Entries, 1)
-		assert.Equal(t, 1, capTable.Total)
+		assert.Equal(t, ptr(1), capTable.Total)
 		assert.Equal(t, "Founder LLC", capTable.Entries[0].OwnerName)
 		assert.Equal(t, 1000, capTable.Entries[0].Units)
 		assert.InDelta(t, 100.0, capTable.Entries[0].Percentage, 0.01)
@@ -190,14 +200,17 @@ func TestAPIHandler(t *testing.T) {
 			Body: &CreateFundJSONRequestBody{
 				Name:         "Paginated Fund",
 				TotalUnits:   1500,
-				InitialOwner: "Initial Owner",
+				InitialOwner: ptr("Initial Owner"),
 			},
 		})
 		require.NoError(t, err)
 		created := createResp.(CreateFund201JSONResponse)
 
 		for i := 1; i <= 4; i++ {
-			entry, _ := ownership.NewCapTableEntry(created.Id, "Owner "+string(rune('A'+i-1)), i*100)
+			o, err := owner.NewOwner("Owner "+string(rune('A'+i-1)), owner.TypeIndividual, nil)
+			require.NoError(t, err)
+			require.NoError(t, ownerStore.Create(ctx, o))
+			entry, _ := ownership.NewCapTableEntry(created.Id, o.ID, o.LegalName, i*100)
 			require.NoError(t, ownershipStore.Create(ctx, entry))
 		}
 
@@ -212,7 +225,7 @@ func TestAPIHandler(t *testing.T) {
 
 		capTable, ok := resp.(GetCapTable200JSONResponse)
 		require.True(t, ok)
-		assert.Equal(t, 5, capTable.Total)
+		assert.Equal(t, ptr(5), capTable.Total)
 		assert.Len(t, capTable.Entries, 2)
 		assert.Equal(t, 2, capTable.Limit)
 		assert.Equal(t, 0, capTable.Offset)
@@ -285,12 +298,14 @@ func TestAPIHandler_Transfers(t *testing.T) {
 
 	fundStore := fund.NewStore(tc.Pool())
 	ownershipStore := ownership.NewStore(tc.Pool())
+	ownerStore := owner.NewStore(tc.Pool())
 	transferStore := transfer.NewStore(tc.Pool())
 
 	fundService, err := fund.NewService(
 		fundStore,
 		fund.WithPool(tc.Pool()),
 		fund.WithOwnershipRepository(ownershipStore),
+		fund.WithOwnerRepository(ownerStore),
 	)
 	require.NoError(t, err)
 
@@ -300,14 +315,35 @@ func TestAPIHandler_Transfers(t *testing.T) {
 	transferService, err := transfer.NewService(
 		transfer.WithRepository(transferStore),
 		transfer.WithOwnershipRepository(ownershipStore),
+		transfer.WithOwnerRepository(ownerStore),
 		transfer.WithPool(tc.Pool()),
 	)
 	require.NoError(t, err)
 
+	ownerService, err := owner.NewService(owner.WithRepository(ownerStore))
+	require.NoError(t, err)
+
+	distributionService, err := distribution.NewService(
+		distribution.WithRepository(distribution.NewStore(tc.Pool())),
+		distribution.WithOwnershipRepository(ownershipStore),
+		distribution.WithPool(tc.Pool()),
+	)
+	require.NoError(t, err)
+
+	commitmentService, err := commitment.NewService(
+		commitment.WithRepository(commitment.NewStore(tc.Pool())),
+		commitment.WithOwnerRepository(ownerStore),
+		commitment.WithPool(tc.Pool()),
+	)
+	require.NoError(t, err)
+
 	handler := NewAPIHandler(
 		WithFundService(fundService),
 		WithOwnershipService(ownershipService),
+		WithOwnerService(ownerService),
 		WithTransferService(transferService),
+		WithDistributionService(distributionService),
+		WithCommitmentService(commitmentService),
 	)
 
 	t.Run("ListTransfers returns empty list for fund with no transfers", func(t *testing.T) {
@@ -317,7 +353,7 @@ func TestAPIHandler_Transfers(t *testing.T) {
 			Body: &CreateFundJSONRequestBody{
 				Name:         "Transfer Test Fund",
 				TotalUnits:   1000,
-				InitialOwner: "Founder",
+				InitialOwner: ptr("Founder"),
 			},
 		})
 		require.NoError(t, err)
@@ -332,7 +368,7 @@ func TestAPIHandler_Transfers(t *testing.T) {
 		transferList, ok := resp.(ListTransfers200JSONResponse)
 		require.True(t, ok)
 		assert.Empty(t, transferList.Transfers)
-		assert.Equal(t, 0, transferList.Total)
+		assert.Equal(t, ptr(0), transferList.Total)
 		assert.Equal(t, created.Id, transferList.FundId)
 	})
 
@@ -356,7 +392,7 @@ func TestAPIHandler_Transfers(t *testing.T) {
 			Body: &CreateFundJSONRequestBody{
 				Name:         "Transfer Fund",
 				TotalUnits:   1000,
-				InitialOwner: "Alice",
+				InitialOwner: ptr("Alice"),
 			},
 		})
 		require.NoError(t, err)
@@ -365,8 +401,8 @@ func TestAPIHandler_Transfers(t *testing.T) {
 		resp, err := handler.CreateTransfer(ctx, CreateTransferRequestObject{
 			FundId: created.Id,
 			Body: &CreateTransferJSONRequestBody{
-				FromOwner: "Alice",
-				ToOwner:   "Bob",
+				FromOwner: ptr("Alice"),
+				ToOwner:   ptr("Bob"),
 				Units:     200,
 			},
 		})
@@ -406,8 +442,8 @@ func TestAPIHandler_Transfers(t *testing.T) {
 		resp, err := handler.CreateTransfer(ctx, CreateTransferRequestObject{
 			FundId: uuid.New(),
 			Body: &CreateTransferJSONRequestBody{
-				FromOwner: "Alice",
-				ToOwner:   "Bob",
+				FromOwner: ptr("Alice"),
+				ToOwner:   ptr("Bob"),
 				Units:     100,
 			},
 		})
@@ -424,7 +460,7 @@ func TestAPIHandler_Transfers(t *testing.T) {
 			Body: &CreateFundJSONRequestBody{
 				Name:         "Owner Test Fund",
 				TotalUnits:   1000,
-				InitialOwner: "Alice",
+				InitialOwner: ptr("Alice"),
 			},
 		})
 		require.NoError(t, err)
@@ -433,8 +469,8 @@ func TestAPIHandler_Transfers(t *testing.T) {
 		resp, err := handler.CreateTransfer(ctx, CreateTransferRequestObject{
 			FundId: created.Id,
 			Body: &CreateTransferJSONRequestBody{
-				FromOwner: "NonExistent",
-				ToOwner:   "Bob",
+				FromOwner: ptr("NonExistent"),
+				ToOwner:   ptr("Bob"),
 				Units:     100,
 			},
 		})
@@ -452,7 +488,7 @@ func TestAPIHandler_Transfers(t *testing.T) {
 			Body: &CreateFundJSONRequestBody{
 				Name:         "Insufficient Units Fund",
 				TotalUnits:   100,
-				InitialOwner: "Alice",
+				InitialOwner: ptr("Alice"),
 			},
 		})
 		require.NoError(t, err)
@@ -461,8 +497,8 @@ func TestAPIHandler_Transfers(t *testing.T) {
 		resp, err := handler.CreateTransfer(ctx, CreateTransferRequestObject{
 			FundId: created.Id,
 			Body: &CreateTransferJSONRequestBody{
-				FromOwner: "Alice",
-				ToOwner:   "Bob",
+				FromOwner: ptr("Alice"),
+				ToOwner:   ptr("Bob"),
 				Units:     500,
 			},
 		})
@@ -480,7 +516,7 @@ func TestAPIHandler_Transfers(t *testing.T) {
 			Body: &CreateFundJSONRequestBody{
 				Name:         "Self Transfer Fund",
 				TotalUnits:   1000,
-				InitialOwner: "Alice",
+				InitialOwner: ptr("Alice"),
 			},
 		})
 		require.NoError(t, err)
@@ -489,8 +525,8 @@ func TestAPIHandler_Transfers(t *testing.T) {
 		resp, err := handler.CreateTransfer(ctx, CreateTransferRequestObject{
 			FundId: created.Id,
 			Body: &CreateTransferJSONRequestBody{
-				FromOwner: "Alice",
-				ToOwner:   "Alice",
+				FromOwner: ptr("Alice"),
+				ToOwner:   ptr("Alice"),
 				Units:     100,
 			},
 		})
@@ -508,7 +544,7 @@ func TestAPIHandler_Transfers(t *testing.T) {
 			Body: &CreateFundJSONRequestBody{
 				Name:         "Invalid Units Fund",
 				TotalUnits:   1000,
-				InitialOwner: "Alice",
+				InitialOwner: ptr("Alice"),
 			},
 		})
 		require.NoError(t, err)
@@ -517,8 +553,8 @@ func TestAPIHandler_Transfers(t *testing.T) {
 		resp, err := handler.CreateTransfer(ctx, CreateTransferRequestObject{
 			FundId: created.Id,
 			Body: &CreateTransferJSONRequestBody{
-				FromOwner: "Alice",
-				ToOwner:   "Bob",
+				FromOwner: ptr("Alice"),
+				ToOwner:   ptr("Bob"),
 				Units:     0,
 			},
 		})
@@ -536,7 +572,7 @@ func TestAPIHandler_Transfers(t *testing.T) {
 			Body: &CreateFundJSONRequestBody{
 				Name:         "Invalid Owner Fund",
 				TotalUnits:   1000,
-				InitialOwner: "Alice",
+				InitialOwner: ptr("Alice"),
 			},
 		})
 		require.NoError(t, err)
@@ -545,8 +581,8 @@ func TestAPIHandler_Transfers(t *testing.T) {
 		resp, err := handler.CreateTransfer(ctx, CreateTransferRequestObject{
 			FundId: created.Id,
 			Body: &CreateTransferJSONRequestBody{
-				FromOwner: "",
-				ToOwner:   "Bob",
+				FromOwner: ptr(""),
+				ToOwner:   ptr("Bob"),
 				Units:     100,
 			},
 		})
@@ -564,7 +600,7 @@ func TestAPIHandler_Transfers(t *testing.T) {
 			Body: &CreateFundJSONRequestBody{
 				Name:         "Idempotency Fund",
 				TotalUnits:   1000,
-				InitialOwner: "Alice",
+				InitialOwner: ptr("Alice"),
 			},
 		})
 		require.NoError(t, err)
@@ -575,8 +611,8 @@ func TestAPIHandler_Transfers(t *testing.T) {
 		resp1, err := handler.CreateTransfer(ctx, CreateTransferRequestObject{
 			FundId: created.Id,
 			Body: &CreateTransferJSONRequestBody{
-				FromOwner:      "Alice",
-				ToOwner:        "Bob",
+				FromOwner:      ptr("Alice"),
+				ToOwner:        ptr("Bob"),
 				Units:          100,
 				IdempotencyKey: (*openapi_types.UUID)(&idempotencyKey),
 			},
@@ -587,8 +623,8 @@ func TestAPIHandler_Transfers(t *testing.T) {
 		resp2, err := handler.CreateTransfer(ctx, CreateTransferRequestObject{
 			FundId: created.Id,
 			Body: &CreateTransferJSONRequestBody{
-				FromOwner:      "Alice",
-				ToOwner:        "Bob",
+				FromOwner:      ptr("Alice"),
+				ToOwner:        ptr("Bob"),
 				Units:          100,
 				IdempotencyKey: (*openapi_types.UUID)(&idempotencyKey),
 			},
@@ -621,7 +657,7 @@ func TestAPIHandler_Transfers(t *testing.T) {
 			Body: &CreateFundJSONRequestBody{
 				Name:         "Duplicate Key Fund",
 				TotalUnits:   1000,
-				InitialOwner: "Alice",
+				InitialOwner: ptr("Alice"),
 			},
 		})
 		require.NoError(t, err)
@@ -632,8 +668,8 @@ func TestAPIHandler_Transfers(t *testing.T) {
 		_, err = handler.CreateTransfer(ctx, CreateTransferRequestObject{
 			FundId: created.Id,
 			Body: &CreateTransferJSONRequestBody{
-				FromOwner:      "Alice",
-				ToOwner:        "Bob",
+				FromOwner:      ptr("Alice"),
+				ToOwner:        ptr("Bob"),
 				Units:          100,
 				IdempotencyKey: (*openapi_types.UUID)(&idempotencyKey),
 			},
@@ -643,8 +679,8 @@ func TestAPIHandler_Transfers(t *testing.T) {
 		resp, err := handler.CreateTransfer(ctx, CreateTransferRequestObject{
 			FundId: created.Id,
 			Body: &CreateTransferJSONRequestBody{
-				FromOwner:      "Alice",
-				ToOwner:        "Bob",
+				FromOwner:      ptr("Alice"),
+				ToOwner:        ptr("Bob"),
 				Units:          200,
 				IdempotencyKey: (*openapi_types.UUID)(&idempotencyKey),
 			},
@@ -663,7 +699,7 @@ func TestAPIHandler_Transfers(t *testing.T) {
 			Body: &CreateFundJSONRequestBody{
 				Name:         "List Transfers Fund",
 				TotalUnits:   1000,
-				InitialOwner: "Alice",
+				InitialOwner: ptr("Alice"),
 			},
 		})
 		require.NoError(t, err)
@@ -672,8 +708,8 @@ func TestAPIHandler_Transfers(t *testing.T) {
 		_, err = handler.CreateTransfer(ctx, CreateTransferRequestObject{
 			FundId: created.Id,
 			Body: &CreateTransferJSONRequestBody{
-				FromOwner: "Alice",
-				ToOwner:   "Bob",
+				FromOwner: ptr("Alice"),
+				ToOwner:   ptr("Bob"),
 				Units:     100,
 			},
 		})
@@ -682,8 +718,8 @@ func TestAPIHandler_Transfers(t *testing.T) {
 		_, err = handler.CreateTransfer(ctx, CreateTransferRequestObject{
 			FundId: created.Id,
 			Body: &CreateTransferJSONRequestBody{
-				FromOwner: "Alice",
-				ToOwner:   "Charlie",
+				FromOwner: ptr("Alice"),
+				ToOwner:   ptr("Charlie"),
 				Units:     200,
 			},
 		})
@@ -696,7 +732,7 @@ func TestAPIHandler_Transfers(t *testing.T) {
 		require.NoError(t, err)
 
 		transferList := resp.(ListTransfers200JSONResponse)
-		assert.Equal(t, 2, transferList.Total)
+		assert.Equal(t, ptr(2), transferList.Total)
 		assert.Len(t, transferList.Transfers, 2)
 	})
 
@@ -707,7 +743,7 @@ func TestAPIHandler_Transfers(t *testing.T) {
 			Body: &CreateFundJSONRequestBody{
 				Name:         "Pagination Fund",
 				TotalUnits:   1000,
-				InitialOwner: "Alice",
+				InitialOwner: ptr("Alice"),
 			},
 		})
 		require.NoError(t, err)
@@ -717,8 +753,8 @@ func TestAPIHandler_Transfers(t *testing.T) {
 			_, err = handler.CreateTransfer(ctx, CreateTransferRequestObject{
 				FundId: created.Id,
 				Body: &CreateTransferJSONRequestBody{
-					FromOwner: "Alice",
-					ToOwner:   "Bob",
+					FromOwner: ptr("Alice"),
+					ToOwner:   ptr("Bob"),
 					Units:     10,
 				},
 			})
@@ -733,7 +769,7 @@ func TestAPIHandler_Transfers(t *testing.T) {
 		require.NoError(t, err)
 
 		transferList := resp.(ListTransfers200JSONResponse)
-		assert.Equal(t, 3, transferList.Total)
+		assert.Equal(t, ptr(3), transferList.Total)
 		assert.Len(t, transferList.Transfers, 2)
 		assert.Equal(t, 2, transferList.Limit)
 		assert.Equal(t, 0, transferList.Offset)
@@ -749,4 +785,1163 @@ func TestAPIHandler_Transfers(t *testing.T) {
 		assert.Len(t, transferList.Transfers, 1)
 		assert.Equal(t, 2, transferList.Offset)
 	})
+
+	t.Run("GetCapTable with asOf replays history", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		createResp, err := handler.CreateFund(ctx, CreateFundRequestObject{
+			Body: &CreateFundJSONRequestBody{
+				Name:         "As Of Fund",
+				TotalUnits:   1000,
+				InitialOwner: ptr("Founder"),
+			},
+		})
+		require.NoError(t, err)
+		created := createResp.(CreateFund201JSONResponse)
+
+		first, err := handler.CreateTransfer(ctx, CreateTransferRequestObject{
+			FundId: created.Id,
+			Body:   &CreateTransferJSONRequestBody{FromOwner: ptr("Founder"), ToOwner: ptr("Alice"), Units: 300},
+		})
+		require.NoError(t, err)
+		asOf := first.(CreateTransfer201JSONResponse).TransferredAt
+
+		_, err = handler.CreateTransfer(ctx, CreateTransferRequestObject{
+			FundId: created.Id,
+			Body:   &CreateTransferJSONRequestBody{FromOwner: ptr("Alice"), ToOwner: ptr("Bob"), Units: 100},
+		})
+		require.NoError(t, err)
+
+		resp, err := handler.GetCapTable(ctx, GetCapTableRequestObject{
+			FundId: created.Id,
+			Params: GetCapTableParams{AsOf: &asOf},
+		})
+		require.NoError(t, err)
+
+		capTable, ok := resp.(GetCapTable200JSONResponse)
+		require.True(t, ok)
+		require.Len(t, capTable.Entries, 2)
+		assert.Equal(t, "Founder", capTable.Entries[0].OwnerName)
+		assert.Equal(t, 700, capTable.Entries[0].Units)
+		assert.InDelta(t, 70.0, capTable.Entries[0].Percentage, 0.001)
+		assert.Equal(t, "Alice", capTable.Entries[1].OwnerName)
+		assert.Equal(t, 300, capTable.Entries[1].Units)
+
+		before := created.CreatedAt.Add(-time.Second)
+		resp, err = handler.GetCapTable(ctx, GetCapTableRequestObject{
+			FundId: created.Id,
+			Params: GetCapTableParams{AsOf: &before},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, resp.(GetCapTable200JSONResponse).Entries)
+	})
+
+	t.Run("CreateTransferBatch applies all legs", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		createResp, err := handler.CreateFund(ctx, CreateFundRequestObject{
+			Body: &CreateFundJSONRequestBody{
+				Name:         "Batch Fund",
+				TotalUnits:   1000,
+				InitialOwner: ptr("Seller"),
+			},
+		})
+		require.NoError(t, err)
+		created := createResp.(CreateFund201JSONResponse)
+
+		resp, err := handler.CreateTransferBatch(ctx, CreateTransferBatchRequestObject{
+			FundId: created.Id,
+			Body: &CreateTransferBatchJSONRequestBody{
+				Legs: []TransferLeg{
+					{FromOwner: ptr("Seller"), ToOwner: ptr("Alice"), Units: 300},
+					{FromOwner: ptr("Seller"), ToOwner: ptr("Bob"), Units: 200},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		batch, ok := resp.(CreateTransferBatch201JSONResponse)
+		require.True(t, ok)
+		require.Len(t, batch.Transfers, 2)
+		assert.Equal(t, batch.Id, *batch.Transfers[1].BatchId)
+		assert.Equal(t, 1, *batch.Transfers[1].LegIndex)
+		assert.Equal(t, "Bob", batch.Transfers[1].ToOwner)
+	})
+
+	t.Run("CreateTransferBatch reports the failing leg", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		createResp, err := handler.CreateFund(ctx, CreateFundRequestObject{
+			Body: &CreateFundJSONRequestBody{
+				Name:         "Batch Fund",
+				TotalUnits:   1000,
+				InitialOwner: ptr("Seller"),
+			},
+		})
+		require.NoError(t, err)
+		created := createResp.(CreateFund201JSONResponse)
+
+		resp, err := handler.CreateTransferBatch(ctx, CreateTransferBatchRequestObject{
+			FundId: created.Id,
+			Body: &CreateTransferBatchJSONRequestBody{
+				Legs: []TransferLeg{
+					{FromOwner: ptr("Seller"), ToOwner: ptr("Alice"), Units: 600},
+					{FromOwner: ptr("Seller"), ToOwner: ptr("Bob"), Units: 600},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		badReq, ok := resp.(CreateTransferBatch400JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, INSUFFICIENTUNITS, badReq.Code)
+		require.NotNil(t, badReq.Details)
+		assert.Equal(t, 1, (*badReq.Details)["legIndex"])
+
+		capResp, err := handler.GetCapTable(ctx, GetCapTableRequestObject{FundId: created.Id})
+		require.NoError(t, err)
+		capTable := capResp.(GetCapTable200JSONResponse)
+		require.Len(t, capTable.Entries, 1)
+		assert.Equal(t, 1000, capTable.Entries[0].Units)
+	})
+
+	t.Run("ReverseTransfer returns compensating transfer and links history", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		createResp, err := handler.CreateFund(ctx, CreateFundRequestObject{
+			Body: &CreateFundJSONRequestBody{
+				Name:         "Reversal Fund",
+				TotalUnits:   1000,
+				InitialOwner: ptr("Founder"),
+			},
+		})
+		require.NoError(t, err)
+		created := createResp.(CreateFund201JSONResponse)
+
+		transferResp, err := handler.CreateTransfer(ctx, CreateTransferRequestObject{
+			FundId: created.Id,
+			Body: &CreateTransferJSONRequestBody{
+				FromOwner: ptr("Founder"),
+				ToOwner:   ptr("Alice"),
+				Units:     250,
+			},
+		})
+		require.NoError(t, err)
+		original := transferResp.(CreateTransfer201JSONResponse)
+
+		resp, err := handler.ReverseTransfer(ctx, ReverseTransferRequestObject{FundId: created.Id, TransferId: original.Id})
+		require.NoError(t, err)
+		reversal, ok := resp.(ReverseTransfer201JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, "Alice", reversal.FromOwner)
+		assert.Equal(t, "Founder", reversal.ToOwner)
+		require.NotNil(t, reversal.ReversesTransferId)
+		assert.Equal(t, original.Id, *reversal.ReversesTransferId)
+
+		listResp, err := handler.ListTransfers(ctx, ListTransfersRequestObject{FundId: created.Id})
+		require.NoError(t, err)
+		list := listResp.(ListTransfers200JSONResponse)
+		require.Len(t, list.Transfers, 2)
+		require.NotNil(t, list.Transfers[0].ReversedByTransferId)
+		assert.Equal(t, reversal.Id, *list.Transfers[0].ReversedByTransferId)
+
+		resp, err = handler.ReverseTransfer(ctx, ReverseTransferRequestObject{FundId: created.Id, TransferId: original.Id})
+		require.NoError(t, err)
+		conflict, ok := resp.(ReverseTransfer409JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, ALREADYREVERSED, conflict.Code)
+	})
+
+	t.Run("ReverseTransfer returns 404 for unknown transfer", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		createResp, err := handler.CreateFund(ctx, CreateFundRequestObject{
+			Body: &CreateFundJSONRequestBody{
+				Name:         "Reversal Fund",
+				TotalUnits:   1000,
+				InitialOwner: ptr("Founder"),
+			},
+		})
+		require.NoError(t, err)
+		created := createResp.(CreateFund201JSONResponse)
+
+		resp, err := handler.ReverseTransfer(ctx, ReverseTransferRequestObject{FundId: created.Id, TransferId: uuid.New()})
+		require.NoError(t, err)
+		notFound, ok := resp.(ReverseTransfer404JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, TRANSFERNOTFOUND, notFound.Code)
+	})
+
+	t.Run("Transfers above the approval threshold wait for review", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		createResp, err := handler.CreateFund(ctx, CreateFundRequestObject{
+			Body: &CreateFundJSONRequestBody{
+				Name:         "Approval Fund",
+				TotalUnits:   1000,
+				InitialOwner: ptr("Founder"),
+			},
+		})
+		require.NoError(t, err)
+		created := createResp.(CreateFund201JSONResponse)
+
+		threshold := 100
+		thresholdResp, err := handler.SetApprovalThreshold(ctx, SetApprovalThresholdRequestObject{
+			FundId: created.Id,
+			Body:   &SetApprovalThresholdJSONRequestBody{Threshold: &threshold},
+		})
+		require.NoError(t, err)
+		updated, ok := thresholdResp.(SetApprovalThreshold200JSONResponse)
+		require.True(t, ok)
+		require.NotNil(t, updated.ApprovalThreshold)
+		assert.Equal(t, 100, *updated.ApprovalThreshold)
+
+		transferResp, err := handler.CreateTransfer(ctx, CreateTransferRequestObject{
+			FundId: created.Id,
+			Body: &CreateTransferJSONRequestBody{
+				FromOwner:   ptr("Founder"),
+				ToOwner:     ptr("Alice"),
+				Units:       400,
+				RequestedBy: ptr("Operator"),
+			},
+		})
+		require.NoError(t, err)
+		accepted, ok := transferResp.(CreateTransfer202JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, TransferStatusPending, accepted.Status)
+
+		pendingResp, err := handler.ListPendingTransfers(ctx, ListPendingTransfersRequestObject{FundId: created.Id})
+		require.NoError(t, err)
+		pending := pendingResp.(ListPendingTransfers200JSONResponse)
+		require.Len(t, pending.Transfers, 1)
+		assert.Equal(t, accepted.Id, pending.Transfers[0].Id)
+
+		approveResp, err := handler.ApproveTransfer(ctx, ApproveTransferRequestObject{
+			FundId:     created.Id,
+			TransferId: accepted.Id,
+			Body:       &ApproveTransferJSONRequestBody{ReviewedBy: ptr("Compliance")},
+		})
+		require.NoError(t, err)
+		approved, ok := approveResp.(ApproveTransfer200JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, TransferStatusApproved, approved.Status)
+		require.NotNil(t, approved.ReviewedBy)
+		assert.Equal(t, "Compliance", *approved.ReviewedBy)
+
+		rejectResp, err := handler.RejectTransfer(ctx, RejectTransferRequestObject{
+			FundId:     created.Id,
+			TransferId: accepted.Id,
+			Body:       &RejectTransferJSONRequestBody{ReviewedBy: ptr("Compliance")},
+		})
+		require.NoError(t, err)
+		conflict, ok := rejectResp.(RejectTransfer409JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, TRANSFERNOTPENDING, conflict.Code)
+
+		capResp, err := handler.GetCapTable(ctx, GetCapTableRequestObject{FundId: created.Id})
+		require.NoError(t, err)
+		capTable := capResp.(GetCapTable200JSONResponse)
+		require.Len(t, capTable.Entries, 2)
+		assert.Equal(t, 600, capTable.Entries[0].Units)
+		assert.Equal(t, 400, capTable.Entries[1].Units)
+	})
+
+	t.Run("Transfers must be approved by a principal other than the requester", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		createResp, err := handler.CreateFund(ctx, CreateFundRequestObject{
+			Body: &CreateFundJSONRequestBody{
+				Name:         "Four Eyes Fund",
+				TotalUnits:   1000,
+				InitialOwner: ptr("Founder"),
+			},
+		})
+		require.NoError(t, err)
+		created := createResp.(CreateFund201JSONResponse)
+
+		threshold := 100
+		_, err = handler.SetApprovalThreshold(ctx, SetApprovalThresholdRequestObject{
+			FundId: created.Id,
+			Body:   &SetApprovalThresholdJSONRequestBody{Threshold: &threshold},
+		})
+		require.NoError(t, err)
+
+		requester := auth.WithPrincipal(ctx, &auth.Principal{Subject: "api_key:requester", Role: auth.RoleOperator})
+		reviewer := auth.WithPrincipal(ctx, &auth.Principal{Subject: "api_key:reviewer", Role: auth.RoleOperator})
+
+		transferResp, err := handler.CreateTransfer(requester, CreateTransferRequestObject{
+			FundId: created.Id,
+			Body: &CreateTransferJSONRequestBody{
+				FromOwner: ptr("Founder"),
+				ToOwner:   ptr("Alice"),
+				Units:     400,
+			},
+		})
+		require.NoError(t, err)
+		accepted, ok := transferResp.(CreateTransfer202JSONResponse)
+		require.True(t, ok)
+		require.NotNil(t, accepted.RequestedBy)
+		assert.Equal(t, "api_key:requester", *accepted.RequestedBy)
+
+		selfResp, err := handler.ApproveTransfer(requester, ApproveTransferRequestObject{
+			FundId:     created.Id,
+			TransferId: accepted.Id,
+			Body:       &ApproveTransferJSONRequestBody{ReviewedBy: ptr("Someone Else")},
+		})
+		require.NoError(t, err)
+		self, ok := selfResp.(ApproveTransfer409JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, SELFREVIEW, self.Code)
+
+		approveResp, err := handler.ApproveTransfer(reviewer, ApproveTransferRequestObject{
+			FundId:     created.Id,
+			TransferId: accepted.Id,
+			Body:       &ApproveTransferJSONRequestBody{},
+		})
+		require.NoError(t, err)
+		approved, ok := approveResp.(ApproveTransfer200JSONResponse)
+		require.True(t, ok)
+		require.NotNil(t, approved.ReviewedBy)
+		assert.Equal(t, "api_key:reviewer", *approved.ReviewedBy)
+	})
+
+	t.Run("Transfers without a principal record the requester from the body", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		createResp, err := handler.CreateFund(ctx, CreateFundRequestObject{
+			Body: &CreateFundJSONRequestBody{
+				Name:         "Unauthenticated Fund",
+				TotalUnits:   1000,
+				InitialOwner: ptr("Founder"),
+			},
+		})
+		require.NoError(t, err)
+		created := createResp.(CreateFund201JSONResponse)
+
+		threshold := 100
+		_, err = handler.SetApprovalThreshold(ctx, SetApprovalThresholdRequestObject{
+			FundId: created.Id,
+			Body:   &SetApprovalThresholdJSONRequestBody{Threshold: &threshold},
+		})
+		require.NoError(t, err)
+
+		missingResp, err := handler.CreateTransfer(ctx, CreateTransferRequestObject{
+			FundId: created.Id,
+			Body: &CreateTransferJSONRequestBody{
+				FromOwner: ptr("Founder"),
+				ToOwner:   ptr("Alice"),
+				Units:     400,
+			},
+		})
+		require.NoError(t, err)
+		missing, ok := missingResp.(CreateTransfer400JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, INVALIDREQUEST, missing.Code)
+
+		transferResp, err := handler.CreateTransfer(ctx, CreateTransferRequestObject{
+			FundId: created.Id,
+			Body: &CreateTransferJSONRequestBody{
+				FromOwner:   ptr("Founder"),
+				ToOwner:     ptr("Alice"),
+				Units:       400,
+				RequestedBy: ptr("Alice"),
+			},
+		})
+		require.NoError(t, err)
+		accepted, ok := transferResp.(CreateTransfer202JSONResponse)
+		require.True(t, ok)
+		require.NotNil(t, accepted.RequestedBy)
+		assert.Equal(t, "Alice", *accepted.RequestedBy)
+
+		selfResp, err := handler.ApproveTransfer(ctx, ApproveTransferRequestObject{
+			FundId:     created.Id,
+			TransferId: accepted.Id,
+			Body:       &ApproveTransferJSONRequestBody{ReviewedBy: ptr("Alice")},
+		})
+		require.NoError(t, err)
+		self, ok := selfResp.(ApproveTransfer409JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, SELFREVIEW, self.Code)
+
+		approveResp, err := handler.ApproveTransfer(ctx, ApproveTransferRequestObject{
+			FundId:     created.Id,
+			TransferId: accepted.Id,
+			Body:       &ApproveTransferJSONRequestBody{ReviewedBy: ptr("Compliance")},
+		})
+		require.NoError(t, err)
+		_, ok = approveResp.(ApproveTransfer200JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("Owners keep their identity across renames", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		ownerResp, err := handler.CreateOwner(ctx, CreateOwnerRequestObject{
+			Body: &CreateOwnerJSONRequestBody{LegalName: "Acme LLC", Type: ptr(Entity)},
+		})
+		require.NoError(t, err)
+		acme, ok := ownerResp.(CreateOwner201JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, Entity, acme.Type)
+
+		createResp, err := handler.CreateFund(ctx, CreateFundRequestObject{
+			Body: &CreateFundJSONRequestBody{
+				Name:           "Owner Identity Fund",
+				TotalUnits:     1000,
+				InitialOwnerId: &acme.Id,
+			},
+		})
+		require.NoError(t, err)
+		created := createResp.(CreateFund201JSONResponse)
+
+		transferResp, err := handler.CreateTransfer(ctx, CreateTransferRequestObject{
+			FundId: created.Id,
+			Body: &CreateTransferJSONRequestBody{
+				FromOwnerId: &acme.Id,
+				ToOwner:     ptr("Bob"),
+				Units:       300,
+			},
+		})
+		require.NoError(t, err)
+		transferred, ok := transferResp.(CreateTransfer201JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, acme.Id, transferred.FromOwnerId)
+		assert.Equal(t, "Acme LLC", transferred.FromOwner)
+
+		renameResp, err := handler.UpdateOwner(ctx, UpdateOwnerRequestObject{
+			OwnerId: acme.Id,
+			Body:    &UpdateOwnerJSONRequestBody{LegalName: ptr("ACME, LLC")},
+		})
+		require.NoError(t, err)
+		renamed, ok := renameResp.(UpdateOwner200JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, "ACME, LLC", renamed.LegalName)
+
+		capTableResp, err := handler.GetCapTable(ctx, GetCapTableRequestObject{FundId: created.Id})
+		require.NoError(t, err)
+		capTable := capTableResp.(GetCapTable200JSONResponse)
+		require.Len(t, capTable.Entries, 2)
+		assert.Equal(t, acme.Id, capTable.Entries[0].OwnerId)
+		assert.Equal(t, "ACME, LLC", capTable.Entries[0].OwnerName)
+
+		_, err = handler.CreateTransfer(ctx, CreateTransferRequestObject{
+			FundId: created.Id,
+			Body: &CreateTransferJSONRequestBody{
+				FromOwner: ptr("ACME, LLC"),
+				ToOwner:   ptr("Bob"),
+				Units:     100,
+			},
+		})
+		require.NoError(t, err)
+
+		listResp, err := handler.ListTransfers(ctx, ListTransfersRequestObject{FundId: created.Id})
+		require.NoError(t, err)
+		list := listResp.(ListTransfers200JSONResponse)
+		require.Len(t, list.Transfers, 2)
+		for _, tr := range list.Transfers {
+			assert.Equal(t, acme.Id, tr.FromOwnerId)
+		}
+		assert.ElementsMatch(t, []string{"Acme LLC", "ACME, LLC"}, []string{list.Transfers[0].FromOwner, list.Transfers[1].FromOwner})
+
+		missingResp, err := handler.GetOwner(ctx, GetOwnerRequestObject{OwnerId: uuid.New()})
+		require.NoError(t, err)
+		_, ok = missingResp.(GetOwner404JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("Owner holdings span funds", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		ownerResp, err := handler.CreateOwner(ctx, CreateOwnerRequestObject{
+			Body: &CreateOwnerJSONRequestBody{LegalName: "Acme LLC", Type: ptr(Entity)},
+		})
+		require.NoError(t, err)
+		acme := ownerResp.(CreateOwner201JSONResponse)
+
+		firstResp, err := handler.CreateFund(ctx, CreateFundRequestObject{
+			Body: &CreateFundJSONRequestBody{Name: "Portfolio Fund A", TotalUnits: 1000, InitialOwnerId: &acme.Id},
+		})
+		require.NoError(t, err)
+		first := firstResp.(CreateFund201JSONResponse)
+
+		secondResp, err := handler.CreateFund(ctx, CreateFundRequestObject{
+			Body: &CreateFundJSONRequestBody{Name: "Portfolio Fund B", TotalUnits: 1000, InitialOwner: ptr("Founder")},
+		})
+		require.NoError(t, err)
+		second := secondResp.(CreateFund201JSONResponse)
+
+		for _, req := range []CreateTransferRequestObject{
+			{FundId: second.Id, Body: &CreateTransferJSONRequestBody{FromOwner: ptr("Founder"), ToOwnerId: &acme.Id, Units: 250}},
+			{FundId: first.Id, Body: &CreateTransferJSONRequestBody{FromOwnerId: &acme.Id, ToOwner: ptr("Bob"), Units: 400}},
+			{FundId: second.Id, Body: &CreateTransferJSONRequestBody{FromOwner: ptr("Founder"), ToOwner: ptr("Bob"), Units: 100}},
+		} {
+			resp, err := handler.CreateTransfer(ctx, req)
+			require.NoError(t, err)
+			_, ok := resp.(CreateTransfer201JSONResponse)
+			require.True(t, ok)
+		}
+
+		resp, err := handler.GetOwnerHoldings(ctx, GetOwnerHoldingsRequestObject{OwnerId: acme.Id})
+		require.NoError(t, err)
+		portfolio, ok := resp.(GetOwnerHoldings200JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, acme.Id, portfolio.Owner.Id)
+
+		require.Len(t, portfolio.Holdings, 2)
+		assert.Equal(t, first.Id, portfolio.Holdings[0].FundId)
+		assert.Equal(t, "Portfolio Fund A", portfolio.Holdings[0].FundName)
+		assert.Equal(t, 600, portfolio.Holdings[0].Units)
+		assert.InDelta(t, 60.0, portfolio.Holdings[0].Percentage, 0.001)
+		assert.Equal(t, second.Id, portfolio.Holdings[1].FundId)
+		assert.InDelta(t, 25.0, portfolio.Holdings[1].Percentage, 0.001)
+
+		assert.Equal(t, 2, portfolio.Total)
+		require.Len(t, portfolio.Transfers, 2)
+		assert.Equal(t, second.Id, portfolio.Transfers[0].FundId)
+		assert.Equal(t, first.Id, portfolio.Transfers[1].FundId)
+
+		pageResp, err := handler.GetOwnerHoldings(ctx, GetOwnerHoldingsRequestObject{
+			OwnerId: acme.Id,
+			Params:  GetOwnerHoldingsParams{Limit: ptr(1), Offset: ptr(1)},
+		})
+		require.NoError(t, err)
+		page := pageResp.(GetOwnerHoldings200JSONResponse)
+		assert.Equal(t, 2, page.Total)
+		require.Len(t, page.Transfers, 1)
+		assert.Equal(t, first.Id, page.Transfers[0].FundId)
+		assert.Len(t, page.Holdings, 2)
+
+		missingResp, err := handler.GetOwnerHoldings(ctx, GetOwnerHoldingsRequestObject{OwnerId: uuid.New()})
+		require.NoError(t, err)
+		_, ok = missingResp.(GetOwnerHoldings404JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("ImportFund creates the fund and every holder or nothing", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		badResp, err := handler.ImportFund(ctx, ImportFundRequestObject{JSONBody: &ImportFundJSONRequestBody{
+			Name:       "Legacy Fund",
+			TotalUnits: 1000,
+			Rows: []ImportRow{
+				{Owner: ptr("Alice"), Units: 600},
+				{Owner: ptr("Bob"), Units: -5},
+				{Owner: ptr("Alice"), Units: 400},
+			},
+		}})
+		require.NoError(t, err)
+		bad, ok := badResp.(ImportFund400JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, INVALIDIMPORT, bad.Code)
+		require.NotNil(t, bad.Details)
+		rows := (*bad.Details)["rows"].([]map[string]interface{})
+		require.Len(t, rows, 2)
+		assert.Equal(t, 2, rows[0]["row"])
+		assert.Equal(t, 3, rows[1]["row"])
+
+		mismatchResp, err := handler.ImportFund(ctx, ImportFundRequestObject{JSONBody: &ImportFundJSONRequestBody{
+			Name:       "Legacy Fund",
+			TotalUnits: 1000,
+			Rows:       []ImportRow{{Owner: ptr("Alice"), Units: 600}, {Owner: ptr("Bob"), Units: 300}},
+		}})
+		require.NoError(t, err)
+		mismatch, ok := mismatchResp.(ImportFund400JSONResponse)
+		require.True(t, ok)
+		assert.Contains(t, mismatch.Message, "add up to 900")
+
+		listResp, err := handler.ListFunds(ctx, ListFundsRequestObject{})
+		require.NoError(t, err)
+		assert.Empty(t, listResp.(ListFunds200JSONResponse).Funds)
+
+		resp, err := handler.ImportFund(ctx, ImportFundRequestObject{
+			Params: ImportFundParams{Name: ptr("Legacy Fund"), TotalUnits: ptr(1000)},
+			Body:   strings.NewReader("owner,units\nAlice,600\nBob,300\nCarol,100\n"),
+		})
+		require.NoError(t, err)
+		created, ok := resp.(ImportFund201JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, "Legacy Fund", created.Name)
+
+		capResp, err := handler.GetCapTable(ctx, GetCapTableRequestObject{FundId: created.Id})
+		require.NoError(t, err)
+		capTable := capResp.(GetCapTable200JSONResponse)
+		require.Len(t, capTable.Entries, 3)
+		assert.Equal(t, "Alice", capTable.Entries[0].OwnerName)
+		assert.InDelta(t, 60.0, capTable.Entries[0].Percentage, 0.001)
+		assert.Equal(t, "Carol", capTable.Entries[2].OwnerName)
+	})
+
+	t.Run("GetCapTable with asOf replays an imported fund", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		resp, err := handler.ImportFund(ctx, ImportFundRequestObject{JSONBody: &ImportFundJSONRequestBody{
+			Name:       "Imported Fund",
+			TotalUnits: 1000,
+			Rows:       []ImportRow{{Owner: ptr("Alice"), Units: 600}, {Owner: ptr("Bob"), Units: 300}, {Owner: ptr("Carol"), Units: 100}},
+		}})
+		require.NoError(t, err)
+		created := resp.(ImportFund201JSONResponse)
+
+		transferResp, err := handler.CreateTransfer(ctx, CreateTransferRequestObject{
+			FundId: created.Id,
+			Body:   &CreateTransferJSONRequestBody{FromOwner: ptr("Alice"), ToOwner: ptr("Carol"), Units: 200},
+		})
+		require.NoError(t, err)
+		transferredAt := transferResp.(CreateTransfer201JSONResponse).TransferredAt
+
+		unitsAsOf := func(asOf time.Time) map[string]int {
+			resp, err := handler.GetCapTable(ctx, GetCapTableRequestObject{FundId: created.Id, Params: GetCapTableParams{AsOf: &asOf}})
+			require.NoError(t, err)
+			out := make(map[string]int)
+			for _, e := range resp.(GetCapTable200JSONResponse).Entries {
+				out[e.OwnerName] = e.Units
+			}
+			return out
+		}
+
+		assert.Equal(t, map[string]int{"Alice": 600, "Bob": 300, "Carol": 100}, unitsAsOf(created.CreatedAt))
+		assert.Equal(t, map[string]int{"Alice": 400, "Bob": 300, "Carol": 300}, unitsAsOf(transferredAt))
+
+		eventsResp, err := handler.ListUnitEvents(ctx, ListUnitEventsRequestObject{FundId: created.Id})
+		require.NoError(t, err)
+		assert.Len(t, eventsResp.(ListUnitEvents200JSONResponse).Events, 3)
+	})
+
+	t.Run("Only open funds accept transfers and status changes follow the lifecycle", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		createResp, err := handler.CreateFund(ctx, CreateFundRequestObject{
+			Body: &CreateFundJSONRequestBody{Name: "Lifecycle Fund", TotalUnits: 1000, InitialOwner: ptr("Founder")},
+		})
+		require.NoError(t, err)
+		created := createResp.(CreateFund201JSONResponse)
+		assert.Equal(t, Open, created.Status)
+
+		threshold := 100
+		_, err = handler.SetApprovalThreshold(ctx, SetApprovalThresholdRequestObject{
+			FundId: created.Id,
+			Body:   &SetApprovalThresholdJSONRequestBody{Threshold: &threshold},
+		})
+		require.NoError(t, err)
+		pendingResp, err := handler.CreateTransfer(ctx, CreateTransferRequestObject{
+			FundId: created.Id,
+			Body:   &CreateTransferJSONRequestBody{FromOwner: ptr("Founder"), ToOwner: ptr("Alice"), Units: 400, RequestedBy: ptr("Operator")},
+		})
+		require.NoError(t, err)
+		pending := pendingResp.(CreateTransfer202JSONResponse)
+
+		suspendResp, err := handler.ChangeFundStatus(ctx, ChangeFundStatusRequestObject{
+			FundId: created.Id,
+			Body:   &ChangeFundStatusJSONRequestBody{Status: Suspended, Reason: ptr("Regulatory review")},
+		})
+		require.NoError(t, err)
+		suspended, ok := suspendResp.(ChangeFundStatus200JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, Suspended, suspended.Status)
+		assert.True(t, suspended.StatusChangedAt.After(created.StatusChangedAt))
+
+		transferResp, err := handler.CreateTransfer(ctx, CreateTransferRequestObject{
+			FundId: created.Id,
+			Body:   &CreateTransferJSONRequestBody{FromOwner: ptr("Founder"), ToOwner: ptr("Bob"), Units: 10},
+		})
+		require.NoError(t, err)
+		rejected, ok := transferResp.(CreateTransfer409JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, FUNDNOTOPEN, rejected.Code)
+
+		approveResp, err := handler.ApproveTransfer(ctx, ApproveTransferRequestObject{
+			FundId:     created.Id,
+			TransferId: pending.Id,
+			Body:       &ApproveTransferJSONRequestBody{ReviewedBy: ptr("Compliance")},
+		})
+		require.NoError(t, err)
+		notOpen, ok := approveResp.(ApproveTransfer409JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, FUNDNOTOPEN, notOpen.Code)
+
+		rejectResp, err := handler.RejectTransfer(ctx, RejectTransferRequestObject{
+			FundId:     created.Id,
+			TransferId: pending.Id,
+			Body:       &RejectTransferJSONRequestBody{ReviewedBy: ptr("Compliance")},
+		})
+		require.NoError(t, err)
+		_, ok = rejectResp.(RejectTransfer200JSONResponse)
+		assert.True(t, ok)
+
+		issueResp, err := handler.IssueUnits(ctx, IssueUnitsRequestObject{
+			FundId: created.Id,
+			Body:   &IssueUnitsJSONRequestBody{Owner: ptr("Founder"), Units: 10},
+		})
+		require.NoError(t, err)
+		issueConflict, ok := issueResp.(IssueUnits409JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, FUNDNOTOPEN, issueConflict.Code)
+
+		for _, status := range []FundStatus{Closed, Liquidated} {
+			resp, err := handler.ChangeFundStatus(ctx, ChangeFundStatusRequestObject{
+				FundId: created.Id,
+				Body:   &ChangeFundStatusJSONRequestBody{Status: status},
+			})
+			require.NoError(t, err)
+			_, ok := resp.(ChangeFundStatus200JSONResponse)
+			require.True(t, ok, "moving to %s", status)
+		}
+
+		reopenResp, err := handler.ChangeFundStatus(ctx, ChangeFundStatusRequestObject{
+			FundId: created.Id,
+			Body:   &ChangeFundStatusJSONRequestBody{Status: Open},
+		})
+		require.NoError(t, err)
+		invalid, ok := reopenResp.(ChangeFundStatus409JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, INVALIDSTATUSTRANSITION, invalid.Code)
+
+		missingResp, err := handler.ChangeFundStatus(ctx, ChangeFundStatusRequestObject{
+			FundId: uuid.New(),
+			Body:   &ChangeFundStatusJSONRequestBody{Status: Closed},
+		})
+		require.NoError(t, err)
+		_, ok = missingResp.(ChangeFundStatus404JSONResponse)
+		assert.True(t, ok)
+
+		_, err = handler.CreateFund(ctx, CreateFundRequestObject{
+			Body: &CreateFundJSONRequestBody{Name: "Still Open Fund", TotalUnits: 1000, InitialOwner: ptr("Founder")},
+		})
+		require.NoError(t, err)
+
+		liquidated := Liquidated
+		listResp, err := handler.ListFunds(ctx, ListFundsRequestObject{Params: ListFundsParams{Status: &liquidated}})
+		require.NoError(t, err)
+		list := listResp.(ListFunds200JSONResponse)
+		require.Len(t, list.Funds, 1)
+		assert.Equal(t, created.Id, list.Funds[0].Id)
+
+		open := Open
+		listResp, err = handler.ListFunds(ctx, ListFundsRequestObject{Params: ListFundsParams{Status: &open}})
+		require.NoError(t, err)
+		list = listResp.(ListFunds200JSONResponse)
+		require.Len(t, list.Funds, 1)
+		assert.Equal(t, "Still Open Fund", list.Funds[0].Name)
+	})
+
+	t.Run("Issuances and redemptions change total units and keep the cap table balanced", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		fundResp, err := handler.CreateFund(ctx, CreateFundRequestObject{
+			Body: &CreateFundJSONRequestBody{Name: "Evergreen Fund", TotalUnits: 1000, InitialOwner: ptr("Founder")},
+		})
+		require.NoError(t, err)
+		f := fundResp.(CreateFund201JSONResponse)
+
+		issueResp, err := handler.IssueUnits(ctx, IssueUnitsRequestObject{
+			FundId: f.Id,
+			Body:   &IssueUnitsJSONRequestBody{Owner: ptr("Investor C"), Units: 500},
+		})
+		require.NoError(t, err)
+		issued, ok := issueResp.(IssueUnits201JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, Issuance, issued.Kind)
+		assert.Equal(t, "Investor C", issued.OwnerName)
+		assert.Equal(t, 1500, issued.TotalUnits)
+
+		redeemResp, err := handler.RedeemUnits(ctx, RedeemUnitsRequestObject{
+			FundId: f.Id,
+			Body:   &RedeemUnitsJSONRequestBody{Owner: ptr("Founder"), Units: 200},
+		})
+		require.NoError(t, err)
+		redeemed, ok := redeemResp.(RedeemUnits201JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, 1300, redeemed.TotalUnits)
+
+		overResp, err := handler.RedeemUnits(ctx, RedeemUnitsRequestObject{
+			FundId: f.Id,
+			Body:   &RedeemUnitsJSONRequestBody{OwnerId: &issued.OwnerId, Units: 501},
+		})
+		require.NoError(t, err)
+		over, ok := overResp.(RedeemUnits400JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, INSUFFICIENTUNITS, over.Code)
+
+		strangerResp, err := handler.RedeemUnits(ctx, RedeemUnitsRequestObject{
+			FundId: f.Id,
+			Body:   &RedeemUnitsJSONRequestBody{Owner: ptr("Stranger"), Units: 1},
+		})
+		require.NoError(t, err)
+		_, ok = strangerResp.(RedeemUnits404JSONResponse)
+		assert.True(t, ok)
+
+		getResp, err := handler.GetFund(ctx, GetFundRequestObject{FundId: f.Id})
+		require.NoError(t, err)
+		assert.Equal(t, 1300, getResp.(GetFund200JSONResponse).TotalUnits)
+
+		capResp, err := handler.GetCapTable(ctx, GetCapTableRequestObject{FundId: f.Id})
+		require.NoError(t, err)
+		capTable := capResp.(GetCapTable200JSONResponse)
+		require.Len(t, capTable.Entries, 2)
+		assert.Equal(t, "Founder", capTable.Entries[0].OwnerName)
+		assert.Equal(t, 800, capTable.Entries[0].Units)
+		assert.Equal(t, 500, capTable.Entries[1].Units)
+
+		asOf := issued.OccurredAt
+		asOfResp, err := handler.GetCapTable(ctx, GetCapTableRequestObject{FundId: f.Id, Params: GetCapTableParams{AsOf: &asOf}})
+		require.NoError(t, err)
+		asOfTable := asOfResp.(GetCapTable200JSONResponse)
+		require.Len(t, asOfTable.Entries, 2)
+		assert.Equal(t, 1000, asOfTable.Entries[0].Units)
+		assert.InDelta(t, 66.667, asOfTable.Entries[0].Percentage, 0.001)
+
+		eventsResp, err := handler.ListUnitEvents(ctx, ListUnitEventsRequestObject{FundId: f.Id})
+		require.NoError(t, err)
+		events := eventsResp.(ListUnitEvents200JSONResponse)
+		require.Len(t, events.Events, 3)
+		assert.Equal(t, Issuance, events.Events[0].Kind)
+		assert.Equal(t, 1000, events.Events[0].Units)
+		assert.Equal(t, "Founder", events.Events[0].OwnerName)
+		assert.Equal(t, Redemption, events.Events[2].Kind)
+		assert.Equal(t, 1300, events.Events[2].TotalUnits)
+	})
+
+	t.Run("Share classes keep units and transfers within their class", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		fundResp, err := handler.CreateFund(ctx, CreateFundRequestObject{
+			Body: &CreateFundJSONRequestBody{Name: "Class Fund", TotalUnits: 1000, InitialOwner: ptr("Founder")},
+		})
+		require.NoError(t, err)
+		f := fundResp.(CreateFund201JSONResponse)
+
+		classResp, err := handler.CreateShareClass(ctx, CreateShareClassRequestObject{
+			FundId: f.Id,
+			Body:   &CreateShareClassJSONRequestBody{Name: "Class B", AuthorizedUnits: 500},
+		})
+		require.NoError(t, err)
+		classB, ok := classResp.(CreateShareClass201JSONResponse)
+		require.True(t, ok)
+		assert.False(t, classB.Default)
+		assert.Equal(t, 0, classB.OutstandingUnits)
+
+		dupResp, err := handler.CreateShareClass(ctx, CreateShareClassRequestObject{
+			FundId: f.Id,
+			Body:   &CreateShareClassJSONRequestBody{Name: "Class B", AuthorizedUnits: 10},
+		})
+		require.NoError(t, err)
+		dup, ok := dupResp.(CreateShareClass409JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, DUPLICATESHARECLASS, dup.Code)
+
+		overResp, err := handler.IssueUnits(ctx, IssueUnitsRequestObject{
+			FundId: f.Id,
+			Body:   &IssueUnitsJSONRequestBody{Owner: ptr("Investor B"), ClassId: &classB.Id, Units: 501},
+		})
+		require.NoError(t, err)
+		over, ok := overResp.(IssueUnits400JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, AUTHORIZEDUNITSEXCEEDED, over.Code)
+
+		issueResp, err := handler.IssueUnits(ctx, IssueUnitsRequestObject{
+			FundId: f.Id,
+			Body:   &IssueUnitsJSONRequestBody{Owner: ptr("Investor B"), ClassId: &classB.Id, Units: 500},
+		})
+		require.NoError(t, err)
+		issued, ok := issueResp.(IssueUnits201JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, classB.Id, issued.ClassId)
+		assert.Equal(t, "Class B", issued.ClassName)
+
+		wrongClassResp, err := handler.CreateTransfer(ctx, CreateTransferRequestObject{
+			FundId: f.Id,
+			Body:   &CreateTransferJSONRequestBody{FromOwner: ptr("Founder"), ToOwner: ptr("Investor B"), ClassId: &classB.Id, Units: 1},
+		})
+		require.NoError(t, err)
+		_, ok = wrongClassResp.(CreateTransfer404JSONResponse)
+		assert.True(t, ok)
+
+		missingClass := uuid.New()
+		missingResp, err := handler.CreateTransfer(ctx, CreateTransferRequestObject{
+			FundId: f.Id,
+			Body:   &CreateTransferJSONRequestBody{FromOwner: ptr("Investor B"), ToOwner: ptr("Founder"), ClassId: &missingClass, Units: 1},
+		})
+		require.NoError(t, err)
+		missing, ok := missingResp.(CreateTransfer404JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, SHARECLASSNOTFOUND, missing.Code)
+
+		transferResp, err := handler.CreateTransfer(ctx, CreateTransferRequestObject{
+			FundId: f.Id,
+			Body:   &CreateTransferJSONRequestBody{FromOwner: ptr("Investor B"), ToOwner: ptr("Founder"), ClassId: &classB.Id, Units: 100},
+		})
+		require.NoError(t, err)
+		moved, ok := transferResp.(CreateTransfer201JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, classB.Id, moved.ClassId)
+
+		capResp, err := handler.GetCapTable(ctx, GetCapTableRequestObject{FundId: f.Id})
+		require.NoError(t, err)
+		capTable := capResp.(GetCapTable200JSONResponse)
+		require.Len(t, capTable.Classes, 2)
+		assert.Equal(t, "Class A", capTable.Classes[0].Name)
+		assert.True(t, capTable.Classes[0].Default)
+		assert.Equal(t, 500, capTable.Classes[1].OutstandingUnits)
+		require.Len(t, capTable.Entries, 3)
+
+		assert.Equal(t, "Founder", capTable.Entries[0].OwnerName)
+		assert.Equal(t, "Class A", capTable.Entries[0].ClassName)
+		assert.Equal(t, 1000, capTable.Entries[0].Units)
+		assert.InDelta(t, 66.667, capTable.Entries[0].Percentage, 0.001)
+		assert.InDelta(t, 100.0, capTable.Entries[0].ClassPercentage, 0.001)
+
+		assert.Equal(t, "Investor B", capTable.Entries[1].OwnerName)
+		assert.Equal(t, 400, capTable.Entries[1].Units)
+		assert.InDelta(t, 26.667, capTable.Entries[1].Percentage, 0.001)
+		assert.InDelta(t, 80.0, capTable.Entries[1].ClassPercentage, 0.001)
+
+		assert.Equal(t, "Founder", capTable.Entries[2].OwnerName)
+		assert.Equal(t, classB.Id, capTable.Entries[2].ClassId)
+		assert.Equal(t, 100, capTable.Entries[2].Units)
+		assert.InDelta(t, 20.0, capTable.Entries[2].ClassPercentage, 0.001)
+
+		verifyResp, err := handler.VerifyLedger(ctx, VerifyLedgerRequestObject{FundId: f.Id})
+		require.NoError(t, err)
+		assert.True(t, verifyResp.(VerifyLedger200JSONResponse).Valid)
+	})
+
+	t.Run("Exports stream the whole cap table and ledger", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		fundResp, err := handler.CreateFund(ctx, CreateFundRequestObject{
+			Body: &CreateFundJSONRequestBody{Name: "Export Fund", TotalUnits: 1000, InitialOwner: ptr("Founder")},
+		})
+		require.NoError(t, err)
+		f := fundResp.(CreateFund201JSONResponse)
+
+		for _, to := range []string{"Alice", "Bob", "Carol"} {
+			resp, err := handler.CreateTransfer(ctx, CreateTransferRequestObject{
+				FundId: f.Id,
+				Body:   &CreateTransferJSONRequestBody{FromOwner: ptr("Founder"), ToOwner: ptr(to), Units: 100},
+			})
+			require.NoError(t, err)
+			_, ok := resp.(CreateTransfer201JSONResponse)
+			require.True(t, ok)
+		}
+
+		capResp, err := handler.ExportCapTable(ctx, ExportCapTableRequestObject{FundId: f.Id})
+		require.NoError(t, err)
+		rec := httptest.NewRecorder()
+		require.NoError(t, capResp.VisitExportCapTableResponse(rec))
+		assert.Equal(t, "text/csv", rec.Header().Get("Content-Type"))
+
+		records, err := csv.NewReader(rec.Body).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 5)
+		assert.Equal(t, []string{"ownerId", "ownerName", "classId", "className", "units", "percentage", "classPercentage", "acquiredAt"}, records[0])
+		assert.Equal(t, []string{"Founder"}, records[1][1:2])
+		assert.Equal(t, []string{"Class A", "700", "70", "70"}, records[1][3:7])
+		assert.Equal(t, "Alice", records[2][1])
+
+		transferResp, err := handler.ExportTransfers(ctx, ExportTransfersRequestObject{
+			FundId: f.Id,
+			Params: ExportTransfersParams{Sort: ptr(Desc), Accept: ptr("application/x-ndjson")},
+		})
+		require.NoError(t, err)
+		rec = httptest.NewRecorder()
+		require.NoError(t, transferResp.VisitExportTransfersResponse(rec))
+		assert.Equal(t, "application/x-ndjson", rec.Header().Get("Content-Type"))
+
+		lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+		require.Len(t, lines, 3)
+		assert.Contains(t, lines[0], `"toOwner":"Carol"`)
+		assert.Contains(t, lines[2], `"toOwner":"Alice"`)
+
+		badResp, err := handler.ExportTransfers(ctx, ExportTransfersRequestObject{
+			FundId: f.Id,
+			Params: ExportTransfersParams{MinUnits: ptr(10), MaxUnits: ptr(1)},
+		})
+		require.NoError(t, err)
+		_, ok := badResp.(ExportTransfers400JSONResponse)
+		assert.True(t, ok)
+
+		missingResp, err := handler.ExportCapTable(ctx, ExportCapTableRequestObject{FundId: uuid.New()})
+		require.NoError(t, err)
+		_, ok = missingResp.(ExportCapTable404JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("Distributions split an amount pro rata and can be fetched and exported", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		fundResp, err := handler.CreateFund(ctx, CreateFundRequestObject{
+			Body: &CreateFundJSONRequestBody{Name: "Distribution Fund", TotalUnits: 3, InitialOwner: ptr("Founder")},
+		})
+		require.NoError(t, err)
+		f := fundResp.(CreateFund201JSONResponse)
+
+		transferResp, err := handler.CreateTransfer(ctx, CreateTransferRequestObject{
+			FundId: f.Id,
+			Body:   &CreateTransferJSONRequestBody{FromOwner: ptr("Founder"), ToOwner: ptr("Alice"), Units: 1},
+		})
+		require.NoError(t, err)
+		_, ok := transferResp.(CreateTransfer201JSONResponse)
+		require.True(t, ok)
+
+		createResp, err := handler.CreateDistribution(ctx, CreateDistributionRequestObject{
+			FundId: f.Id,
+			Body:   &CreateDistributionJSONRequestBody{TotalAmount: 1000000},
+		})
+		require.NoError(t, err)
+		created, ok := createResp.(CreateDistribution201JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, 3, created.TotalUnits)
+		assert.Equal(t, 2, created.Holders)
+		require.NotNil(t, created.LineItems)
+		items := *created.LineItems
+		require.Len(t, items, 2)
+		assert.Equal(t, "Founder", items[0].OwnerName)
+		assert.Equal(t, int64(666667), items[0].Amount)
+		assert.InDelta(t, 66.667, items[0].Percentage, 0.001)
+		assert.Equal(t, "Alice", items[1].OwnerName)
+		assert.Equal(t, int64(333333), items[1].Amount)
+
+		getResp, err := handler.GetDistribution(ctx, GetDistributionRequestObject{FundId: f.Id, DistributionId: created.Id})
+		require.NoError(t, err)
+		got, ok := getResp.(GetDistribution200JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, items, *got.LineItems)
+
+		listResp, err := handler.ListDistributions(ctx, ListDistributionsRequestObject{FundId: f.Id})
+		require.NoError(t, err)
+		list, ok := listResp.(ListDistributions200JSONResponse)
+		require.True(t, ok)
+		require.Len(t, list.Distributions, 1)
+		assert.Equal(t, created.Id, list.Distributions[0].Id)
+		assert.Nil(t, list.Distributions[0].LineItems)
+
+		exportResp, err := handler.ExportDistribution(ctx, ExportDistributionRequestObject{FundId: f.Id, DistributionId: created.Id})
+		require.NoError(t, err)
+		rec := httptest.NewRecorder()
+		require.NoError(t, exportResp.VisitExportDistributionResponse(rec))
+		assert.Equal(t, "text/csv", rec.Header().Get("Content-Type"))
+		records, err := csv.NewReader(rec.Body).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 3)
+		assert.Equal(t, []string{"ownerId", "ownerName", "units", "percentage", "amount"}, records[0])
+		assert.Equal(t, []string{"Founder", "2"}, records[1][1:3])
+		assert.Equal(t, "666667", records[1][4])
+
+		future := time.Now().Add(time.Hour)
+		futureResp, err := handler.CreateDistribution(ctx, CreateDistributionRequestObject{
+			FundId: f.Id,
+			Body:   &CreateDistributionJSONRequestBody{TotalAmount: 100, RecordDate: &future},
+		})
+		require.NoError(t, err)
+		_, ok = futureResp.(CreateDistribution400JSONResponse)
+		assert.True(t, ok)
+
+		missingFundResp, err := handler.CreateDistribution(ctx, CreateDistributionRequestObject{
+			FundId: uuid.New(),
+			Body:   &CreateDistributionJSONRequestBody{TotalAmount: 100},
+		})
+		require.NoError(t, err)
+		_, ok = missingFundResp.(CreateDistribution404JSONResponse)
+		assert.True(t, ok)
+
+		missingResp, err := handler.GetDistribution(ctx, GetDistributionRequestObject{FundId: f.Id, DistributionId: uuid.New()})
+		require.NoError(t, err)
+		missing, ok := missingResp.(GetDistribution404JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, DISTRIBUTIONNOTFOUND, missing.Code)
+	})
+
+	t.Run("Commitments are called pro rata and track unfunded balances", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		fundResp, err := handler.CreateFund(ctx, CreateFundRequestObject{
+			Body: &CreateFundJSONRequestBody{Name: "Commitment Fund", TotalUnits: 1000, InitialOwner: ptr("Founder")},
+		})
+		require.NoError(t, err)
+		f := fundResp.(CreateFund201JSONResponse)
+
+		aliceResp, err := handler.CreateCommitment(ctx, CreateCommitmentRequestObject{
+			FundId: f.Id,
+			Body:   &CreateCommitmentJSONRequestBody{Owner: ptr("Alice"), Amount: 6000},
+		})
+		require.NoError(t, err)
+		alice, ok := aliceResp.(CreateCommitment201JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, int64(6000), alice.UnfundedAmount)
+		assert.Equal(t, 0, alice.Units)
+
+		_, err = handler.CreateCommitment(ctx, CreateCommitmentRequestObject{
+			FundId: f.Id,
+			Body:   &CreateCommitmentJSONRequestBody{Owner: ptr("Founder"), Amount: 4000},
+		})
+		require.NoError(t, err)
+
+		dupResp, err := handler.CreateCommitment(ctx, CreateCommitmentRequestObject{
+			FundId: f.Id,
+			Body:   &CreateCommitmentJSONRequestBody{OwnerId: &alice.OwnerId, Amount: 100},
+		})
+		require.NoError(t, err)
+		dup, ok := dupResp.(CreateCommitment409JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, DUPLICATECOMMITMENT, dup.Code)
+
+		capResp, err := handler.GetCapTable(ctx, GetCapTableRequestObject{FundId: f.Id})
+		require.NoError(t, err)
+		capTable, ok := capResp.(GetCapTable200JSONResponse)
+		require.True(t, ok)
+		require.Len(t, capTable.Entries, 1)
+		assert.Equal(t, "Founder", capTable.Entries[0].OwnerName)
+
+		callResp, err := handler.CreateCapitalCall(ctx, CreateCapitalCallRequestObject{
+			FundId: f.Id,
+			Body:   &CreateCapitalCallJSONRequestBody{TotalAmount: 2500},
+		})
+		require.NoError(t, err)
+		call, ok := callResp.(CreateCapitalCall201JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, 2, call.Investors)
+		require.NotNil(t, call.LineItems)
+		items := *call.LineItems
+		require.Len(t, items, 2)
+		assert.Equal(t, "Alice", items[0].OwnerName)
+		assert.Equal(t, int64(1500), items[0].Amount)
+		assert.Equal(t, int64(4500), items[0].UnfundedAmount)
+
+		getResp, err := handler.GetCapitalCall(ctx, GetCapitalCallRequestObject{FundId: f.Id, CapitalCallId: call.Id})
+		require.NoError(t, err)
+		got, ok := getResp.(GetCapitalCall200JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, items, *got.LineItems)
+
+		listResp, err := handler.ListCommitments(ctx, ListCommitmentsRequestObject{FundId: f.Id})
+		require.NoError(t, err)
+		list, ok := listResp.(ListCommitments200JSONResponse)
+		require.True(t, ok)
+		require.Len(t, list.Commitments, 2)
+		assert.Equal(t, int64(1500), list.Commitments[0].CalledAmount)
+		assert.Equal(t, int64(3000), list.Commitments[1].UnfundedAmount)
+		assert.Equal(t, 1000, list.Commitments[1].Units)
+
+		callsResp, err := handler.ListCapitalCalls(ctx, ListCapitalCallsRequestObject{FundId: f.Id})
+		require.NoError(t, err)
+		calls, ok := callsResp.(ListCapitalCalls200JSONResponse)
+		require.True(t, ok)
+		require.Len(t, calls.CapitalCalls, 1)
+		assert.Nil(t, calls.CapitalCalls[0].LineItems)
+
+		overResp, err := handler.CreateCapitalCall(ctx, CreateCapitalCallRequestObject{
+			FundId: f.Id,
+			Body:   &CreateCapitalCallJSONRequestBody{TotalAmount: 7501},
+		})
+		require.NoError(t, err)
+		over, ok := overResp.(CreateCapitalCall400JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, UNFUNDEDCOMMITMENTEXCEEDED, over.Code)
+
+		missingResp, err := handler.GetCapitalCall(ctx, GetCapitalCallRequestObject{FundId: f.Id, CapitalCallId: uuid.New()})
+		require.NoError(t, err)
+		missing, ok := missingResp.(GetCapitalCall404JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, CAPITALCALLNOTFOUND, missing.Code)
+	})
 }
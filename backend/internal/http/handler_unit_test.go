@@ -5,9 +5,18 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/arowden/augment-fund/internal/auth"
+	"github.com/arowden/augment-fund/internal/outbox"
+	"github.com/arowden/augment-fund/internal/ownership"
 	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -89,7 +98,7 @@ func TestCreateFund_NilService(t *testing.T) {
 		Body: &CreateFundJSONRequestBody{
 			Name:         "Test Fund",
 			TotalUnits:   1000,
-			InitialOwner: "Owner",
+			InitialOwner: ptr("Owner"),
 		},
 	})
 	require.NoError(t, err)
@@ -101,6 +110,47 @@ func TestCreateFund_NilService(t *testing.T) {
 }
 
 
+func TestImportFund_NilService(t *testing.T) {
+	h := NewAPIHandler()
+
+	resp, err := h.ImportFund(context.Background(), ImportFundRequestObject{
+		JSONBody: &ImportFundJSONRequestBody{Name: "Test Fund", TotalUnits: 10, Rows: []ImportRow{{Owner: ptr("Owner"), Units: 10}}},
+	})
+	require.NoError(t, err)
+
+	errResp, ok := resp.(ImportFund500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, errResp.Message, "fund service not configured")
+}
+
+func TestChangeFundStatus_NilService(t *testing.T) {
+	h := NewAPIHandler()
+
+	resp, err := h.ChangeFundStatus(context.Background(), ChangeFundStatusRequestObject{
+		FundId: uuid.New(),
+		Body:   &ChangeFundStatusJSONRequestBody{Status: Closed},
+	})
+	require.NoError(t, err)
+
+	errResp, ok := resp.(ChangeFundStatus500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, errResp.Message, "fund service not configured")
+}
+
+func TestIssueUnits_NilService(t *testing.T) {
+	h := NewAPIHandler()
+
+	resp, err := h.IssueUnits(context.Background(), IssueUnitsRequestObject{
+		FundId: uuid.New(),
+		Body:   &IssueUnitsJSONRequestBody{Owner: ptr("Owner"), Units: 10},
+	})
+	require.NoError(t, err)
+
+	errResp, ok := resp.(IssueUnits500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, errResp.Message, "fund service not configured")
+}
+
 func TestGetFund_NilService(t *testing.T) {
 	h := NewAPIHandler()
 
@@ -125,6 +175,108 @@ func TestGetCapTable_NilService(t *testing.T) {
 	assert.Contains(t, errResp.Message, "ownership service not configured")
 }
 
+func TestShareClasses_NilService(t *testing.T) {
+	h := NewAPIHandler()
+
+	list, err := h.ListShareClasses(context.Background(), ListShareClassesRequestObject{})
+	require.NoError(t, err)
+	listErr, ok := list.(ListShareClasses500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, listErr.Message, "ownership service not configured")
+
+	create, err := h.CreateShareClass(context.Background(), CreateShareClassRequestObject{})
+	require.NoError(t, err)
+	createErr, ok := create.(CreateShareClass500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, createErr.Message, "ownership service not configured")
+}
+
+func TestDistributions_NilService(t *testing.T) {
+	h := NewAPIHandler()
+
+	list, err := h.ListDistributions(context.Background(), ListDistributionsRequestObject{})
+	require.NoError(t, err)
+	listErr, ok := list.(ListDistributions500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, listErr.Message, "distribution service not configured")
+
+	create, err := h.CreateDistribution(context.Background(), CreateDistributionRequestObject{})
+	require.NoError(t, err)
+	createErr, ok := create.(CreateDistribution500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, createErr.Message, "distribution service not configured")
+
+	get, err := h.GetDistribution(context.Background(), GetDistributionRequestObject{})
+	require.NoError(t, err)
+	getErr, ok := get.(GetDistribution500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, getErr.Message, "distribution service not configured")
+
+	export, err := h.ExportDistribution(context.Background(), ExportDistributionRequestObject{})
+	require.NoError(t, err)
+	exportErr, ok := export.(ExportDistribution500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, exportErr.Message, "distribution service not configured")
+}
+
+func TestCommitments_NilService(t *testing.T) {
+	h := NewAPIHandler()
+
+	list, err := h.ListCommitments(context.Background(), ListCommitmentsRequestObject{})
+	require.NoError(t, err)
+	listErr, ok := list.(ListCommitments500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, listErr.Message, "commitment service not configured")
+
+	create, err := h.CreateCommitment(context.Background(), CreateCommitmentRequestObject{})
+	require.NoError(t, err)
+	createErr, ok := create.(CreateCommitment500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, createErr.Message, "commitment service not configured")
+
+	calls, err := h.ListCapitalCalls(context.Background(), ListCapitalCallsRequestObject{})
+	require.NoError(t, err)
+	callsErr, ok := calls.(ListCapitalCalls500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, callsErr.Message, "commitment service not configured")
+
+	call, err := h.CreateCapitalCall(context.Background(), CreateCapitalCallRequestObject{})
+	require.NoError(t, err)
+	callErr, ok := call.(CreateCapitalCall500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, callErr.Message, "commitment service not configured")
+
+	get, err := h.GetCapitalCall(context.Background(), GetCapitalCallRequestObject{})
+	require.NoError(t, err)
+	getErr, ok := get.(GetCapitalCall500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, getErr.Message, "commitment service not configured")
+}
+
+func TestCreateTransferBatch_NilService(t *testing.T) {
+	h := NewAPIHandler()
+
+	resp, err := h.CreateTransferBatch(context.Background(), CreateTransferBatchRequestObject{})
+	require.NoError(t, err)
+
+	errResp, ok := resp.(CreateTransferBatch500JSONResponse)
+	require.True(t, ok)
+	assert.Equal(t, INTERNALERROR, errResp.Code)
+	assert.Contains(t, errResp.Message, "transfer service not configured")
+}
+
+func TestGetReconciliation_NilService(t *testing.T) {
+	h := NewAPIHandler()
+
+	resp, err := h.GetReconciliation(context.Background(), GetReconciliationRequestObject{})
+	require.NoError(t, err)
+
+	errResp, ok := resp.(GetReconciliation500JSONResponse)
+	require.True(t, ok)
+	assert.Equal(t, INTERNALERROR, errResp.Code)
+	assert.Contains(t, errResp.Message, "reconciliation service not configured")
+}
+
 func TestListTransfers_NilService(t *testing.T) {
 	h := NewAPIHandler()
 
@@ -142,8 +294,8 @@ func TestCreateTransfer_NilService(t *testing.T) {
 
 	resp, err := h.CreateTransfer(context.Background(), CreateTransferRequestObject{
 		Body: &CreateTransferJSONRequestBody{
-			FromOwner: "Alice",
-			ToOwner:   "Bob",
+			FromOwner: ptr("Alice"),
+			ToOwner:   ptr("Bob"),
 			Units:     100,
 		},
 	})
@@ -155,6 +307,393 @@ func TestCreateTransfer_NilService(t *testing.T) {
 	assert.Contains(t, errResp.Message, "transfer service not configured")
 }
 
+func TestReverseTransfer_NilService(t *testing.T) {
+	h := NewAPIHandler()
+
+	resp, err := h.ReverseTransfer(context.Background(), ReverseTransferRequestObject{})
+	require.NoError(t, err)
+
+	errResp, ok := resp.(ReverseTransfer500JSONResponse)
+	require.True(t, ok)
+	assert.Equal(t, INTERNALERROR, errResp.Code)
+	assert.Contains(t, errResp.Message, "transfer service not configured")
+}
+
+
+func TestApprovalHandlers_NilService(t *testing.T) {
+	h := NewAPIHandler()
+	ctx := context.Background()
+
+	pendingResp, err := h.ListPendingTransfers(ctx, ListPendingTransfersRequestObject{})
+	require.NoError(t, err)
+	pending, ok := pendingResp.(ListPendingTransfers500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, pending.Message, "transfer service not configured")
+
+	approveResp, err := h.ApproveTransfer(ctx, ApproveTransferRequestObject{Body: &ApproveTransferJSONRequestBody{ReviewedBy: ptr("Compliance")}})
+	require.NoError(t, err)
+	approve, ok := approveResp.(ApproveTransfer500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, approve.Message, "transfer service not configured")
+
+	rejectResp, err := h.RejectTransfer(ctx, RejectTransferRequestObject{Body: &RejectTransferJSONRequestBody{ReviewedBy: ptr("Compliance")}})
+	require.NoError(t, err)
+	reject, ok := rejectResp.(RejectTransfer500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, reject.Message, "transfer service not configured")
+
+	thresholdResp, err := h.SetApprovalThreshold(ctx, SetApprovalThresholdRequestObject{Body: &SetApprovalThresholdJSONRequestBody{}})
+	require.NoError(t, err)
+	threshold, ok := thresholdResp.(SetApprovalThreshold500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, threshold.Message, "fund service not configured")
+}
+
+func TestWebhookHandlers_NilService(t *testing.T) {
+	h := NewAPIHandler()
+	ctx := context.Background()
+
+	listResp, err := h.ListWebhooks(ctx, ListWebhooksRequestObject{})
+	require.NoError(t, err)
+	list, ok := listResp.(ListWebhooks500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, list.Message, "webhook service not configured")
+
+	createResp, err := h.CreateWebhook(ctx, CreateWebhookRequestObject{Body: &CreateWebhookJSONRequestBody{Url: "https://example.com/hooks"}})
+	require.NoError(t, err)
+	create, ok := createResp.(CreateWebhook500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, create.Message, "webhook service not configured")
+
+	deleteResp, err := h.DeleteWebhook(ctx, DeleteWebhookRequestObject{})
+	require.NoError(t, err)
+	del, ok := deleteResp.(DeleteWebhook500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, del.Message, "webhook service not configured")
+
+	deliveriesResp, err := h.ListWebhookDeliveries(ctx, ListWebhookDeliveriesRequestObject{})
+	require.NoError(t, err)
+	deliveries, ok := deliveriesResp.(ListWebhookDeliveries500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, deliveries.Message, "webhook service not configured")
+
+	retryResp, err := h.RetryWebhookDelivery(ctx, RetryWebhookDeliveryRequestObject{})
+	require.NoError(t, err)
+	retry, ok := retryResp.(RetryWebhookDelivery500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, retry.Message, "webhook service not configured")
+}
+
+func TestApiKeyHandlers_NilService(t *testing.T) {
+	h := NewAPIHandler()
+	ctx := context.Background()
+
+	listResp, err := h.ListApiKeys(ctx, ListApiKeysRequestObject{})
+	require.NoError(t, err)
+	list, ok := listResp.(ListApiKeys500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, list.Message, "auth service not configured")
+
+	createResp, err := h.CreateApiKey(ctx, CreateApiKeyRequestObject{Body: &CreateApiKeyJSONRequestBody{Name: "ci", Role: Viewer}})
+	require.NoError(t, err)
+	create, ok := createResp.(CreateApiKey500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, create.Message, "auth service not configured")
+
+	revokeResp, err := h.RevokeApiKey(ctx, RevokeApiKeyRequestObject{})
+	require.NoError(t, err)
+	revoke, ok := revokeResp.(RevokeApiKey500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, revoke.Message, "auth service not configured")
+}
+
+func TestOwnerHandlers_NilService(t *testing.T) {
+	h := NewAPIHandler()
+	ctx := context.Background()
+
+	listResp, err := h.ListOwners(ctx, ListOwnersRequestObject{})
+	require.NoError(t, err)
+	list, ok := listResp.(ListOwners500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, list.Message, "owner service not configured")
+
+	createResp, err := h.CreateOwner(ctx, CreateOwnerRequestObject{Body: &CreateOwnerJSONRequestBody{LegalName: "Acme, LLC"}})
+	require.NoError(t, err)
+	create, ok := createResp.(CreateOwner500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, create.Message, "owner service not configured")
+
+	getResp, err := h.GetOwner(ctx, GetOwnerRequestObject{OwnerId: uuid.New()})
+	require.NoError(t, err)
+	get, ok := getResp.(GetOwner500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, get.Message, "owner service not configured")
+
+	updateResp, err := h.UpdateOwner(ctx, UpdateOwnerRequestObject{OwnerId: uuid.New(), Body: &UpdateOwnerJSONRequestBody{}})
+	require.NoError(t, err)
+	update, ok := updateResp.(UpdateOwner500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, update.Message, "owner service not configured")
+
+	holdingsResp, err := h.GetOwnerHoldings(ctx, GetOwnerHoldingsRequestObject{OwnerId: uuid.New()})
+	require.NoError(t, err)
+	holdings, ok := holdingsResp.(GetOwnerHoldings500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, holdings.Message, "not configured")
+}
+
+func TestVerifyLedger_NilService(t *testing.T) {
+	h := NewAPIHandler()
+
+	resp, err := h.VerifyLedger(context.Background(), VerifyLedgerRequestObject{FundId: uuid.New()})
+	require.NoError(t, err)
+	errResp, ok := resp.(VerifyLedger500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, errResp.Message, "transfer service not configured")
+}
+
+func TestListAuditEvents_NilService(t *testing.T) {
+	h := NewAPIHandler()
+
+	resp, err := h.ListAuditEvents(context.Background(), ListAuditEventsRequestObject{})
+	require.NoError(t, err)
+	errResp, ok := resp.(ListAuditEvents500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, errResp.Message, "audit service not configured")
+}
+
+func TestOperationPermission_CoversEveryOperation(t *testing.T) {
+	iface := reflect.TypeOf((*StrictServerInterface)(nil)).Elem()
+	for i := 0; i < iface.NumMethod(); i++ {
+		name := iface.Method(i).Name
+		_, ok := operationPermissions[name]
+		assert.True(t, ok, "operation %s has no permission mapping", name)
+	}
+	assert.Equal(t, auth.PermissionAdminister, OperationPermission("Unknown"))
+}
+
+func TestAuthMiddleware_SetsPrincipal(t *testing.T) {
+	principal := &auth.Principal{Subject: "api_key:1", Name: "ci", Role: auth.RoleOperator}
+	keys := keyFunc(func(ctx context.Context, rawKey string) (*auth.Principal, error) {
+		if rawKey != "afk_valid" {
+			return nil, auth.ErrUnauthenticated
+		}
+		return principal, nil
+	})
+
+	var got *auth.Principal
+	next := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		got, _ = auth.PrincipalFromContext(ctx)
+		return "ok", nil
+	}
+	handler := NewAuthMiddleware(WithAPIKeys(keys))(next, "CreateTransfer")
+
+	req := httptest.NewRequest(http.MethodPost, "/funds/x/transfers", nil)
+	req.Header.Set(APIKeyHeader, "afk_valid")
+	resp, err := handler(req.Context(), httptest.NewRecorder(), req, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+	assert.Same(t, principal, got)
+
+	t.Run("returns 500 when the key store fails", func(t *testing.T) {
+		failing := keyFunc(func(ctx context.Context, rawKey string) (*auth.Principal, error) {
+			return nil, errors.New("db down")
+		})
+		rec := httptest.NewRecorder()
+		resp, err := NewAuthMiddleware(WithAPIKeys(failing))(next, "ListFunds")(req.Context(), rec, req, nil)
+		require.NoError(t, err)
+		assert.Nil(t, resp)
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.NotContains(t, rec.Body.String(), "db down")
+	})
+}
+
+type keyFunc func(ctx context.Context, rawKey string) (*auth.Principal, error)
+
+func (f keyFunc) Authenticate(ctx context.Context, rawKey string) (*auth.Principal, error) {
+	return f(ctx, rawKey)
+}
+
+func TestStreamFundEvents_NilService(t *testing.T) {
+	h := NewAPIHandler()
+
+	resp, err := h.StreamFundEvents(context.Background(), StreamFundEventsRequestObject{})
+	require.NoError(t, err)
+
+	errResp, ok := resp.(StreamFundEvents500JSONResponse)
+	require.True(t, ok)
+	assert.Equal(t, INTERNALERROR, errResp.Code)
+	assert.Contains(t, errResp.Message, "event service not configured")
+}
+
+type stubEventReader struct {
+	events []*outbox.Event
+}
+
+func (r *stubEventReader) ListSince(ctx context.Context, fundID uuid.UUID, after int64, limit int) ([]*outbox.Event, error) {
+	var events []*outbox.Event
+	for _, e := range r.events {
+		if e.Sequence > after {
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
+
+func (r *stubEventReader) LatestSequence(ctx context.Context, fundID uuid.UUID) (int64, error) {
+	return 0, nil
+}
+
+func TestStreamFundEvents_WritesServerSentEvents(t *testing.T) {
+	fundID := uuid.New()
+	reader := &stubEventReader{events: []*outbox.Event{
+		{Sequence: 7, ID: uuid.New(), Type: outbox.EventTransferExecuted, FundID: fundID, Payload: []byte(`{"units":10}`)},
+		{Sequence: 8, ID: uuid.New(), Type: outbox.EventBalanceChanged, FundID: fundID, Payload: []byte(`{"units":90}`)},
+	}}
+	svc, err := outbox.NewService(outbox.WithRepository(reader), outbox.WithNotifier(outbox.NewBroker(nil, nil)))
+	require.NoError(t, err)
+	h := NewAPIHandler(WithEventService(svc))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	after := int64(7)
+	resp, err := h.StreamFundEvents(ctx, StreamFundEventsRequestObject{FundId: fundID, Params: StreamFundEventsParams{LastEventID: &after}})
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	done := make(chan error, 1)
+	go func() { done <- resp.VisitStreamFundEventsResponse(rec) }()
+
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	require.NoError(t, <-done)
+
+	assert.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+	body := rec.Body.String()
+	assert.NotContains(t, body, "id: 7\n")
+	assert.Contains(t, body, "id: 8\nevent: balance.changed\ndata: {")
+	assert.Contains(t, body, `"data":{"units":90}`)
+}
+
+func TestExportHandlers_NilService(t *testing.T) {
+	h := NewAPIHandler()
+	ctx := context.Background()
+
+	capResp, err := h.ExportCapTable(ctx, ExportCapTableRequestObject{FundId: uuid.New()})
+	require.NoError(t, err)
+	capErr, ok := capResp.(ExportCapTable500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, capErr.Message, "ownership service not configured")
+
+	transferResp, err := h.ExportTransfers(ctx, ExportTransfersRequestObject{FundId: uuid.New()})
+	require.NoError(t, err)
+	transferErr, ok := transferResp.(ExportTransfers500JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, transferErr.Message, "transfer service not configured")
+}
+
+func TestNegotiateExport(t *testing.T) {
+	tests := []struct {
+		name   string
+		accept *string
+		want   exportFormat
+		ok     bool
+	}{
+		{"missing header defaults to csv", nil, exportCSV, true},
+		{"wildcard defaults to csv", ptr("*/*"), exportCSV, true},
+		{"csv", ptr("text/csv"), exportCSV, true},
+		{"ndjson", ptr("application/x-ndjson"), exportNDJSON, true},
+		{"highest quality wins", ptr("text/csv;q=0.5, application/x-ndjson"), exportNDJSON, true},
+		{"explicit type beats lower-quality wildcard", ptr("application/x-ndjson, */*;q=0.1"), exportNDJSON, true},
+		{"zero quality is refused", ptr("text/csv;q=0"), "", false},
+		{"unsupported type", ptr("application/pdf"), "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := negotiateExport(tt.accept)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCSVText_NeutralisesFormulas(t *testing.T) {
+	assert.Equal(t, "Investor A", csvText("Investor A"))
+	assert.Equal(t, "'=HYPERLINK(\"x\")", csvText("=HYPERLINK(\"x\")"))
+	assert.Equal(t, "'+1", csvText("+1"))
+	assert.Equal(t, "", csvText(""))
+}
+
+type stubCapTableRepository struct {
+	ownership.Repository
+	classes []*ownership.ShareClass
+	entries []*ownership.Entry
+}
+
+func (r *stubCapTableRepository) ListShareClasses(ctx context.Context, fundID uuid.UUID) ([]*ownership.ShareClass, error) {
+	return r.classes, nil
+}
+
+func (r *stubCapTableRepository) StreamByFundID(ctx context.Context, fundID uuid.UUID, fn func(*ownership.Entry) error) error {
+	for _, e := range r.entries {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestExportCapTable_StreamsRows(t *testing.T) {
+	fundID := uuid.New()
+	acquired := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	classA := &ownership.ShareClass{ID: uuid.New(), FundID: fundID, Name: "Class A", AuthorizedUnits: 1000, OutstandingUnits: 800, Default: true}
+	classB := &ownership.ShareClass{ID: uuid.New(), FundID: fundID, Name: "Class B", AuthorizedUnits: 500, OutstandingUnits: 200}
+	repo := &stubCapTableRepository{
+		classes: []*ownership.ShareClass{classA, classB},
+		entries: []*ownership.Entry{
+			{OwnerID: uuid.New(), FundID: fundID, OwnerName: "Founder, LLC", ClassID: classA.ID, ClassName: classA.Name, Units: 600, AcquiredAt: acquired},
+			{OwnerID: uuid.New(), FundID: fundID, OwnerName: "=cmd", ClassID: classB.ID, ClassName: classB.Name, Units: 200, AcquiredAt: acquired},
+		},
+	}
+	svc, err := ownership.NewService(ownership.WithRepository(repo))
+	require.NoError(t, err)
+	h := NewAPIHandler(WithOwnershipService(svc))
+
+	export := func(accept *string) *httptest.ResponseRecorder {
+		resp, err := h.ExportCapTable(context.Background(), ExportCapTableRequestObject{FundId: fundID, Params: ExportCapTableParams{Accept: accept}})
+		require.NoError(t, err)
+		rec := httptest.NewRecorder()
+		require.NoError(t, resp.VisitExportCapTableResponse(rec))
+		return rec
+	}
+
+	t.Run("csv", func(t *testing.T) {
+		rec := export(nil)
+		assert.Equal(t, "text/csv", rec.Header().Get("Content-Type"))
+		assert.Contains(t, rec.Header().Get("Content-Disposition"), "cap-table-"+fundID.String()+".csv")
+		lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+		require.Len(t, lines, 3)
+		assert.Equal(t, "ownerId,ownerName,classId,className,units,percentage,classPercentage,acquiredAt", lines[0])
+		assert.Contains(t, lines[1], `"Founder, LLC",`+classA.ID.String()+",Class A,600,0,75,")
+		assert.Contains(t, lines[2], ",'=cmd,"+classB.ID.String()+",Class B,200,0,100,")
+	})
+
+	t.Run("ndjson", func(t *testing.T) {
+		rec := export(ptr("application/x-ndjson"))
+		assert.Equal(t, "application/x-ndjson", rec.Header().Get("Content-Type"))
+		lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+		require.Len(t, lines, 2)
+		assert.Contains(t, lines[0], `"ownerName":"Founder, LLC"`)
+		assert.Contains(t, lines[1], `"ownerName":"=cmd"`)
+	})
+
+	t.Run("unsupported type", func(t *testing.T) {
+		resp, err := h.ExportCapTable(context.Background(), ExportCapTableRequestObject{FundId: fundID, Params: ExportCapTableParams{Accept: ptr("application/pdf")}})
+		require.NoError(t, err)
+		errResp, ok := resp.(ExportCapTable406JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, NOTACCEPTABLE, errResp.Code)
+	})
+}
 
 func TestLogError(t *testing.T) {
 	var buf bytes.Buffer
@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/go-chi/chi/v5"
@@ -14,78 +16,182 @@ import (
 )
 
 const (
-	DUPLICATETRANSFER ErrorCode = "DUPLICATE_TRANSFER"
-	FUNDNOTFOUND      ErrorCode = "FUND_NOT_FOUND"
-	INSUFFICIENTUNITS ErrorCode = "INSUFFICIENT_UNITS"
-	INTERNALERROR     ErrorCode = "INTERNAL_ERROR"
-	INVALIDFUND       ErrorCode = "INVALID_FUND"
-	INVALIDREQUEST    ErrorCode = "INVALID_REQUEST"
-	OWNERNOTFOUND     ErrorCode = "OWNER_NOT_FOUND"
-	SELFTRANSFER      ErrorCode = "SELF_TRANSFER"
+	ApiKeyAuthScopes = "ApiKeyAuth.Scopes"
+	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
-type CapTable struct {
-	Entries []CapTableEntry `json:"entries"`
+const (
+	Admin    ApiKeyRole = "admin"
+	Operator ApiKeyRole = "operator"
+	Viewer   ApiKeyRole = "viewer"
+)
 
-	FundId openapi_types.UUID `json:"fundId"`
+const (
+	AuditEventOperationCapitalCallCreate  AuditEventOperation = "capital_call.create"
+	AuditEventOperationCommitmentCreate   AuditEventOperation = "commitment.create"
+	AuditEventOperationDatabaseReset      AuditEventOperation = "database.reset"
+	AuditEventOperationDistributionCreate AuditEventOperation = "distribution.create"
+	AuditEventOperationFundCreate         AuditEventOperation = "fund.create"
+	AuditEventOperationFundImport         AuditEventOperation = "fund.import"
+	AuditEventOperationFundStatus         AuditEventOperation = "fund.status"
+	AuditEventOperationTransferApprove    AuditEventOperation = "transfer.approve"
+	AuditEventOperationTransferBatch      AuditEventOperation = "transfer.batch"
+	AuditEventOperationTransferExecute    AuditEventOperation = "transfer.execute"
+	AuditEventOperationTransferReject     AuditEventOperation = "transfer.reject"
+	AuditEventOperationTransferRequest    AuditEventOperation = "transfer.request"
+	AuditEventOperationTransferReverse    AuditEventOperation = "transfer.reverse"
+	AuditEventOperationUnitsIssue         AuditEventOperation = "units.issue"
+	AuditEventOperationUnitsRedeem        AuditEventOperation = "units.redeem"
+)
 
-	Limit int `json:"limit"`
+const (
+	ALREADYREVERSED            ErrorCode = "ALREADY_REVERSED"
+	APIKEYNOTFOUND             ErrorCode = "API_KEY_NOT_FOUND"
+	APPROVALREQUIRED           ErrorCode = "APPROVAL_REQUIRED"
+	AUTHORIZEDUNITSEXCEEDED    ErrorCode = "AUTHORIZED_UNITS_EXCEEDED"
+	CAPITALCALLNOTFOUND        ErrorCode = "CAPITAL_CALL_NOT_FOUND"
+	DELIVERYNOTDEAD            ErrorCode = "DELIVERY_NOT_DEAD"
+	DELIVERYNOTFOUND           ErrorCode = "DELIVERY_NOT_FOUND"
+	DISTRIBUTIONNOTFOUND       ErrorCode = "DISTRIBUTION_NOT_FOUND"
+	DUPLICATECOMMITMENT        ErrorCode = "DUPLICATE_COMMITMENT"
+	DUPLICATESHARECLASS        ErrorCode = "DUPLICATE_SHARE_CLASS"
+	DUPLICATETRANSFER          ErrorCode = "DUPLICATE_TRANSFER"
+	FORBIDDEN                  ErrorCode = "FORBIDDEN"
+	FUNDNOTFOUND               ErrorCode = "FUND_NOT_FOUND"
+	FUNDNOTOPEN                ErrorCode = "FUND_NOT_OPEN"
+	INSUFFICIENTUNITS          ErrorCode = "INSUFFICIENT_UNITS"
+	INTERNALERROR              ErrorCode = "INTERNAL_ERROR"
+	INVALIDFUND                ErrorCode = "INVALID_FUND"
+	INVALIDIMPORT              ErrorCode = "INVALID_IMPORT"
+	INVALIDOWNER               ErrorCode = "INVALID_OWNER"
+	INVALIDREQUEST             ErrorCode = "INVALID_REQUEST"
+	INVALIDSTATUSTRANSITION    ErrorCode = "INVALID_STATUS_TRANSITION"
+	NOTACCEPTABLE              ErrorCode = "NOT_ACCEPTABLE"
+	OWNERCONFLICT              ErrorCode = "OWNER_CONFLICT"
+	OWNERNOTFOUND              ErrorCode = "OWNER_NOT_FOUND"
+	SELFREVIEW                 ErrorCode = "SELF_REVIEW"
+	SELFTRANSFER               ErrorCode = "SELF_TRANSFER"
+	SHARECLASSNOTFOUND         ErrorCode = "SHARE_CLASS_NOT_FOUND"
+	TRANSFERNOTAPPROVED        ErrorCode = "TRANSFER_NOT_APPROVED"
+	TRANSFERNOTFOUND           ErrorCode = "TRANSFER_NOT_FOUND"
+	TRANSFERNOTPENDING         ErrorCode = "TRANSFER_NOT_PENDING"
+	UNAUTHENTICATED            ErrorCode = "UNAUTHENTICATED"
+	UNFUNDEDCOMMITMENTEXCEEDED ErrorCode = "UNFUNDED_COMMITMENT_EXCEEDED"
+	UNKNOWNREQUESTER           ErrorCode = "UNKNOWN_REQUESTER"
+	WEBHOOKNOTFOUND            ErrorCode = "WEBHOOK_NOT_FOUND"
+)
 
-	Offset int `json:"offset"`
+const (
+	Closed     FundStatus = "closed"
+	Liquidated FundStatus = "liquidated"
+	Open       FundStatus = "open"
+	Suspended  FundStatus = "suspended"
+)
 
-	Total int `json:"total"`
-}
+const (
+	HashMismatch     LedgerBreakReason = "hash_mismatch"
+	HeadMismatch     LedgerBreakReason = "head_mismatch"
+	PrevHashMismatch LedgerBreakReason = "prev_hash_mismatch"
+	SequenceGap      LedgerBreakReason = "sequence_gap"
+	Unchained        LedgerBreakReason = "unchained"
+)
 
-type CapTableEntry struct {
-	AcquiredAt time.Time `json:"acquiredAt"`
+const (
+	Entity     OwnerType = "entity"
+	Individual OwnerType = "individual"
+)
 
-	OwnerName string `json:"ownerName"`
+const (
+	Asc  SortOrder = "asc"
+	Desc SortOrder = "desc"
+)
 
-	Percentage float64 `json:"percentage"`
+const (
+	TransferStatusApproved TransferStatus = "approved"
+	TransferStatusPending  TransferStatus = "pending"
+	TransferStatusRejected TransferStatus = "rejected"
+)
 
-	Units int `json:"units"`
-}
+const (
+	Issuance   UnitEventKind = "issuance"
+	Redemption UnitEventKind = "redemption"
+)
 
-type CreateFundRequest struct {
-	InitialOwner string `json:"initialOwner"`
+const (
+	WebhookDeliveryStatusDead      WebhookDeliveryStatus = "dead"
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+)
+
+const (
+	BalanceChanged    WebhookEventType = "balance.changed"
+	FundCreated       WebhookEventType = "fund.created"
+	FundStatusChanged WebhookEventType = "fund.status_changed"
+	TransferExecuted  WebhookEventType = "transfer.executed"
+	UnitsIssued       WebhookEventType = "units.issued"
+	UnitsRedeemed     WebhookEventType = "units.redeemed"
+)
+
+type ApiKey struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	Id openapi_types.UUID `json:"id"`
+
+	Key *string `json:"key,omitempty"`
 
 	Name string `json:"name"`
 
-	TotalUnits int `json:"totalUnits"`
+	Prefix string `json:"prefix"`
+
+	RevokedAt *time.Time `json:"revokedAt"`
+
+	Role ApiKeyRole `json:"role"`
 }
 
-type CreateTransferRequest struct {
-	FromOwner string `json:"fromOwner"`
+type ApiKeyList struct {
+	ApiKeys []ApiKey `json:"apiKeys"`
+}
 
-	IdempotencyKey *openapi_types.UUID `json:"idempotencyKey,omitempty"`
+type ApiKeyRole string
 
-	ToOwner string `json:"toOwner"`
+type ApprovalThresholdRequest struct {
+	Threshold *int `json:"threshold,omitempty"`
+}
 
-	Units int `json:"units"`
+type ApproveTransferRequest struct {
+	ReviewedBy *string `json:"reviewedBy,omitempty"`
 }
 
-type Error struct {
-	Code ErrorCode `json:"code"`
+type AuditEvent struct {
+	Actor string `json:"actor"`
 
-	Details *map[string]interface{} `json:"details,omitempty"`
+	ActorName *string `json:"actorName,omitempty"`
 
-	Message string `json:"message"`
-}
+	After *map[string]int `json:"after,omitempty"`
 
-type ErrorCode string
+	Before *map[string]int `json:"before,omitempty"`
 
-type Fund struct {
-	CreatedAt time.Time `json:"createdAt"`
+	ClientIp *string `json:"clientIp,omitempty"`
+
+	Details *map[string]interface{} `json:"details,omitempty"`
+
+	FundId *openapi_types.UUID `json:"fundId,omitempty"`
 
 	Id openapi_types.UUID `json:"id"`
 
-	Name string `json:"name"`
+	OccurredAt time.Time `json:"occurredAt"`
 
-	TotalUnits int `json:"totalUnits"`
+	Operation AuditEventOperation `json:"operation"`
+
+	RequestId *string `json:"requestId,omitempty"`
+
+	TransferId *openapi_types.UUID `json:"transferId,omitempty"`
 }
 
-type FundList struct {
-	Funds []Fund `json:"funds"`
+type AuditEventOperation string
+
+type AuditEventList struct {
+	Events []AuditEvent `json:"events"`
 
 	Limit int `json:"limit"`
 
@@ -94,794 +200,7685 @@ type FundList struct {
 	Total int `json:"total"`
 }
 
-type Transfer struct {
-	FromOwner string `json:"fromOwner"`
+type CapTable struct {
+	Classes []ShareClass `json:"classes"`
+
+	Entries []CapTableEntry `json:"entries"`
 
 	FundId openapi_types.UUID `json:"fundId"`
 
-	Id openapi_types.UUID `json:"id"`
+	Limit int `json:"limit"`
 
-	ToOwner string `json:"toOwner"`
+	NextCursor *string `json:"nextCursor,omitempty"`
 
-	TransferredAt time.Time `json:"transferredAt"`
+	Offset int `json:"offset"`
+
+	Total *int `json:"total,omitempty"`
+}
+
+type CapTableEntry struct {
+	AcquiredAt time.Time `json:"acquiredAt"`
+
+	ClassId openapi_types.UUID `json:"classId"`
+
+	ClassName string `json:"className"`
+
+	ClassPercentage float64 `json:"classPercentage"`
+
+	OwnerId openapi_types.UUID `json:"ownerId"`
+
+	OwnerName string `json:"ownerName"`
+
+	Percentage float64 `json:"percentage"`
 
 	Units int `json:"units"`
 }
 
-type TransferList struct {
+type CapitalCall struct {
+	CreatedAt time.Time `json:"createdAt"`
+
 	FundId openapi_types.UUID `json:"fundId"`
 
-	Limit int `json:"limit"`
+	Id openapi_types.UUID `json:"id"`
 
-	Offset int `json:"offset"`
+	Investors int `json:"investors"`
 
-	Total int `json:"total"`
+	LineItems *[]CapitalCallLineItem `json:"lineItems,omitempty"`
 
-	Transfers []Transfer `json:"transfers"`
+	TotalAmount int64 `json:"totalAmount"`
 }
 
-type FundId = openapi_types.UUID
+type CapitalCallLineItem struct {
+	Amount int64 `json:"amount"`
 
-type Limit = int
+	Commitment int64 `json:"commitment"`
 
-type Offset = int
+	OwnerId openapi_types.UUID `json:"ownerId"`
 
-type BadRequest = Error
+	OwnerName string `json:"ownerName"`
 
-type DuplicateTransfer = Error
+	UnfundedAmount int64 `json:"unfundedAmount"`
+}
 
-type FundNotFound = Error
+type CapitalCallList struct {
+	CapitalCalls []CapitalCall `json:"capitalCalls"`
 
-type InternalError = Error
+	FundId openapi_types.UUID `json:"fundId"`
 
-type TransferBadRequest = Error
+	Limit int `json:"limit"`
 
-type TransferNotFound = Error
+	NextCursor *string `json:"nextCursor,omitempty"`
 
-type ListFundsParams struct {
-	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset int `json:"offset"`
 
-	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+	Total *int `json:"total,omitempty"`
 }
 
-type GetCapTableParams struct {
-	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+type Commitment struct {
+	Amount int64 `json:"amount"`
 
-	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
-}
+	CalledAmount int64 `json:"calledAmount"`
 
-type ListTransfersParams struct {
-	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
 
-	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
-}
+	FundId openapi_types.UUID `json:"fundId"`
 
-type CreateFundJSONRequestBody = CreateFundRequest
+	Id openapi_types.UUID `json:"id"`
 
-type CreateTransferJSONRequestBody = CreateTransferRequest
+	OwnerId openapi_types.UUID `json:"ownerId"`
 
-type ServerInterface interface {
-	ListFunds(w http.ResponseWriter, r *http.Request, params ListFundsParams)
-	CreateFund(w http.ResponseWriter, r *http.Request)
-	GetFund(w http.ResponseWriter, r *http.Request, fundId FundId)
-	GetCapTable(w http.ResponseWriter, r *http.Request, fundId FundId, params GetCapTableParams)
-	ListTransfers(w http.ResponseWriter, r *http.Request, fundId FundId, params ListTransfersParams)
-	CreateTransfer(w http.ResponseWriter, r *http.Request, fundId FundId)
-	ResetDatabase(w http.ResponseWriter, r *http.Request)
-}
+	OwnerName string `json:"ownerName"`
 
+	UnfundedAmount int64 `json:"unfundedAmount"`
 
-type Unimplemented struct{}
+	Units int `json:"units"`
 
-func (_ Unimplemented) ListFunds(w http.ResponseWriter, r *http.Request, params ListFundsParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+	UpdatedAt time.Time `json:"updatedAt"`
 }
 
-func (_ Unimplemented) CreateFund(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+type CommitmentList struct {
+	Commitments []Commitment `json:"commitments"`
 
-func (_ Unimplemented) GetFund(w http.ResponseWriter, r *http.Request, fundId FundId) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	FundId openapi_types.UUID `json:"fundId"`
 
-func (_ Unimplemented) GetCapTable(w http.ResponseWriter, r *http.Request, fundId FundId, params GetCapTableParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	Limit int `json:"limit"`
 
-func (_ Unimplemented) ListTransfers(w http.ResponseWriter, r *http.Request, fundId FundId, params ListTransfersParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	NextCursor *string `json:"nextCursor,omitempty"`
 
-func (_ Unimplemented) CreateTransfer(w http.ResponseWriter, r *http.Request, fundId FundId) {
-	w.WriteHeader(http.StatusNotImplemented)
+	Offset int `json:"offset"`
+
+	Total *int `json:"total,omitempty"`
 }
 
-func (_ Unimplemented) ResetDatabase(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+type CreateApiKeyRequest struct {
+	Name string `json:"name"`
+
+	Role ApiKeyRole `json:"role"`
 }
 
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+type CreateCapitalCallRequest struct {
+	TotalAmount int64 `json:"totalAmount"`
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+type CreateCommitmentRequest struct {
+	Amount int64 `json:"amount"`
 
-func (siw *ServerInterfaceWrapper) ListFunds(w http.ResponseWriter, r *http.Request) {
+	Owner *string `json:"owner,omitempty"`
 
-	var err error
+	OwnerId *openapi_types.UUID `json:"ownerId,omitempty"`
+}
 
-	var params ListFundsParams
+type CreateDistributionRequest struct {
+	RecordDate *time.Time `json:"recordDate,omitempty"`
 
+	TotalAmount int64 `json:"totalAmount"`
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
+type CreateFundRequest struct {
+	InitialOwner *string `json:"initialOwner,omitempty"`
 
+	InitialOwnerId *openapi_types.UUID `json:"initialOwnerId,omitempty"`
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
-		return
-	}
+	Name string `json:"name"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListFunds(w, r, params)
-	}))
+	TotalUnits int `json:"totalUnits"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type CreateOwnerRequest struct {
+	ExternalRef *string `json:"externalRef,omitempty"`
 
-	handler.ServeHTTP(w, r)
+	LegalName string `json:"legalName"`
+
+	Type *OwnerType `json:"type,omitempty"`
 }
 
-func (siw *ServerInterfaceWrapper) CreateFund(w http.ResponseWriter, r *http.Request) {
+type CreateShareClassRequest struct {
+	AuthorizedUnits int `json:"authorizedUnits"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateFund(w, r)
-	}))
+	Name string `json:"name"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type CreateTransferBatchRequest struct {
+	IdempotencyKey *openapi_types.UUID `json:"idempotencyKey,omitempty"`
 
-	handler.ServeHTTP(w, r)
+	Legs []TransferLeg `json:"legs"`
 }
 
-func (siw *ServerInterfaceWrapper) GetFund(w http.ResponseWriter, r *http.Request) {
+type CreateTransferRequest struct {
+	ClassId *openapi_types.UUID `json:"classId,omitempty"`
 
-	var err error
+	FromOwner *string `json:"fromOwner,omitempty"`
 
-	var fundId FundId
+	FromOwnerId *openapi_types.UUID `json:"fromOwnerId,omitempty"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "fundId", chi.URLParam(r, "fundId"), &fundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
-		return
-	}
+	IdempotencyKey *openapi_types.UUID `json:"idempotencyKey,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetFund(w, r, fundId)
-	}))
+	RequestedBy *string `json:"requestedBy,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	ToOwner *string `json:"toOwner,omitempty"`
 
-	handler.ServeHTTP(w, r)
-}
+	ToOwnerId *openapi_types.UUID `json:"toOwnerId,omitempty"`
 
-func (siw *ServerInterfaceWrapper) GetCapTable(w http.ResponseWriter, r *http.Request) {
+	Units int `json:"units"`
+}
 
-	var err error
+type CreateWebhookRequest struct {
+	EventTypes *[]WebhookEventType `json:"eventTypes,omitempty"`
 
-	var fundId FundId
+	Url string `json:"url"`
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "fundId", chi.URLParam(r, "fundId"), &fundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
-		return
-	}
+type Distribution struct {
+	CreatedAt time.Time `json:"createdAt"`
 
-	var params GetCapTableParams
+	FundId openapi_types.UUID `json:"fundId"`
 
+	Holders int `json:"holders"`
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
+	Id openapi_types.UUID `json:"id"`
 
+	LineItems *[]DistributionLineItem `json:"lineItems,omitempty"`
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
-		return
-	}
+	RecordDate time.Time `json:"recordDate"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCapTable(w, r, fundId, params)
-	}))
+	TotalAmount int64 `json:"totalAmount"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r)
+	TotalUnits int `json:"totalUnits"`
 }
 
-func (siw *ServerInterfaceWrapper) ListTransfers(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+type DistributionLineItem struct {
+	Amount int64 `json:"amount"`
 
-	var fundId FundId
+	OwnerId openapi_types.UUID `json:"ownerId"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "fundId", chi.URLParam(r, "fundId"), &fundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
-		return
-	}
+	OwnerName string `json:"ownerName"`
 
-	var params ListTransfersParams
+	Percentage float64 `json:"percentage"`
 
+	Units int `json:"units"`
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
+type DistributionList struct {
+	Distributions []Distribution `json:"distributions"`
 
+	FundId openapi_types.UUID `json:"fundId"`
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
-		return
-	}
+	Limit int `json:"limit"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTransfers(w, r, fundId, params)
-	}))
+	NextCursor *string `json:"nextCursor,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	Offset int `json:"offset"`
 
-	handler.ServeHTTP(w, r)
+	Total *int `json:"total,omitempty"`
 }
 
-func (siw *ServerInterfaceWrapper) CreateTransfer(w http.ResponseWriter, r *http.Request) {
+type Error struct {
+	Code ErrorCode `json:"code"`
 
-	var err error
+	Details *map[string]interface{} `json:"details,omitempty"`
 
-	var fundId FundId
+	Message string `json:"message"`
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "fundId", chi.URLParam(r, "fundId"), &fundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
-		return
-	}
+type ErrorCode string
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateTransfer(w, r, fundId)
-	}))
+type Fund struct {
+	ApprovalThreshold *int `json:"approvalThreshold,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	CreatedAt time.Time `json:"createdAt"`
 
-	handler.ServeHTTP(w, r)
-}
+	Id openapi_types.UUID `json:"id"`
 
-func (siw *ServerInterfaceWrapper) ResetDatabase(w http.ResponseWriter, r *http.Request) {
+	LedgerHead *string `json:"ledgerHead,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ResetDatabase(w, r)
-	}))
+	LedgerSequence *int64 `json:"ledgerSequence,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	Name string `json:"name"`
 
-	handler.ServeHTTP(w, r)
-}
+	Status FundStatus `json:"status"`
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+	StatusChangedAt time.Time `json:"statusChangedAt"`
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+	TotalUnits int `json:"totalUnits"`
 }
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+type FundDrift struct {
+	FundId openapi_types.UUID `json:"fundId"`
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	FundName string `json:"fundName"`
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	Owners []OwnerDrift `json:"owners"`
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	RecordedUnits int `json:"recordedUnits"`
 
-type RequiredParamError struct {
-	ParamName string
+	TotalUnits int `json:"totalUnits"`
 }
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+type FundList struct {
+	Funds []Fund `json:"funds"`
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	Limit int `json:"limit"`
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	NextCursor *string `json:"nextCursor,omitempty"`
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	Offset int `json:"offset"`
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+	Total *int `json:"total,omitempty"`
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+type FundStatus string
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+type FundStatusChangeRequest struct {
+	Reason *string `json:"reason,omitempty"`
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+	Status FundStatus `json:"status"`
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+type ImportFundRequest struct {
+	Name string      `json:"name"`
+	Rows []ImportRow `json:"rows"`
 
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
+	TotalUnits int `json:"totalUnits"`
 }
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+type ImportRow struct {
+	Owner *string `json:"owner,omitempty"`
 
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
-}
+	OwnerId *openapi_types.UUID `json:"ownerId,omitempty"`
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+	Units int `json:"units"`
 }
 
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+type LedgerBreak struct {
+	ActualHash *string `json:"actualHash,omitempty"`
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+	ExpectedHash *string `json:"expectedHash,omitempty"`
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/funds", wrapper.ListFunds)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/funds", wrapper.CreateFund)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/funds/{fundId}", wrapper.GetFund)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/funds/{fundId}/cap-table", wrapper.GetCapTable)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/funds/{fundId}/transfers", wrapper.ListTransfers)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/funds/{fundId}/transfers", wrapper.CreateTransfer)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/reset", wrapper.ResetDatabase)
-	})
+	Reason LedgerBreakReason `json:"reason"`
 
-	return r
-}
+	Sequence int64 `json:"sequence"`
 
-type BadRequestJSONResponse Error
+	TransferId *openapi_types.UUID `json:"transferId,omitempty"`
+}
 
-type DuplicateTransferJSONResponse Error
+type LedgerBreakReason string
 
-type FundNotFoundJSONResponse Error
+type LedgerVerification struct {
+	Checked int `json:"checked"`
 
-type InternalErrorJSONResponse Error
+	FirstBrokenLink *LedgerBreak `json:"firstBrokenLink,omitempty"`
 
-type TransferBadRequestJSONResponse Error
+	FundId openapi_types.UUID `json:"fundId"`
 
-type TransferNotFoundJSONResponse Error
+	HeadHash *string `json:"headHash,omitempty"`
 
-type ListFundsRequestObject struct {
-	Params ListFundsParams
-}
+	HeadSequence int64 `json:"headSequence"`
 
-type ListFundsResponseObject interface {
-	VisitListFundsResponse(w http.ResponseWriter) error
+	Valid bool `json:"valid"`
 }
 
-type ListFunds200JSONResponse FundList
+type Owner struct {
+	CreatedAt time.Time `json:"createdAt"`
 
-func (response ListFunds200JSONResponse) VisitListFundsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ExternalRef *string `json:"externalRef,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	Id openapi_types.UUID `json:"id"`
 
-type ListFunds500JSONResponse struct{ InternalErrorJSONResponse }
+	LegalName string `json:"legalName"`
 
-func (response ListFunds500JSONResponse) VisitListFundsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	Type OwnerType `json:"type"`
 
-	return json.NewEncoder(w).Encode(response)
+	UpdatedAt time.Time `json:"updatedAt"`
 }
 
-type CreateFundRequestObject struct {
-	Body *CreateFundJSONRequestBody
-}
+type OwnerDrift struct {
+	ClassId openapi_types.UUID `json:"classId"`
 
-type CreateFundResponseObject interface {
-	VisitCreateFundResponse(w http.ResponseWriter) error
-}
+	OwnerId openapi_types.UUID `json:"ownerId"`
 
-type CreateFund201JSONResponse Fund
+	OwnerName string `json:"ownerName"`
 
-func (response CreateFund201JSONResponse) VisitCreateFundResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	RecordedUnits int `json:"recordedUnits"`
 
-	return json.NewEncoder(w).Encode(response)
+	ReplayedUnits int `json:"replayedUnits"`
 }
 
-type CreateFund400JSONResponse struct{ BadRequestJSONResponse }
+type OwnerHolding struct {
+	AcquiredAt time.Time `json:"acquiredAt"`
 
-func (response CreateFund400JSONResponse) VisitCreateFundResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ClassId openapi_types.UUID `json:"classId"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ClassName string `json:"className"`
 
-type CreateFund500JSONResponse struct{ InternalErrorJSONResponse }
+	FundId openapi_types.UUID `json:"fundId"`
 
-func (response CreateFund500JSONResponse) VisitCreateFundResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	FundName string `json:"fundName"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	Percentage float64 `json:"percentage"`
 
-type GetFundRequestObject struct {
-	FundId FundId `json:"fundId"`
+	Units int `json:"units"`
 }
 
-type GetFundResponseObject interface {
-	VisitGetFundResponse(w http.ResponseWriter) error
-}
+type OwnerList struct {
+	Limit int `json:"limit"`
 
-type GetFund200JSONResponse Fund
+	Offset int `json:"offset"`
 
-func (response GetFund200JSONResponse) VisitGetFundResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	Owners []Owner `json:"owners"`
 
-	return json.NewEncoder(w).Encode(response)
+	Total int `json:"total"`
 }
 
-type GetFund400JSONResponse struct{ BadRequestJSONResponse }
+type OwnerPortfolio struct {
+	Holdings []OwnerHolding `json:"holdings"`
 
-func (response GetFund400JSONResponse) VisitGetFundResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	Limit int `json:"limit"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	Offset int `json:"offset"`
 
-type GetFund404JSONResponse struct{ FundNotFoundJSONResponse }
+	Owner Owner `json:"owner"`
 
-func (response GetFund404JSONResponse) VisitGetFundResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	Total int `json:"total"`
 
-	return json.NewEncoder(w).Encode(response)
+	Transfers []Transfer `json:"transfers"`
 }
 
-type GetFund500JSONResponse struct{ InternalErrorJSONResponse }
+type OwnerType string
 
-func (response GetFund500JSONResponse) VisitGetFundResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+type ReconciliationReport struct {
+	Balanced bool `json:"balanced"`
 
-	return json.NewEncoder(w).Encode(response)
+	CompletedAt time.Time `json:"completedAt"`
+
+	Drifted []FundDrift `json:"drifted"`
+
+	FundsChecked int `json:"fundsChecked"`
+
+	StartedAt time.Time `json:"startedAt"`
 }
 
-type GetCapTableRequestObject struct {
-	FundId FundId `json:"fundId"`
-	Params GetCapTableParams
+type RejectTransferRequest struct {
+	Reason *string `json:"reason,omitempty"`
+
+	ReviewedBy *string `json:"reviewedBy,omitempty"`
 }
 
-type GetCapTableResponseObject interface {
-	VisitGetCapTableResponse(w http.ResponseWriter) error
+type ShareClass struct {
+	AuthorizedUnits int `json:"authorizedUnits"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	Default bool `json:"default"`
+
+	FundId openapi_types.UUID `json:"fundId"`
+
+	Id openapi_types.UUID `json:"id"`
+
+	Name string `json:"name"`
+
+	OutstandingUnits int `json:"outstandingUnits"`
 }
 
-type GetCapTable200JSONResponse CapTable
+type ShareClassList struct {
+	Classes []ShareClass `json:"classes"`
 
-func (response GetCapTable200JSONResponse) VisitGetCapTableResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	FundId openapi_types.UUID `json:"fundId"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+type SortOrder string
+
+type Transfer struct {
+	BatchId *openapi_types.UUID `json:"batchId,omitempty"`
+
+	ClassId openapi_types.UUID `json:"classId"`
+
+	ClassName string `json:"className"`
+
+	FromOwner string `json:"fromOwner"`
+
+	FromOwnerId openapi_types.UUID `json:"fromOwnerId"`
+
+	FundId openapi_types.UUID `json:"fundId"`
+
+	Hash *string `json:"hash,omitempty"`
+
+	Id openapi_types.UUID `json:"id"`
+
+	LedgerSequence *int64 `json:"ledgerSequence,omitempty"`
+
+	LegIndex *int `json:"legIndex,omitempty"`
+
+	RejectionReason *string `json:"rejectionReason,omitempty"`
+
+	RequestedAt time.Time `json:"requestedAt"`
+
+	RequestedBy *string `json:"requestedBy,omitempty"`
+
+	ReversedByTransferId *openapi_types.UUID `json:"reversedByTransferId,omitempty"`
+
+	ReversesTransferId *openapi_types.UUID `json:"reversesTransferId,omitempty"`
+
+	ReviewedAt *time.Time `json:"reviewedAt,omitempty"`
+
+	ReviewedBy *string `json:"reviewedBy,omitempty"`
+
+	Status TransferStatus `json:"status"`
+
+	ToOwner string `json:"toOwner"`
+
+	ToOwnerId openapi_types.UUID `json:"toOwnerId"`
+
+	TransferredAt time.Time `json:"transferredAt"`
+
+	Units int `json:"units"`
 }
 
-type GetCapTable400JSONResponse struct{ BadRequestJSONResponse }
+type TransferBatch struct {
+	CreatedAt time.Time `json:"createdAt"`
 
-func (response GetCapTable400JSONResponse) VisitGetCapTableResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	FundId openapi_types.UUID `json:"fundId"`
 
-	return json.NewEncoder(w).Encode(response)
+	Id openapi_types.UUID `json:"id"`
+
+	IdempotencyKey *openapi_types.UUID `json:"idempotencyKey,omitempty"`
+
+	Transfers []Transfer `json:"transfers"`
 }
 
-type GetCapTable404JSONResponse struct{ FundNotFoundJSONResponse }
+type TransferLeg struct {
+	ClassId *openapi_types.UUID `json:"classId,omitempty"`
 
-func (response GetCapTable404JSONResponse) VisitGetCapTableResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	FromOwner *string `json:"fromOwner,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	FromOwnerId *openapi_types.UUID `json:"fromOwnerId,omitempty"`
+
+	ToOwner *string `json:"toOwner,omitempty"`
+
+	ToOwnerId *openapi_types.UUID `json:"toOwnerId,omitempty"`
+
+	Units int `json:"units"`
 }
 
-type GetCapTable500JSONResponse struct{ InternalErrorJSONResponse }
+type TransferList struct {
+	FundId openapi_types.UUID `json:"fundId"`
 
-func (response GetCapTable500JSONResponse) VisitGetCapTableResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	Limit int `json:"limit"`
 
-	return json.NewEncoder(w).Encode(response)
+	NextCursor *string `json:"nextCursor,omitempty"`
+
+	Offset int `json:"offset"`
+
+	Total *int `json:"total,omitempty"`
+
+	Transfers []Transfer `json:"transfers"`
 }
 
-type ListTransfersRequestObject struct {
-	FundId FundId `json:"fundId"`
-	Params ListTransfersParams
+type TransferStatus string
+
+type UnitEvent struct {
+	ClassId openapi_types.UUID `json:"classId"`
+
+	ClassName string `json:"className"`
+
+	FundId openapi_types.UUID `json:"fundId"`
+
+	Id openapi_types.UUID `json:"id"`
+
+	Kind UnitEventKind `json:"kind"`
+
+	OccurredAt time.Time `json:"occurredAt"`
+
+	OwnerId openapi_types.UUID `json:"ownerId"`
+
+	OwnerName string `json:"ownerName"`
+
+	TotalUnits int `json:"totalUnits"`
+
+	Units int `json:"units"`
 }
 
-type ListTransfersResponseObject interface {
-	VisitListTransfersResponse(w http.ResponseWriter) error
+type UnitEventKind string
+
+type UnitEventList struct {
+	Events []UnitEvent `json:"events"`
+
+	FundId openapi_types.UUID `json:"fundId"`
+
+	Limit int `json:"limit"`
+
+	NextCursor *string `json:"nextCursor,omitempty"`
+
+	Offset int `json:"offset"`
+
+	Total *int `json:"total,omitempty"`
 }
 
-type ListTransfers200JSONResponse TransferList
+type UnitEventRequest struct {
+	ClassId *openapi_types.UUID `json:"classId,omitempty"`
 
-func (response ListTransfers200JSONResponse) VisitListTransfersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	Owner *string `json:"owner,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	OwnerId *openapi_types.UUID `json:"ownerId,omitempty"`
+
+	Units int `json:"units"`
 }
 
-type ListTransfers400JSONResponse struct{ BadRequestJSONResponse }
+type UpdateOwnerRequest struct {
+	ExternalRef *string `json:"externalRef,omitempty"`
 
-func (response ListTransfers400JSONResponse) VisitListTransfersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	LegalName *string `json:"legalName,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	Type *OwnerType `json:"type,omitempty"`
 }
 
-type ListTransfers404JSONResponse struct{ FundNotFoundJSONResponse }
+type Webhook struct {
+	CreatedAt time.Time `json:"createdAt"`
 
-func (response ListTransfers404JSONResponse) VisitListTransfersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	EventTypes []WebhookEventType `json:"eventTypes"`
 
-	return json.NewEncoder(w).Encode(response)
+	Id openapi_types.UUID `json:"id"`
+
+	Secret *string `json:"secret,omitempty"`
+
+	Url string `json:"url"`
 }
 
-type ListTransfers500JSONResponse struct{ InternalErrorJSONResponse }
+type WebhookDelivery struct {
+	Attempts int `json:"attempts"`
 
-func (response ListTransfers500JSONResponse) VisitListTransfersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	CreatedAt time.Time `json:"createdAt"`
 
-	return json.NewEncoder(w).Encode(response)
+	DeliveredAt *time.Time `json:"deliveredAt,omitempty"`
+
+	EventId openapi_types.UUID `json:"eventId"`
+
+	EventSequence int64 `json:"eventSequence"`
+
+	EventType WebhookEventType `json:"eventType"`
+
+	Id openapi_types.UUID `json:"id"`
+
+	LastError *string `json:"lastError,omitempty"`
+
+	LastStatusCode *int `json:"lastStatusCode,omitempty"`
+
+	NextAttemptAt time.Time `json:"nextAttemptAt"`
+
+	Status WebhookDeliveryStatus `json:"status"`
+
+	WebhookId openapi_types.UUID `json:"webhookId"`
 }
 
-type CreateTransferRequestObject struct {
-	FundId FundId `json:"fundId"`
-	Body   *CreateTransferJSONRequestBody
+type WebhookDeliveryList struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+
+	Limit int `json:"limit"`
+
+	Offset int `json:"offset"`
+
+	Total int `json:"total"`
 }
 
-type CreateTransferResponseObject interface {
-	VisitCreateTransferResponse(w http.ResponseWriter) error
+type WebhookDeliveryStatus string
+
+type WebhookEventType string
+
+type WebhookList struct {
+	Webhooks []Webhook `json:"webhooks"`
 }
 
-type CreateTransfer200JSONResponse Transfer
+type ApiKeyId = openapi_types.UUID
 
-func (response CreateTransfer200JSONResponse) VisitCreateTransferResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type AsOf = time.Time
 
-	return json.NewEncoder(w).Encode(response)
+type CapitalCallId = openapi_types.UUID
+
+type Cursor = string
+
+type DeliveryId = openapi_types.UUID
+
+type DistributionId = openapi_types.UUID
+
+type ExportAccept = string
+
+type FundId = openapi_types.UUID
+
+type Limit = int
+
+type Offset = int
+
+type OwnerId = openapi_types.UUID
+
+type TransferFromOwnerId = openapi_types.UUID
+
+type TransferId = openapi_types.UUID
+
+type TransferMaxUnits = int
+
+type TransferMinUnits = int
+
+type TransferOwnerId = openapi_types.UUID
+
+type TransferSince = time.Time
+
+type TransferSort = SortOrder
+
+type TransferToOwnerId = openapi_types.UUID
+
+type TransferUntil = time.Time
+
+type WebhookId = openapi_types.UUID
+
+type ApiKeyNotFound = Error
+
+type BadRequest = Error
+
+type CapitalCallBadRequest = Error
+
+type CapitalCallNotFound = Error
+
+type CommitmentConflict = Error
+
+type DistributionNotFound = Error
+
+type DuplicateTransfer = Error
+
+type Forbidden = Error
+
+type FundNotFound = Error
+
+type InternalError = Error
+
+type NotAcceptable = Error
+
+type OwnerConflict = Error
+
+type OwnerNotFound = Error
+
+type TransferBadRequest = Error
+
+type TransferNotFound = Error
+
+type TransferNotPending = Error
+
+type TransferReviewNotFound = Error
+
+type Unauthorized = Error
+
+type UnitEventBadRequest = Error
+
+type UnitEventConflict = Error
+
+type WebhookNotFound = Error
+
+type ListAuditEventsParams struct {
+	FundId *openapi_types.UUID `form:"fundId,omitempty" json:"fundId,omitempty"`
+
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty"`
+
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
-type CreateTransfer201JSONResponse Transfer
+type ListFundsParams struct {
+	Status *FundStatus `form:"status,omitempty" json:"status,omitempty"`
 
-func (response CreateTransfer201JSONResponse) VisitCreateTransferResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+type ImportFundParams struct {
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+
+	TotalUnits *int `form:"totalUnits,omitempty" json:"totalUnits,omitempty"`
+}
+
+type GetCapTableParams struct {
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	AsOf *AsOf `form:"asOf,omitempty" json:"asOf,omitempty"`
+}
+
+type ExportCapTableParams struct {
+	Accept *ExportAccept `json:"Accept,omitempty"`
+}
+
+type ListCapitalCallsParams struct {
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+type ListCommitmentsParams struct {
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+type ListDistributionsParams struct {
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+type ExportDistributionParams struct {
+	Accept *ExportAccept `json:"Accept,omitempty"`
+}
+
+type StreamFundEventsParams struct {
+	LastEventID *int64 `json:"Last-Event-ID,omitempty"`
+}
+
+type ListTransfersParams struct {
+	OwnerId *TransferOwnerId `form:"ownerId,omitempty" json:"ownerId,omitempty"`
+
+	FromOwnerId *TransferFromOwnerId `form:"fromOwnerId,omitempty" json:"fromOwnerId,omitempty"`
+
+	ToOwnerId *TransferToOwnerId `form:"toOwnerId,omitempty" json:"toOwnerId,omitempty"`
+
+	Since *TransferSince `form:"since,omitempty" json:"since,omitempty"`
+
+	Until *TransferUntil `form:"until,omitempty" json:"until,omitempty"`
+
+	MinUnits *TransferMinUnits `form:"minUnits,omitempty" json:"minUnits,omitempty"`
+
+	MaxUnits *TransferMaxUnits `form:"maxUnits,omitempty" json:"maxUnits,omitempty"`
+
+	Sort *TransferSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+type ExportTransfersParams struct {
+	OwnerId *TransferOwnerId `form:"ownerId,omitempty" json:"ownerId,omitempty"`
+
+	FromOwnerId *TransferFromOwnerId `form:"fromOwnerId,omitempty" json:"fromOwnerId,omitempty"`
+
+	ToOwnerId *TransferToOwnerId `form:"toOwnerId,omitempty" json:"toOwnerId,omitempty"`
+
+	Since *TransferSince `form:"since,omitempty" json:"since,omitempty"`
+
+	Until *TransferUntil `form:"until,omitempty" json:"until,omitempty"`
+
+	MinUnits *TransferMinUnits `form:"minUnits,omitempty" json:"minUnits,omitempty"`
+
+	MaxUnits *TransferMaxUnits `form:"maxUnits,omitempty" json:"maxUnits,omitempty"`
+
+	Sort *TransferSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	Accept *ExportAccept `json:"Accept,omitempty"`
+}
+
+type ListPendingTransfersParams struct {
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+type ListUnitEventsParams struct {
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+type ListOwnersParams struct {
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+type GetOwnerHoldingsParams struct {
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+type ListWebhookDeliveriesParams struct {
+	Status *WebhookDeliveryStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+type CreateApiKeyJSONRequestBody = CreateApiKeyRequest
+
+type ChangeFundStatusJSONRequestBody = FundStatusChangeRequest
+
+type CreateFundJSONRequestBody = CreateFundRequest
+
+type ImportFundJSONRequestBody = ImportFundRequest
+
+type SetApprovalThresholdJSONRequestBody = ApprovalThresholdRequest
+
+type CreateCapitalCallJSONRequestBody = CreateCapitalCallRequest
+
+type CreateCommitmentJSONRequestBody = CreateCommitmentRequest
+
+type CreateDistributionJSONRequestBody = CreateDistributionRequest
+
+type IssueUnitsJSONRequestBody = UnitEventRequest
+
+type RedeemUnitsJSONRequestBody = UnitEventRequest
+
+type CreateShareClassJSONRequestBody = CreateShareClassRequest
+
+type CreateTransferJSONRequestBody = CreateTransferRequest
+
+type CreateTransferBatchJSONRequestBody = CreateTransferBatchRequest
+
+type ApproveTransferJSONRequestBody = ApproveTransferRequest
+
+type RejectTransferJSONRequestBody = RejectTransferRequest
+
+type CreateOwnerJSONRequestBody = CreateOwnerRequest
+
+type UpdateOwnerJSONRequestBody = UpdateOwnerRequest
+
+type CreateWebhookJSONRequestBody = CreateWebhookRequest
+
+type ServerInterface interface {
+	ListApiKeys(w http.ResponseWriter, r *http.Request)
+	CreateApiKey(w http.ResponseWriter, r *http.Request)
+	RevokeApiKey(w http.ResponseWriter, r *http.Request, keyId ApiKeyId)
+	ChangeFundStatus(w http.ResponseWriter, r *http.Request, fundId FundId)
+	GetReconciliation(w http.ResponseWriter, r *http.Request)
+	ListAuditEvents(w http.ResponseWriter, r *http.Request, params ListAuditEventsParams)
+	ListFunds(w http.ResponseWriter, r *http.Request, params ListFundsParams)
+	CreateFund(w http.ResponseWriter, r *http.Request)
+	ImportFund(w http.ResponseWriter, r *http.Request, params ImportFundParams)
+	GetFund(w http.ResponseWriter, r *http.Request, fundId FundId)
+	SetApprovalThreshold(w http.ResponseWriter, r *http.Request, fundId FundId)
+	GetCapTable(w http.ResponseWriter, r *http.Request, fundId FundId, params GetCapTableParams)
+	ExportCapTable(w http.ResponseWriter, r *http.Request, fundId FundId, params ExportCapTableParams)
+	ListCapitalCalls(w http.ResponseWriter, r *http.Request, fundId FundId, params ListCapitalCallsParams)
+	CreateCapitalCall(w http.ResponseWriter, r *http.Request, fundId FundId)
+	GetCapitalCall(w http.ResponseWriter, r *http.Request, fundId FundId, capitalCallId CapitalCallId)
+	ListCommitments(w http.ResponseWriter, r *http.Request, fundId FundId, params ListCommitmentsParams)
+	CreateCommitment(w http.ResponseWriter, r *http.Request, fundId FundId)
+	ListDistributions(w http.ResponseWriter, r *http.Request, fundId FundId, params ListDistributionsParams)
+	CreateDistribution(w http.ResponseWriter, r *http.Request, fundId FundId)
+	GetDistribution(w http.ResponseWriter, r *http.Request, fundId FundId, distributionId DistributionId)
+	ExportDistribution(w http.ResponseWriter, r *http.Request, fundId FundId, distributionId DistributionId, params ExportDistributionParams)
+	StreamFundEvents(w http.ResponseWriter, r *http.Request, fundId FundId, params StreamFundEventsParams)
+	IssueUnits(w http.ResponseWriter, r *http.Request, fundId FundId)
+	VerifyLedger(w http.ResponseWriter, r *http.Request, fundId FundId)
+	RedeemUnits(w http.ResponseWriter, r *http.Request, fundId FundId)
+	ListShareClasses(w http.ResponseWriter, r *http.Request, fundId FundId)
+	CreateShareClass(w http.ResponseWriter, r *http.Request, fundId FundId)
+	ListTransfers(w http.ResponseWriter, r *http.Request, fundId FundId, params ListTransfersParams)
+	CreateTransfer(w http.ResponseWriter, r *http.Request, fundId FundId)
+	CreateTransferBatch(w http.ResponseWriter, r *http.Request, fundId FundId)
+	ExportTransfers(w http.ResponseWriter, r *http.Request, fundId FundId, params ExportTransfersParams)
+	ListPendingTransfers(w http.ResponseWriter, r *http.Request, fundId FundId, params ListPendingTransfersParams)
+	ApproveTransfer(w http.ResponseWriter, r *http.Request, fundId FundId, transferId TransferId)
+	RejectTransfer(w http.ResponseWriter, r *http.Request, fundId FundId, transferId TransferId)
+	ReverseTransfer(w http.ResponseWriter, r *http.Request, fundId FundId, transferId TransferId)
+	ListUnitEvents(w http.ResponseWriter, r *http.Request, fundId FundId, params ListUnitEventsParams)
+	ListOwners(w http.ResponseWriter, r *http.Request, params ListOwnersParams)
+	CreateOwner(w http.ResponseWriter, r *http.Request)
+	GetOwner(w http.ResponseWriter, r *http.Request, ownerId OwnerId)
+	UpdateOwner(w http.ResponseWriter, r *http.Request, ownerId OwnerId)
+	GetOwnerHoldings(w http.ResponseWriter, r *http.Request, ownerId OwnerId, params GetOwnerHoldingsParams)
+	ResetDatabase(w http.ResponseWriter, r *http.Request)
+	ListWebhooks(w http.ResponseWriter, r *http.Request)
+	CreateWebhook(w http.ResponseWriter, r *http.Request)
+	DeleteWebhook(w http.ResponseWriter, r *http.Request, webhookId WebhookId)
+	ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, webhookId WebhookId, params ListWebhookDeliveriesParams)
+	RetryWebhookDelivery(w http.ResponseWriter, r *http.Request, webhookId WebhookId, deliveryId DeliveryId)
+}
+
+
+type Unimplemented struct{}
+
+func (_ Unimplemented) ListApiKeys(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) CreateApiKey(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) RevokeApiKey(w http.ResponseWriter, r *http.Request, keyId ApiKeyId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) ChangeFundStatus(w http.ResponseWriter, r *http.Request, fundId FundId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) GetReconciliation(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) ListAuditEvents(w http.ResponseWriter, r *http.Request, params ListAuditEventsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) ListFunds(w http.ResponseWriter, r *http.Request, params ListFundsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) CreateFund(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) ImportFund(w http.ResponseWriter, r *http.Request, params ImportFundParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) GetFund(w http.ResponseWriter, r *http.Request, fundId FundId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) SetApprovalThreshold(w http.ResponseWriter, r *http.Request, fundId FundId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) GetCapTable(w http.ResponseWriter, r *http.Request, fundId FundId, params GetCapTableParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) ExportCapTable(w http.ResponseWriter, r *http.Request, fundId FundId, params ExportCapTableParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) ListCapitalCalls(w http.ResponseWriter, r *http.Request, fundId FundId, params ListCapitalCallsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) CreateCapitalCall(w http.ResponseWriter, r *http.Request, fundId FundId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) GetCapitalCall(w http.ResponseWriter, r *http.Request, fundId FundId, capitalCallId CapitalCallId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) ListCommitments(w http.ResponseWriter, r *http.Request, fundId FundId, params ListCommitmentsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) CreateCommitment(w http.ResponseWriter, r *http.Request, fundId FundId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) ListDistributions(w http.ResponseWriter, r *http.Request, fundId FundId, params ListDistributionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) CreateDistribution(w http.ResponseWriter, r *http.Request, fundId FundId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) GetDistribution(w http.ResponseWriter, r *http.Request, fundId FundId, distributionId DistributionId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) ExportDistribution(w http.ResponseWriter, r *http.Request, fundId FundId, distributionId DistributionId, params ExportDistributionParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) StreamFundEvents(w http.ResponseWriter, r *http.Request, fundId FundId, params StreamFundEventsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) IssueUnits(w http.ResponseWriter, r *http.Request, fundId FundId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) VerifyLedger(w http.ResponseWriter, r *http.Request, fundId FundId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) RedeemUnits(w http.ResponseWriter, r *http.Request, fundId FundId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) ListShareClasses(w http.ResponseWriter, r *http.Request, fundId FundId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) CreateShareClass(w http.ResponseWriter, r *http.Request, fundId FundId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) ListTransfers(w http.ResponseWriter, r *http.Request, fundId FundId, params ListTransfersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) CreateTransfer(w http.ResponseWriter, r *http.Request, fundId FundId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) CreateTransferBatch(w http.ResponseWriter, r *http.Request, fundId FundId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) ExportTransfers(w http.ResponseWriter, r *http.Request, fundId FundId, params ExportTransfersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) ListPendingTransfers(w http.ResponseWriter, r *http.Request, fundId FundId, params ListPendingTransfersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) ApproveTransfer(w http.ResponseWriter, r *http.Request, fundId FundId, transferId TransferId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) RejectTransfer(w http.ResponseWriter, r *http.Request, fundId FundId, transferId TransferId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) ReverseTransfer(w http.ResponseWriter, r *http.Request, fundId FundId, transferId TransferId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) ListUnitEvents(w http.ResponseWriter, r *http.Request, fundId FundId, params ListUnitEventsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) ListOwners(w http.ResponseWriter, r *http.Request, params ListOwnersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) CreateOwner(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) GetOwner(w http.ResponseWriter, r *http.Request, ownerId OwnerId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) UpdateOwner(w http.ResponseWriter, r *http.Request, ownerId OwnerId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) GetOwnerHoldings(w http.ResponseWriter, r *http.Request, ownerId OwnerId, params GetOwnerHoldingsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) ResetDatabase(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) DeleteWebhook(w http.ResponseWriter, r *http.Request, webhookId WebhookId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, webhookId WebhookId, params ListWebhookDeliveriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (_ Unimplemented) RetryWebhookDelivery(w http.ResponseWriter, r *http.Request, webhookId WebhookId, deliveryId DeliveryId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+func (siw *ServerInterfaceWrapper) ListApiKeys(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListApiKeys(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) CreateApiKey(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateApiKey(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) RevokeApiKey(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var keyId ApiKeyId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "keyId", chi.URLParam(r, "keyId"), &keyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeApiKey(w, r, keyId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) ChangeFundStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var fundId FundId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundId", chi.URLParam(r, "fundId"), &fundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ChangeFundStatus(w, r, fundId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) GetReconciliation(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReconciliation(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) ListAuditEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	var params ListAuditEventsParams
+
+
+	err = runtime.BindQueryParameter("form", true, false, "fundId", r.URL.Query(), &params.FundId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", r.URL.Query(), &params.Actor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actor", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAuditEvents(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) ListFunds(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	var params ListFundsParams
+
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListFunds(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) CreateFund(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateFund(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) ImportFund(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	var params ImportFundParams
+
+
+	err = runtime.BindQueryParameter("form", true, false, "name", r.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "totalUnits", r.URL.Query(), &params.TotalUnits)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "totalUnits", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportFund(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) GetFund(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var fundId FundId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundId", chi.URLParam(r, "fundId"), &fundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFund(w, r, fundId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) SetApprovalThreshold(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var fundId FundId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundId", chi.URLParam(r, "fundId"), &fundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetApprovalThreshold(w, r, fundId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) GetCapTable(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var fundId FundId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundId", chi.URLParam(r, "fundId"), &fundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	var params GetCapTableParams
+
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "asOf", r.URL.Query(), &params.AsOf)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "asOf", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCapTable(w, r, fundId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) ExportCapTable(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var fundId FundId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundId", chi.URLParam(r, "fundId"), &fundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	var params ExportCapTableParams
+
+	headers := r.Header
+
+	if valueList, found := headers[http.CanonicalHeaderKey("Accept")]; found {
+		var Accept ExportAccept
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Accept", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Accept", valueList[0], &Accept, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Accept", Err: err})
+			return
+		}
+
+		params.Accept = &Accept
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportCapTable(w, r, fundId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) ListCapitalCalls(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var fundId FundId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundId", chi.URLParam(r, "fundId"), &fundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	var params ListCapitalCallsParams
+
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCapitalCalls(w, r, fundId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) CreateCapitalCall(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var fundId FundId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundId", chi.URLParam(r, "fundId"), &fundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCapitalCall(w, r, fundId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) GetCapitalCall(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var fundId FundId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundId", chi.URLParam(r, "fundId"), &fundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
+		return
+	}
+
+	var capitalCallId CapitalCallId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "capitalCallId", chi.URLParam(r, "capitalCallId"), &capitalCallId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "capitalCallId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCapitalCall(w, r, fundId, capitalCallId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) ListCommitments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var fundId FundId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundId", chi.URLParam(r, "fundId"), &fundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	var params ListCommitmentsParams
+
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCommitments(w, r, fundId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) CreateCommitment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var fundId FundId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundId", chi.URLParam(r, "fundId"), &fundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCommitment(w, r, fundId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) ListDistributions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var fundId FundId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundId", chi.URLParam(r, "fundId"), &fundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	var params ListDistributionsParams
+
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListDistributions(w, r, fundId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) CreateDistribution(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var fundId FundId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundId", chi.URLParam(r, "fundId"), &fundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateDistribution(w, r, fundId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) GetDistribution(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var fundId FundId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundId", chi.URLParam(r, "fundId"), &fundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
+		return
+	}
+
+	var distributionId DistributionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "distributionId", chi.URLParam(r, "distributionId"), &distributionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "distributionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDistribution(w, r, fundId, distributionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) ExportDistribution(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var fundId FundId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundId", chi.URLParam(r, "fundId"), &fundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
+		return
+	}
+
+	var distributionId DistributionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "distributionId", chi.URLParam(r, "distributionId"), &distributionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "distributionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	var params ExportDistributionParams
+
+	headers := r.Header
+
+	if valueList, found := headers[http.CanonicalHeaderKey("Accept")]; found {
+		var Accept ExportAccept
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Accept", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Accept", valueList[0], &Accept, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Accept", Err: err})
+			return
+		}
+
+		params.Accept = &Accept
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportDistribution(w, r, fundId, distributionId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) StreamFundEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var fundId FundId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundId", chi.URLParam(r, "fundId"), &fundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	var params StreamFundEventsParams
+
+	headers := r.Header
+
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID int64
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StreamFundEvents(w, r, fundId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) IssueUnits(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var fundId FundId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundId", chi.URLParam(r, "fundId"), &fundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.IssueUnits(w, r, fundId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) VerifyLedger(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var fundId FundId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundId", chi.URLParam(r, "fundId"), &fundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerifyLedger(w, r, fundId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) RedeemUnits(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var fundId FundId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundId", chi.URLParam(r, "fundId"), &fundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RedeemUnits(w, r, fundId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) ListShareClasses(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var fundId FundId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundId", chi.URLParam(r, "fundId"), &fundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListShareClasses(w, r, fundId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) CreateShareClass(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var fundId FundId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundId", chi.URLParam(r, "fundId"), &fundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateShareClass(w, r, fundId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) ListTransfers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var fundId FundId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundId", chi.URLParam(r, "fundId"), &fundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	var params ListTransfersParams
+
+
+	err = runtime.BindQueryParameter("form", true, false, "ownerId", r.URL.Query(), &params.OwnerId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ownerId", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "fromOwnerId", r.URL.Query(), &params.FromOwnerId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fromOwnerId", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "toOwnerId", r.URL.Query(), &params.ToOwnerId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "toOwnerId", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "until", r.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "minUnits", r.URL.Query(), &params.MinUnits)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "minUnits", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "maxUnits", r.URL.Query(), &params.MaxUnits)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "maxUnits", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTransfers(w, r, fundId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) CreateTransfer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var fundId FundId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundId", chi.URLParam(r, "fundId"), &fundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTransfer(w, r, fundId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) CreateTransferBatch(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var fundId FundId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundId", chi.URLParam(r, "fundId"), &fundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTransferBatch(w, r, fundId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) ExportTransfers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var fundId FundId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundId", chi.URLParam(r, "fundId"), &fundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	var params ExportTransfersParams
+
+
+	err = runtime.BindQueryParameter("form", true, false, "ownerId", r.URL.Query(), &params.OwnerId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ownerId", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "fromOwnerId", r.URL.Query(), &params.FromOwnerId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fromOwnerId", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "toOwnerId", r.URL.Query(), &params.ToOwnerId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "toOwnerId", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "until", r.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "minUnits", r.URL.Query(), &params.MinUnits)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "minUnits", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "maxUnits", r.URL.Query(), &params.MaxUnits)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "maxUnits", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	if valueList, found := headers[http.CanonicalHeaderKey("Accept")]; found {
+		var Accept ExportAccept
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Accept", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Accept", valueList[0], &Accept, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Accept", Err: err})
+			return
+		}
+
+		params.Accept = &Accept
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportTransfers(w, r, fundId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) ListPendingTransfers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var fundId FundId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundId", chi.URLParam(r, "fundId"), &fundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	var params ListPendingTransfersParams
+
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPendingTransfers(w, r, fundId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) ApproveTransfer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var fundId FundId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundId", chi.URLParam(r, "fundId"), &fundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
+		return
+	}
+
+	var transferId TransferId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "transferId", chi.URLParam(r, "transferId"), &transferId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "transferId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApproveTransfer(w, r, fundId, transferId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) RejectTransfer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var fundId FundId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundId", chi.URLParam(r, "fundId"), &fundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
+		return
+	}
+
+	var transferId TransferId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "transferId", chi.URLParam(r, "transferId"), &transferId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "transferId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RejectTransfer(w, r, fundId, transferId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) ReverseTransfer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var fundId FundId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundId", chi.URLParam(r, "fundId"), &fundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
+		return
+	}
+
+	var transferId TransferId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "transferId", chi.URLParam(r, "transferId"), &transferId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "transferId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReverseTransfer(w, r, fundId, transferId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) ListUnitEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var fundId FundId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundId", chi.URLParam(r, "fundId"), &fundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	var params ListUnitEventsParams
+
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListUnitEvents(w, r, fundId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) ListOwners(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	var params ListOwnersParams
+
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOwners(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) CreateOwner(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateOwner(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) GetOwner(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var ownerId OwnerId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ownerId", chi.URLParam(r, "ownerId"), &ownerId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ownerId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOwner(w, r, ownerId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) UpdateOwner(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var ownerId OwnerId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ownerId", chi.URLParam(r, "ownerId"), &ownerId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ownerId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateOwner(w, r, ownerId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) GetOwnerHoldings(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var ownerId OwnerId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ownerId", chi.URLParam(r, "ownerId"), &ownerId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ownerId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	var params GetOwnerHoldingsParams
+
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOwnerHoldings(w, r, ownerId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) ResetDatabase(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResetDatabase(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWebhooks(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWebhook(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var webhookId WebhookId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", chi.URLParam(r, "webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWebhook(w, r, webhookId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var webhookId WebhookId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", chi.URLParam(r, "webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	var params ListWebhookDeliveriesParams
+
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWebhookDeliveries(w, r, webhookId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (siw *ServerInterfaceWrapper) RetryWebhookDelivery(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	var webhookId WebhookId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", chi.URLParam(r, "webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
+		return
+	}
+
+	var deliveryId DeliveryId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deliveryId", chi.URLParam(r, "deliveryId"), &deliveryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deliveryId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RetryWebhookDelivery(w, r, webhookId, deliveryId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/api-keys", wrapper.ListApiKeys)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/api-keys", wrapper.CreateApiKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/api-keys/{keyId}", wrapper.RevokeApiKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/funds/{fundId}/status", wrapper.ChangeFundStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/reconciliation", wrapper.GetReconciliation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/audit", wrapper.ListAuditEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/funds", wrapper.ListFunds)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/funds", wrapper.CreateFund)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/funds/import", wrapper.ImportFund)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/funds/{fundId}", wrapper.GetFund)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/funds/{fundId}/approval-threshold", wrapper.SetApprovalThreshold)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/funds/{fundId}/cap-table", wrapper.GetCapTable)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/funds/{fundId}/cap-table/export", wrapper.ExportCapTable)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/funds/{fundId}/capital-calls", wrapper.ListCapitalCalls)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/funds/{fundId}/capital-calls", wrapper.CreateCapitalCall)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/funds/{fundId}/capital-calls/{capitalCallId}", wrapper.GetCapitalCall)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/funds/{fundId}/commitments", wrapper.ListCommitments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/funds/{fundId}/commitments", wrapper.CreateCommitment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/funds/{fundId}/distributions", wrapper.ListDistributions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/funds/{fundId}/distributions", wrapper.CreateDistribution)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/funds/{fundId}/distributions/{distributionId}", wrapper.GetDistribution)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/funds/{fundId}/distributions/{distributionId}/export", wrapper.ExportDistribution)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/funds/{fundId}/events", wrapper.StreamFundEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/funds/{fundId}/issuances", wrapper.IssueUnits)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/funds/{fundId}/ledger/verify", wrapper.VerifyLedger)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/funds/{fundId}/redemptions", wrapper.RedeemUnits)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/funds/{fundId}/share-classes", wrapper.ListShareClasses)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/funds/{fundId}/share-classes", wrapper.CreateShareClass)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/funds/{fundId}/transfers", wrapper.ListTransfers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/funds/{fundId}/transfers", wrapper.CreateTransfer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/funds/{fundId}/transfers/batch", wrapper.CreateTransferBatch)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/funds/{fundId}/transfers/export", wrapper.ExportTransfers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/funds/{fundId}/transfers/pending", wrapper.ListPendingTransfers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/funds/{fundId}/transfers/{transferId}/approve", wrapper.ApproveTransfer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/funds/{fundId}/transfers/{transferId}/reject", wrapper.RejectTransfer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/funds/{fundId}/transfers/{transferId}/reverse", wrapper.ReverseTransfer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/funds/{fundId}/unit-events", wrapper.ListUnitEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/owners", wrapper.ListOwners)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/owners", wrapper.CreateOwner)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/owners/{ownerId}", wrapper.GetOwner)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/owners/{ownerId}", wrapper.UpdateOwner)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/owners/{ownerId}/holdings", wrapper.GetOwnerHoldings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/reset", wrapper.ResetDatabase)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks", wrapper.ListWebhooks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks", wrapper.CreateWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/webhooks/{webhookId}", wrapper.DeleteWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks/{webhookId}/deliveries", wrapper.ListWebhookDeliveries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks/{webhookId}/deliveries/{deliveryId}/retry", wrapper.RetryWebhookDelivery)
+	})
+
+	return r
+}
+
+type ApiKeyNotFoundJSONResponse Error
+
+type BadRequestJSONResponse Error
+
+type CapitalCallBadRequestJSONResponse Error
+
+type CapitalCallNotFoundJSONResponse Error
+
+type CommitmentConflictJSONResponse Error
+
+type DistributionNotFoundJSONResponse Error
+
+type DuplicateTransferJSONResponse Error
+
+type ForbiddenJSONResponse Error
+
+type FundNotFoundJSONResponse Error
+
+type InternalErrorJSONResponse Error
+
+type NotAcceptableJSONResponse Error
+
+type OwnerConflictJSONResponse Error
+
+type OwnerNotFoundJSONResponse Error
+
+type TransferBadRequestJSONResponse Error
+
+type TransferNotFoundJSONResponse Error
+
+type TransferNotPendingJSONResponse Error
+
+type TransferReviewNotFoundJSONResponse Error
+
+type UnauthorizedJSONResponse Error
+
+type UnitEventBadRequestJSONResponse Error
+
+type UnitEventConflictJSONResponse Error
+
+type WebhookNotFoundJSONResponse Error
+
+type ListApiKeysRequestObject struct {
+}
+
+type ListApiKeysResponseObject interface {
+	VisitListApiKeysResponse(w http.ResponseWriter) error
+}
+
+type ListApiKeys200JSONResponse ApiKeyList
+
+func (response ListApiKeys200JSONResponse) VisitListApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListApiKeys401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListApiKeys401JSONResponse) VisitListApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListApiKeys403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListApiKeys403JSONResponse) VisitListApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListApiKeys500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ListApiKeys500JSONResponse) VisitListApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateApiKeyRequestObject struct {
+	Body *CreateApiKeyJSONRequestBody
+}
+
+type CreateApiKeyResponseObject interface {
+	VisitCreateApiKeyResponse(w http.ResponseWriter) error
+}
+
+type CreateApiKey201JSONResponse ApiKey
+
+func (response CreateApiKey201JSONResponse) VisitCreateApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateApiKey400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateApiKey400JSONResponse) VisitCreateApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateApiKey401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateApiKey401JSONResponse) VisitCreateApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateApiKey403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateApiKey403JSONResponse) VisitCreateApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateApiKey500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response CreateApiKey500JSONResponse) VisitCreateApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeApiKeyRequestObject struct {
+	KeyId ApiKeyId `json:"keyId"`
+}
+
+type RevokeApiKeyResponseObject interface {
+	VisitRevokeApiKeyResponse(w http.ResponseWriter) error
+}
+
+type RevokeApiKey204Response struct {
+}
+
+func (response RevokeApiKey204Response) VisitRevokeApiKeyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RevokeApiKey401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RevokeApiKey401JSONResponse) VisitRevokeApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeApiKey403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RevokeApiKey403JSONResponse) VisitRevokeApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeApiKey404JSONResponse struct{ ApiKeyNotFoundJSONResponse }
+
+func (response RevokeApiKey404JSONResponse) VisitRevokeApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeApiKey500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response RevokeApiKey500JSONResponse) VisitRevokeApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeFundStatusRequestObject struct {
+	FundId FundId `json:"fundId"`
+	Body   *ChangeFundStatusJSONRequestBody
+}
+
+type ChangeFundStatusResponseObject interface {
+	VisitChangeFundStatusResponse(w http.ResponseWriter) error
+}
+
+type ChangeFundStatus200JSONResponse Fund
+
+func (response ChangeFundStatus200JSONResponse) VisitChangeFundStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeFundStatus400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ChangeFundStatus400JSONResponse) VisitChangeFundStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeFundStatus401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ChangeFundStatus401JSONResponse) VisitChangeFundStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeFundStatus403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ChangeFundStatus403JSONResponse) VisitChangeFundStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeFundStatus404JSONResponse struct{ FundNotFoundJSONResponse }
+
+func (response ChangeFundStatus404JSONResponse) VisitChangeFundStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeFundStatus409JSONResponse Error
+
+func (response ChangeFundStatus409JSONResponse) VisitChangeFundStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeFundStatus500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ChangeFundStatus500JSONResponse) VisitChangeFundStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReconciliationRequestObject struct {
+}
+
+type GetReconciliationResponseObject interface {
+	VisitGetReconciliationResponse(w http.ResponseWriter) error
+}
+
+type GetReconciliation200JSONResponse ReconciliationReport
+
+func (response GetReconciliation200JSONResponse) VisitGetReconciliationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReconciliation401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetReconciliation401JSONResponse) VisitGetReconciliationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReconciliation403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetReconciliation403JSONResponse) VisitGetReconciliationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReconciliation500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetReconciliation500JSONResponse) VisitGetReconciliationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAuditEventsRequestObject struct {
+	Params ListAuditEventsParams
+}
+
+type ListAuditEventsResponseObject interface {
+	VisitListAuditEventsResponse(w http.ResponseWriter) error
+}
+
+type ListAuditEvents200JSONResponse AuditEventList
+
+func (response ListAuditEvents200JSONResponse) VisitListAuditEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAuditEvents400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListAuditEvents400JSONResponse) VisitListAuditEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAuditEvents401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListAuditEvents401JSONResponse) VisitListAuditEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAuditEvents403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListAuditEvents403JSONResponse) VisitListAuditEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAuditEvents500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ListAuditEvents500JSONResponse) VisitListAuditEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFundsRequestObject struct {
+	Params ListFundsParams
+}
+
+type ListFundsResponseObject interface {
+	VisitListFundsResponse(w http.ResponseWriter) error
+}
+
+type ListFunds200JSONResponse FundList
+
+func (response ListFunds200JSONResponse) VisitListFundsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFunds400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListFunds400JSONResponse) VisitListFundsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFunds401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListFunds401JSONResponse) VisitListFundsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFunds403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListFunds403JSONResponse) VisitListFundsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFunds500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ListFunds500JSONResponse) VisitListFundsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateFundRequestObject struct {
+	Body *CreateFundJSONRequestBody
+}
+
+type CreateFundResponseObject interface {
+	VisitCreateFundResponse(w http.ResponseWriter) error
+}
+
+type CreateFund201JSONResponse Fund
+
+func (response CreateFund201JSONResponse) VisitCreateFundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateFund400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateFund400JSONResponse) VisitCreateFundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateFund401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateFund401JSONResponse) VisitCreateFundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateFund403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateFund403JSONResponse) VisitCreateFundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateFund404JSONResponse struct{ OwnerNotFoundJSONResponse }
+
+func (response CreateFund404JSONResponse) VisitCreateFundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateFund409JSONResponse struct{ OwnerConflictJSONResponse }
+
+func (response CreateFund409JSONResponse) VisitCreateFundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateFund500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response CreateFund500JSONResponse) VisitCreateFundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportFundRequestObject struct {
+	Params   ImportFundParams
+	JSONBody *ImportFundJSONRequestBody
+	Body     io.Reader
+}
+
+type ImportFundResponseObject interface {
+	VisitImportFundResponse(w http.ResponseWriter) error
+}
+
+type ImportFund201JSONResponse Fund
+
+func (response ImportFund201JSONResponse) VisitImportFundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportFund400JSONResponse Error
+
+func (response ImportFund400JSONResponse) VisitImportFundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportFund401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ImportFund401JSONResponse) VisitImportFundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportFund403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ImportFund403JSONResponse) VisitImportFundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportFund500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ImportFund500JSONResponse) VisitImportFundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFundRequestObject struct {
+	FundId FundId `json:"fundId"`
+}
+
+type GetFundResponseObject interface {
+	VisitGetFundResponse(w http.ResponseWriter) error
+}
+
+type GetFund200JSONResponse Fund
+
+func (response GetFund200JSONResponse) VisitGetFundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFund400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetFund400JSONResponse) VisitGetFundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFund401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetFund401JSONResponse) VisitGetFundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFund403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetFund403JSONResponse) VisitGetFundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFund404JSONResponse struct{ FundNotFoundJSONResponse }
+
+func (response GetFund404JSONResponse) VisitGetFundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFund500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetFund500JSONResponse) VisitGetFundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetApprovalThresholdRequestObject struct {
+	FundId FundId `json:"fundId"`
+	Body   *SetApprovalThresholdJSONRequestBody
+}
+
+type SetApprovalThresholdResponseObject interface {
+	VisitSetApprovalThresholdResponse(w http.ResponseWriter) error
+}
+
+type SetApprovalThreshold200JSONResponse Fund
+
+func (response SetApprovalThreshold200JSONResponse) VisitSetApprovalThresholdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetApprovalThreshold400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetApprovalThreshold400JSONResponse) VisitSetApprovalThresholdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetApprovalThreshold401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response SetApprovalThreshold401JSONResponse) VisitSetApprovalThresholdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetApprovalThreshold403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response SetApprovalThreshold403JSONResponse) VisitSetApprovalThresholdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetApprovalThreshold404JSONResponse struct{ FundNotFoundJSONResponse }
+
+func (response SetApprovalThreshold404JSONResponse) VisitSetApprovalThresholdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetApprovalThreshold500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response SetApprovalThreshold500JSONResponse) VisitSetApprovalThresholdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapTableRequestObject struct {
+	FundId FundId `json:"fundId"`
+	Params GetCapTableParams
+}
+
+type GetCapTableResponseObject interface {
+	VisitGetCapTableResponse(w http.ResponseWriter) error
+}
+
+type GetCapTable200JSONResponse CapTable
+
+func (response GetCapTable200JSONResponse) VisitGetCapTableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapTable400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetCapTable400JSONResponse) VisitGetCapTableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapTable401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetCapTable401JSONResponse) VisitGetCapTableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapTable403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetCapTable403JSONResponse) VisitGetCapTableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapTable404JSONResponse struct{ FundNotFoundJSONResponse }
+
+func (response GetCapTable404JSONResponse) VisitGetCapTableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapTable500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetCapTable500JSONResponse) VisitGetCapTableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportCapTableRequestObject struct {
+	FundId FundId `json:"fundId"`
+	Params ExportCapTableParams
+}
+
+type ExportCapTableResponseObject interface {
+	VisitExportCapTableResponse(w http.ResponseWriter) error
+}
+
+type ExportCapTable200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response ExportCapTable200ApplicationxNdjsonResponse) VisitExportCapTableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportCapTable200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response ExportCapTable200TextcsvResponse) VisitExportCapTableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportCapTable401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ExportCapTable401JSONResponse) VisitExportCapTableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportCapTable403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ExportCapTable403JSONResponse) VisitExportCapTableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportCapTable404JSONResponse struct{ FundNotFoundJSONResponse }
+
+func (response ExportCapTable404JSONResponse) VisitExportCapTableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportCapTable406JSONResponse struct{ NotAcceptableJSONResponse }
+
+func (response ExportCapTable406JSONResponse) VisitExportCapTableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(406)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportCapTable500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ExportCapTable500JSONResponse) VisitExportCapTableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCapitalCallsRequestObject struct {
+	FundId FundId `json:"fundId"`
+	Params ListCapitalCallsParams
+}
+
+type ListCapitalCallsResponseObject interface {
+	VisitListCapitalCallsResponse(w http.ResponseWriter) error
+}
+
+type ListCapitalCalls200JSONResponse CapitalCallList
+
+func (response ListCapitalCalls200JSONResponse) VisitListCapitalCallsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCapitalCalls400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListCapitalCalls400JSONResponse) VisitListCapitalCallsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCapitalCalls401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListCapitalCalls401JSONResponse) VisitListCapitalCallsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCapitalCalls403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListCapitalCalls403JSONResponse) VisitListCapitalCallsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCapitalCalls404JSONResponse struct{ FundNotFoundJSONResponse }
+
+func (response ListCapitalCalls404JSONResponse) VisitListCapitalCallsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCapitalCalls500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ListCapitalCalls500JSONResponse) VisitListCapitalCallsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCapitalCallRequestObject struct {
+	FundId FundId `json:"fundId"`
+	Body   *CreateCapitalCallJSONRequestBody
+}
+
+type CreateCapitalCallResponseObject interface {
+	VisitCreateCapitalCallResponse(w http.ResponseWriter) error
+}
+
+type CreateCapitalCall201JSONResponse CapitalCall
+
+func (response CreateCapitalCall201JSONResponse) VisitCreateCapitalCallResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCapitalCall400JSONResponse struct {
+	CapitalCallBadRequestJSONResponse
+}
+
+func (response CreateCapitalCall400JSONResponse) VisitCreateCapitalCallResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCapitalCall401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateCapitalCall401JSONResponse) VisitCreateCapitalCallResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCapitalCall403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateCapitalCall403JSONResponse) VisitCreateCapitalCallResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCapitalCall404JSONResponse struct{ FundNotFoundJSONResponse }
+
+func (response CreateCapitalCall404JSONResponse) VisitCreateCapitalCallResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCapitalCall409JSONResponse Error
+
+func (response CreateCapitalCall409JSONResponse) VisitCreateCapitalCallResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCapitalCall500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response CreateCapitalCall500JSONResponse) VisitCreateCapitalCallResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapitalCallRequestObject struct {
+	FundId        FundId        `json:"fundId"`
+	CapitalCallId CapitalCallId `json:"capitalCallId"`
+}
+
+type GetCapitalCallResponseObject interface {
+	VisitGetCapitalCallResponse(w http.ResponseWriter) error
+}
+
+type GetCapitalCall200JSONResponse CapitalCall
+
+func (response GetCapitalCall200JSONResponse) VisitGetCapitalCallResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapitalCall401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetCapitalCall401JSONResponse) VisitGetCapitalCallResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapitalCall403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetCapitalCall403JSONResponse) VisitGetCapitalCallResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapitalCall404JSONResponse struct {
+	CapitalCallNotFoundJSONResponse
+}
+
+func (response GetCapitalCall404JSONResponse) VisitGetCapitalCallResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapitalCall500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetCapitalCall500JSONResponse) VisitGetCapitalCallResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCommitmentsRequestObject struct {
+	FundId FundId `json:"fundId"`
+	Params ListCommitmentsParams
+}
+
+type ListCommitmentsResponseObject interface {
+	VisitListCommitmentsResponse(w http.ResponseWriter) error
+}
+
+type ListCommitments200JSONResponse CommitmentList
+
+func (response ListCommitments200JSONResponse) VisitListCommitmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCommitments400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListCommitments400JSONResponse) VisitListCommitmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCommitments401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListCommitments401JSONResponse) VisitListCommitmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCommitments403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListCommitments403JSONResponse) VisitListCommitmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCommitments404JSONResponse struct{ FundNotFoundJSONResponse }
+
+func (response ListCommitments404JSONResponse) VisitListCommitmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCommitments500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ListCommitments500JSONResponse) VisitListCommitmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCommitmentRequestObject struct {
+	FundId FundId `json:"fundId"`
+	Body   *CreateCommitmentJSONRequestBody
+}
+
+type CreateCommitmentResponseObject interface {
+	VisitCreateCommitmentResponse(w http.ResponseWriter) error
+}
+
+type CreateCommitment201JSONResponse Commitment
+
+func (response CreateCommitment201JSONResponse) VisitCreateCommitmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCommitment400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateCommitment400JSONResponse) VisitCreateCommitmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCommitment401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateCommitment401JSONResponse) VisitCreateCommitmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCommitment403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateCommitment403JSONResponse) VisitCreateCommitmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCommitment404JSONResponse struct{ TransferNotFoundJSONResponse }
+
+func (response CreateCommitment404JSONResponse) VisitCreateCommitmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCommitment409JSONResponse struct{ CommitmentConflictJSONResponse }
+
+func (response CreateCommitment409JSONResponse) VisitCreateCommitmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCommitment500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response CreateCommitment500JSONResponse) VisitCreateCommitmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDistributionsRequestObject struct {
+	FundId FundId `json:"fundId"`
+	Params ListDistributionsParams
+}
+
+type ListDistributionsResponseObject interface {
+	VisitListDistributionsResponse(w http.ResponseWriter) error
+}
+
+type ListDistributions200JSONResponse DistributionList
+
+func (response ListDistributions200JSONResponse) VisitListDistributionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDistributions400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListDistributions400JSONResponse) VisitListDistributionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDistributions401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListDistributions401JSONResponse) VisitListDistributionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDistributions403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListDistributions403JSONResponse) VisitListDistributionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDistributions404JSONResponse struct{ FundNotFoundJSONResponse }
+
+func (response ListDistributions404JSONResponse) VisitListDistributionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDistributions500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ListDistributions500JSONResponse) VisitListDistributionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDistributionRequestObject struct {
+	FundId FundId `json:"fundId"`
+	Body   *CreateDistributionJSONRequestBody
+}
+
+type CreateDistributionResponseObject interface {
+	VisitCreateDistributionResponse(w http.ResponseWriter) error
+}
+
+type CreateDistribution201JSONResponse Distribution
+
+func (response CreateDistribution201JSONResponse) VisitCreateDistributionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDistribution400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateDistribution400JSONResponse) VisitCreateDistributionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDistribution401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateDistribution401JSONResponse) VisitCreateDistributionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDistribution403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateDistribution403JSONResponse) VisitCreateDistributionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDistribution404JSONResponse struct{ FundNotFoundJSONResponse }
+
+func (response CreateDistribution404JSONResponse) VisitCreateDistributionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDistribution500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response CreateDistribution500JSONResponse) VisitCreateDistributionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDistributionRequestObject struct {
+	FundId         FundId         `json:"fundId"`
+	DistributionId DistributionId `json:"distributionId"`
+}
+
+type GetDistributionResponseObject interface {
+	VisitGetDistributionResponse(w http.ResponseWriter) error
+}
+
+type GetDistribution200JSONResponse Distribution
+
+func (response GetDistribution200JSONResponse) VisitGetDistributionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDistribution401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetDistribution401JSONResponse) VisitGetDistributionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDistribution403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetDistribution403JSONResponse) VisitGetDistributionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDistribution404JSONResponse struct {
+	DistributionNotFoundJSONResponse
+}
+
+func (response GetDistribution404JSONResponse) VisitGetDistributionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDistribution500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetDistribution500JSONResponse) VisitGetDistributionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportDistributionRequestObject struct {
+	FundId         FundId         `json:"fundId"`
+	DistributionId DistributionId `json:"distributionId"`
+	Params         ExportDistributionParams
+}
+
+type ExportDistributionResponseObject interface {
+	VisitExportDistributionResponse(w http.ResponseWriter) error
+}
+
+type ExportDistribution200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response ExportDistribution200ApplicationxNdjsonResponse) VisitExportDistributionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportDistribution200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response ExportDistribution200TextcsvResponse) VisitExportDistributionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportDistribution401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ExportDistribution401JSONResponse) VisitExportDistributionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportDistribution403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ExportDistribution403JSONResponse) VisitExportDistributionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportDistribution404JSONResponse struct {
+	DistributionNotFoundJSONResponse
+}
+
+func (response ExportDistribution404JSONResponse) VisitExportDistributionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportDistribution406JSONResponse struct{ NotAcceptableJSONResponse }
+
+func (response ExportDistribution406JSONResponse) VisitExportDistributionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(406)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportDistribution500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ExportDistribution500JSONResponse) VisitExportDistributionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamFundEventsRequestObject struct {
+	FundId FundId `json:"fundId"`
+	Params StreamFundEventsParams
+}
+
+type StreamFundEventsResponseObject interface {
+	VisitStreamFundEventsResponse(w http.ResponseWriter) error
+}
+
+type StreamFundEvents200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response StreamFundEvents200TexteventStreamResponse) VisitStreamFundEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type StreamFundEvents401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response StreamFundEvents401JSONResponse) VisitStreamFundEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamFundEvents403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response StreamFundEvents403JSONResponse) VisitStreamFundEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamFundEvents404JSONResponse struct{ FundNotFoundJSONResponse }
+
+func (response StreamFundEvents404JSONResponse) VisitStreamFundEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamFundEvents500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response StreamFundEvents500JSONResponse) VisitStreamFundEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IssueUnitsRequestObject struct {
+	FundId FundId `json:"fundId"`
+	Body   *IssueUnitsJSONRequestBody
+}
+
+type IssueUnitsResponseObject interface {
+	VisitIssueUnitsResponse(w http.ResponseWriter) error
+}
+
+type IssueUnits201JSONResponse UnitEvent
+
+func (response IssueUnits201JSONResponse) VisitIssueUnitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IssueUnits400JSONResponse struct {
+	UnitEventBadRequestJSONResponse
+}
+
+func (response IssueUnits400JSONResponse) VisitIssueUnitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IssueUnits401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response IssueUnits401JSONResponse) VisitIssueUnitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IssueUnits403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response IssueUnits403JSONResponse) VisitIssueUnitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IssueUnits404JSONResponse struct{ TransferNotFoundJSONResponse }
+
+func (response IssueUnits404JSONResponse) VisitIssueUnitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IssueUnits409JSONResponse struct{ UnitEventConflictJSONResponse }
+
+func (response IssueUnits409JSONResponse) VisitIssueUnitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IssueUnits500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response IssueUnits500JSONResponse) VisitIssueUnitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyLedgerRequestObject struct {
+	FundId FundId `json:"fundId"`
+}
+
+type VerifyLedgerResponseObject interface {
+	VisitVerifyLedgerResponse(w http.ResponseWriter) error
+}
+
+type VerifyLedger200JSONResponse LedgerVerification
+
+func (response VerifyLedger200JSONResponse) VisitVerifyLedgerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyLedger401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response VerifyLedger401JSONResponse) VisitVerifyLedgerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyLedger403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response VerifyLedger403JSONResponse) VisitVerifyLedgerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyLedger404JSONResponse struct{ FundNotFoundJSONResponse }
+
+func (response VerifyLedger404JSONResponse) VisitVerifyLedgerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyLedger500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response VerifyLedger500JSONResponse) VisitVerifyLedgerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RedeemUnitsRequestObject struct {
+	FundId FundId `json:"fundId"`
+	Body   *RedeemUnitsJSONRequestBody
+}
+
+type RedeemUnitsResponseObject interface {
+	VisitRedeemUnitsResponse(w http.ResponseWriter) error
+}
+
+type RedeemUnits201JSONResponse UnitEvent
+
+func (response RedeemUnits201JSONResponse) VisitRedeemUnitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RedeemUnits400JSONResponse struct {
+	UnitEventBadRequestJSONResponse
+}
+
+func (response RedeemUnits400JSONResponse) VisitRedeemUnitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RedeemUnits401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RedeemUnits401JSONResponse) VisitRedeemUnitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RedeemUnits403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RedeemUnits403JSONResponse) VisitRedeemUnitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RedeemUnits404JSONResponse struct{ TransferNotFoundJSONResponse }
+
+func (response RedeemUnits404JSONResponse) VisitRedeemUnitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RedeemUnits409JSONResponse struct{ UnitEventConflictJSONResponse }
+
+func (response RedeemUnits409JSONResponse) VisitRedeemUnitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RedeemUnits500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response RedeemUnits500JSONResponse) VisitRedeemUnitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListShareClassesRequestObject struct {
+	FundId FundId `json:"fundId"`
+}
+
+type ListShareClassesResponseObject interface {
+	VisitListShareClassesResponse(w http.ResponseWriter) error
+}
+
+type ListShareClasses200JSONResponse ShareClassList
+
+func (response ListShareClasses200JSONResponse) VisitListShareClassesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListShareClasses401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListShareClasses401JSONResponse) VisitListShareClassesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListShareClasses403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListShareClasses403JSONResponse) VisitListShareClassesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListShareClasses404JSONResponse struct{ FundNotFoundJSONResponse }
+
+func (response ListShareClasses404JSONResponse) VisitListShareClassesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListShareClasses500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ListShareClasses500JSONResponse) VisitListShareClassesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateShareClassRequestObject struct {
+	FundId FundId `json:"fundId"`
+	Body   *CreateShareClassJSONRequestBody
+}
+
+type CreateShareClassResponseObject interface {
+	VisitCreateShareClassResponse(w http.ResponseWriter) error
+}
+
+type CreateShareClass201JSONResponse ShareClass
+
+func (response CreateShareClass201JSONResponse) VisitCreateShareClassResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateShareClass400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateShareClass400JSONResponse) VisitCreateShareClassResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateShareClass401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateShareClass401JSONResponse) VisitCreateShareClassResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateShareClass403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateShareClass403JSONResponse) VisitCreateShareClassResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateShareClass404JSONResponse struct{ FundNotFoundJSONResponse }
+
+func (response CreateShareClass404JSONResponse) VisitCreateShareClassResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateShareClass409JSONResponse Error
+
+func (response CreateShareClass409JSONResponse) VisitCreateShareClassResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateShareClass500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response CreateShareClass500JSONResponse) VisitCreateShareClassResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTransfersRequestObject struct {
+	FundId FundId `json:"fundId"`
+	Params ListTransfersParams
+}
+
+type ListTransfersResponseObject interface {
+	VisitListTransfersResponse(w http.ResponseWriter) error
+}
+
+type ListTransfers200JSONResponse TransferList
+
+func (response ListTransfers200JSONResponse) VisitListTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTransfers400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListTransfers400JSONResponse) VisitListTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTransfers401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListTransfers401JSONResponse) VisitListTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTransfers403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListTransfers403JSONResponse) VisitListTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTransfers404JSONResponse struct{ FundNotFoundJSONResponse }
+
+func (response ListTransfers404JSONResponse) VisitListTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTransfers500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ListTransfers500JSONResponse) VisitListTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTransferRequestObject struct {
+	FundId FundId `json:"fundId"`
+	Body   *CreateTransferJSONRequestBody
+}
+
+type CreateTransferResponseObject interface {
+	VisitCreateTransferResponse(w http.ResponseWriter) error
+}
+
+type CreateTransfer200JSONResponse Transfer
+
+func (response CreateTransfer200JSONResponse) VisitCreateTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTransfer201JSONResponse Transfer
+
+func (response CreateTransfer201JSONResponse) VisitCreateTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTransfer202JSONResponse Transfer
+
+func (response CreateTransfer202JSONResponse) VisitCreateTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTransfer400JSONResponse struct{ TransferBadRequestJSONResponse }
+
+func (response CreateTransfer400JSONResponse) VisitCreateTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTransfer401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateTransfer401JSONResponse) VisitCreateTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTransfer403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateTransfer403JSONResponse) VisitCreateTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTransfer404JSONResponse struct{ TransferNotFoundJSONResponse }
+
+func (response CreateTransfer404JSONResponse) VisitCreateTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTransfer409JSONResponse struct{ DuplicateTransferJSONResponse }
+
+func (response CreateTransfer409JSONResponse) VisitCreateTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTransfer500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response CreateTransfer500JSONResponse) VisitCreateTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTransferBatchRequestObject struct {
+	FundId FundId `json:"fundId"`
+	Body   *CreateTransferBatchJSONRequestBody
+}
+
+type CreateTransferBatchResponseObject interface {
+	VisitCreateTransferBatchResponse(w http.ResponseWriter) error
+}
+
+type CreateTransferBatch201JSONResponse TransferBatch
+
+func (response CreateTransferBatch201JSONResponse) VisitCreateTransferBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTransferBatch400JSONResponse struct{ TransferBadRequestJSONResponse }
+
+func (response CreateTransferBatch400JSONResponse) VisitCreateTransferBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTransferBatch401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateTransferBatch401JSONResponse) VisitCreateTransferBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTransferBatch403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateTransferBatch403JSONResponse) VisitCreateTransferBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTransferBatch404JSONResponse struct{ TransferNotFoundJSONResponse }
+
+func (response CreateTransferBatch404JSONResponse) VisitCreateTransferBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTransferBatch409JSONResponse struct{ DuplicateTransferJSONResponse }
+
+func (response CreateTransferBatch409JSONResponse) VisitCreateTransferBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTransferBatch500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response CreateTransferBatch500JSONResponse) VisitCreateTransferBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportTransfersRequestObject struct {
+	FundId FundId `json:"fundId"`
+	Params ExportTransfersParams
+}
+
+type ExportTransfersResponseObject interface {
+	VisitExportTransfersResponse(w http.ResponseWriter) error
+}
+
+type ExportTransfers200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response ExportTransfers200ApplicationxNdjsonResponse) VisitExportTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportTransfers200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response ExportTransfers200TextcsvResponse) VisitExportTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportTransfers400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ExportTransfers400JSONResponse) VisitExportTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportTransfers401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ExportTransfers401JSONResponse) VisitExportTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportTransfers403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ExportTransfers403JSONResponse) VisitExportTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportTransfers404JSONResponse struct{ FundNotFoundJSONResponse }
+
+func (response ExportTransfers404JSONResponse) VisitExportTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportTransfers406JSONResponse struct{ NotAcceptableJSONResponse }
+
+func (response ExportTransfers406JSONResponse) VisitExportTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(406)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportTransfers500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ExportTransfers500JSONResponse) VisitExportTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPendingTransfersRequestObject struct {
+	FundId FundId `json:"fundId"`
+	Params ListPendingTransfersParams
+}
+
+type ListPendingTransfersResponseObject interface {
+	VisitListPendingTransfersResponse(w http.ResponseWriter) error
+}
+
+type ListPendingTransfers200JSONResponse TransferList
+
+func (response ListPendingTransfers200JSONResponse) VisitListPendingTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPendingTransfers400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListPendingTransfers400JSONResponse) VisitListPendingTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPendingTransfers401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListPendingTransfers401JSONResponse) VisitListPendingTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPendingTransfers403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListPendingTransfers403JSONResponse) VisitListPendingTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPendingTransfers404JSONResponse struct{ FundNotFoundJSONResponse }
+
+func (response ListPendingTransfers404JSONResponse) VisitListPendingTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPendingTransfers500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ListPendingTransfers500JSONResponse) VisitListPendingTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveTransferRequestObject struct {
+	FundId     FundId     `json:"fundId"`
+	TransferId TransferId `json:"transferId"`
+	Body       *ApproveTransferJSONRequestBody
+}
+
+type ApproveTransferResponseObject interface {
+	VisitApproveTransferResponse(w http.ResponseWriter) error
+}
+
+type ApproveTransfer200JSONResponse Transfer
+
+func (response ApproveTransfer200JSONResponse) VisitApproveTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveTransfer400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ApproveTransfer400JSONResponse) VisitApproveTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveTransfer401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ApproveTransfer401JSONResponse) VisitApproveTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveTransfer403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ApproveTransfer403JSONResponse) VisitApproveTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveTransfer404JSONResponse struct {
+	TransferReviewNotFoundJSONResponse
+}
+
+func (response ApproveTransfer404JSONResponse) VisitApproveTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveTransfer409JSONResponse struct{ TransferNotPendingJSONResponse }
+
+func (response ApproveTransfer409JSONResponse) VisitApproveTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveTransfer500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ApproveTransfer500JSONResponse) VisitApproveTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectTransferRequestObject struct {
+	FundId     FundId     `json:"fundId"`
+	TransferId TransferId `json:"transferId"`
+	Body       *RejectTransferJSONRequestBody
+}
+
+type RejectTransferResponseObject interface {
+	VisitRejectTransferResponse(w http.ResponseWriter) error
+}
+
+type RejectTransfer200JSONResponse Transfer
+
+func (response RejectTransfer200JSONResponse) VisitRejectTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectTransfer400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RejectTransfer400JSONResponse) VisitRejectTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectTransfer401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RejectTransfer401JSONResponse) VisitRejectTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectTransfer403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RejectTransfer403JSONResponse) VisitRejectTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectTransfer404JSONResponse struct {
+	TransferReviewNotFoundJSONResponse
+}
+
+func (response RejectTransfer404JSONResponse) VisitRejectTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectTransfer409JSONResponse struct{ TransferNotPendingJSONResponse }
+
+func (response RejectTransfer409JSONResponse) VisitRejectTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectTransfer500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response RejectTransfer500JSONResponse) VisitRejectTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReverseTransferRequestObject struct {
+	FundId     FundId     `json:"fundId"`
+	TransferId TransferId `json:"transferId"`
+}
+
+type ReverseTransferResponseObject interface {
+	VisitReverseTransferResponse(w http.ResponseWriter) error
+}
+
+type ReverseTransfer201JSONResponse Transfer
+
+func (response ReverseTransfer201JSONResponse) VisitReverseTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReverseTransfer400JSONResponse Error
+
+func (response ReverseTransfer400JSONResponse) VisitReverseTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReverseTransfer401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ReverseTransfer401JSONResponse) VisitReverseTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReverseTransfer403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ReverseTransfer403JSONResponse) VisitReverseTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReverseTransfer404JSONResponse Error
+
+func (response ReverseTransfer404JSONResponse) VisitReverseTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReverseTransfer409JSONResponse Error
+
+func (response ReverseTransfer409JSONResponse) VisitReverseTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReverseTransfer500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ReverseTransfer500JSONResponse) VisitReverseTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUnitEventsRequestObject struct {
+	FundId FundId `json:"fundId"`
+	Params ListUnitEventsParams
+}
+
+type ListUnitEventsResponseObject interface {
+	VisitListUnitEventsResponse(w http.ResponseWriter) error
+}
+
+type ListUnitEvents200JSONResponse UnitEventList
+
+func (response ListUnitEvents200JSONResponse) VisitListUnitEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUnitEvents400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListUnitEvents400JSONResponse) VisitListUnitEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUnitEvents401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListUnitEvents401JSONResponse) VisitListUnitEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUnitEvents403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListUnitEvents403JSONResponse) VisitListUnitEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUnitEvents404JSONResponse struct{ FundNotFoundJSONResponse }
+
+func (response ListUnitEvents404JSONResponse) VisitListUnitEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUnitEvents500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ListUnitEvents500JSONResponse) VisitListUnitEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOwnersRequestObject struct {
+	Params ListOwnersParams
+}
+
+type ListOwnersResponseObject interface {
+	VisitListOwnersResponse(w http.ResponseWriter) error
+}
+
+type ListOwners200JSONResponse OwnerList
+
+func (response ListOwners200JSONResponse) VisitListOwnersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOwners400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListOwners400JSONResponse) VisitListOwnersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOwners401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListOwners401JSONResponse) VisitListOwnersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOwners403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListOwners403JSONResponse) VisitListOwnersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOwners500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ListOwners500JSONResponse) VisitListOwnersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOwnerRequestObject struct {
+	Body *CreateOwnerJSONRequestBody
+}
+
+type CreateOwnerResponseObject interface {
+	VisitCreateOwnerResponse(w http.ResponseWriter) error
+}
+
+type CreateOwner201JSONResponse Owner
+
+func (response CreateOwner201JSONResponse) VisitCreateOwnerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOwner400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateOwner400JSONResponse) VisitCreateOwnerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOwner401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateOwner401JSONResponse) VisitCreateOwnerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOwner403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateOwner403JSONResponse) VisitCreateOwnerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOwner409JSONResponse struct{ OwnerConflictJSONResponse }
+
+func (response CreateOwner409JSONResponse) VisitCreateOwnerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOwner500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response CreateOwner500JSONResponse) VisitCreateOwnerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOwnerRequestObject struct {
+	OwnerId OwnerId `json:"ownerId"`
+}
+
+type GetOwnerResponseObject interface {
+	VisitGetOwnerResponse(w http.ResponseWriter) error
+}
+
+type GetOwner200JSONResponse Owner
+
+func (response GetOwner200JSONResponse) VisitGetOwnerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOwner401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetOwner401JSONResponse) VisitGetOwnerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOwner403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetOwner403JSONResponse) VisitGetOwnerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOwner404JSONResponse struct{ OwnerNotFoundJSONResponse }
+
+func (response GetOwner404JSONResponse) VisitGetOwnerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOwner500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetOwner500JSONResponse) VisitGetOwnerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOwnerRequestObject struct {
+	OwnerId OwnerId `json:"ownerId"`
+	Body    *UpdateOwnerJSONRequestBody
+}
+
+type UpdateOwnerResponseObject interface {
+	VisitUpdateOwnerResponse(w http.ResponseWriter) error
+}
+
+type UpdateOwner200JSONResponse Owner
+
+func (response UpdateOwner200JSONResponse) VisitUpdateOwnerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOwner400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateOwner400JSONResponse) VisitUpdateOwnerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOwner401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateOwner401JSONResponse) VisitUpdateOwnerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOwner403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdateOwner403JSONResponse) VisitUpdateOwnerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOwner404JSONResponse struct{ OwnerNotFoundJSONResponse }
+
+func (response UpdateOwner404JSONResponse) VisitUpdateOwnerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOwner409JSONResponse struct{ OwnerConflictJSONResponse }
+
+func (response UpdateOwner409JSONResponse) VisitUpdateOwnerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOwner500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response UpdateOwner500JSONResponse) VisitUpdateOwnerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOwnerHoldingsRequestObject struct {
+	OwnerId OwnerId `json:"ownerId"`
+	Params  GetOwnerHoldingsParams
+}
+
+type GetOwnerHoldingsResponseObject interface {
+	VisitGetOwnerHoldingsResponse(w http.ResponseWriter) error
+}
+
+type GetOwnerHoldings200JSONResponse OwnerPortfolio
+
+func (response GetOwnerHoldings200JSONResponse) VisitGetOwnerHoldingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOwnerHoldings400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetOwnerHoldings400JSONResponse) VisitGetOwnerHoldingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOwnerHoldings401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetOwnerHoldings401JSONResponse) VisitGetOwnerHoldingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOwnerHoldings403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetOwnerHoldings403JSONResponse) VisitGetOwnerHoldingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOwnerHoldings404JSONResponse struct{ OwnerNotFoundJSONResponse }
+
+func (response GetOwnerHoldings404JSONResponse) VisitGetOwnerHoldingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOwnerHoldings500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetOwnerHoldings500JSONResponse) VisitGetOwnerHoldingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetDatabaseRequestObject struct {
+}
+
+type ResetDatabaseResponseObject interface {
+	VisitResetDatabaseResponse(w http.ResponseWriter) error
+}
+
+type ResetDatabase200JSONResponse struct {
+	DeletedFunds     *int    `json:"deletedFunds,omitempty"`
+	DeletedOwnership *int    `json:"deletedOwnership,omitempty"`
+	DeletedTransfers *int    `json:"deletedTransfers,omitempty"`
+	Message          *string `json:"message,omitempty"`
+}
+
+func (response ResetDatabase200JSONResponse) VisitResetDatabaseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetDatabase401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ResetDatabase401JSONResponse) VisitResetDatabaseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetDatabase403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ResetDatabase403JSONResponse) VisitResetDatabaseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetDatabase500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ResetDatabase500JSONResponse) VisitResetDatabaseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooksRequestObject struct {
+}
+
+type ListWebhooksResponseObject interface {
+	VisitListWebhooksResponse(w http.ResponseWriter) error
+}
+
+type ListWebhooks200JSONResponse WebhookList
+
+func (response ListWebhooks200JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooks401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListWebhooks401JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooks403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListWebhooks403JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooks500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ListWebhooks500JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhookRequestObject struct {
+	Body *CreateWebhookJSONRequestBody
+}
+
+type CreateWebhookResponseObject interface {
+	VisitCreateWebhookResponse(w http.ResponseWriter) error
+}
+
+type CreateWebhook201JSONResponse Webhook
+
+func (response CreateWebhook201JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateWebhook400JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateWebhook401JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateWebhook403JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response CreateWebhook500JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhookRequestObject struct {
+	WebhookId WebhookId `json:"webhookId"`
+}
+
+type DeleteWebhookResponseObject interface {
+	VisitDeleteWebhookResponse(w http.ResponseWriter) error
+}
+
+type DeleteWebhook204Response struct {
+}
+
+func (response DeleteWebhook204Response) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteWebhook401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteWebhook401JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteWebhook403JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook404JSONResponse struct{ WebhookNotFoundJSONResponse }
+
+func (response DeleteWebhook404JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response DeleteWebhook500JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveriesRequestObject struct {
+	WebhookId WebhookId `json:"webhookId"`
+	Params    ListWebhookDeliveriesParams
+}
+
+type ListWebhookDeliveriesResponseObject interface {
+	VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error
+}
+
+type ListWebhookDeliveries200JSONResponse WebhookDeliveryList
+
+func (response ListWebhookDeliveries200JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListWebhookDeliveries400JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListWebhookDeliveries401JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListWebhookDeliveries403JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries404JSONResponse struct{ WebhookNotFoundJSONResponse }
+
+func (response ListWebhookDeliveries404JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ListWebhookDeliveries500JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryWebhookDeliveryRequestObject struct {
+	WebhookId  WebhookId  `json:"webhookId"`
+	DeliveryId DeliveryId `json:"deliveryId"`
+}
+
+type RetryWebhookDeliveryResponseObject interface {
+	VisitRetryWebhookDeliveryResponse(w http.ResponseWriter) error
+}
+
+type RetryWebhookDelivery200JSONResponse WebhookDelivery
+
+func (response RetryWebhookDelivery200JSONResponse) VisitRetryWebhookDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryWebhookDelivery401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RetryWebhookDelivery401JSONResponse) VisitRetryWebhookDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryWebhookDelivery403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RetryWebhookDelivery403JSONResponse) VisitRetryWebhookDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryWebhookDelivery404JSONResponse struct{ WebhookNotFoundJSONResponse }
+
+func (response RetryWebhookDelivery404JSONResponse) VisitRetryWebhookDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryWebhookDelivery409JSONResponse Error
+
+func (response RetryWebhookDelivery409JSONResponse) VisitRetryWebhookDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryWebhookDelivery500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response RetryWebhookDelivery500JSONResponse) VisitRetryWebhookDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StrictServerInterface interface {
+	ListApiKeys(ctx context.Context, request ListApiKeysRequestObject) (ListApiKeysResponseObject, error)
+	CreateApiKey(ctx context.Context, request CreateApiKeyRequestObject) (CreateApiKeyResponseObject, error)
+	RevokeApiKey(ctx context.Context, request RevokeApiKeyRequestObject) (RevokeApiKeyResponseObject, error)
+	ChangeFundStatus(ctx context.Context, request ChangeFundStatusRequestObject) (ChangeFundStatusResponseObject, error)
+	GetReconciliation(ctx context.Context, request GetReconciliationRequestObject) (GetReconciliationResponseObject, error)
+	ListAuditEvents(ctx context.Context, request ListAuditEventsRequestObject) (ListAuditEventsResponseObject, error)
+	ListFunds(ctx context.Context, request ListFundsRequestObject) (ListFundsResponseObject, error)
+	CreateFund(ctx context.Context, request CreateFundRequestObject) (CreateFundResponseObject, error)
+	ImportFund(ctx context.Context, request ImportFundRequestObject) (ImportFundResponseObject, error)
+	GetFund(ctx context.Context, request GetFundRequestObject) (GetFundResponseObject, error)
+	SetApprovalThreshold(ctx context.Context, request SetApprovalThresholdRequestObject) (SetApprovalThresholdResponseObject, error)
+	GetCapTable(ctx context.Context, request GetCapTableRequestObject) (GetCapTableResponseObject, error)
+	ExportCapTable(ctx context.Context, request ExportCapTableRequestObject) (ExportCapTableResponseObject, error)
+	ListCapitalCalls(ctx context.Context, request ListCapitalCallsRequestObject) (ListCapitalCallsResponseObject, error)
+	CreateCapitalCall(ctx context.Context, request CreateCapitalCallRequestObject) (CreateCapitalCallResponseObject, error)
+	GetCapitalCall(ctx context.Context, request GetCapitalCallRequestObject) (GetCapitalCallResponseObject, error)
+	ListCommitments(ctx context.Context, request ListCommitmentsRequestObject) (ListCommitmentsResponseObject, error)
+	CreateCommitment(ctx context.Context, request CreateCommitmentRequestObject) (CreateCommitmentResponseObject, error)
+	ListDistributions(ctx context.Context, request ListDistributionsRequestObject) (ListDistributionsResponseObject, error)
+	CreateDistribution(ctx context.Context, request CreateDistributionRequestObject) (CreateDistributionResponseObject, error)
+	GetDistribution(ctx context.Context, request GetDistributionRequestObject) (GetDistributionResponseObject, error)
+	ExportDistribution(ctx context.Context, request ExportDistributionRequestObject) (ExportDistributionResponseObject, error)
+	StreamFundEvents(ctx context.Context, request StreamFundEventsRequestObject) (StreamFundEventsResponseObject, error)
+	IssueUnits(ctx context.Context, request IssueUnitsRequestObject) (IssueUnitsResponseObject, error)
+	VerifyLedger(ctx context.Context, request VerifyLedgerRequestObject) (VerifyLedgerResponseObject, error)
+	RedeemUnits(ctx context.Context, request RedeemUnitsRequestObject) (RedeemUnitsResponseObject, error)
+	ListShareClasses(ctx context.Context, request ListShareClassesRequestObject) (ListShareClassesResponseObject, error)
+	CreateShareClass(ctx context.Context, request CreateShareClassRequestObject) (CreateShareClassResponseObject, error)
+	ListTransfers(ctx context.Context, request ListTransfersRequestObject) (ListTransfersResponseObject, error)
+	CreateTransfer(ctx context.Context, request CreateTransferRequestObject) (CreateTransferResponseObject, error)
+	CreateTransferBatch(ctx context.Context, request CreateTransferBatchRequestObject) (CreateTransferBatchResponseObject, error)
+	ExportTransfers(ctx context.Context, request ExportTransfersRequestObject) (ExportTransfersResponseObject, error)
+	ListPendingTransfers(ctx context.Context, request ListPendingTransfersRequestObject) (ListPendingTransfersResponseObject, error)
+	ApproveTransfer(ctx context.Context, request ApproveTransferRequestObject) (ApproveTransferResponseObject, error)
+	RejectTransfer(ctx context.Context, request RejectTransferRequestObject) (RejectTransferResponseObject, error)
+	ReverseTransfer(ctx context.Context, request ReverseTransferRequestObject) (ReverseTransferResponseObject, error)
+	ListUnitEvents(ctx context.Context, request ListUnitEventsRequestObject) (ListUnitEventsResponseObject, error)
+	ListOwners(ctx context.Context, request ListOwnersRequestObject) (ListOwnersResponseObject, error)
+	CreateOwner(ctx context.Context, request CreateOwnerRequestObject) (CreateOwnerResponseObject, error)
+	GetOwner(ctx context.Context, request GetOwnerRequestObject) (GetOwnerResponseObject, error)
+	UpdateOwner(ctx context.Context, request UpdateOwnerRequestObject) (UpdateOwnerResponseObject, error)
+	GetOwnerHoldings(ctx context.Context, request GetOwnerHoldingsRequestObject) (GetOwnerHoldingsResponseObject, error)
+	ResetDatabase(ctx context.Context, request ResetDatabaseRequestObject) (ResetDatabaseResponseObject, error)
+	ListWebhooks(ctx context.Context, request ListWebhooksRequestObject) (ListWebhooksResponseObject, error)
+	CreateWebhook(ctx context.Context, request CreateWebhookRequestObject) (CreateWebhookResponseObject, error)
+	DeleteWebhook(ctx context.Context, request DeleteWebhookRequestObject) (DeleteWebhookResponseObject, error)
+	ListWebhookDeliveries(ctx context.Context, request ListWebhookDeliveriesRequestObject) (ListWebhookDeliveriesResponseObject, error)
+	RetryWebhookDelivery(ctx context.Context, request RetryWebhookDeliveryRequestObject) (RetryWebhookDeliveryResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+func (sh *strictHandler) ListApiKeys(w http.ResponseWriter, r *http.Request) {
+	var request ListApiKeysRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListApiKeys(ctx, request.(ListApiKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListApiKeys")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListApiKeysResponseObject); ok {
+		if err := validResponse.VisitListApiKeysResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) CreateApiKey(w http.ResponseWriter, r *http.Request) {
+	var request CreateApiKeyRequestObject
+
+	var body CreateApiKeyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateApiKey(ctx, request.(CreateApiKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateApiKey")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateApiKeyResponseObject); ok {
+		if err := validResponse.VisitCreateApiKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) RevokeApiKey(w http.ResponseWriter, r *http.Request, keyId ApiKeyId) {
+	var request RevokeApiKeyRequestObject
+
+	request.KeyId = keyId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeApiKey(ctx, request.(RevokeApiKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeApiKey")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevokeApiKeyResponseObject); ok {
+		if err := validResponse.VisitRevokeApiKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) ChangeFundStatus(w http.ResponseWriter, r *http.Request, fundId FundId) {
+	var request ChangeFundStatusRequestObject
+
+	request.FundId = fundId
+
+	var body ChangeFundStatusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ChangeFundStatus(ctx, request.(ChangeFundStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ChangeFundStatus")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ChangeFundStatusResponseObject); ok {
+		if err := validResponse.VisitChangeFundStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) GetReconciliation(w http.ResponseWriter, r *http.Request) {
+	var request GetReconciliationRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReconciliation(ctx, request.(GetReconciliationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReconciliation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetReconciliationResponseObject); ok {
+		if err := validResponse.VisitGetReconciliationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) ListAuditEvents(w http.ResponseWriter, r *http.Request, params ListAuditEventsParams) {
+	var request ListAuditEventsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAuditEvents(ctx, request.(ListAuditEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAuditEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAuditEventsResponseObject); ok {
+		if err := validResponse.VisitListAuditEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) ListFunds(w http.ResponseWriter, r *http.Request, params ListFundsParams) {
+	var request ListFundsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListFunds(ctx, request.(ListFundsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListFunds")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListFundsResponseObject); ok {
+		if err := validResponse.VisitListFundsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) CreateFund(w http.ResponseWriter, r *http.Request) {
+	var request CreateFundRequestObject
+
+	var body CreateFundJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateFund(ctx, request.(CreateFundRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateFund")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateFundResponseObject); ok {
+		if err := validResponse.VisitCreateFundResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) ImportFund(w http.ResponseWriter, r *http.Request, params ImportFundParams) {
+	var request ImportFundRequestObject
+
+	request.Params = params
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+
+		var body ImportFundJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.JSONBody = &body
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "text/csv") {
+		request.Body = r.Body
+	}
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ImportFund(ctx, request.(ImportFundRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ImportFund")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ImportFundResponseObject); ok {
+		if err := validResponse.VisitImportFundResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) GetFund(w http.ResponseWriter, r *http.Request, fundId FundId) {
+	var request GetFundRequestObject
+
+	request.FundId = fundId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetFund(ctx, request.(GetFundRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetFund")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetFundResponseObject); ok {
+		if err := validResponse.VisitGetFundResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) SetApprovalThreshold(w http.ResponseWriter, r *http.Request, fundId FundId) {
+	var request SetApprovalThresholdRequestObject
+
+	request.FundId = fundId
+
+	var body SetApprovalThresholdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetApprovalThreshold(ctx, request.(SetApprovalThresholdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetApprovalThreshold")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetApprovalThresholdResponseObject); ok {
+		if err := validResponse.VisitSetApprovalThresholdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) GetCapTable(w http.ResponseWriter, r *http.Request, fundId FundId, params GetCapTableParams) {
+	var request GetCapTableRequestObject
+
+	request.FundId = fundId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCapTable(ctx, request.(GetCapTableRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCapTable")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCapTableResponseObject); ok {
+		if err := validResponse.VisitGetCapTableResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) ExportCapTable(w http.ResponseWriter, r *http.Request, fundId FundId, params ExportCapTableParams) {
+	var request ExportCapTableRequestObject
+
+	request.FundId = fundId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportCapTable(ctx, request.(ExportCapTableRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportCapTable")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExportCapTableResponseObject); ok {
+		if err := validResponse.VisitExportCapTableResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) ListCapitalCalls(w http.ResponseWriter, r *http.Request, fundId FundId, params ListCapitalCallsParams) {
+	var request ListCapitalCallsRequestObject
+
+	request.FundId = fundId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListCapitalCalls(ctx, request.(ListCapitalCallsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListCapitalCalls")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListCapitalCallsResponseObject); ok {
+		if err := validResponse.VisitListCapitalCallsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) CreateCapitalCall(w http.ResponseWriter, r *http.Request, fundId FundId) {
+	var request CreateCapitalCallRequestObject
+
+	request.FundId = fundId
+
+	var body CreateCapitalCallJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCapitalCall(ctx, request.(CreateCapitalCallRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCapitalCall")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCapitalCallResponseObject); ok {
+		if err := validResponse.VisitCreateCapitalCallResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) GetCapitalCall(w http.ResponseWriter, r *http.Request, fundId FundId, capitalCallId CapitalCallId) {
+	var request GetCapitalCallRequestObject
+
+	request.FundId = fundId
+	request.CapitalCallId = capitalCallId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCapitalCall(ctx, request.(GetCapitalCallRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCapitalCall")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCapitalCallResponseObject); ok {
+		if err := validResponse.VisitGetCapitalCallResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) ListCommitments(w http.ResponseWriter, r *http.Request, fundId FundId, params ListCommitmentsParams) {
+	var request ListCommitmentsRequestObject
+
+	request.FundId = fundId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListCommitments(ctx, request.(ListCommitmentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListCommitments")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListCommitmentsResponseObject); ok {
+		if err := validResponse.VisitListCommitmentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) CreateCommitment(w http.ResponseWriter, r *http.Request, fundId FundId) {
+	var request CreateCommitmentRequestObject
+
+	request.FundId = fundId
+
+	var body CreateCommitmentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCommitment(ctx, request.(CreateCommitmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCommitment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCommitmentResponseObject); ok {
+		if err := validResponse.VisitCreateCommitmentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) ListDistributions(w http.ResponseWriter, r *http.Request, fundId FundId, params ListDistributionsParams) {
+	var request ListDistributionsRequestObject
+
+	request.FundId = fundId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListDistributions(ctx, request.(ListDistributionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListDistributions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListDistributionsResponseObject); ok {
+		if err := validResponse.VisitListDistributionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) CreateDistribution(w http.ResponseWriter, r *http.Request, fundId FundId) {
+	var request CreateDistributionRequestObject
+
+	request.FundId = fundId
+
+	var body CreateDistributionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateDistribution(ctx, request.(CreateDistributionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateDistribution")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateDistributionResponseObject); ok {
+		if err := validResponse.VisitCreateDistributionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) GetDistribution(w http.ResponseWriter, r *http.Request, fundId FundId, distributionId DistributionId) {
+	var request GetDistributionRequestObject
+
+	request.FundId = fundId
+	request.DistributionId = distributionId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDistribution(ctx, request.(GetDistributionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDistribution")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDistributionResponseObject); ok {
+		if err := validResponse.VisitGetDistributionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) ExportDistribution(w http.ResponseWriter, r *http.Request, fundId FundId, distributionId DistributionId, params ExportDistributionParams) {
+	var request ExportDistributionRequestObject
+
+	request.FundId = fundId
+	request.DistributionId = distributionId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportDistribution(ctx, request.(ExportDistributionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportDistribution")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExportDistributionResponseObject); ok {
+		if err := validResponse.VisitExportDistributionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) StreamFundEvents(w http.ResponseWriter, r *http.Request, fundId FundId, params StreamFundEventsParams) {
+	var request StreamFundEventsRequestObject
+
+	request.FundId = fundId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StreamFundEvents(ctx, request.(StreamFundEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StreamFundEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StreamFundEventsResponseObject); ok {
+		if err := validResponse.VisitStreamFundEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) IssueUnits(w http.ResponseWriter, r *http.Request, fundId FundId) {
+	var request IssueUnitsRequestObject
+
+	request.FundId = fundId
+
+	var body IssueUnitsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.IssueUnits(ctx, request.(IssueUnitsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "IssueUnits")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(IssueUnitsResponseObject); ok {
+		if err := validResponse.VisitIssueUnitsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) VerifyLedger(w http.ResponseWriter, r *http.Request, fundId FundId) {
+	var request VerifyLedgerRequestObject
+
+	request.FundId = fundId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.VerifyLedger(ctx, request.(VerifyLedgerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "VerifyLedger")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(VerifyLedgerResponseObject); ok {
+		if err := validResponse.VisitVerifyLedgerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) RedeemUnits(w http.ResponseWriter, r *http.Request, fundId FundId) {
+	var request RedeemUnitsRequestObject
+
+	request.FundId = fundId
+
+	var body RedeemUnitsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RedeemUnits(ctx, request.(RedeemUnitsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RedeemUnits")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RedeemUnitsResponseObject); ok {
+		if err := validResponse.VisitRedeemUnitsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) ListShareClasses(w http.ResponseWriter, r *http.Request, fundId FundId) {
+	var request ListShareClassesRequestObject
+
+	request.FundId = fundId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListShareClasses(ctx, request.(ListShareClassesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListShareClasses")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListShareClassesResponseObject); ok {
+		if err := validResponse.VisitListShareClassesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) CreateShareClass(w http.ResponseWriter, r *http.Request, fundId FundId) {
+	var request CreateShareClassRequestObject
+
+	request.FundId = fundId
+
+	var body CreateShareClassJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateShareClass(ctx, request.(CreateShareClassRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateShareClass")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateShareClassResponseObject); ok {
+		if err := validResponse.VisitCreateShareClassResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) ListTransfers(w http.ResponseWriter, r *http.Request, fundId FundId, params ListTransfersParams) {
+	var request ListTransfersRequestObject
+
+	request.FundId = fundId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTransfers(ctx, request.(ListTransfersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTransfers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListTransfersResponseObject); ok {
+		if err := validResponse.VisitListTransfersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) CreateTransfer(w http.ResponseWriter, r *http.Request, fundId FundId) {
+	var request CreateTransferRequestObject
+
+	request.FundId = fundId
+
+	var body CreateTransferJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateTransfer(ctx, request.(CreateTransferRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateTransfer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateTransferResponseObject); ok {
+		if err := validResponse.VisitCreateTransferResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) CreateTransferBatch(w http.ResponseWriter, r *http.Request, fundId FundId) {
+	var request CreateTransferBatchRequestObject
+
+	request.FundId = fundId
+
+	var body CreateTransferBatchJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateTransferBatch(ctx, request.(CreateTransferBatchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateTransferBatch")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateTransferBatchResponseObject); ok {
+		if err := validResponse.VisitCreateTransferBatchResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) ExportTransfers(w http.ResponseWriter, r *http.Request, fundId FundId, params ExportTransfersParams) {
+	var request ExportTransfersRequestObject
+
+	request.FundId = fundId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportTransfers(ctx, request.(ExportTransfersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportTransfers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExportTransfersResponseObject); ok {
+		if err := validResponse.VisitExportTransfersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) ListPendingTransfers(w http.ResponseWriter, r *http.Request, fundId FundId, params ListPendingTransfersParams) {
+	var request ListPendingTransfersRequestObject
+
+	request.FundId = fundId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPendingTransfers(ctx, request.(ListPendingTransfersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPendingTransfers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListPendingTransfersResponseObject); ok {
+		if err := validResponse.VisitListPendingTransfersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) ApproveTransfer(w http.ResponseWriter, r *http.Request, fundId FundId, transferId TransferId) {
+	var request ApproveTransferRequestObject
+
+	request.FundId = fundId
+	request.TransferId = transferId
+
+	var body ApproveTransferJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ApproveTransfer(ctx, request.(ApproveTransferRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApproveTransfer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ApproveTransferResponseObject); ok {
+		if err := validResponse.VisitApproveTransferResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateTransfer400JSONResponse struct{ TransferBadRequestJSONResponse }
+func (sh *strictHandler) RejectTransfer(w http.ResponseWriter, r *http.Request, fundId FundId, transferId TransferId) {
+	var request RejectTransferRequestObject
 
-func (response CreateTransfer400JSONResponse) VisitCreateTransferResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.FundId = fundId
+	request.TransferId = transferId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body RejectTransferJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type CreateTransfer404JSONResponse struct{ TransferNotFoundJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RejectTransfer(ctx, request.(RejectTransferRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RejectTransfer")
+	}
 
-func (response CreateTransfer404JSONResponse) VisitCreateTransferResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RejectTransferResponseObject); ok {
+		if err := validResponse.VisitRejectTransferResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateTransfer409JSONResponse struct{ DuplicateTransferJSONResponse }
-
-func (response CreateTransfer409JSONResponse) VisitCreateTransferResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+func (sh *strictHandler) ReverseTransfer(w http.ResponseWriter, r *http.Request, fundId FundId, transferId TransferId) {
+	var request ReverseTransferRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.FundId = fundId
+	request.TransferId = transferId
 
-type CreateTransfer500JSONResponse struct{ InternalErrorJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReverseTransfer(ctx, request.(ReverseTransferRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReverseTransfer")
+	}
 
-func (response CreateTransfer500JSONResponse) VisitCreateTransferResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReverseTransferResponseObject); ok {
+		if err := validResponse.VisitReverseTransferResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ResetDatabaseRequestObject struct {
-}
+func (sh *strictHandler) ListUnitEvents(w http.ResponseWriter, r *http.Request, fundId FundId, params ListUnitEventsParams) {
+	var request ListUnitEventsRequestObject
 
-type ResetDatabaseResponseObject interface {
-	VisitResetDatabaseResponse(w http.ResponseWriter) error
-}
+	request.FundId = fundId
+	request.Params = params
 
-type ResetDatabase200JSONResponse struct {
-	DeletedFunds     *int    `json:"deletedFunds,omitempty"`
-	DeletedOwnership *int    `json:"deletedOwnership,omitempty"`
-	DeletedTransfers *int    `json:"deletedTransfers,omitempty"`
-	Message          *string `json:"message,omitempty"`
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListUnitEvents(ctx, request.(ListUnitEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListUnitEvents")
+	}
 
-func (response ResetDatabase200JSONResponse) VisitResetDatabaseResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListUnitEventsResponseObject); ok {
+		if err := validResponse.VisitListUnitEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ResetDatabase500JSONResponse struct{ InternalErrorJSONResponse }
+func (sh *strictHandler) ListOwners(w http.ResponseWriter, r *http.Request, params ListOwnersParams) {
+	var request ListOwnersRequestObject
 
-func (response ResetDatabase500JSONResponse) VisitResetDatabaseResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListOwners(ctx, request.(ListOwnersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListOwners")
+	}
 
-type StrictServerInterface interface {
-	ListFunds(ctx context.Context, request ListFundsRequestObject) (ListFundsResponseObject, error)
-	CreateFund(ctx context.Context, request CreateFundRequestObject) (CreateFundResponseObject, error)
-	GetFund(ctx context.Context, request GetFundRequestObject) (GetFundResponseObject, error)
-	GetCapTable(ctx context.Context, request GetCapTableRequestObject) (GetCapTableResponseObject, error)
-	ListTransfers(ctx context.Context, request ListTransfersRequestObject) (ListTransfersResponseObject, error)
-	CreateTransfer(ctx context.Context, request CreateTransferRequestObject) (CreateTransferResponseObject, error)
-	ResetDatabase(ctx context.Context, request ResetDatabaseRequestObject) (ResetDatabaseResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListOwnersResponseObject); ok {
+		if err := validResponse.VisitListOwnersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+func (sh *strictHandler) CreateOwner(w http.ResponseWriter, r *http.Request) {
+	var request CreateOwnerRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	var body CreateOwnerJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateOwner(ctx, request.(CreateOwnerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateOwner")
+	}
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateOwnerResponseObject); ok {
+		if err := validResponse.VisitCreateOwnerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (sh *strictHandler) ListFunds(w http.ResponseWriter, r *http.Request, params ListFundsParams) {
-	var request ListFundsRequestObject
+func (sh *strictHandler) GetOwner(w http.ResponseWriter, r *http.Request, ownerId OwnerId) {
+	var request GetOwnerRequestObject
 
-	request.Params = params
+	request.OwnerId = ownerId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListFunds(ctx, request.(ListFundsRequestObject))
+		return sh.ssi.GetOwner(ctx, request.(GetOwnerRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListFunds")
+		handler = middleware(handler, "GetOwner")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListFundsResponseObject); ok {
-		if err := validResponse.VisitListFundsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetOwnerResponseObject); ok {
+		if err := validResponse.VisitGetOwnerResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -889,10 +7886,12 @@ func (sh *strictHandler) ListFunds(w http.ResponseWriter, r *http.Request, param
 	}
 }
 
-func (sh *strictHandler) CreateFund(w http.ResponseWriter, r *http.Request) {
-	var request CreateFundRequestObject
+func (sh *strictHandler) UpdateOwner(w http.ResponseWriter, r *http.Request, ownerId OwnerId) {
+	var request UpdateOwnerRequestObject
 
-	var body CreateFundJSONRequestBody
+	request.OwnerId = ownerId
+
+	var body UpdateOwnerJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -900,18 +7899,18 @@ func (sh *strictHandler) CreateFund(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateFund(ctx, request.(CreateFundRequestObject))
+		return sh.ssi.UpdateOwner(ctx, request.(UpdateOwnerRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateFund")
+		handler = middleware(handler, "UpdateOwner")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateFundResponseObject); ok {
-		if err := validResponse.VisitCreateFundResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateOwnerResponseObject); ok {
+		if err := validResponse.VisitUpdateOwnerResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -919,24 +7918,25 @@ func (sh *strictHandler) CreateFund(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func (sh *strictHandler) GetFund(w http.ResponseWriter, r *http.Request, fundId FundId) {
-	var request GetFundRequestObject
+func (sh *strictHandler) GetOwnerHoldings(w http.ResponseWriter, r *http.Request, ownerId OwnerId, params GetOwnerHoldingsParams) {
+	var request GetOwnerHoldingsRequestObject
 
-	request.FundId = fundId
+	request.OwnerId = ownerId
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetFund(ctx, request.(GetFundRequestObject))
+		return sh.ssi.GetOwnerHoldings(ctx, request.(GetOwnerHoldingsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetFund")
+		handler = middleware(handler, "GetOwnerHoldings")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetFundResponseObject); ok {
-		if err := validResponse.VisitGetFundResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetOwnerHoldingsResponseObject); ok {
+		if err := validResponse.VisitGetOwnerHoldingsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -944,25 +7944,22 @@ func (sh *strictHandler) GetFund(w http.ResponseWriter, r *http.Request, fundId
 	}
 }
 
-func (sh *strictHandler) GetCapTable(w http.ResponseWriter, r *http.Request, fundId FundId, params GetCapTableParams) {
-	var request GetCapTableRequestObject
-
-	request.FundId = fundId
-	request.Params = params
+func (sh *strictHandler) ResetDatabase(w http.ResponseWriter, r *http.Request) {
+	var request ResetDatabaseRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCapTable(ctx, request.(GetCapTableRequestObject))
+		return sh.ssi.ResetDatabase(ctx, request.(ResetDatabaseRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCapTable")
+		handler = middleware(handler, "ResetDatabase")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCapTableResponseObject); ok {
-		if err := validResponse.VisitGetCapTableResponse(w); err != nil {
+	} else if validResponse, ok := response.(ResetDatabaseResponseObject); ok {
+		if err := validResponse.VisitResetDatabaseResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -970,25 +7967,22 @@ func (sh *strictHandler) GetCapTable(w http.ResponseWriter, r *http.Request, fun
 	}
 }
 
-func (sh *strictHandler) ListTransfers(w http.ResponseWriter, r *http.Request, fundId FundId, params ListTransfersParams) {
-	var request ListTransfersRequestObject
-
-	request.FundId = fundId
-	request.Params = params
+func (sh *strictHandler) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	var request ListWebhooksRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListTransfers(ctx, request.(ListTransfersRequestObject))
+		return sh.ssi.ListWebhooks(ctx, request.(ListWebhooksRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListTransfers")
+		handler = middleware(handler, "ListWebhooks")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListTransfersResponseObject); ok {
-		if err := validResponse.VisitListTransfersResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListWebhooksResponseObject); ok {
+		if err := validResponse.VisitListWebhooksResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -996,12 +7990,10 @@ func (sh *strictHandler) ListTransfers(w http.ResponseWriter, r *http.Request, f
 	}
 }
 
-func (sh *strictHandler) CreateTransfer(w http.ResponseWriter, r *http.Request, fundId FundId) {
-	var request CreateTransferRequestObject
-
-	request.FundId = fundId
+func (sh *strictHandler) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	var request CreateWebhookRequestObject
 
-	var body CreateTransferJSONRequestBody
+	var body CreateWebhookJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1009,18 +8001,18 @@ func (sh *strictHandler) CreateTransfer(w http.ResponseWriter, r *http.Request,
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateTransfer(ctx, request.(CreateTransferRequestObject))
+		return sh.ssi.CreateWebhook(ctx, request.(CreateWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateTransfer")
+		handler = middleware(handler, "CreateWebhook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateTransferResponseObject); ok {
-		if err := validResponse.VisitCreateTransferResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateWebhookResponseObject); ok {
+		if err := validResponse.VisitCreateWebhookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1028,22 +8020,76 @@ func (sh *strictHandler) CreateTransfer(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-func (sh *strictHandler) ResetDatabase(w http.ResponseWriter, r *http.Request) {
-	var request ResetDatabaseRequestObject
+func (sh *strictHandler) DeleteWebhook(w http.ResponseWriter, r *http.Request, webhookId WebhookId) {
+	var request DeleteWebhookRequestObject
+
+	request.WebhookId = webhookId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ResetDatabase(ctx, request.(ResetDatabaseRequestObject))
+		return sh.ssi.DeleteWebhook(ctx, request.(DeleteWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ResetDatabase")
+		handler = middleware(handler, "DeleteWebhook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ResetDatabaseResponseObject); ok {
-		if err := validResponse.VisitResetDatabaseResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteWebhookResponseObject); ok {
+		if err := validResponse.VisitDeleteWebhookResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, webhookId WebhookId, params ListWebhookDeliveriesParams) {
+	var request ListWebhookDeliveriesRequestObject
+
+	request.WebhookId = webhookId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListWebhookDeliveries(ctx, request.(ListWebhookDeliveriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListWebhookDeliveries")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListWebhookDeliveriesResponseObject); ok {
+		if err := validResponse.VisitListWebhookDeliveriesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+func (sh *strictHandler) RetryWebhookDelivery(w http.ResponseWriter, r *http.Request, webhookId WebhookId, deliveryId DeliveryId) {
+	var request RetryWebhookDeliveryRequestObject
+
+	request.WebhookId = webhookId
+	request.DeliveryId = deliveryId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RetryWebhookDelivery(ctx, request.(RetryWebhookDeliveryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RetryWebhookDelivery")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RetryWebhookDeliveryResponseObject); ok {
+		if err := validResponse.VisitRetryWebhookDeliveryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
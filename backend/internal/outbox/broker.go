@@ -0,0 +1,166 @@
+package outbox
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const reconnectDelay = time.Second
+
+type Subscription struct {
+	C <-chan struct{}
+
+	c      chan struct{}
+	fundID uuid.UUID
+	broker *Broker
+	once   sync.Once
+}
+
+func (s *Subscription) Close() {
+	s.once.Do(func() { s.broker.remove(s) })
+}
+
+type Broker struct {
+	pool *pgxpool.Pool
+	log  *slog.Logger
+
+	mu     sync.Mutex
+	subs   map[uuid.UUID]map[*Subscription]struct{}
+	closed bool
+}
+
+func NewBroker(pool *pgxpool.Pool, log *slog.Logger) *Broker {
+	if log == nil {
+		log = slog.Default()
+	}
+	return &Broker{
+		pool: pool,
+		log:  log,
+		subs: make(map[uuid.UUID]map[*Subscription]struct{}),
+	}
+}
+
+func (b *Broker) Subscribe(fundID uuid.UUID) *Subscription {
+	c := make(chan struct{}, 1)
+	sub := &Subscription{C: c, c: c, fundID: fundID, broker: b}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		close(c)
+		return sub
+	}
+	if b.subs[fundID] == nil {
+		b.subs[fundID] = make(map[*Subscription]struct{})
+	}
+	b.subs[fundID][sub] = struct{}{}
+	return sub
+}
+
+func (b *Broker) Run(ctx context.Context) {
+	defer b.shutdown()
+
+	for {
+		err := b.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		b.log.Warn("outbox listener disconnected, reconnecting", slog.String("error", err.Error()))
+		b.signalAll()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(reconnectDelay):
+		}
+	}
+}
+
+func (b *Broker) listen(ctx context.Context) error {
+	conn, err := b.pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+NotifyChannel); err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = conn.Exec(context.Background(), "UNLISTEN "+NotifyChannel)
+	}()
+
+	b.signalAll()
+
+	for {
+		n, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		fundID, err := uuid.Parse(n.Payload)
+		if err != nil {
+			b.log.Warn("ignoring malformed outbox notification", slog.String("payload", n.Payload))
+			continue
+		}
+		b.signal(fundID)
+	}
+}
+
+func (b *Broker) signal(fundID uuid.UUID) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for sub := range b.subs[fundID] {
+		wake(sub.c)
+	}
+}
+
+func (b *Broker) signalAll() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, subs := range b.subs {
+		for sub := range subs {
+			wake(sub.c)
+		}
+	}
+}
+
+func (b *Broker) remove(sub *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	subs, ok := b.subs[sub.fundID]
+	if !ok {
+		return
+	}
+	if _, ok := subs[sub]; !ok {
+		return
+	}
+	delete(subs, sub)
+	if len(subs) == 0 {
+		delete(b.subs, sub.fundID)
+	}
+	close(sub.c)
+}
+
+func (b *Broker) shutdown() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	for fundID, subs := range b.subs {
+		for sub := range subs {
+			close(sub.c)
+		}
+		delete(b.subs, fundID)
+	}
+}
+
+func wake(c chan struct{}) {
+	select {
+	case c <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,74 @@
+package outbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	EventFundCreated       = "fund.created"
+	EventTransferExecuted  = "transfer.executed"
+	EventBalanceChanged    = "balance.changed"
+	EventUnitsIssued       = "units.issued"
+	EventUnitsRedeemed     = "units.redeemed"
+	EventFundStatusChanged = "fund.status_changed"
+)
+
+var EventTypes = []string{EventFundCreated, EventTransferExecuted, EventBalanceChanged, EventUnitsIssued, EventUnitsRedeemed, EventFundStatusChanged}
+
+type Event struct {
+	Sequence  int64
+	ID        uuid.UUID
+	Type      string
+	FundID    uuid.UUID
+	Payload   json.RawMessage
+	CreatedAt time.Time
+}
+
+func NewEvent(eventType string, fundID uuid.UUID, payload any) (*Event, error) {
+	if !IsEventType(eventType) {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownEventType, eventType)
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("marshal %s payload: %w", eventType, err)
+	}
+	return &Event{
+		ID:      uuid.New(),
+		Type:    eventType,
+		FundID:  fundID,
+		Payload: data,
+	}, nil
+}
+
+type Envelope struct {
+	ID        uuid.UUID       `json:"id"`
+	Sequence  int64           `json:"sequence"`
+	Type      string          `json:"type"`
+	FundID    uuid.UUID       `json:"fundId"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Data      json.RawMessage `json:"data"`
+}
+
+func (e *Event) Envelope() Envelope {
+	return Envelope{
+		ID:        e.ID,
+		Sequence:  e.Sequence,
+		Type:      e.Type,
+		FundID:    e.FundID,
+		CreatedAt: e.CreatedAt,
+		Data:      e.Payload,
+	}
+}
+
+func IsEventType(eventType string) bool {
+	for _, t := range EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,31 @@
+package outbox
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEvent(t *testing.T) {
+	t.Run("marshals the payload", func(t *testing.T) {
+		fundID := uuid.New()
+		event, err := NewEvent(EventFundCreated, fundID, map[string]any{"name": "Growth Fund I"})
+		require.NoError(t, err)
+		assert.NotEqual(t, uuid.Nil, event.ID)
+		assert.Equal(t, EventFundCreated, event.Type)
+		assert.Equal(t, fundID, event.FundID)
+		assert.JSONEq(t, `{"name":"Growth Fund I"}`, string(event.Payload))
+	})
+
+	t.Run("rejects unknown event types", func(t *testing.T) {
+		_, err := NewEvent("fund.deleted", uuid.New(), nil)
+		assert.ErrorIs(t, err, ErrUnknownEventType)
+	})
+
+	t.Run("reports unmarshalable payloads", func(t *testing.T) {
+		_, err := NewEvent(EventTransferExecuted, uuid.New(), make(chan int))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,7 @@
+package outbox
+
+import "errors"
+
+var ErrUnknownEventType = errors.New("unknown event type")
+
+var ErrNilEvent = errors.New("outbox: cannot append nil event")
@@ -0,0 +1,18 @@
+package outbox
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type Writer interface {
+	AppendTx(ctx context.Context, tx pgx.Tx, event *Event) error
+}
+
+type Reader interface {
+	ListSince(ctx context.Context, fundID uuid.UUID, after int64, limit int) ([]*Event, error)
+
+	LatestSequence(ctx context.Context, fundID uuid.UUID) (int64, error)
+}
@@ -0,0 +1,111 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultHeartbeat = 15 * time.Second
+	defaultPageSize  = 100
+)
+
+type Notifier interface {
+	Subscribe(fundID uuid.UUID) *Subscription
+}
+
+type Sink interface {
+	Send(event *Event) error
+	Heartbeat() error
+}
+
+type Service struct {
+	repo      Reader
+	notifier  Notifier
+	heartbeat time.Duration
+	pageSize  int
+}
+
+type ServiceOption func(*Service)
+
+func WithRepository(r Reader) ServiceOption {
+	return func(s *Service) { s.repo = r }
+}
+
+func WithNotifier(n Notifier) ServiceOption {
+	return func(s *Service) { s.notifier = n }
+}
+
+func WithHeartbeat(d time.Duration) ServiceOption {
+	return func(s *Service) { s.heartbeat = d }
+}
+
+func NewService(opts ...ServiceOption) (*Service, error) {
+	s := &Service{heartbeat: defaultHeartbeat, pageSize: defaultPageSize}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.repo == nil {
+		return nil, errors.New("outbox: repository is required")
+	}
+	if s.notifier == nil {
+		return nil, errors.New("outbox: notifier is required")
+	}
+	return s, nil
+}
+
+func (s *Service) Follow(ctx context.Context, fundID uuid.UUID, lastSequence *int64, sink Sink) error {
+	sub := s.notifier.Subscribe(fundID)
+	defer sub.Close()
+
+	var after int64
+	if lastSequence != nil {
+		after = *lastSequence
+	} else {
+		latest, err := s.repo.LatestSequence(ctx, fundID)
+		if err != nil {
+			return err
+		}
+		after = latest
+	}
+
+	heartbeat := time.NewTicker(s.heartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		for {
+			events, err := s.repo.ListSince(ctx, fundID, after, s.pageSize)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+			for _, e := range events {
+				if err := sink.Send(e); err != nil {
+					return err
+				}
+				after = e.Sequence
+			}
+			if len(events) < s.pageSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case _, ok := <-sub.C:
+			if !ok {
+				return nil
+			}
+		case <-heartbeat.C:
+			if err := sink.Heartbeat(); err != nil {
+				return err
+			}
+		}
+	}
+}
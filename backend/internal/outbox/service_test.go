@@ -0,0 +1,206 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockReader struct {
+	mu     sync.Mutex
+	events []*Event
+	err    error
+}
+
+func (m *mockReader) append(fundID uuid.UUID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.events = append(m.events, &Event{Sequence: int64(len(m.events) + 1), ID: uuid.New(), Type: EventTransferExecuted, FundID: fundID})
+}
+
+func (m *mockReader) ListSince(ctx context.Context, fundID uuid.UUID, after int64, limit int) ([]*Event, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return nil, m.err
+	}
+	var events []*Event
+	for _, e := range m.events {
+		if e.FundID == fundID && e.Sequence > after && len(events) < limit {
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
+
+func (m *mockReader) LatestSequence(ctx context.Context, fundID uuid.UUID) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var latest int64
+	for _, e := range m.events {
+		if e.FundID == fundID {
+			latest = e.Sequence
+		}
+	}
+	return latest, nil
+}
+
+type recordingSink struct {
+	sent       chan *Event
+	heartbeats chan struct{}
+}
+
+func newRecordingSink() *recordingSink {
+	return &recordingSink{sent: make(chan *Event, 16), heartbeats: make(chan struct{}, 16)}
+}
+
+func (s *recordingSink) Send(event *Event) error {
+	s.sent <- event
+	return nil
+}
+
+func (s *recordingSink) Heartbeat() error {
+	s.heartbeats <- struct{}{}
+	return nil
+}
+
+func (s *recordingSink) next(t *testing.T) *Event {
+	t.Helper()
+	select {
+	case e := <-s.sent:
+		return e
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for event")
+		return nil
+	}
+}
+
+func TestNewService(t *testing.T) {
+	_, err := NewService(WithNotifier(NewBroker(nil, nil)))
+	assert.ErrorContains(t, err, "repository is required")
+
+	_, err = NewService(WithRepository(&mockReader{}))
+	assert.ErrorContains(t, err, "notifier is required")
+}
+
+func TestService_Follow(t *testing.T) {
+	fundID := uuid.New()
+
+	t.Run("replays events after the last sequence then follows notifications", func(t *testing.T) {
+		reader := &mockReader{}
+		reader.append(fundID)
+		reader.append(fundID)
+		reader.append(uuid.New())
+		broker := NewBroker(nil, nil)
+		svc, err := NewService(WithRepository(reader), WithNotifier(broker))
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		sink := newRecordingSink()
+		after := int64(1)
+		done := make(chan error, 1)
+		go func() { done <- svc.Follow(ctx, fundID, &after, sink) }()
+
+		assert.EqualValues(t, 2, sink.next(t).Sequence)
+
+		reader.append(fundID)
+		broker.signal(fundID)
+		assert.EqualValues(t, 4, sink.next(t).Sequence)
+
+		cancel()
+		assert.NoError(t, <-done)
+	})
+
+	t.Run("starts from the latest event without Last-Event-ID", func(t *testing.T) {
+		reader := &mockReader{}
+		reader.append(fundID)
+		broker := NewBroker(nil, nil)
+		svc, err := NewService(WithRepository(reader), WithNotifier(broker))
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		sink := newRecordingSink()
+		go func() { _ = svc.Follow(ctx, fundID, nil, sink) }()
+
+		require.Eventually(t, func() bool {
+			broker.mu.Lock()
+			defer broker.mu.Unlock()
+			return len(broker.subs[fundID]) == 1
+		}, time.Second, 5*time.Millisecond)
+
+		reader.append(fundID)
+		broker.signal(fundID)
+		assert.EqualValues(t, 2, sink.next(t).Sequence)
+	})
+
+	t.Run("sends heartbeats while idle", func(t *testing.T) {
+		svc, err := NewService(WithRepository(&mockReader{}), WithNotifier(NewBroker(nil, nil)), WithHeartbeat(5*time.Millisecond))
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		sink := newRecordingSink()
+		go func() { _ = svc.Follow(ctx, fundID, nil, sink) }()
+
+		select {
+		case <-sink.heartbeats:
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for heartbeat")
+		}
+	})
+
+	t.Run("ends when the broker shuts down", func(t *testing.T) {
+		broker := NewBroker(nil, nil)
+		svc, err := NewService(WithRepository(&mockReader{}), WithNotifier(broker))
+		require.NoError(t, err)
+
+		broker.shutdown()
+		assert.NoError(t, svc.Follow(context.Background(), fundID, nil, newRecordingSink()))
+	})
+
+	t.Run("returns read errors", func(t *testing.T) {
+		svc, err := NewService(WithRepository(&mockReader{err: errors.New("db down")}), WithNotifier(NewBroker(nil, nil)))
+		require.NoError(t, err)
+
+		after := int64(0)
+		assert.ErrorContains(t, svc.Follow(context.Background(), fundID, &after, newRecordingSink()), "db down")
+	})
+}
+
+func TestBroker_Subscribe(t *testing.T) {
+	broker := NewBroker(nil, nil)
+	fundID := uuid.New()
+
+	sub := broker.Subscribe(fundID)
+	other := broker.Subscribe(uuid.New())
+
+	broker.signal(fundID)
+	broker.signal(fundID)
+	select {
+	case <-sub.C:
+	default:
+		t.Fatal("expected a wake-up")
+	}
+	select {
+	case <-sub.C:
+		t.Fatal("wake-ups should coalesce")
+	default:
+	}
+	select {
+	case <-other.C:
+		t.Fatal("other funds should not be woken")
+	default:
+	}
+
+	sub.Close()
+	sub.Close()
+	_, ok := <-sub.C
+	assert.False(t, ok)
+}
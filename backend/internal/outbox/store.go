@@ -0,0 +1,89 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const NotifyChannel = "outbox_events"
+
+type DB interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+type Store struct {
+	db DB
+}
+
+func NewStore(db DB) *Store {
+	if db == nil {
+		return nil
+	}
+	return &Store{db: db}
+}
+
+func (s *Store) AppendTx(ctx context.Context, tx pgx.Tx, event *Event) error {
+	if event == nil {
+		return ErrNilEvent
+	}
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtextextended($1::text, 0))`, event.FundID); err != nil {
+		return fmt.Errorf("lock outbox for fund %s: %w", event.FundID, err)
+	}
+
+	const query = `
+		WITH appended AS (
+			INSERT INTO outbox (id, event_type, fund_id, payload, created_at)
+			VALUES ($1, $2, $3, $4, NOW())
+			RETURNING sequence, created_at
+		)
+		SELECT sequence, created_at, pg_notify($5, $3::text)
+		FROM appended
+	`
+	err := tx.QueryRow(ctx, query, event.ID, event.Type, event.FundID, event.Payload, NotifyChannel).Scan(&event.Sequence, &event.CreatedAt, nil)
+	if err != nil {
+		return fmt.Errorf("append %s event %s to outbox: %w", event.Type, event.ID, err)
+	}
+	return nil
+}
+
+func (s *Store) ListSince(ctx context.Context, fundID uuid.UUID, after int64, limit int) ([]*Event, error) {
+	const query = `
+		SELECT sequence, id, event_type, fund_id, payload, created_at
+		FROM outbox
+		WHERE fund_id = $1 AND sequence > $2
+		ORDER BY sequence ASC
+		LIMIT $3
+	`
+	rows, err := s.db.Query(ctx, query, fundID, after, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list events for fund %s: %w", fundID, err)
+	}
+	defer rows.Close()
+
+	var events []*Event
+	for rows.Next() {
+		var e Event
+		if err := rows.Scan(&e.Sequence, &e.ID, &e.Type, &e.FundID, &e.Payload, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan event row: %w", err)
+		}
+		events = append(events, &e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate event rows: %w", err)
+	}
+	return events, nil
+}
+
+func (s *Store) LatestSequence(ctx context.Context, fundID uuid.UUID) (int64, error) {
+	var sequence int64
+	err := s.db.QueryRow(ctx, `SELECT COALESCE(MAX(sequence), 0) FROM outbox WHERE fund_id = $1`, fundID).Scan(&sequence)
+	if err != nil {
+		return 0, fmt.Errorf("find latest event for fund %s: %w", fundID, err)
+	}
+	return sequence, nil
+}
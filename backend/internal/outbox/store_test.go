@@ -0,0 +1,111 @@
+package outbox_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/arowden/augment-fund/internal/fund"
+	"github.com/arowden/augment-fund/internal/outbox"
+	"github.com/arowden/augment-fund/internal/owner"
+	"github.com/arowden/augment-fund/internal/ownership"
+	"github.com/arowden/augment-fund/internal/postgres"
+	"github.com/arowden/augment-fund/internal/transfer"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx := context.Background()
+	tc, err := postgres.NewTestContainer(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() { tc.Cleanup(ctx) })
+
+	store := outbox.NewStore(tc.Pool())
+	ownershipStore := ownership.NewStore(tc.Pool())
+	ownerStore := owner.NewStore(tc.Pool())
+	fundService, err := fund.NewService(
+		fund.NewStore(tc.Pool()),
+		fund.WithPool(tc.Pool()),
+		fund.WithOwnershipRepository(ownershipStore),
+		fund.WithOwnerRepository(ownerStore),
+		fund.WithOutbox(store),
+	)
+	require.NoError(t, err)
+	transferService, err := transfer.NewService(
+		transfer.WithRepository(transfer.NewStore(tc.Pool())),
+		transfer.WithOwnershipRepository(ownershipStore),
+		transfer.WithOwnerRepository(ownerStore),
+		transfer.WithPool(tc.Pool()),
+		transfer.WithOutbox(store),
+	)
+	require.NoError(t, err)
+
+	t.Run("records settled transfers and balance changes in order", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		f, err := fundService.CreateFundWithInitialOwner(ctx, "Evented Fund", 1000, "Founder")
+		require.NoError(t, err)
+		_, err = transferService.ExecuteTransfer(ctx, transfer.Request{FundID: f.ID, FromOwner: "Founder", ToOwner: "Alice", Units: 300})
+		require.NoError(t, err)
+
+		events, err := store.ListSince(ctx, f.ID, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, events, 4)
+		assert.Equal(t, outbox.EventFundCreated, events[0].Type)
+		assert.Equal(t, outbox.EventTransferExecuted, events[1].Type)
+		assert.Equal(t, outbox.EventBalanceChanged, events[2].Type)
+		var change map[string]any
+		require.NoError(t, json.Unmarshal(events[2].Payload, &change))
+		assert.Equal(t, "Founder", change["ownerName"])
+		assert.EqualValues(t, 700, change["units"])
+		assert.EqualValues(t, -300, change["delta"])
+		assert.Equal(t, outbox.EventBalanceChanged, events[3].Type)
+		for i := 1; i < len(events); i++ {
+			assert.Greater(t, events[i].Sequence, events[i-1].Sequence)
+		}
+
+		latest, err := store.LatestSequence(ctx, f.ID)
+		require.NoError(t, err)
+		assert.Equal(t, events[3].Sequence, latest)
+
+		rest, err := store.ListSince(ctx, f.ID, events[1].Sequence, 10)
+		require.NoError(t, err)
+		assert.Len(t, rest, 2)
+	})
+
+	t.Run("broker wakes subscribers when events commit", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		f, err := fundService.CreateFundWithInitialOwner(ctx, "Notified Fund", 1000, "Founder")
+		require.NoError(t, err)
+
+		runCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		broker := outbox.NewBroker(tc.Pool(), nil)
+		sub := broker.Subscribe(f.ID)
+		defer sub.Close()
+		go broker.Run(runCtx)
+
+		select {
+		case <-sub.C:
+		case <-time.After(5 * time.Second):
+			t.Fatal("expected a wake-up once listening")
+		}
+
+		_, err = transferService.ExecuteTransfer(ctx, transfer.Request{FundID: f.ID, FromOwner: "Founder", ToOwner: "Alice", Units: 100})
+		require.NoError(t, err)
+
+		select {
+		case <-sub.C:
+		case <-time.After(5 * time.Second):
+			t.Fatal("expected a wake-up after the transfer committed")
+		}
+	})
+}
@@ -0,0 +1,87 @@
+package owner
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/arowden/augment-fund/internal/validation"
+	"github.com/google/uuid"
+)
+
+type Type string
+
+const (
+	TypeIndividual Type = "individual"
+	TypeEntity     Type = "entity"
+)
+
+func (t Type) Valid() bool {
+	return t == TypeIndividual || t == TypeEntity
+}
+
+type Owner struct {
+	ID          uuid.UUID
+	LegalName   string
+	Type        Type
+	ExternalRef *string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func NewOwner(legalName string, ownerType Type, externalRef *string) (*Owner, error) {
+	if ownerType == "" {
+		ownerType = TypeIndividual
+	}
+	now := time.Now()
+	o := &Owner{
+		ID:        uuid.New(),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := o.Apply(Update{LegalName: &legalName, Type: &ownerType, ExternalRef: externalRef}); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+type Update struct {
+	LegalName   *string
+	Type        *Type
+	ExternalRef *string
+}
+
+func (o *Owner) Apply(u Update) error {
+	legalName := o.LegalName
+	if u.LegalName != nil {
+		legalName = strings.TrimSpace(*u.LegalName)
+		if legalName == "" || utf8.RuneCountInString(legalName) > validation.MaxNameLength {
+			return ErrInvalidName
+		}
+	}
+
+	ownerType := o.Type
+	if u.Type != nil {
+		if !u.Type.Valid() {
+			return ErrInvalidType
+		}
+		ownerType = *u.Type
+	}
+
+	externalRef := o.ExternalRef
+	if u.ExternalRef != nil {
+		ref := strings.TrimSpace(*u.ExternalRef)
+		if utf8.RuneCountInString(ref) > validation.MaxNameLength {
+			return ErrInvalidExternalRef
+		}
+		externalRef = nil
+		if ref != "" {
+			externalRef = &ref
+		}
+	}
+
+	o.LegalName = legalName
+	o.Type = ownerType
+	o.ExternalRef = externalRef
+	return nil
+}
@@ -0,0 +1,104 @@
+package owner
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/arowden/augment-fund/internal/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOwner(t *testing.T) {
+	t.Run("valid inputs", func(t *testing.T) {
+		ref := "crm-1"
+		o, err := NewOwner("Acme LLC", TypeEntity, &ref)
+		require.NoError(t, err)
+		assert.NotEmpty(t, o.ID)
+		assert.Equal(t, "Acme LLC", o.LegalName)
+		assert.Equal(t, TypeEntity, o.Type)
+		require.NotNil(t, o.ExternalRef)
+		assert.Equal(t, "crm-1", *o.ExternalRef)
+		assert.False(t, o.CreatedAt.IsZero())
+	})
+
+	t.Run("defaults to individual", func(t *testing.T) {
+		o, err := NewOwner("Alice", "", nil)
+		require.NoError(t, err)
+		assert.Equal(t, TypeIndividual, o.Type)
+		assert.Nil(t, o.ExternalRef)
+	})
+
+	t.Run("trims whitespace", func(t *testing.T) {
+		ref := "  crm-1  "
+		o, err := NewOwner("  Alice  ", TypeIndividual, &ref)
+		require.NoError(t, err)
+		assert.Equal(t, "Alice", o.LegalName)
+		assert.Equal(t, "crm-1", *o.ExternalRef)
+	})
+
+	t.Run("blank external reference is dropped", func(t *testing.T) {
+		ref := "   "
+		o, err := NewOwner("Alice", TypeIndividual, &ref)
+		require.NoError(t, err)
+		assert.Nil(t, o.ExternalRef)
+	})
+
+	t.Run("invalid inputs", func(t *testing.T) {
+		longRef := strings.Repeat("r", validation.MaxNameLength+1)
+		tests := []struct {
+			name      string
+			legalName string
+			ownerType Type
+			ref       *string
+			want      error
+		}{
+			{"empty name", "", TypeIndividual, nil, ErrInvalidName},
+			{"whitespace name", "   ", TypeIndividual, nil, ErrInvalidName},
+			{"long name", strings.Repeat("A", validation.MaxNameLength+1), TypeIndividual, nil, ErrInvalidName},
+			{"unknown type", "Alice", Type("trust"), nil, ErrInvalidType},
+			{"long external reference", "Alice", TypeIndividual, &longRef, ErrInvalidExternalRef},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				o, err := NewOwner(tt.legalName, tt.ownerType, tt.ref)
+				assert.Nil(t, o)
+				assert.ErrorIs(t, err, tt.want)
+			})
+		}
+	})
+}
+
+func TestOwner_Apply(t *testing.T) {
+	newOwner := func(t *testing.T) *Owner {
+		ref := "crm-1"
+		o, err := NewOwner("Acme LLC", TypeEntity, &ref)
+		require.NoError(t, err)
+		return o
+	}
+
+	t.Run("changes only the fields given", func(t *testing.T) {
+		o := newOwner(t)
+		name := "ACME, LLC"
+		require.NoError(t, o.Apply(Update{LegalName: &name}))
+		assert.Equal(t, "ACME, LLC", o.LegalName)
+		assert.Equal(t, TypeEntity, o.Type)
+		assert.Equal(t, "crm-1", *o.ExternalRef)
+	})
+
+	t.Run("empty external reference clears it", func(t *testing.T) {
+		o := newOwner(t)
+		empty := ""
+		require.NoError(t, o.Apply(Update{ExternalRef: &empty}))
+		assert.Nil(t, o.ExternalRef)
+	})
+
+	t.Run("leaves the owner untouched on error", func(t *testing.T) {
+		o := newOwner(t)
+		name := "Renamed"
+		bad := Type("trust")
+		assert.ErrorIs(t, o.Apply(Update{LegalName: &name, Type: &bad}), ErrInvalidType)
+		assert.Equal(t, "Acme LLC", o.LegalName)
+		assert.Equal(t, TypeEntity, o.Type)
+	})
+}
@@ -0,0 +1,33 @@
+package owner
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/arowden/augment-fund/internal/validation"
+	"github.com/google/uuid"
+)
+
+var ErrNotFound = errors.New("owner not found")
+
+var ErrInvalidName = fmt.Errorf("invalid owner: legal name must be non-empty (max %d chars)", validation.MaxNameLength)
+
+var ErrInvalidType = fmt.Errorf("invalid owner: type must be %q or %q", TypeIndividual, TypeEntity)
+
+var ErrInvalidExternalRef = fmt.Errorf("invalid owner: external reference must be at most %d chars", validation.MaxNameLength)
+
+var ErrNilOwner = errors.New("owner: cannot operate on nil owner")
+
+var ErrDuplicateExternalRef = errors.New("external reference is already assigned to another owner")
+
+var ErrNameConflict = errors.New("another owner with this legal name already holds units in one of this owner's funds")
+
+var ErrAmbiguousName = errors.New("more than one owner has this legal name; identify the owner by ID")
+
+func NotFoundError(id uuid.UUID) error {
+	return fmt.Errorf("owner %s: %w", id, ErrNotFound)
+}
+
+func AmbiguousNameError(legalName string) error {
+	return fmt.Errorf("owner %q: %w", legalName, ErrAmbiguousName)
+}
@@ -0,0 +1,28 @@
+package owner
+
+import (
+	"context"
+
+	"github.com/arowden/augment-fund/internal/validation"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type ListParams = validation.ListParams
+
+type ListResult struct {
+	Items  []*Owner
+	Total  int
+	Limit  int
+	Offset int
+}
+
+type Repository interface {
+	Create(ctx context.Context, owner *Owner) error
+	CreateTx(ctx context.Context, tx pgx.Tx, owner *Owner) error
+	FindByID(ctx context.Context, id uuid.UUID) (*Owner, error)
+	FindByIDTx(ctx context.Context, tx pgx.Tx, id uuid.UUID) (*Owner, error)
+	List(ctx context.Context, params ListParams) (*ListResult, error)
+	Update(ctx context.Context, owner *Owner) error
+	ResolveTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, legalName string) (*Owner, error)
+}
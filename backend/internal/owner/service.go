@@ -0,0 +1,64 @@
+package owner
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+type Service struct {
+	repo Repository
+}
+
+type ServiceOption func(*Service)
+
+func WithRepository(repo Repository) ServiceOption {
+	return func(s *Service) {
+		s.repo = repo
+	}
+}
+
+func NewService(opts ...ServiceOption) (*Service, error) {
+	s := &Service{}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.repo == nil {
+		return nil, errors.New("owner: repository is required")
+	}
+	return s, nil
+}
+
+func (s *Service) CreateOwner(ctx context.Context, legalName string, ownerType Type, externalRef *string) (*Owner, error) {
+	owner, err := NewOwner(legalName, ownerType, externalRef)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(ctx, owner); err != nil {
+		return nil, err
+	}
+	return owner, nil
+}
+
+func (s *Service) GetOwner(ctx context.Context, id uuid.UUID) (*Owner, error) {
+	return s.repo.FindByID(ctx, id)
+}
+
+func (s *Service) ListOwners(ctx context.Context, params ListParams) (*ListResult, error) {
+	return s.repo.List(ctx, params)
+}
+
+func (s *Service) UpdateOwner(ctx context.Context, id uuid.UUID, update Update) (*Owner, error) {
+	owner, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := owner.Apply(update); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Update(ctx, owner); err != nil {
+		return nil, err
+	}
+	return owner, nil
+}
@@ -0,0 +1,127 @@
+package owner
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRepository struct {
+	owners    map[uuid.UUID]*Owner
+	updateErr error
+}
+
+func newMockRepository() *mockRepository {
+	return &mockRepository{owners: make(map[uuid.UUID]*Owner)}
+}
+
+func (m *mockRepository) Create(ctx context.Context, owner *Owner) error {
+	m.owners[owner.ID] = owner
+	return nil
+}
+
+func (m *mockRepository) CreateTx(ctx context.Context, tx pgx.Tx, owner *Owner) error {
+	return m.Create(ctx, owner)
+}
+
+func (m *mockRepository) FindByID(ctx context.Context, id uuid.UUID) (*Owner, error) {
+	o, ok := m.owners[id]
+	if !ok {
+		return nil, NotFoundError(id)
+	}
+	clone := *o
+	return &clone, nil
+}
+
+func (m *mockRepository) FindByIDTx(ctx context.Context, tx pgx.Tx, id uuid.UUID) (*Owner, error) {
+	return m.FindByID(ctx, id)
+}
+
+func (m *mockRepository) List(ctx context.Context, params ListParams) (*ListResult, error) {
+	items := make([]*Owner, 0, len(m.owners))
+	for _, o := range m.owners {
+		items = append(items, o)
+	}
+	return &ListResult{Items: items, Total: len(items), Limit: params.Limit, Offset: params.Offset}, nil
+}
+
+func (m *mockRepository) Update(ctx context.Context, owner *Owner) error {
+	if m.updateErr != nil {
+		return m.updateErr
+	}
+	m.owners[owner.ID] = owner
+	return nil
+}
+
+func (m *mockRepository) ResolveTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, legalName string) (*Owner, error) {
+	return nil, errors.New("not implemented")
+}
+
+func TestNewService(t *testing.T) {
+	svc, err := NewService()
+	assert.Nil(t, svc)
+	assert.ErrorContains(t, err, "repository is required")
+}
+
+func TestService_CreateOwner(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockRepository()
+	svc, err := NewService(WithRepository(repo))
+	require.NoError(t, err)
+
+	t.Run("stores a valid owner", func(t *testing.T) {
+		o, err := svc.CreateOwner(ctx, "Acme LLC", TypeEntity, nil)
+		require.NoError(t, err)
+		assert.Contains(t, repo.owners, o.ID)
+	})
+
+	t.Run("rejects invalid input without storing", func(t *testing.T) {
+		before := len(repo.owners)
+		_, err := svc.CreateOwner(ctx, " ", TypeEntity, nil)
+		assert.ErrorIs(t, err, ErrInvalidName)
+		assert.Len(t, repo.owners, before)
+	})
+}
+
+func TestService_UpdateOwner(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockRepository()
+	svc, err := NewService(WithRepository(repo))
+	require.NoError(t, err)
+
+	created, err := svc.CreateOwner(ctx, "Acme LLC", TypeEntity, nil)
+	require.NoError(t, err)
+
+	t.Run("applies the update", func(t *testing.T) {
+		name := "ACME, LLC"
+		o, err := svc.UpdateOwner(ctx, created.ID, Update{LegalName: &name})
+		require.NoError(t, err)
+		assert.Equal(t, "ACME, LLC", o.LegalName)
+		assert.Equal(t, "ACME, LLC", repo.owners[created.ID].LegalName)
+	})
+
+	t.Run("unknown owner", func(t *testing.T) {
+		_, err := svc.UpdateOwner(ctx, uuid.New(), Update{})
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("invalid update is not stored", func(t *testing.T) {
+		bad := Type("trust")
+		_, err := svc.UpdateOwner(ctx, created.ID, Update{Type: &bad})
+		assert.ErrorIs(t, err, ErrInvalidType)
+		assert.Equal(t, TypeEntity, repo.owners[created.ID].Type)
+	})
+
+	t.Run("propagates repository errors", func(t *testing.T) {
+		repo.updateErr = ErrNameConflict
+		defer func() { repo.updateErr = nil }()
+		name := "Other"
+		_, err := svc.UpdateOwner(ctx, created.ID, Update{LegalName: &name})
+		assert.ErrorIs(t, err, ErrNameConflict)
+	})
+}
@@ -0,0 +1,250 @@
+package owner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const ownerColumns = `o.id, o.legal_name, o.type, o.external_ref, o.created_at, o.updated_at`
+
+const externalRefIndex = "idx_owners_external_ref"
+
+type DB interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+type Store struct {
+	db DB
+}
+
+func NewStore(db DB) *Store {
+	if db == nil {
+		return nil
+	}
+	return &Store{db: db}
+}
+
+func (s *Store) Create(ctx context.Context, owner *Owner) error {
+	return s.create(ctx, s.db, owner)
+}
+
+func (s *Store) CreateTx(ctx context.Context, tx pgx.Tx, owner *Owner) error {
+	return s.create(ctx, tx, owner)
+}
+
+func (s *Store) create(ctx context.Context, db DB, owner *Owner) error {
+	if owner == nil {
+		return ErrNilOwner
+	}
+
+	const query = `
+		INSERT INTO owners (id, legal_name, type, external_ref, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	_, err := db.Exec(ctx, query, owner.ID, owner.LegalName, owner.Type, owner.ExternalRef, owner.CreatedAt, owner.UpdatedAt)
+	if err != nil {
+		if isUniqueViolation(err, externalRefIndex) {
+			return fmt.Errorf("%w: %s", ErrDuplicateExternalRef, *owner.ExternalRef)
+		}
+		return fmt.Errorf("create owner %s: %w", owner.ID, err)
+	}
+	return nil
+}
+
+func (s *Store) FindByID(ctx context.Context, id uuid.UUID) (*Owner, error) {
+	return s.findByID(ctx, s.db, id)
+}
+
+func (s *Store) FindByIDTx(ctx context.Context, tx pgx.Tx, id uuid.UUID) (*Owner, error) {
+	return s.findByID(ctx, tx, id)
+}
+
+func (s *Store) findByID(ctx context.Context, db DB, id uuid.UUID) (*Owner, error) {
+	const query = `SELECT ` + ownerColumns + ` FROM owners o WHERE o.id = $1`
+	owner, err := scanOwner(db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, NotFoundError(id)
+		}
+		return nil, fmt.Errorf("find owner %s: %w", id, err)
+	}
+	return owner, nil
+}
+
+func (s *Store) List(ctx context.Context, params ListParams) (*ListResult, error) {
+	params = params.Normalize()
+
+	const query = `
+		SELECT ` + ownerColumns + `, COUNT(*) OVER() AS total
+		FROM owners o
+		ORDER BY o.legal_name ASC, o.id ASC
+		LIMIT $1 OFFSET $2
+	`
+	rows, err := s.db.Query(ctx, query, params.Limit, params.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("list owners: %w", err)
+	}
+	defer rows.Close()
+
+	owners := make([]*Owner, 0, params.Limit)
+	var total int
+	for rows.Next() {
+		var o Owner
+		if err := rows.Scan(&o.ID, &o.LegalName, &o.Type, &o.ExternalRef, &o.CreatedAt, &o.UpdatedAt, &total); err != nil {
+			return nil, fmt.Errorf("scan owner row: %w", err)
+		}
+		owners = append(owners, &o)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate owner rows: %w", err)
+	}
+
+	if len(owners) == 0 && params.Offset > 0 {
+		const countQuery = `SELECT COUNT(*) FROM owners`
+		if err := s.db.QueryRow(ctx, countQuery).Scan(&total); err != nil {
+			return nil, fmt.Errorf("count owners: %w", err)
+		}
+	}
+
+	return &ListResult{
+		Items:  owners,
+		Total:  total,
+		Limit:  params.Limit,
+		Offset: params.Offset,
+	}, nil
+}
+
+func (s *Store) Update(ctx context.Context, owner *Owner) error {
+	if owner == nil {
+		return ErrNilOwner
+	}
+
+	const query = `
+		WITH updated AS (
+			UPDATE owners
+			SET legal_name = $2, type = $3, external_ref = $4
+			WHERE id = $1
+			RETURNING id, legal_name, updated_at
+		), renamed AS (
+			UPDATE cap_table_entries e
+			SET owner_name = u.legal_name
+			FROM updated u
+			WHERE e.owner_id = u.id AND e.owner_name <> u.legal_name
+		)
+		SELECT updated_at FROM updated
+	`
+	err := s.db.QueryRow(ctx, query, owner.ID, owner.LegalName, owner.Type, owner.ExternalRef).Scan(&owner.UpdatedAt)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return NotFoundError(owner.ID)
+		case isUniqueViolation(err, externalRefIndex):
+			return fmt.Errorf("%w: %s", ErrDuplicateExternalRef, *owner.ExternalRef)
+		case isUniqueViolation(err, ""):
+			return fmt.Errorf("rename owner %s to %q: %w", owner.ID, owner.LegalName, ErrNameConflict)
+		}
+		return fmt.Errorf("update owner %s: %w", owner.ID, err)
+	}
+	return nil
+}
+
+func (s *Store) ResolveTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, legalName string) (*Owner, error) {
+	const heldQuery = `
+		SELECT ` + ownerColumns + `
+		FROM owners o
+		WHERE EXISTS (
+			SELECT 1 FROM cap_table_entries e
+			WHERE e.owner_id = o.id AND e.fund_id = $1 AND e.owner_name = $2 AND e.deleted_at IS NULL
+		)
+		ORDER BY o.created_at ASC
+		LIMIT 2
+	`
+	held, err := queryOwners(ctx, tx, heldQuery, fundID, legalName)
+	if err != nil {
+		return nil, fmt.Errorf("find owner %q in fund %s: %w", legalName, fundID, err)
+	}
+	switch len(held) {
+	case 0:
+	case 1:
+		return held[0], nil
+	default:
+		return nil, AmbiguousNameError(legalName)
+	}
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtextextended($1, 0))`, legalName); err != nil {
+		return nil, fmt.Errorf("lock owner name %q: %w", legalName, err)
+	}
+
+	const byNameQuery = `
+		SELECT ` + ownerColumns + `
+		FROM owners o
+		WHERE o.legal_name = $1
+		ORDER BY o.created_at ASC
+		LIMIT 2
+	`
+	matches, err := queryOwners(ctx, tx, byNameQuery, legalName)
+	if err != nil {
+		return nil, fmt.Errorf("find owner %q: %w", legalName, err)
+	}
+
+	switch len(matches) {
+	case 0:
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, AmbiguousNameError(legalName)
+	}
+
+	owner, err := NewOwner(legalName, TypeIndividual, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.create(ctx, tx, owner); err != nil {
+		return nil, err
+	}
+	return owner, nil
+}
+
+func queryOwners(ctx context.Context, tx pgx.Tx, query string, args ...any) ([]*Owner, error) {
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var owners []*Owner
+	for rows.Next() {
+		o, err := scanOwner(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan owner row: %w", err)
+		}
+		owners = append(owners, o)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate owner rows: %w", err)
+	}
+	return owners, nil
+}
+
+func scanOwner(row pgx.Row) (*Owner, error) {
+	var o Owner
+	if err := row.Scan(&o.ID, &o.LegalName, &o.Type, &o.ExternalRef, &o.CreatedAt, &o.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return &o, nil
+}
+
+func isUniqueViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != "23505" {
+		return false
+	}
+	return constraint == "" || pgErr.ConstraintName == constraint
+}
@@ -0,0 +1,158 @@
+package owner_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arowden/augment-fund/internal/fund"
+	"github.com/arowden/augment-fund/internal/owner"
+	"github.com/arowden/augment-fund/internal/ownership"
+	"github.com/arowden/augment-fund/internal/postgres"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx := context.Background()
+	tc, err := postgres.NewTestContainer(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() { tc.Cleanup(ctx) })
+
+	store := owner.NewStore(tc.Pool())
+	fundStore := fund.NewStore(tc.Pool())
+	ownershipStore := ownership.NewStore(tc.Pool())
+
+	createOwner := func(t *testing.T, name string, ref *string) *owner.Owner {
+		o, err := owner.NewOwner(name, owner.TypeIndividual, ref)
+		require.NoError(t, err)
+		require.NoError(t, store.Create(ctx, o))
+		return o
+	}
+
+	hold := func(t *testing.T, fundID uuid.UUID, o *owner.Owner, units int) {
+		entry, err := ownership.NewCapTableEntry(fundID, o.ID, o.LegalName, units)
+		require.NoError(t, err)
+		require.NoError(t, ownershipStore.Create(ctx, entry))
+	}
+
+	createFund := func(t *testing.T, name string) *fund.Fund {
+		f, err := fund.NewFund(name, 1000)
+		require.NoError(t, err)
+		require.NoError(t, fundStore.Create(ctx, f))
+		return f
+	}
+
+	t.Run("Create and FindByID round trip", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		ref := "crm-1"
+		o := createOwner(t, "Alice", &ref)
+
+		found, err := store.FindByID(ctx, o.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Alice", found.LegalName)
+		assert.Equal(t, owner.TypeIndividual, found.Type)
+		require.NotNil(t, found.ExternalRef)
+		assert.Equal(t, "crm-1", *found.ExternalRef)
+
+		_, err = store.FindByID(ctx, uuid.New())
+		assert.ErrorIs(t, err, owner.ErrNotFound)
+	})
+
+	t.Run("external references are unique", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		ref := "crm-1"
+		createOwner(t, "Alice", &ref)
+
+		dup, err := owner.NewOwner("Bob", owner.TypeIndividual, &ref)
+		require.NoError(t, err)
+		assert.ErrorIs(t, store.Create(ctx, dup), owner.ErrDuplicateExternalRef)
+	})
+
+	t.Run("List orders by legal name", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		createOwner(t, "Charlie", nil)
+		createOwner(t, "Alice", nil)
+		createOwner(t, "Bob", nil)
+
+		result, err := store.List(ctx, owner.ListParams{Limit: 2})
+		require.NoError(t, err)
+		assert.Equal(t, 3, result.Total)
+		require.Len(t, result.Items, 2)
+		assert.Equal(t, "Alice", result.Items[0].LegalName)
+		assert.Equal(t, "Bob", result.Items[1].LegalName)
+	})
+
+	t.Run("Update renames cap table entries", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		f := createFund(t, "Rename Fund")
+		o := createOwner(t, "Acme LLC", nil)
+		hold(t, f.ID, o, 1000)
+
+		name := "ACME, LLC"
+		require.NoError(t, o.Apply(owner.Update{LegalName: &name}))
+		require.NoError(t, store.Update(ctx, o))
+
+		entry, err := ownershipStore.FindByFundAndOwner(ctx, f.ID, nil, "ACME, LLC")
+		require.NoError(t, err)
+		assert.Equal(t, o.ID, entry.OwnerID)
+	})
+
+	t.Run("Update rejects a name already held in the same fund", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		f := createFund(t, "Conflict Fund")
+		alice := createOwner(t, "Alice", nil)
+		bob := createOwner(t, "Bob", nil)
+		hold(t, f.ID, alice, 500)
+		hold(t, f.ID, bob, 500)
+
+		name := "Alice"
+		require.NoError(t, bob.Apply(owner.Update{LegalName: &name}))
+		assert.ErrorIs(t, store.Update(ctx, bob), owner.ErrNameConflict)
+
+		missing, err := owner.NewOwner("Nobody", owner.TypeIndividual, nil)
+		require.NoError(t, err)
+		assert.ErrorIs(t, store.Update(ctx, missing), owner.ErrNotFound)
+	})
+
+	t.Run("ResolveTx prefers the fund's holder, then a unique legal name, then creates", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		f := createFund(t, "Resolve Fund")
+		other := createFund(t, "Other Fund")
+		held := createOwner(t, "Alice", nil)
+		createOwner(t, "Alice", nil)
+		hold(t, f.ID, held, 1000)
+		bob := createOwner(t, "Bob", nil)
+
+		tx, err := tc.Pool().Begin(ctx)
+		require.NoError(t, err)
+		defer tx.Rollback(ctx)
+
+		resolved, err := store.ResolveTx(ctx, tx, f.ID, "Alice")
+		require.NoError(t, err)
+		assert.Equal(t, held.ID, resolved.ID)
+
+		_, err = store.ResolveTx(ctx, tx, other.ID, "Alice")
+		assert.ErrorIs(t, err, owner.ErrAmbiguousName)
+
+		resolved, err = store.ResolveTx(ctx, tx, other.ID, "Bob")
+		require.NoError(t, err)
+		assert.Equal(t, bob.ID, resolved.ID)
+
+		created, err := store.ResolveTx(ctx, tx, other.ID, "Dana")
+		require.NoError(t, err)
+		found, err := store.FindByIDTx(ctx, tx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Dana", found.LegalName)
+	})
+}
@@ -1,13 +1,43 @@
 package ownership
 
-import "github.com/google/uuid"
+import (
+	"bytes"
+
+	"github.com/arowden/augment-fund/internal/validation"
+	"github.com/google/uuid"
+)
 
 type CapTableView struct {
-	FundID     uuid.UUID
-	Entries    []*Entry
-	TotalCount int
-	Limit      int
-	Offset     int
+	FundID           uuid.UUID
+	Entries          []*Entry
+	Classes          []*ShareClass
+	TotalCount       int
+	UnitsOutstanding int
+	Limit            int
+	Offset           int
+	NextCursor       string
+}
+
+const cursorKind = "cap_table"
+
+type capTableCursor struct {
+	Units     int       `json:"units"`
+	OwnerName string    `json:"ownerName"`
+	ClassID   uuid.UUID `json:"classId"`
+}
+
+func cursorOf(e *Entry) any {
+	return capTableCursor{Units: e.Units, OwnerName: e.OwnerName, ClassID: e.ClassID}
+}
+
+func (c capTableCursor) precedes(e *Entry) bool {
+	if e.Units != c.Units {
+		return e.Units < c.Units
+	}
+	if e.OwnerName != c.OwnerName {
+		return e.OwnerName > c.OwnerName
+	}
+	return bytes.Compare(e.ClassID[:], c.ClassID[:]) > 0
 }
 
 func (c *CapTableView) TotalUnits() int {
@@ -18,6 +48,15 @@ func (c *CapTableView) TotalUnits() int {
 	return total
 }
 
+func (c *CapTableView) FindClass(id uuid.UUID) *ShareClass {
+	for _, class := range c.Classes {
+		if class.ID == id {
+			return class
+		}
+	}
+	return nil
+}
+
 func (c *CapTableView) FindOwner(ownerName string) *Entry {
 	for _, e := range c.Entries {
 		if e.OwnerName == ownerName {
@@ -26,3 +65,10 @@ func (c *CapTableView) FindOwner(ownerName string) *Entry {
 	}
 	return nil
 }
+
+func Percentage(units, totalUnits int) float64 {
+	if totalUnits <= 0 {
+		return 0
+	}
+	return float64(units) / float64(totalUnits) * validation.PercentageMultiplier
+}
@@ -0,0 +1,21 @@
+package ownership
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPercentage(t *testing.T) {
+	t.Run("computes share of total units", func(t *testing.T) {
+		assert.InDelta(t, 25.0, Percentage(250, 1000), 1e-9)
+	})
+
+	t.Run("returns zero for zero total", func(t *testing.T) {
+		assert.Equal(t, 0.0, Percentage(100, 0))
+	})
+
+	t.Run("full ownership is 100 percent", func(t *testing.T) {
+		assert.InDelta(t, 100.0, Percentage(1000, 1000), 1e-9)
+	})
+}
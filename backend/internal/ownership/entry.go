@@ -12,16 +12,28 @@ import (
 type Entry struct {
 	ID         uuid.UUID
 	FundID     uuid.UUID
+	OwnerID    uuid.UUID
 	OwnerName  string
+	ClassID    uuid.UUID
+	ClassName  string
 	Units      int
 	AcquiredAt time.Time
 	UpdatedAt  time.Time
 	DeletedAt  *time.Time
 }
 
-func NewCapTableEntry(fundID uuid.UUID, ownerName string, units int) (*Entry, error) {
+type Holder struct {
+	OwnerID uuid.UUID
+	ClassID uuid.UUID
+}
+
+func (e *Entry) Holder() Holder {
+	return Holder{OwnerID: e.OwnerID, ClassID: e.ClassID}
+}
+
+func NewCapTableEntry(fundID, ownerID uuid.UUID, ownerName string, units int) (*Entry, error) {
 	trimmedName := strings.TrimSpace(ownerName)
-	if trimmedName == "" || utf8.RuneCountInString(trimmedName) > validation.MaxNameLength {
+	if ownerID == uuid.Nil || trimmedName == "" || utf8.RuneCountInString(trimmedName) > validation.MaxNameLength {
 		return nil, ErrInvalidOwner
 	}
 	if units < 0 || units > validation.MaxUnits {
@@ -32,6 +44,7 @@ func NewCapTableEntry(fundID uuid.UUID, ownerName string, units int) (*Entry, er
 	return &Entry{
 		ID:         uuid.New(),
 		FundID:     fundID,
+		OwnerID:    ownerID,
 		OwnerName:  trimmedName,
 		Units:      units,
 		AcquiredAt: now,
@@ -39,3 +52,18 @@ func NewCapTableEntry(fundID uuid.UUID, ownerName string, units int) (*Entry, er
 		DeletedAt:  nil,
 	}, nil
 }
+
+func (e *Entry) scanTargets() []any {
+	return []any{
+		&e.ID,
+		&e.FundID,
+		&e.OwnerID,
+		&e.OwnerName,
+		&e.ClassID,
+		&e.ClassName,
+		&e.Units,
+		&e.AcquiredAt,
+		&e.UpdatedAt,
+		&e.DeletedAt,
+	}
+}
@@ -18,10 +18,26 @@ var ErrInvalidUnits = fmt.Errorf("invalid units: must be between 0 and %d", vali
 
 var ErrNilEntry = errors.New("ownership: cannot operate on nil entry")
 
+var ErrOwnerNameTaken = errors.New("another owner with the same name already holds units in this fund")
+
+var ErrShareClassNotFound = errors.New("share class not found")
+
+var ErrInvalidShareClass = fmt.Errorf("invalid share class: name must be non-empty (max %d chars) and authorizedUnits must be between %d and %d", validation.MaxNameLength, validation.MinUnits, validation.MaxUnits)
+
+var ErrDuplicateShareClass = errors.New("share class name already exists in this fund")
+
 func OwnerNotFoundError(fundID uuid.UUID, ownerName string) error {
 	return fmt.Errorf("owner %q in fund %s: %w", ownerName, fundID, ErrOwnerNotFound)
 }
 
+func OwnerIDNotFoundError(fundID, ownerID uuid.UUID) error {
+	return fmt.Errorf("owner %s in fund %s: %w", ownerID, fundID, ErrOwnerNotFound)
+}
+
+func ShareClassNotFoundError(fundID, classID uuid.UUID) error {
+	return fmt.Errorf("share class %s in fund %s: %w", classID, fundID, ErrShareClassNotFound)
+}
+
 func NotFoundError(id uuid.UUID) error {
 	return fmt.Errorf("entry %s: %w", id, ErrNotFound)
 }
@@ -0,0 +1,23 @@
+package ownership
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Holding struct {
+	FundID         uuid.UUID
+	FundName       string
+	FundTotalUnits int
+	OwnerID        uuid.UUID
+	OwnerName      string
+	ClassID        uuid.UUID
+	ClassName      string
+	Units          int
+	AcquiredAt     time.Time
+}
+
+func (h *Holding) Percentage() float64 {
+	return Percentage(h.Units, h.FundTotalUnits)
+}
@@ -0,0 +1,82 @@
+package ownership
+
+import (
+	"bytes"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Movement struct {
+	FromOwnerID uuid.UUID
+	FromOwner   string
+	ToOwnerID   uuid.UUID
+	ToOwner     string
+	ClassID     uuid.UUID
+	ClassName   string
+	Units       int
+	At          time.Time
+}
+
+type Ledger struct {
+	FundID    uuid.UUID
+	CreatedAt time.Time
+	Movements []Movement
+}
+
+func (l *Ledger) Replay(asOf time.Time) []*Entry {
+	if l.CreatedAt.After(asOf) {
+		return []*Entry{}
+	}
+
+	balances := make(map[Holder]*Entry)
+	apply := func(m Movement, ownerID uuid.UUID, name string, units int) {
+		if ownerID == uuid.Nil {
+			return
+		}
+		key := Holder{OwnerID: ownerID, ClassID: m.ClassID}
+		if e, ok := balances[key]; ok {
+			e.Units += units
+			e.UpdatedAt = m.At
+			return
+		}
+		balances[key] = &Entry{
+			FundID:     l.FundID,
+			OwnerID:    ownerID,
+			OwnerName:  name,
+			ClassID:    m.ClassID,
+			ClassName:  m.ClassName,
+			Units:      units,
+			AcquiredAt: m.At,
+			UpdatedAt:  m.At,
+		}
+	}
+
+	for _, m := range l.Movements {
+		if m.At.After(asOf) {
+			continue
+		}
+		apply(m, m.FromOwnerID, m.FromOwner, -m.Units)
+		apply(m, m.ToOwnerID, m.ToOwner, m.Units)
+	}
+
+	entries := make([]*Entry, 0, len(balances))
+	for _, e := range balances {
+		entries = append(entries, e)
+	}
+	sortEntries(entries)
+	return entries
+}
+
+func sortEntries(entries []*Entry) {
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Units != entries[j].Units {
+			return entries[i].Units > entries[j].Units
+		}
+		if entries[i].OwnerName != entries[j].OwnerName {
+			return entries[i].OwnerName < entries[j].OwnerName
+		}
+		return bytes.Compare(entries[i].ClassID[:], entries[j].ClassID[:]) < 0
+	})
+}
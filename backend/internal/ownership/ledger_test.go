@@ -0,0 +1,115 @@
+package ownership
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLedger_Replay(t *testing.T) {
+	created := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	founder, alice, bob := uuid.New(), uuid.New(), uuid.New()
+	ledger := &Ledger{
+		FundID:    uuid.New(),
+		CreatedAt: created,
+		Movements: []Movement{
+			{ToOwnerID: founder, ToOwner: "Founder", Units: 1000, At: created},
+			{FromOwnerID: founder, FromOwner: "Founder", ToOwnerID: alice, ToOwner: "Alice", Units: 300, At: created.Add(24 * time.Hour)},
+			{FromOwnerID: founder, FromOwner: "Founder", ToOwnerID: bob, ToOwner: "Bob", Units: 200, At: created.Add(48 * time.Hour)},
+			{FromOwnerID: alice, FromOwner: "Alice", ToOwnerID: bob, ToOwner: "Bob", Units: 100, At: created.Add(72 * time.Hour)},
+		},
+	}
+
+	balances := func(entries []*Entry) map[string]int {
+		m := make(map[string]int, len(entries))
+		for _, e := range entries {
+			m[e.OwnerName] = e.Units
+		}
+		return m
+	}
+
+	t.Run("before fund creation is empty", func(t *testing.T) {
+		assert.Empty(t, ledger.Replay(created.Add(-time.Second)))
+	})
+
+	t.Run("at creation the initial owner holds everything", func(t *testing.T) {
+		entries := ledger.Replay(created)
+		require.Len(t, entries, 1)
+		assert.Equal(t, "Founder", entries[0].OwnerName)
+		assert.Equal(t, founder, entries[0].OwnerID)
+		assert.Equal(t, 1000, entries[0].Units)
+		assert.Equal(t, created, entries[0].AcquiredAt)
+	})
+
+	t.Run("includes transfers executed exactly at the instant", func(t *testing.T) {
+		assert.Equal(t, map[string]int{"Founder": 500, "Alice": 300, "Bob": 200}, balances(ledger.Replay(created.Add(48*time.Hour))))
+	})
+
+	t.Run("replays full history", func(t *testing.T) {
+		entries := ledger.Replay(created.Add(96 * time.Hour))
+		assert.Equal(t, map[string]int{"Founder": 500, "Alice": 200, "Bob": 300}, balances(entries))
+		assert.Equal(t, "Founder", entries[0].OwnerName)
+		assert.Equal(t, "Bob", entries[1].OwnerName)
+		assert.Equal(t, created.Add(24*time.Hour), entries[2].AcquiredAt)
+	})
+
+	t.Run("owners that sold out stay with zero units", func(t *testing.T) {
+		l := &Ledger{
+			CreatedAt: created,
+			Movements: []Movement{
+				{ToOwnerID: founder, ToOwner: "Founder", Units: 100, At: created},
+				{FromOwnerID: founder, FromOwner: "Founder", ToOwnerID: alice, ToOwner: "Alice", Units: 100, At: created.Add(time.Hour)},
+			},
+		}
+		assert.Equal(t, map[string]int{"Founder": 0, "Alice": 100}, balances(l.Replay(created.Add(2*time.Hour))))
+	})
+
+	t.Run("balances follow the owner ID rather than the name", func(t *testing.T) {
+		l := &Ledger{
+			CreatedAt: created,
+			Movements: []Movement{
+				{ToOwnerID: founder, ToOwner: "Founder Holdings LLC", Units: 100, At: created},
+				{FromOwnerID: founder, FromOwner: "Founder Holdings LLC", ToOwnerID: alice, ToOwner: "Alice", Units: 40, At: created.Add(time.Hour)},
+				{FromOwnerID: alice, FromOwner: "Alice", ToOwnerID: founder, ToOwner: "Founder Holdings LLC", Units: 10, At: created.Add(2 * time.Hour)},
+			},
+		}
+		assert.Equal(t, map[string]int{"Founder Holdings LLC": 70, "Alice": 30}, balances(l.Replay(created.Add(3*time.Hour))))
+	})
+	t.Run("an owner's classes are replayed separately", func(t *testing.T) {
+		classA, classB := uuid.New(), uuid.New()
+		l := &Ledger{
+			CreatedAt: created,
+			Movements: []Movement{
+				{ToOwnerID: founder, ToOwner: "Founder", ClassID: classA, ClassName: "Class A", Units: 100, At: created},
+				{ToOwnerID: alice, ToOwner: "Alice", ClassID: classB, ClassName: "Class B", Units: 50, At: created},
+				{FromOwnerID: alice, FromOwner: "Alice", ToOwnerID: founder, ToOwner: "Founder", ClassID: classB, ClassName: "Class B", Units: 20, At: created.Add(time.Hour)},
+			},
+		}
+		entries := l.Replay(created.Add(time.Hour))
+		require.Len(t, entries, 3)
+		assert.Equal(t, Holder{OwnerID: founder, ClassID: classA}, entries[0].Holder())
+		assert.Equal(t, 100, entries[0].Units)
+		assert.Equal(t, Holder{OwnerID: alice, ClassID: classB}, entries[1].Holder())
+		assert.Equal(t, 30, entries[1].Units)
+		assert.Equal(t, Holder{OwnerID: founder, ClassID: classB}, entries[2].Holder())
+		assert.Equal(t, "Class B", entries[2].ClassName)
+		assert.Equal(t, 20, entries[2].Units)
+	})
+
+	t.Run("issuances and redemptions change the units outstanding", func(t *testing.T) {
+		l := &Ledger{
+			CreatedAt: created,
+			Movements: []Movement{
+				{ToOwnerID: founder, ToOwner: "Founder", Units: 600, At: created},
+				{ToOwnerID: alice, ToOwner: "Alice", Units: 400, At: created},
+				{ToOwnerID: bob, ToOwner: "Bob", Units: 250, At: created.Add(time.Hour)},
+				{FromOwnerID: founder, FromOwner: "Founder", Units: 100, At: created.Add(2 * time.Hour)},
+			},
+		}
+		assert.Equal(t, map[string]int{"Founder": 600, "Alice": 400}, balances(l.Replay(created)))
+		assert.Equal(t, map[string]int{"Founder": 500, "Alice": 400, "Bob": 250}, balances(l.Replay(created.Add(2*time.Hour))))
+	})
+}
@@ -2,6 +2,7 @@ package ownership
 
 import (
 	"context"
+	"time"
 
 	"github.com/arowden/augment-fund/internal/validation"
 	"github.com/google/uuid"
@@ -17,15 +18,29 @@ type Repository interface {
 
 	FindByFundID(ctx context.Context, fundID uuid.UUID, params ListParams) (*CapTableView, error)
 
-	FindByFundAndOwner(ctx context.Context, fundID uuid.UUID, ownerName string) (*Entry, error)
+	StreamByFundID(ctx context.Context, fundID uuid.UUID, fn func(*Entry) error) error
 
-	FindByFundAndOwnerForUpdateTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, ownerName string) (*Entry, error)
+	FindHoldingsByOwnerID(ctx context.Context, ownerID uuid.UUID) ([]*Holding, error)
+
+	FindByFundAndOwner(ctx context.Context, fundID uuid.UUID, classID *uuid.UUID, ownerName string) (*Entry, error)
+
+	FindByFundAndOwnerForUpdateTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, classID *uuid.UUID, ownerName string) (*Entry, error)
+
+	FindByFundAndOwnerIDForUpdateTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, classID *uuid.UUID, ownerID uuid.UUID) (*Entry, error)
 
 	DecrementUnitsTx(ctx context.Context, tx pgx.Tx, entryID uuid.UUID, units int) error
 
-	IncrementOrCreateTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, ownerName string, units int) error
+	IncrementOrCreateTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, classID *uuid.UUID, ownerID uuid.UUID, units int) error
 
 	Upsert(ctx context.Context, entry *Entry) error
 
 	UpsertTx(ctx context.Context, tx pgx.Tx, entry *Entry) error
+
+	FindLedger(ctx context.Context, fundID uuid.UUID, until time.Time) (*Ledger, error)
+
+	CreateShareClass(ctx context.Context, class *ShareClass) error
+
+	ListShareClasses(ctx context.Context, fundID uuid.UUID) ([]*ShareClass, error)
+
+	FindShareClassTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, classID *uuid.UUID) (*ShareClass, error)
 }
@@ -3,7 +3,10 @@ package ownership
 import (
 	"context"
 	"errors"
+	"sort"
+	"time"
 
+	"github.com/arowden/augment-fund/internal/validation"
 	"github.com/google/uuid"
 )
 
@@ -31,15 +34,108 @@ func NewService(opts ...ServiceOption) (*Service, error) {
 }
 
 func (s *Service) GetCapTable(ctx context.Context, fundID uuid.UUID, params ListParams) (*CapTableView, error) {
-	return s.repo.FindByFundID(ctx, fundID, params)
+	view, err := s.repo.FindByFundID(ctx, fundID, params)
+	if err != nil {
+		return nil, err
+	}
+	view.Classes, err = s.repo.ListShareClasses(ctx, fundID)
+	if err != nil {
+		return nil, err
+	}
+	return view, nil
+}
+
+func (s *Service) ExportCapTable(ctx context.Context, fundID uuid.UUID, fn func(*Entry) error) error {
+	return s.repo.StreamByFundID(ctx, fundID, fn)
+}
+
+func (s *Service) GetCapTableAsOf(ctx context.Context, fundID uuid.UUID, asOf time.Time, params ListParams) (*CapTableView, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	params = params.Normalize()
+
+	var after *capTableCursor
+	if params.Cursor != "" {
+		after = &capTableCursor{}
+		if err := validation.DecodeCursor(cursorKind, params.Cursor, after); err != nil {
+			return nil, err
+		}
+	}
+
+	ledger, err := s.repo.FindLedger(ctx, fundID, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	classes, err := s.repo.ListShareClasses(ctx, fundID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := ledger.Replay(asOf)
+	total, outstanding := len(entries), 0
+	byClass := make(map[uuid.UUID]int)
+	for _, e := range entries {
+		outstanding += e.Units
+		byClass[e.ClassID] += e.Units
+	}
+	existing := make([]*ShareClass, 0, len(classes))
+	for _, class := range classes {
+		if class.CreatedAt.After(asOf) {
+			continue
+		}
+		class.OutstandingUnits = byClass[class.ID]
+		existing = append(existing, class)
+	}
+	start := min(params.Offset, total)
+	if after != nil {
+		start = sort.Search(total, func(i int) bool { return after.precedes(entries[i]) })
+	}
+	end := min(start+params.Limit+1, total)
+
+	page, next, err := validation.NextPage(cursorKind, entries[start:end], params.Limit, cursorOf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CapTableView{
+		FundID:           fundID,
+		Entries:          page,
+		Classes:          existing,
+		TotalCount:       total,
+		UnitsOutstanding: outstanding,
+		Limit:            params.Limit,
+		Offset:           params.Offset,
+		NextCursor:       next,
+	}, nil
 }
 
-func (s *Service) GetOwnership(ctx context.Context, fundID uuid.UUID, ownerName string) (*Entry, error) {
-	return s.repo.FindByFundAndOwner(ctx, fundID, ownerName)
+func (s *Service) GetHoldings(ctx context.Context, ownerID uuid.UUID) ([]*Holding, error) {
+	return s.repo.FindHoldingsByOwnerID(ctx, ownerID)
+}
+
+func (s *Service) GetOwnership(ctx context.Context, fundID uuid.UUID, classID *uuid.UUID, ownerName string) (*Entry, error) {
+	return s.repo.FindByFundAndOwner(ctx, fundID, classID, ownerName)
+}
+
+func (s *Service) ListShareClasses(ctx context.Context, fundID uuid.UUID) ([]*ShareClass, error) {
+	return s.repo.ListShareClasses(ctx, fundID)
+}
+
+func (s *Service) CreateShareClass(ctx context.Context, fundID uuid.UUID, name string, authorizedUnits int) (*ShareClass, error) {
+	class, err := NewShareClass(fundID, name, authorizedUnits)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.CreateShareClass(ctx, class); err != nil {
+		return nil, err
+	}
+	return class, nil
 }
 
-func (s *Service) CreateEntry(ctx context.Context, fundID uuid.UUID, ownerName string, units int) (*Entry, error) {
-	entry, err := NewCapTableEntry(fundID, ownerName, units)
+func (s *Service) CreateEntry(ctx context.Context, fundID, ownerID uuid.UUID, ownerName string, units int) (*Entry, error) {
+	entry, err := NewCapTableEntry(fundID, ownerID, ownerName, units)
 	if err != nil {
 		return nil, err
 	}
@@ -3,8 +3,11 @@ package ownership
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
+	"github.com/arowden/augment-fund/internal/validation"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/assert"
@@ -15,12 +18,17 @@ type mockRepository struct {
 	createFunc                      func(ctx context.Context, entry *Entry) error
 	createTxFunc                    func(ctx context.Context, tx pgx.Tx, entry *Entry) error
 	findByFundIDFunc                func(ctx context.Context, fundID uuid.UUID, params ListParams) (*CapTableView, error)
-	findByFundAndOwnerFunc          func(ctx context.Context, fundID uuid.UUID, ownerName string) (*Entry, error)
-	findByFundAndOwnerForUpdateFunc func(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, ownerName string) (*Entry, error)
+	findHoldingsByOwnerIDFunc       func(ctx context.Context, ownerID uuid.UUID) ([]*Holding, error)
+	findByFundAndOwnerFunc          func(ctx context.Context, fundID uuid.UUID, classID *uuid.UUID, ownerName string) (*Entry, error)
+	findByFundAndOwnerForUpdateFunc func(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, classID *uuid.UUID, ownerName string) (*Entry, error)
+	findByOwnerIDForUpdateFunc      func(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, classID *uuid.UUID, ownerID uuid.UUID) (*Entry, error)
 	decrementUnitsTxFunc            func(ctx context.Context, tx pgx.Tx, entryID uuid.UUID, units int) error
-	incrementOrCreateTxFunc         func(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, ownerName string, units int) error
+	incrementOrCreateTxFunc         func(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, classID *uuid.UUID, ownerID uuid.UUID, units int) error
 	upsertFunc                      func(ctx context.Context, entry *Entry) error
 	upsertTxFunc                    func(ctx context.Context, tx pgx.Tx, entry *Entry) error
+	findLedgerFunc                  func(ctx context.Context, fundID uuid.UUID, until time.Time) (*Ledger, error)
+	createShareClassFunc            func(ctx context.Context, class *ShareClass) error
+	listShareClassesFunc            func(ctx context.Context, fundID uuid.UUID) ([]*ShareClass, error)
 }
 
 func (m *mockRepository) Create(ctx context.Context, entry *Entry) error {
@@ -44,20 +52,38 @@ func (m *mockRepository) FindByFundID(ctx context.Context, fundID uuid.UUID, par
 	return &CapTableView{FundID: fundID, Entries: []*Entry{}}, nil
 }
 
-func (m *mockRepository) FindByFundAndOwner(ctx context.Context, fundID uuid.UUID, ownerName string) (*Entry, error) {
+func (m *mockRepository) StreamByFundID(ctx context.Context, fundID uuid.UUID, fn func(*Entry) error) error {
+	return nil
+}
+
+func (m *mockRepository) FindHoldingsByOwnerID(ctx context.Context, ownerID uuid.UUID) ([]*Holding, error) {
+	if m.findHoldingsByOwnerIDFunc != nil {
+		return m.findHoldingsByOwnerIDFunc(ctx, ownerID)
+	}
+	return []*Holding{}, nil
+}
+
+func (m *mockRepository) FindByFundAndOwner(ctx context.Context, fundID uuid.UUID, classID *uuid.UUID, ownerName string) (*Entry, error) {
 	if m.findByFundAndOwnerFunc != nil {
-		return m.findByFundAndOwnerFunc(ctx, fundID, ownerName)
+		return m.findByFundAndOwnerFunc(ctx, fundID, classID, ownerName)
 	}
 	return nil, OwnerNotFoundError(fundID, ownerName)
 }
 
-func (m *mockRepository) FindByFundAndOwnerForUpdateTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, ownerName string) (*Entry, error) {
+func (m *mockRepository) FindByFundAndOwnerForUpdateTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, classID *uuid.UUID, ownerName string) (*Entry, error) {
 	if m.findByFundAndOwnerForUpdateFunc != nil {
-		return m.findByFundAndOwnerForUpdateFunc(ctx, tx, fundID, ownerName)
+		return m.findByFundAndOwnerForUpdateFunc(ctx, tx, fundID, classID, ownerName)
 	}
 	return nil, OwnerNotFoundError(fundID, ownerName)
 }
 
+func (m *mockRepository) FindByFundAndOwnerIDForUpdateTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, classID *uuid.UUID, ownerID uuid.UUID) (*Entry, error) {
+	if m.findByOwnerIDForUpdateFunc != nil {
+		return m.findByOwnerIDForUpdateFunc(ctx, tx, fundID, classID, ownerID)
+	}
+	return nil, OwnerIDNotFoundError(fundID, ownerID)
+}
+
 func (m *mockRepository) DecrementUnitsTx(ctx context.Context, tx pgx.Tx, entryID uuid.UUID, units int) error {
 	if m.decrementUnitsTxFunc != nil {
 		return m.decrementUnitsTxFunc(ctx, tx, entryID, units)
@@ -65,9 +91,9 @@ func (m *mockRepository) DecrementUnitsTx(ctx context.Context, tx pgx.Tx, entryI
 	return nil
 }
 
-func (m *mockRepository) IncrementOrCreateTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, ownerName string, units int) error {
+func (m *mockRepository) IncrementOrCreateTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, classID *uuid.UUID, ownerID uuid.UUID, units int) error {
 	if m.incrementOrCreateTxFunc != nil {
-		return m.incrementOrCreateTxFunc(ctx, tx, fundID, ownerName, units)
+		return m.incrementOrCreateTxFunc(ctx, tx, fundID, classID, ownerID, units)
 	}
 	return nil
 }
@@ -86,6 +112,31 @@ func (m *mockRepository) UpsertTx(ctx context.Context, tx pgx.Tx, entry *Entry)
 	return nil
 }
 
+func (m *mockRepository) FindLedger(ctx context.Context, fundID uuid.UUID, until time.Time) (*Ledger, error) {
+	if m.findLedgerFunc != nil {
+		return m.findLedgerFunc(ctx, fundID, until)
+	}
+	return nil, fmt.Errorf("ledger for fund %s: %w", fundID, ErrNotFound)
+}
+
+func (m *mockRepository) CreateShareClass(ctx context.Context, class *ShareClass) error {
+	if m.createShareClassFunc != nil {
+		return m.createShareClassFunc(ctx, class)
+	}
+	return nil
+}
+
+func (m *mockRepository) ListShareClasses(ctx context.Context, fundID uuid.UUID) ([]*ShareClass, error) {
+	if m.listShareClassesFunc != nil {
+		return m.listShareClassesFunc(ctx, fundID)
+	}
+	return []*ShareClass{}, nil
+}
+
+func (m *mockRepository) FindShareClassTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, classID *uuid.UUID) (*ShareClass, error) {
+	return nil, ErrShareClassNotFound
+}
+
 func TestNewService(t *testing.T) {
 	t.Run("returns error when no repository is configured", func(t *testing.T) {
 		svc, err := NewService()
@@ -165,6 +216,104 @@ func TestService_GetCapTable(t *testing.T) {
 	})
 }
 
+func TestService_GetCapTableAsOf(t *testing.T) {
+	fundID := uuid.New()
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	asOf := created.Add(72 * time.Hour)
+	founder, alice, bob := uuid.New(), uuid.New(), uuid.New()
+	ledger := &Ledger{
+		FundID:    fundID,
+		CreatedAt: created,
+		Movements: []Movement{
+			{ToOwner: "Founder", ToOwnerID: founder, Units: 1000, At: created},
+			{FromOwner: "Founder", FromOwnerID: founder, ToOwner: "Alice", ToOwnerID: alice, Units: 300, At: created.Add(24 * time.Hour)},
+			{FromOwner: "Founder", FromOwnerID: founder, ToOwner: "Bob", ToOwnerID: bob, Units: 200, At: created.Add(48 * time.Hour)},
+		},
+	}
+
+	t.Run("replays ledger up to the instant", func(t *testing.T) {
+		var receivedUntil time.Time
+		repo := &mockRepository{
+			findLedgerFunc: func(ctx context.Context, fID uuid.UUID, until time.Time) (*Ledger, error) {
+				receivedUntil = until
+				return ledger, nil
+			},
+		}
+
+		svc, err := NewService(WithRepository(repo))
+		require.NoError(t, err)
+
+		view, err := svc.GetCapTableAsOf(context.Background(), fundID, asOf, ListParams{})
+		require.NoError(t, err)
+		assert.Equal(t, asOf, receivedUntil)
+		assert.Equal(t, 3, view.TotalCount)
+		assert.Equal(t, 1000, view.TotalUnits())
+		assert.Equal(t, 1000, view.UnitsOutstanding)
+		assert.Equal(t, "Founder", view.Entries[0].OwnerName)
+		assert.Equal(t, 500, view.Entries[0].Units)
+	})
+
+	t.Run("paginates replayed entries", func(t *testing.T) {
+		repo := &mockRepository{
+			findLedgerFunc: func(ctx context.Context, fID uuid.UUID, until time.Time) (*Ledger, error) {
+				return ledger, nil
+			},
+		}
+
+		svc, err := NewService(WithRepository(repo))
+		require.NoError(t, err)
+
+		view, err := svc.GetCapTableAsOf(context.Background(), fundID, asOf, ListParams{Limit: 1, Offset: 1})
+		require.NoError(t, err)
+		assert.Equal(t, 3, view.TotalCount)
+		require.Len(t, view.Entries, 1)
+		assert.Equal(t, "Alice", view.Entries[0].OwnerName)
+
+		view, err = svc.GetCapTableAsOf(context.Background(), fundID, asOf, ListParams{Offset: 10})
+		require.NoError(t, err)
+		assert.Empty(t, view.Entries)
+		assert.Equal(t, 3, view.TotalCount)
+	})
+
+	t.Run("pages replayed entries by cursor", func(t *testing.T) {
+		repo := &mockRepository{
+			findLedgerFunc: func(ctx context.Context, fID uuid.UUID, until time.Time) (*Ledger, error) {
+				return ledger, nil
+			},
+		}
+
+		svc, err := NewService(WithRepository(repo))
+		require.NoError(t, err)
+
+		var names []string
+		params := ListParams{Limit: 2}
+		for {
+			view, err := svc.GetCapTableAsOf(context.Background(), fundID, asOf, params)
+			require.NoError(t, err)
+			for _, e := range view.Entries {
+				names = append(names, e.OwnerName)
+			}
+			if view.NextCursor == "" {
+				break
+			}
+			params.Cursor = view.NextCursor
+		}
+		assert.Equal(t, []string{"Founder", "Alice", "Bob"}, names)
+
+		_, err = svc.GetCapTableAsOf(context.Background(), fundID, asOf, ListParams{Cursor: "garbage"})
+		assert.ErrorIs(t, err, validation.ErrInvalidCursor)
+	})
+
+	t.Run("propagates repository error", func(t *testing.T) {
+		svc, err := NewService(WithRepository(&mockRepository{}))
+		require.NoError(t, err)
+
+		view, err := svc.GetCapTableAsOf(context.Background(), fundID, asOf, ListParams{})
+		assert.Nil(t, view)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
 func TestService_GetOwnership(t *testing.T) {
 	fundID := uuid.New()
 
@@ -176,7 +325,7 @@ func TestService_GetOwnership(t *testing.T) {
 			Units:     500,
 		}
 		repo := &mockRepository{
-			findByFundAndOwnerFunc: func(ctx context.Context, fID uuid.UUID, ownerName string) (*Entry, error) {
+			findByFundAndOwnerFunc: func(ctx context.Context, fID uuid.UUID, classID *uuid.UUID, ownerName string) (*Entry, error) {
 				return expectedEntry, nil
 			},
 		}
@@ -184,14 +333,14 @@ func TestService_GetOwnership(t *testing.T) {
 		svc, err := NewService(WithRepository(repo))
 		require.NoError(t, err)
 
-		entry, err := svc.GetOwnership(context.Background(), fundID, "Alice")
+		entry, err := svc.GetOwnership(context.Background(), fundID, nil, "Alice")
 		require.NoError(t, err)
 		assert.Equal(t, expectedEntry, entry)
 	})
 
 	t.Run("returns error when owner not found", func(t *testing.T) {
 		repo := &mockRepository{
-			findByFundAndOwnerFunc: func(ctx context.Context, fID uuid.UUID, ownerName string) (*Entry, error) {
+			findByFundAndOwnerFunc: func(ctx context.Context, fID uuid.UUID, classID *uuid.UUID, ownerName string) (*Entry, error) {
 				return nil, OwnerNotFoundError(fID, ownerName)
 			},
 		}
@@ -199,7 +348,7 @@ func TestService_GetOwnership(t *testing.T) {
 		svc, err := NewService(WithRepository(repo))
 		require.NoError(t, err)
 
-		entry, err := svc.GetOwnership(context.Background(), fundID, "NonExistent")
+		entry, err := svc.GetOwnership(context.Background(), fundID, nil, "NonExistent")
 		assert.Nil(t, entry)
 		assert.True(t, errors.Is(err, ErrOwnerNotFound))
 	})
@@ -207,6 +356,7 @@ func TestService_GetOwnership(t *testing.T) {
 
 func TestService_CreateEntry(t *testing.T) {
 	fundID := uuid.New()
+	ownerID := uuid.New()
 
 	t.Run("creates entry with valid inputs", func(t *testing.T) {
 		var createdEntry *Entry
@@ -220,7 +370,7 @@ func TestService_CreateEntry(t *testing.T) {
 		svc, err := NewService(WithRepository(repo))
 		require.NoError(t, err)
 
-		entry, err := svc.CreateEntry(context.Background(), fundID, "Alice", 500)
+		entry, err := svc.CreateEntry(context.Background(), fundID, ownerID, "Alice", 500)
 		require.NoError(t, err)
 		assert.NotNil(t, entry)
 		assert.Equal(t, fundID, entry.FundID)
@@ -234,7 +384,7 @@ func TestService_CreateEntry(t *testing.T) {
 		svc, err := NewService(WithRepository(repo))
 		require.NoError(t, err)
 
-		entry, err := svc.CreateEntry(context.Background(), fundID, "", 500)
+		entry, err := svc.CreateEntry(context.Background(), fundID, ownerID, "", 500)
 		assert.Nil(t, entry)
 		assert.ErrorIs(t, err, ErrInvalidOwner)
 	})
@@ -244,7 +394,7 @@ func TestService_CreateEntry(t *testing.T) {
 		svc, err := NewService(WithRepository(repo))
 		require.NoError(t, err)
 
-		entry, err := svc.CreateEntry(context.Background(), fundID, "Alice", -1)
+		entry, err := svc.CreateEntry(context.Background(), fundID, ownerID, "Alice", -1)
 		assert.Nil(t, entry)
 		assert.ErrorIs(t, err, ErrInvalidUnits)
 	})
@@ -260,8 +410,77 @@ func TestService_CreateEntry(t *testing.T) {
 		svc, err := NewService(WithRepository(repo))
 		require.NoError(t, err)
 
-		entry, err := svc.CreateEntry(context.Background(), fundID, "Alice", 500)
+		entry, err := svc.CreateEntry(context.Background(), fundID, ownerID, "Alice", 500)
 		assert.Nil(t, entry)
 		assert.Equal(t, repoErr, err)
 	})
 }
+
+func TestService_ShareClasses(t *testing.T) {
+	fundID := uuid.New()
+
+	t.Run("creates a non-default class", func(t *testing.T) {
+		var created *ShareClass
+		repo := &mockRepository{
+			createShareClassFunc: func(ctx context.Context, class *ShareClass) error {
+				created = class
+				return nil
+			},
+		}
+		svc, err := NewService(WithRepository(repo))
+		require.NoError(t, err)
+
+		class, err := svc.CreateShareClass(context.Background(), fundID, "Class B", 500)
+		require.NoError(t, err)
+		assert.Equal(t, created, class)
+		assert.Equal(t, fundID, class.FundID)
+		assert.False(t, class.Default)
+	})
+
+	t.Run("rejects an invalid class before the repository", func(t *testing.T) {
+		repo := &mockRepository{
+			createShareClassFunc: func(ctx context.Context, class *ShareClass) error {
+				t.Fatal("repository should not be called")
+				return nil
+			},
+		}
+		svc, err := NewService(WithRepository(repo))
+		require.NoError(t, err)
+
+		class, err := svc.CreateShareClass(context.Background(), fundID, " ", 500)
+		assert.Nil(t, class)
+		assert.ErrorIs(t, err, ErrInvalidShareClass)
+	})
+
+	t.Run("as-of cap table counts class units from the replay", func(t *testing.T) {
+		created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		classA := &ShareClass{ID: uuid.New(), Name: "Class A", Default: true, OutstandingUnits: 999, CreatedAt: created}
+		classB := &ShareClass{ID: uuid.New(), Name: "Class B", CreatedAt: created.Add(time.Hour)}
+		classC := &ShareClass{ID: uuid.New(), Name: "Class C", CreatedAt: created.Add(48 * time.Hour)}
+		repo := &mockRepository{
+			findLedgerFunc: func(ctx context.Context, fID uuid.UUID, until time.Time) (*Ledger, error) {
+				return &Ledger{
+					FundID:    fundID,
+					CreatedAt: created,
+					Movements: []Movement{
+						{ToOwner: "Founder", ToOwnerID: uuid.New(), ClassID: classA.ID, Units: 1000, At: created},
+						{ToOwner: "Investor", ToOwnerID: uuid.New(), ClassID: classB.ID, Units: 250, At: created.Add(2 * time.Hour)},
+					},
+				}, nil
+			},
+			listShareClassesFunc: func(ctx context.Context, fID uuid.UUID) ([]*ShareClass, error) {
+				return []*ShareClass{classA, classB, classC}, nil
+			},
+		}
+		svc, err := NewService(WithRepository(repo))
+		require.NoError(t, err)
+
+		view, err := svc.GetCapTableAsOf(context.Background(), fundID, created.Add(24*time.Hour), ListParams{})
+		require.NoError(t, err)
+		require.Len(t, view.Classes, 2)
+		assert.Equal(t, 1000, view.FindClass(classA.ID).OutstandingUnits)
+		assert.Equal(t, 250, view.FindClass(classB.ID).OutstandingUnits)
+		assert.Nil(t, view.FindClass(classC.ID))
+		assert.Equal(t, 1250, view.UnitsOutstanding)
+	})
+}
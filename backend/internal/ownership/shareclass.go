@@ -0,0 +1,54 @@
+package ownership
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/arowden/augment-fund/internal/validation"
+	"github.com/google/uuid"
+)
+
+type ShareClass struct {
+	ID               uuid.UUID
+	FundID           uuid.UUID
+	Name             string
+	AuthorizedUnits  int
+	OutstandingUnits int
+	Default          bool
+	CreatedAt        time.Time
+}
+
+func NewShareClass(fundID uuid.UUID, name string, authorizedUnits int) (*ShareClass, error) {
+	trimmedName := strings.TrimSpace(name)
+	if trimmedName == "" || utf8.RuneCountInString(trimmedName) > validation.MaxNameLength {
+		return nil, ErrInvalidShareClass
+	}
+	if authorizedUnits < validation.MinUnits || authorizedUnits > validation.MaxUnits {
+		return nil, ErrInvalidShareClass
+	}
+
+	return &ShareClass{
+		ID:              uuid.New(),
+		FundID:          fundID,
+		Name:            trimmedName,
+		AuthorizedUnits: authorizedUnits,
+		CreatedAt:       time.Now(),
+	}, nil
+}
+
+func (c *ShareClass) UnissuedUnits() int {
+	return c.AuthorizedUnits - c.OutstandingUnits
+}
+
+func (c *ShareClass) scanTargets() []any {
+	return []any{
+		&c.ID,
+		&c.FundID,
+		&c.Name,
+		&c.AuthorizedUnits,
+		&c.OutstandingUnits,
+		&c.Default,
+		&c.CreatedAt,
+	}
+}
@@ -0,0 +1,48 @@
+package ownership
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/arowden/augment-fund/internal/validation"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewShareClass(t *testing.T) {
+	fundID := uuid.New()
+
+	t.Run("valid inputs", func(t *testing.T) {
+		class, err := NewShareClass(fundID, "  Class B  ", 500)
+		require.NoError(t, err)
+		assert.NotEmpty(t, class.ID)
+		assert.Equal(t, fundID, class.FundID)
+		assert.Equal(t, "Class B", class.Name)
+		assert.Equal(t, 500, class.AuthorizedUnits)
+		assert.Equal(t, 500, class.UnissuedUnits())
+		assert.False(t, class.Default)
+		assert.False(t, class.CreatedAt.IsZero())
+	})
+
+	t.Run("rejects invalid names", func(t *testing.T) {
+		for _, name := range []string{"", "   ", strings.Repeat("a", validation.MaxNameLength+1)} {
+			class, err := NewShareClass(fundID, name, 500)
+			assert.Nil(t, class)
+			assert.ErrorIs(t, err, ErrInvalidShareClass)
+		}
+	})
+
+	t.Run("rejects invalid authorized units", func(t *testing.T) {
+		for _, units := range []int{0, -1, validation.MaxUnits + 1} {
+			class, err := NewShareClass(fundID, "Class B", units)
+			assert.Nil(t, class)
+			assert.ErrorIs(t, err, ErrInvalidShareClass)
+		}
+	})
+
+	t.Run("unissued units shrink as units are issued", func(t *testing.T) {
+		class := &ShareClass{AuthorizedUnits: 500, OutstandingUnits: 320}
+		assert.Equal(t, 180, class.UnissuedUnits())
+	})
+}
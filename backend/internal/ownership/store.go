@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/arowden/augment-fund/internal/validation"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -16,6 +18,17 @@ type DB interface {
 	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
 }
 
+const entryColumns = `e.id, e.fund_id, e.owner_id, e.owner_name, e.class_id, c.name, e.units, e.acquired_at, e.updated_at, e.deleted_at`
+
+const classIDOrDefault = `COALESCE($2::uuid, (SELECT d.id FROM share_classes d WHERE d.fund_id = $1 AND d.is_default))`
+
+func optionalClassID(id uuid.UUID) *uuid.UUID {
+	if id == uuid.Nil {
+		return nil
+	}
+	return &id
+}
+
 type Store struct {
 	db DB
 }
@@ -41,10 +54,11 @@ func (s *Store) create(ctx context.Context, db DB, entry *Entry) error {
 	}
 
 	const query = `
-		INSERT INTO cap_table_entries (id, fund_id, owner_name, units, acquired_at, updated_at, deleted_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		INSERT INTO cap_table_entries (id, fund_id, owner_id, owner_name, class_id, units, acquired_at, updated_at, deleted_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING class_id, (SELECT c.name FROM share_classes c WHERE c.id = class_id)
 	`
-	_, err := db.Exec(ctx, query, entry.ID, entry.FundID, entry.OwnerName, entry.Units, entry.AcquiredAt, entry.UpdatedAt, entry.DeletedAt)
+	err := db.QueryRow(ctx, query, entry.ID, entry.FundID, entry.OwnerID, entry.OwnerName, optionalClassID(entry.ClassID), entry.Units, entry.AcquiredAt, entry.UpdatedAt, entry.DeletedAt).Scan(&entry.ClassID, &entry.ClassName)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
@@ -56,26 +70,49 @@ func (s *Store) create(ctx context.Context, db DB, entry *Entry) error {
 }
 
 func (s *Store) FindByFundID(ctx context.Context, fundID uuid.UUID, params ListParams) (*CapTableView, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
 	params = params.Normalize()
 
-	const query = `
-		SELECT id, fund_id, owner_name, units, acquired_at, updated_at, deleted_at, COUNT(*) OVER() AS total
-		FROM cap_table_entries
-		WHERE fund_id = $1 AND deleted_at IS NULL
-		ORDER BY units DESC, owner_name ASC
-		LIMIT $2 OFFSET $3
-	`
-	rows, err := s.db.Query(ctx, query, fundID, params.Limit, params.Offset)
+	var rows pgx.Rows
+	var err error
+	if params.Cursor != "" {
+		var after capTableCursor
+		if err := validation.DecodeCursor(cursorKind, params.Cursor, &after); err != nil {
+			return nil, err
+		}
+		const query = `
+			SELECT ` + entryColumns + `, 0 AS total
+			FROM cap_table_entries e
+			JOIN share_classes c ON c.id = e.class_id
+			WHERE e.fund_id = $1 AND e.deleted_at IS NULL
+			  AND (e.units < $2 OR (e.units = $2 AND (e.owner_name > $3 OR (e.owner_name = $3 AND e.class_id > $4))))
+			ORDER BY e.units DESC, e.owner_name ASC, e.class_id ASC
+			LIMIT $5
+		`
+		rows, err = s.db.Query(ctx, query, fundID, after.Units, after.OwnerName, after.ClassID, params.Limit+1)
+	} else {
+		const query = `
+			SELECT ` + entryColumns + `, COUNT(*) OVER() AS total
+			FROM cap_table_entries e
+			JOIN share_classes c ON c.id = e.class_id
+			WHERE e.fund_id = $1 AND e.deleted_at IS NULL
+			ORDER BY e.units DESC, e.owner_name ASC, e.class_id ASC
+			LIMIT $2 OFFSET $3
+		`
+		rows, err = s.db.Query(ctx, query, fundID, params.Limit+1, params.Offset)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("find cap table entries for fund %s: %w", fundID, err)
 	}
 	defer rows.Close()
 
-	entries := make([]*Entry, 0, params.Limit)
+	entries := make([]*Entry, 0, params.Limit+1)
 	var total int
 	for rows.Next() {
 		var entry Entry
-		if err := rows.Scan(&entry.ID, &entry.FundID, &entry.OwnerName, &entry.Units, &entry.AcquiredAt, &entry.UpdatedAt, &entry.DeletedAt, &total); err != nil {
+		if err := rows.Scan(append(entry.scanTargets(), &total)...); err != nil {
 			return nil, fmt.Errorf("scan cap table entry row: %w", err)
 		}
 		entries = append(entries, &entry)
@@ -91,31 +128,88 @@ func (s *Store) FindByFundID(ctx context.Context, fundID uuid.UUID, params ListP
 		}
 	}
 
+	entries, next, err := validation.NextPage(cursorKind, entries, params.Limit, cursorOf)
+	if err != nil {
+		return nil, err
+	}
+
 	return &CapTableView{
 		FundID:     fundID,
 		Entries:    entries,
 		TotalCount: total,
 		Limit:      params.Limit,
 		Offset:     params.Offset,
+		NextCursor: next,
 	}, nil
 }
 
-func (s *Store) FindByFundAndOwner(ctx context.Context, fundID uuid.UUID, ownerName string) (*Entry, error) {
+func (s *Store) StreamByFundID(ctx context.Context, fundID uuid.UUID, fn func(*Entry) error) error {
 	const query = `
-		SELECT id, fund_id, owner_name, units, acquired_at, updated_at, deleted_at
-		FROM cap_table_entries
-		WHERE fund_id = $1 AND owner_name = $2 AND deleted_at IS NULL
+		SELECT ` + entryColumns + `
+		FROM cap_table_entries e
+		JOIN share_classes c ON c.id = e.class_id
+		WHERE e.fund_id = $1 AND e.deleted_at IS NULL
+		ORDER BY e.units DESC, e.owner_name ASC, e.class_id ASC
+	`
+	rows, err := s.db.Query(ctx, query, fundID)
+	if err != nil {
+		return fmt.Errorf("stream cap table entries for fund %s: %w", fundID, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var entry Entry
+		if err := rows.Scan(entry.scanTargets()...); err != nil {
+			return fmt.Errorf("scan cap table entry row: %w", err)
+		}
+		if err := fn(&entry); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("iterate cap table entry rows: %w", err)
+	}
+	return nil
+}
+
+func (s *Store) FindHoldingsByOwnerID(ctx context.Context, ownerID uuid.UUID) ([]*Holding, error) {
+	const query = `
+		SELECT e.fund_id, f.name, f.total_units, e.owner_id, e.owner_name, e.class_id, c.name, e.units, e.acquired_at
+		FROM cap_table_entries e
+		JOIN funds f ON f.id = e.fund_id
+		JOIN share_classes c ON c.id = e.class_id
+		WHERE e.owner_id = $1 AND e.deleted_at IS NULL AND e.units > 0
+		ORDER BY e.acquired_at ASC, f.name ASC, c.name ASC
+	`
+	rows, err := s.db.Query(ctx, query, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("find holdings for owner %s: %w", ownerID, err)
+	}
+	defer rows.Close()
+
+	holdings := make([]*Holding, 0)
+	for rows.Next() {
+		var h Holding
+		if err := rows.Scan(&h.FundID, &h.FundName, &h.FundTotalUnits, &h.OwnerID, &h.OwnerName, &h.ClassID, &h.ClassName, &h.Units, &h.AcquiredAt); err != nil {
+			return nil, fmt.Errorf("scan holding row: %w", err)
+		}
+		holdings = append(holdings, &h)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate holding rows: %w", err)
+	}
+	return holdings, nil
+}
+
+func (s *Store) FindByFundAndOwner(ctx context.Context, fundID uuid.UUID, classID *uuid.UUID, ownerName string) (*Entry, error) {
+	const query = `
+		SELECT ` + entryColumns + `
+		FROM cap_table_entries e
+		JOIN share_classes c ON c.id = e.class_id
+		WHERE e.fund_id = $1 AND e.class_id = ` + classIDOrDefault + ` AND e.owner_name = $3 AND e.deleted_at IS NULL
 	`
 	var entry Entry
-	err := s.db.QueryRow(ctx, query, fundID, ownerName).Scan(
-		&entry.ID,
-		&entry.FundID,
-		&entry.OwnerName,
-		&entry.Units,
-		&entry.AcquiredAt,
-		&entry.UpdatedAt,
-		&entry.DeletedAt,
-	)
+	err := s.db.QueryRow(ctx, query, fundID, classID, ownerName).Scan(entry.scanTargets()...)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, OwnerNotFoundError(fundID, ownerName)
@@ -125,23 +219,16 @@ func (s *Store) FindByFundAndOwner(ctx context.Context, fundID uuid.UUID, ownerN
 	return &entry, nil
 }
 
-func (s *Store) FindByFundAndOwnerForUpdateTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, ownerName string) (*Entry, error) {
+func (s *Store) FindByFundAndOwnerForUpdateTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, classID *uuid.UUID, ownerName string) (*Entry, error) {
 	const query = `
-		SELECT id, fund_id, owner_name, units, acquired_at, updated_at, deleted_at
-		FROM cap_table_entries
-		WHERE fund_id = $1 AND owner_name = $2 AND deleted_at IS NULL
-		FOR UPDATE
+		SELECT ` + entryColumns + `
+		FROM cap_table_entries e
+		JOIN share_classes c ON c.id = e.class_id
+		WHERE e.fund_id = $1 AND e.class_id = ` + classIDOrDefault + ` AND e.owner_name = $3 AND e.deleted_at IS NULL
+		FOR UPDATE OF e
 	`
 	var entry Entry
-	err := tx.QueryRow(ctx, query, fundID, ownerName).Scan(
-		&entry.ID,
-		&entry.FundID,
-		&entry.OwnerName,
-		&entry.Units,
-		&entry.AcquiredAt,
-		&entry.UpdatedAt,
-		&entry.DeletedAt,
-	)
+	err := tx.QueryRow(ctx, query, fundID, classID, ownerName).Scan(entry.scanTargets()...)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, OwnerNotFoundError(fundID, ownerName)
@@ -151,6 +238,25 @@ func (s *Store) FindByFundAndOwnerForUpdateTx(ctx context.Context, tx pgx.Tx, fu
 	return &entry, nil
 }
 
+func (s *Store) FindByFundAndOwnerIDForUpdateTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, classID *uuid.UUID, ownerID uuid.UUID) (*Entry, error) {
+	const query = `
+		SELECT ` + entryColumns + `
+		FROM cap_table_entries e
+		JOIN share_classes c ON c.id = e.class_id
+		WHERE e.fund_id = $1 AND e.class_id = ` + classIDOrDefault + ` AND e.owner_id = $3 AND e.deleted_at IS NULL
+		FOR UPDATE OF e
+	`
+	var entry Entry
+	err := tx.QueryRow(ctx, query, fundID, classID, ownerID).Scan(entry.scanTargets()...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, OwnerIDNotFoundError(fundID, ownerID)
+		}
+		return nil, fmt.Errorf("lock owner %s in fund %s: %w", ownerID, fundID, err)
+	}
+	return &entry, nil
+}
+
 func (s *Store) DecrementUnitsTx(ctx context.Context, tx pgx.Tx, entryID uuid.UUID, units int) error {
 	const query = `
 		UPDATE cap_table_entries
@@ -164,16 +270,25 @@ func (s *Store) DecrementUnitsTx(ctx context.Context, tx pgx.Tx, entryID uuid.UU
 	return nil
 }
 
-func (s *Store) IncrementOrCreateTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, ownerName string, units int) error {
+func (s *Store) IncrementOrCreateTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, classID *uuid.UUID, ownerID uuid.UUID, units int) error {
 	const query = `
-		INSERT INTO cap_table_entries (id, fund_id, owner_name, units, acquired_at, updated_at)
-		VALUES ($1, $2, $3, $4, NOW(), NOW())
-		ON CONFLICT (fund_id, owner_name) DO UPDATE
+		INSERT INTO cap_table_entries (id, fund_id, class_id, owner_id, owner_name, units, acquired_at, updated_at)
+		SELECT $3, $1, ` + classIDOrDefault + `, o.id, o.legal_name, $5, NOW(), NOW()
+		FROM owners o
+		WHERE o.id = $4
+		ON CONFLICT (fund_id, class_id, owner_id) DO UPDATE
 		SET units = cap_table_entries.units + EXCLUDED.units, updated_at = NOW()
 	`
-	_, err := tx.Exec(ctx, query, uuid.New(), fundID, ownerName, units)
+	tag, err := tx.Exec(ctx, query, fundID, classID, uuid.New(), ownerID, units)
 	if err != nil {
-		return fmt.Errorf("increment or create owner %q in fund %s: %w", ownerName, fundID, err)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return fmt.Errorf("owner %s in fund %s: %w", ownerID, fundID, ErrOwnerNameTaken)
+		}
+		return fmt.Errorf("increment or create owner %s in fund %s: %w", ownerID, fundID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return OwnerIDNotFoundError(fundID, ownerID)
 	}
 	return nil
 }
@@ -192,16 +307,16 @@ func (s *Store) upsert(ctx context.Context, db DB, entry *Entry) error {
 	}
 
 	const query = `
-		INSERT INTO cap_table_entries (id, fund_id, owner_name, units, acquired_at, updated_at, deleted_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
-		ON CONFLICT (fund_id, owner_name) DO UPDATE SET
+		INSERT INTO cap_table_entries (id, fund_id, owner_id, owner_name, class_id, units, acquired_at, updated_at, deleted_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (fund_id, class_id, owner_id) DO UPDATE SET
 			units = EXCLUDED.units,
 			updated_at = EXCLUDED.updated_at,
 			deleted_at = EXCLUDED.deleted_at
-		RETURNING id, acquired_at
+		RETURNING id, acquired_at, class_id, (SELECT c.name FROM share_classes c WHERE c.id = class_id)
 	`
 	var returnedID uuid.UUID
-	err := db.QueryRow(ctx, query, entry.ID, entry.FundID, entry.OwnerName, entry.Units, entry.AcquiredAt, entry.UpdatedAt, entry.DeletedAt).Scan(&returnedID, &entry.AcquiredAt)
+	err := db.QueryRow(ctx, query, entry.ID, entry.FundID, entry.OwnerID, entry.OwnerName, optionalClassID(entry.ClassID), entry.Units, entry.AcquiredAt, entry.UpdatedAt, entry.DeletedAt).Scan(&returnedID, &entry.AcquiredAt, &entry.ClassID, &entry.ClassName)
 	if err != nil {
 		return fmt.Errorf("upsert cap table entry for owner %q in fund %s: %w", entry.OwnerName, entry.FundID, err)
 	}
@@ -209,3 +324,134 @@ func (s *Store) upsert(ctx context.Context, db DB, entry *Entry) error {
 	entry.ID = returnedID
 	return nil
 }
+
+func (s *Store) FindLedger(ctx context.Context, fundID uuid.UUID, until time.Time) (*Ledger, error) {
+	const fundQuery = `SELECT created_at FROM funds WHERE id = $1`
+	ledger := &Ledger{FundID: fundID}
+	err := s.db.QueryRow(ctx, fundQuery, fundID).Scan(&ledger.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("ledger for fund %s: %w", fundID, ErrNotFound)
+		}
+		return nil, fmt.Errorf("find ledger origin for fund %s: %w", fundID, err)
+	}
+
+	const movementsQuery = `
+		SELECT m.from_owner_id, m.from_owner, m.to_owner_id, m.to_owner, m.class_id, c.name, m.units, m.at
+		FROM (
+			SELECT t.from_owner_id, fe.owner_name AS from_owner, t.to_owner_id, te.owner_name AS to_owner,
+				t.class_id, t.units, t.transferred_at AS at, t.leg_index, t.id
+			FROM transfers t
+			JOIN cap_table_entries fe ON fe.fund_id = t.fund_id AND fe.class_id = t.class_id AND fe.owner_id = t.from_owner_id
+			JOIN cap_table_entries te ON te.fund_id = t.fund_id AND te.class_id = t.class_id AND te.owner_id = t.to_owner_id
+			WHERE t.fund_id = $1 AND t.status = 'approved' AND t.transferred_at <= $2
+			UNION ALL
+			SELECT
+				CASE WHEN u.kind = 'redemption' THEN u.owner_id END,
+				CASE WHEN u.kind = 'redemption' THEN e.owner_name END,
+				CASE WHEN u.kind = 'issuance' THEN u.owner_id END,
+				CASE WHEN u.kind = 'issuance' THEN e.owner_name END,
+				u.class_id, u.units, u.occurred_at, NULL, u.id
+			FROM unit_events u
+			JOIN cap_table_entries e ON e.fund_id = u.fund_id AND e.class_id = u.class_id AND e.owner_id = u.owner_id
+			WHERE u.fund_id = $1 AND u.occurred_at <= $2
+		) m
+		JOIN share_classes c ON c.id = m.class_id
+		ORDER BY m.at ASC, m.leg_index ASC NULLS FIRST, m.id ASC
+	`
+	rows, err := s.db.Query(ctx, movementsQuery, fundID, until)
+	if err != nil {
+		return nil, fmt.Errorf("find movements for fund %s: %w", fundID, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			m                  Movement
+			fromID, toID       *uuid.UUID
+			fromOwner, toOwner *string
+		)
+		if err := rows.Scan(&fromID, &fromOwner, &toID, &toOwner, &m.ClassID, &m.ClassName, &m.Units, &m.At); err != nil {
+			return nil, fmt.Errorf("scan movement row: %w", err)
+		}
+		if fromID != nil {
+			m.FromOwnerID, m.FromOwner = *fromID, *fromOwner
+		}
+		if toID != nil {
+			m.ToOwnerID, m.ToOwner = *toID, *toOwner
+		}
+		ledger.Movements = append(ledger.Movements, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate movement rows: %w", err)
+	}
+
+	return ledger, nil
+}
+
+const shareClassColumns = `c.id, c.fund_id, c.name, c.authorized_units,
+		COALESCE((SELECT SUM(e.units) FROM cap_table_entries e WHERE e.class_id = c.id AND e.deleted_at IS NULL), 0) AS outstanding_units,
+		c.is_default, c.created_at`
+
+func (s *Store) CreateShareClass(ctx context.Context, class *ShareClass) error {
+	const query = `
+		INSERT INTO share_classes (id, fund_id, name, authorized_units, is_default, created_at)
+		VALUES ($1, $2, $3, $4, FALSE, $5)
+	`
+	_, err := s.db.Exec(ctx, query, class.ID, class.FundID, class.Name, class.AuthorizedUnits, class.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return fmt.Errorf("share class %q in fund %s: %w", class.Name, class.FundID, ErrDuplicateShareClass)
+		}
+		return fmt.Errorf("create share class %q in fund %s: %w", class.Name, class.FundID, err)
+	}
+	return nil
+}
+
+func (s *Store) ListShareClasses(ctx context.Context, fundID uuid.UUID) ([]*ShareClass, error) {
+	const query = `
+		SELECT ` + shareClassColumns + `
+		FROM share_classes c
+		WHERE c.fund_id = $1
+		ORDER BY c.is_default DESC, c.created_at ASC, c.name ASC
+	`
+	rows, err := s.db.Query(ctx, query, fundID)
+	if err != nil {
+		return nil, fmt.Errorf("list share classes for fund %s: %w", fundID, err)
+	}
+	defer rows.Close()
+
+	classes := make([]*ShareClass, 0)
+	for rows.Next() {
+		var class ShareClass
+		if err := rows.Scan(class.scanTargets()...); err != nil {
+			return nil, fmt.Errorf("scan share class row: %w", err)
+		}
+		classes = append(classes, &class)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate share class rows: %w", err)
+	}
+	return classes, nil
+}
+
+func (s *Store) FindShareClassTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, classID *uuid.UUID) (*ShareClass, error) {
+	const query = `
+		SELECT ` + shareClassColumns + `
+		FROM share_classes c
+		WHERE c.fund_id = $1 AND c.id = ` + classIDOrDefault + `
+	`
+	var class ShareClass
+	err := tx.QueryRow(ctx, query, fundID, classID).Scan(class.scanTargets()...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			if classID == nil {
+				return nil, fmt.Errorf("default share class of fund %s: %w", fundID, ErrShareClassNotFound)
+			}
+			return nil, ShareClassNotFoundError(fundID, *classID)
+		}
+		return nil, fmt.Errorf("find share class in fund %s: %w", fundID, err)
+	}
+	return &class, nil
+}
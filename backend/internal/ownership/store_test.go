@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/arowden/augment-fund/internal/fund"
+	"github.com/arowden/augment-fund/internal/owner"
 	"github.com/arowden/augment-fund/internal/ownership"
 	"github.com/arowden/augment-fund/internal/postgres"
 
@@ -29,6 +30,7 @@ func TestStore(t *testing.T) {
 
 	store := ownership.NewStore(tc.Pool())
 	fundStore := fund.NewStore(tc.Pool())
+	ownerStore := owner.NewStore(tc.Pool())
 
 	createTestFund := func(t *testing.T, name string, units int) *fund.Fund {
 		f, err := fund.NewFund(name, units)
@@ -37,17 +39,24 @@ func TestStore(t *testing.T) {
 		return f
 	}
 
+	createOwner := func(t *testing.T, name string) uuid.UUID {
+		o, err := owner.NewOwner(name, owner.TypeIndividual, nil)
+		require.NoError(t, err)
+		require.NoError(t, ownerStore.Create(ctx, o))
+		return o.ID
+	}
+
 	t.Run("Create persists entry to database", func(t *testing.T) {
 		tc.Reset(ctx)
 		testFund := createTestFund(t, "Test Fund", 1000)
 
-		entry, err := ownership.NewCapTableEntry(testFund.ID, "John Doe", 500)
+		entry, err := ownership.NewCapTableEntry(testFund.ID, createOwner(t, "John Doe"), "John Doe", 500)
 		require.NoError(t, err)
 
 		err = store.Create(ctx, entry)
 		require.NoError(t, err)
 
-		found, err := store.FindByFundAndOwner(ctx, testFund.ID, "John Doe")
+		found, err := store.FindByFundAndOwner(ctx, testFund.ID, nil, "John Doe")
 		require.NoError(t, err)
 		assert.Equal(t, entry.ID, found.ID)
 		assert.Equal(t, entry.OwnerName, found.OwnerName)
@@ -65,11 +74,12 @@ func TestStore(t *testing.T) {
 		tc.Reset(ctx)
 		testFund := createTestFund(t, "Test Fund", 1000)
 
-		entry1, err := ownership.NewCapTableEntry(testFund.ID, "John Doe", 500)
+		johnDoe := createOwner(t, "John Doe")
+		entry1, err := ownership.NewCapTableEntry(testFund.ID, johnDoe, "John Doe", 500)
 		require.NoError(t, err)
 		require.NoError(t, store.Create(ctx, entry1))
 
-		entry2, err := ownership.NewCapTableEntry(testFund.ID, "John Doe", 300)
+		entry2, err := ownership.NewCapTableEntry(testFund.ID, johnDoe, "John Doe", 300)
 		require.NoError(t, err)
 		err = store.Create(ctx, entry2)
 		assert.Error(t, err)
@@ -80,7 +90,7 @@ func TestStore(t *testing.T) {
 		tc.Reset(ctx)
 		testFund := createTestFund(t, "Test Fund", 1000)
 
-		entry, err := ownership.NewCapTableEntry(testFund.ID, "Tx Owner", 250)
+		entry, err := ownership.NewCapTableEntry(testFund.ID, createOwner(t, "Tx Owner"), "Tx Owner", 250)
 		require.NoError(t, err)
 
 		tx, err := tc.Pool().Begin(ctx)
@@ -92,7 +102,7 @@ func TestStore(t *testing.T) {
 		err = tx.Rollback(ctx)
 		require.NoError(t, err)
 
-		_, err = store.FindByFundAndOwner(ctx, testFund.ID, "Tx Owner")
+		_, err = store.FindByFundAndOwner(ctx, testFund.ID, nil, "Tx Owner")
 		assert.True(t, errors.Is(err, ownership.ErrOwnerNotFound))
 	})
 
@@ -100,7 +110,7 @@ func TestStore(t *testing.T) {
 		tc.Reset(ctx)
 		testFund := createTestFund(t, "Test Fund", 1000)
 
-		entry, err := ownership.NewCapTableEntry(testFund.ID, "Committed Owner", 750)
+		entry, err := ownership.NewCapTableEntry(testFund.ID, createOwner(t, "Committed Owner"), "Committed Owner", 750)
 		require.NoError(t, err)
 
 		tx, err := tc.Pool().Begin(ctx)
@@ -112,7 +122,7 @@ func TestStore(t *testing.T) {
 		err = tx.Commit(ctx)
 		require.NoError(t, err)
 
-		found, err := store.FindByFundAndOwner(ctx, testFund.ID, "Committed Owner")
+		found, err := store.FindByFundAndOwner(ctx, testFund.ID, nil, "Committed Owner")
 		require.NoError(t, err)
 		assert.Equal(t, entry.ID, found.ID)
 	})
@@ -132,13 +142,13 @@ func TestStore(t *testing.T) {
 		tc.Reset(ctx)
 		testFund := createTestFund(t, "Test Fund", 1000)
 
-		entry1, _ := ownership.NewCapTableEntry(testFund.ID, "Small Owner", 100)
+		entry1, _ := ownership.NewCapTableEntry(testFund.ID, createOwner(t, "Small Owner"), "Small Owner", 100)
 		require.NoError(t, store.Create(ctx, entry1))
 
-		entry2, _ := ownership.NewCapTableEntry(testFund.ID, "Large Owner", 500)
+		entry2, _ := ownership.NewCapTableEntry(testFund.ID, createOwner(t, "Large Owner"), "Large Owner", 500)
 		require.NoError(t, store.Create(ctx, entry2))
 
-		entry3, _ := ownership.NewCapTableEntry(testFund.ID, "Medium Owner", 300)
+		entry3, _ := ownership.NewCapTableEntry(testFund.ID, createOwner(t, "Medium Owner"), "Medium Owner", 300)
 		require.NoError(t, store.Create(ctx, entry3))
 
 		view, err := store.FindByFundID(ctx, testFund.ID, ownership.ListParams{Limit: 10})
@@ -159,7 +169,8 @@ func TestStore(t *testing.T) {
 		testFund := createTestFund(t, "Test Fund", 1000)
 
 		for i := 1; i <= 5; i++ {
-			entry, _ := ownership.NewCapTableEntry(testFund.ID, "Owner "+string(rune('A'+i-1)), i*100)
+			name := "Owner " + string(rune('A'+i-1))
+			entry, _ := ownership.NewCapTableEntry(testFund.ID, createOwner(t, name), name, i*100)
 			require.NoError(t, store.Create(ctx, entry))
 		}
 
@@ -185,7 +196,7 @@ func TestStore(t *testing.T) {
 			{"Owner E", 500},
 		}
 		for _, e := range entries {
-			entry, _ := ownership.NewCapTableEntry(testFund.ID, e.name, e.units)
+			entry, _ := ownership.NewCapTableEntry(testFund.ID, createOwner(t, e.name), e.name, e.units)
 			require.NoError(t, store.Create(ctx, entry))
 		}
 
@@ -203,7 +214,7 @@ func TestStore(t *testing.T) {
 		tc.Reset(ctx)
 		testFund := createTestFund(t, "Test Fund", 100)
 
-		entry, _ := ownership.NewCapTableEntry(testFund.ID, "Only Owner", 100)
+		entry, _ := ownership.NewCapTableEntry(testFund.ID, createOwner(t, "Only Owner"), "Only Owner", 100)
 		require.NoError(t, store.Create(ctx, entry))
 
 		view, err := store.FindByFundID(ctx, testFund.ID, ownership.ListParams{Offset: 100})
@@ -216,7 +227,7 @@ func TestStore(t *testing.T) {
 		tc.Reset(ctx)
 		testFund := createTestFund(t, "Test Fund", 1000)
 
-		_, err := store.FindByFundAndOwner(ctx, testFund.ID, "Nonexistent")
+		_, err := store.FindByFundAndOwner(ctx, testFund.ID, nil, "Nonexistent")
 		assert.True(t, errors.Is(err, ownership.ErrOwnerNotFound))
 	})
 
@@ -224,10 +235,10 @@ func TestStore(t *testing.T) {
 		tc.Reset(ctx)
 		testFund := createTestFund(t, "Test Fund", 1000)
 
-		entry, _ := ownership.NewCapTableEntry(testFund.ID, "Specific Owner", 333)
+		entry, _ := ownership.NewCapTableEntry(testFund.ID, createOwner(t, "Specific Owner"), "Specific Owner", 333)
 		require.NoError(t, store.Create(ctx, entry))
 
-		found, err := store.FindByFundAndOwner(ctx, testFund.ID, "Specific Owner")
+		found, err := store.FindByFundAndOwner(ctx, testFund.ID, nil, "Specific Owner")
 		require.NoError(t, err)
 		assert.Equal(t, entry.ID, found.ID)
 		assert.Equal(t, "Specific Owner", found.OwnerName)
@@ -238,13 +249,13 @@ func TestStore(t *testing.T) {
 		tc.Reset(ctx)
 		testFund := createTestFund(t, "Test Fund", 1000)
 
-		entry, err := ownership.NewCapTableEntry(testFund.ID, "New Owner", 400)
+		entry, err := ownership.NewCapTableEntry(testFund.ID, createOwner(t, "New Owner"), "New Owner", 400)
 		require.NoError(t, err)
 
 		err = store.Upsert(ctx, entry)
 		require.NoError(t, err)
 
-		found, err := store.FindByFundAndOwner(ctx, testFund.ID, "New Owner")
+		found, err := store.FindByFundAndOwner(ctx, testFund.ID, nil, "New Owner")
 		require.NoError(t, err)
 		assert.Equal(t, 400, found.Units)
 	})
@@ -253,16 +264,17 @@ func TestStore(t *testing.T) {
 		tc.Reset(ctx)
 		testFund := createTestFund(t, "Test Fund", 1000)
 
-		original, _ := ownership.NewCapTableEntry(testFund.ID, "Update Owner", 300)
+		ownerID := createOwner(t, "Update Owner")
+		original, _ := ownership.NewCapTableEntry(testFund.ID, ownerID, "Update Owner", 300)
 		require.NoError(t, store.Create(ctx, original))
 
 		time.Sleep(50 * time.Millisecond)
 
-		updated, _ := ownership.NewCapTableEntry(testFund.ID, "Update Owner", 500)
+		updated, _ := ownership.NewCapTableEntry(testFund.ID, ownerID, "Update Owner", 500)
 		err := store.Upsert(ctx, updated)
 		require.NoError(t, err)
 
-		found, err := store.FindByFundAndOwner(ctx, testFund.ID, "Update Owner")
+		found, err := store.FindByFundAndOwner(ctx, testFund.ID, nil, "Update Owner")
 		require.NoError(t, err)
 		assert.Equal(t, 500, found.Units)
 		assert.Equal(t, original.ID, found.ID)
@@ -272,20 +284,21 @@ func TestStore(t *testing.T) {
 		tc.Reset(ctx)
 		testFund := createTestFund(t, "Test Fund", 1000)
 
-		original, _ := ownership.NewCapTableEntry(testFund.ID, "Acquired Owner", 200)
+		ownerID := createOwner(t, "Acquired Owner")
+		original, _ := ownership.NewCapTableEntry(testFund.ID, ownerID, "Acquired Owner", 200)
 		require.NoError(t, store.Create(ctx, original))
 
-		created, err := store.FindByFundAndOwner(ctx, testFund.ID, "Acquired Owner")
+		created, err := store.FindByFundAndOwner(ctx, testFund.ID, nil, "Acquired Owner")
 		require.NoError(t, err)
 		originalAcquiredAt := created.AcquiredAt
 
 		time.Sleep(50 * time.Millisecond)
 
-		updated, _ := ownership.NewCapTableEntry(testFund.ID, "Acquired Owner", 400)
+		updated, _ := ownership.NewCapTableEntry(testFund.ID, ownerID, "Acquired Owner", 400)
 		err = store.Upsert(ctx, updated)
 		require.NoError(t, err)
 
-		found, err := store.FindByFundAndOwner(ctx, testFund.ID, "Acquired Owner")
+		found, err := store.FindByFundAndOwner(ctx, testFund.ID, nil, "Acquired Owner")
 		require.NoError(t, err)
 
 		assert.Equal(t, originalAcquiredAt.Unix(), found.AcquiredAt.Unix())
@@ -303,7 +316,7 @@ func TestStore(t *testing.T) {
 		tc.Reset(ctx)
 		testFund := createTestFund(t, "Test Fund", 1000)
 
-		entry, err := ownership.NewCapTableEntry(testFund.ID, "Rollback Owner", 600)
+		entry, err := ownership.NewCapTableEntry(testFund.ID, createOwner(t, "Rollback Owner"), "Rollback Owner", 600)
 		require.NoError(t, err)
 
 		tx, err := tc.Pool().Begin(ctx)
@@ -315,7 +328,7 @@ func TestStore(t *testing.T) {
 		err = tx.Rollback(ctx)
 		require.NoError(t, err)
 
-		_, err = store.FindByFundAndOwner(ctx, testFund.ID, "Rollback Owner")
+		_, err = store.FindByFundAndOwner(ctx, testFund.ID, nil, "Rollback Owner")
 		assert.True(t, errors.Is(err, ownership.ErrOwnerNotFound))
 	})
 
@@ -323,7 +336,7 @@ func TestStore(t *testing.T) {
 		tc.Reset(ctx)
 		testFund := createTestFund(t, "Test Fund", 1000)
 
-		entry, err := ownership.NewCapTableEntry(testFund.ID, "Commit Owner", 700)
+		entry, err := ownership.NewCapTableEntry(testFund.ID, createOwner(t, "Commit Owner"), "Commit Owner", 700)
 		require.NoError(t, err)
 
 		tx, err := tc.Pool().Begin(ctx)
@@ -335,7 +348,7 @@ func TestStore(t *testing.T) {
 		err = tx.Commit(ctx)
 		require.NoError(t, err)
 
-		found, err := store.FindByFundAndOwner(ctx, testFund.ID, "Commit Owner")
+		found, err := store.FindByFundAndOwner(ctx, testFund.ID, nil, "Commit Owner")
 		require.NoError(t, err)
 		assert.Equal(t, 700, found.Units)
 	})
@@ -347,6 +360,7 @@ func TestStore(t *testing.T) {
 		const numGoroutines = 10
 		const unitsPerGoroutine = 100
 
+		ownerID := createOwner(t, "Concurrent Owner")
 		var wg sync.WaitGroup
 		errChan := make(chan error, numGoroutines)
 
@@ -354,7 +368,7 @@ func TestStore(t *testing.T) {
 			wg.Add(1)
 			go func(id int) {
 				defer wg.Done()
-				entry, err := ownership.NewCapTableEntry(testFund.ID, "Concurrent Owner", (id+1)*unitsPerGoroutine)
+				entry, err := ownership.NewCapTableEntry(testFund.ID, ownerID, "Concurrent Owner", (id+1)*unitsPerGoroutine)
 				if err != nil {
 					errChan <- err
 					return
@@ -372,7 +386,7 @@ func TestStore(t *testing.T) {
 			t.Errorf("concurrent upsert error: %v", err)
 		}
 
-		found, err := store.FindByFundAndOwner(ctx, testFund.ID, "Concurrent Owner")
+		found, err := store.FindByFundAndOwner(ctx, testFund.ID, nil, "Concurrent Owner")
 		require.NoError(t, err)
 		assert.Greater(t, found.Units, 0)
 	})
@@ -381,7 +395,7 @@ func TestStore(t *testing.T) {
 		tc.Reset(ctx)
 		testFund := createTestFund(t, "Test Fund", 1000)
 
-		entry, _ := ownership.NewCapTableEntry(testFund.ID, "Active Owner", 500)
+		entry, _ := ownership.NewCapTableEntry(testFund.ID, createOwner(t, "Active Owner"), "Active Owner", 500)
 		require.NoError(t, store.Create(ctx, entry))
 
 		_, err := tc.Pool().Exec(ctx, `UPDATE cap_table_entries SET deleted_at = NOW() WHERE owner_name = $1`, "Active Owner")
@@ -396,13 +410,13 @@ func TestStore(t *testing.T) {
 		tc.Reset(ctx)
 		testFund := createTestFund(t, "Test Fund", 1000)
 
-		entry, _ := ownership.NewCapTableEntry(testFund.ID, "Deleted Owner", 500)
+		entry, _ := ownership.NewCapTableEntry(testFund.ID, createOwner(t, "Deleted Owner"), "Deleted Owner", 500)
 		require.NoError(t, store.Create(ctx, entry))
 
 		_, err := tc.Pool().Exec(ctx, `UPDATE cap_table_entries SET deleted_at = NOW() WHERE owner_name = $1`, "Deleted Owner")
 		require.NoError(t, err)
 
-		_, err = store.FindByFundAndOwner(ctx, testFund.ID, "Deleted Owner")
+		_, err = store.FindByFundAndOwner(ctx, testFund.ID, nil, "Deleted Owner")
 		assert.True(t, errors.Is(err, ownership.ErrOwnerNotFound))
 	})
 
@@ -411,21 +425,179 @@ func TestStore(t *testing.T) {
 		fund1 := createTestFund(t, "Fund One", 1000)
 		fund2 := createTestFund(t, "Fund Two", 2000)
 
-		entry1, _ := ownership.NewCapTableEntry(fund1.ID, "Shared Owner", 100)
+		shared := createOwner(t, "Shared Owner")
+		entry1, _ := ownership.NewCapTableEntry(fund1.ID, shared, "Shared Owner", 100)
 		require.NoError(t, store.Create(ctx, entry1))
 
-		entry2, _ := ownership.NewCapTableEntry(fund2.ID, "Shared Owner", 200)
+		entry2, _ := ownership.NewCapTableEntry(fund2.ID, shared, "Shared Owner", 200)
 		require.NoError(t, store.Create(ctx, entry2))
 
-		found1, err := store.FindByFundAndOwner(ctx, fund1.ID, "Shared Owner")
+		found1, err := store.FindByFundAndOwner(ctx, fund1.ID, nil, "Shared Owner")
 		require.NoError(t, err)
 		assert.Equal(t, 100, found1.Units)
 
-		found2, err := store.FindByFundAndOwner(ctx, fund2.ID, "Shared Owner")
+		found2, err := store.FindByFundAndOwner(ctx, fund2.ID, nil, "Shared Owner")
 		require.NoError(t, err)
 		assert.Equal(t, 200, found2.Units)
 	})
 
+	t.Run("IncrementOrCreateTx keys on owner ID and copies the legal name", func(t *testing.T) {
+		tc.Reset(ctx)
+		testFund := createTestFund(t, "Test Fund", 1000)
+		ownerID := createOwner(t, "Acme LLC")
+
+		tx, err := tc.Pool().Begin(ctx)
+		require.NoError(t, err)
+		require.NoError(t, store.IncrementOrCreateTx(ctx, tx, testFund.ID, nil, ownerID, 100))
+		require.NoError(t, store.IncrementOrCreateTx(ctx, tx, testFund.ID, nil, ownerID, 50))
+
+		err = store.IncrementOrCreateTx(ctx, tx, testFund.ID, nil, uuid.New(), 10)
+		assert.ErrorIs(t, err, ownership.ErrOwnerNotFound)
+		require.NoError(t, tx.Rollback(ctx))
+
+		tx, err = tc.Pool().Begin(ctx)
+		require.NoError(t, err)
+		require.NoError(t, store.IncrementOrCreateTx(ctx, tx, testFund.ID, nil, ownerID, 150))
+		entry, err := store.FindByFundAndOwnerIDForUpdateTx(ctx, tx, testFund.ID, nil, ownerID)
+		require.NoError(t, err)
+		require.NoError(t, tx.Commit(ctx))
+
+		assert.Equal(t, "Acme LLC", entry.OwnerName)
+		assert.Equal(t, 150, entry.Units)
+	})
+
+	t.Run("FindByFundID pages by cursor on units then owner name", func(t *testing.T) {
+		tc.Reset(ctx)
+		testFund := createTestFund(t, "Cursor Fund", 1000)
+		for _, e := range []struct {
+			name  string
+			units int
+		}{{"Carol", 300}, {"Alice", 300}, {"Bob", 400}} {
+			entry, err := ownership.NewCapTableEntry(testFund.ID, createOwner(t, e.name), e.name, e.units)
+			require.NoError(t, err)
+			require.NoError(t, store.Create(ctx, entry))
+		}
+
+		var names []string
+		params := ownership.ListParams{Limit: 1}
+		for {
+			view, err := store.FindByFundID(ctx, testFund.ID, params)
+			require.NoError(t, err)
+			for _, e := range view.Entries {
+				names = append(names, e.OwnerName)
+			}
+			if view.NextCursor == "" {
+				break
+			}
+			params.Cursor = view.NextCursor
+		}
+		assert.Equal(t, []string{"Bob", "Alice", "Carol"}, names)
+	})
+
+	t.Run("StreamByFundID yields entries in cap table order", func(t *testing.T) {
+		tc.Reset(ctx)
+		testFund := createTestFund(t, "Stream Fund", 1000)
+		for _, e := range []struct {
+			name  string
+			units int
+		}{{"Carol", 300}, {"Alice", 300}, {"Bob", 400}} {
+			entry, err := ownership.NewCapTableEntry(testFund.ID, createOwner(t, e.name), e.name, e.units)
+			require.NoError(t, err)
+			require.NoError(t, store.Create(ctx, entry))
+		}
+
+		var names []string
+		err := store.StreamByFundID(ctx, testFund.ID, func(e *ownership.Entry) error {
+			names = append(names, e.OwnerName)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Bob", "Alice", "Carol"}, names)
+	})
+
+	t.Run("FindHoldingsByOwnerID lists funds with units for the owner", func(t *testing.T) {
+		tc.Reset(ctx)
+		fundA := createTestFund(t, "Fund A", 1000)
+		fundB := createTestFund(t, "Fund B", 4000)
+		fundC := createTestFund(t, "Fund C", 1000)
+		alice := createOwner(t, "Alice")
+
+		for _, e := range []struct {
+			fundID uuid.UUID
+			units  int
+		}{{fundA.ID, 500}, {fundB.ID, 1000}, {fundC.ID, 0}} {
+			entry, err := ownership.NewCapTableEntry(e.fundID, alice, "Alice", e.units)
+			require.NoError(t, err)
+			require.NoError(t, store.Create(ctx, entry))
+		}
+		other, err := ownership.NewCapTableEntry(fundA.ID, createOwner(t, "Bob"), "Bob", 500)
+		require.NoError(t, err)
+		require.NoError(t, store.Create(ctx, other))
+
+		holdings, err := store.FindHoldingsByOwnerID(ctx, alice)
+		require.NoError(t, err)
+		require.Len(t, holdings, 2)
+		assert.Equal(t, "Fund A", holdings[0].FundName)
+		assert.InDelta(t, 50.0, holdings[0].Percentage(), 1e-9)
+		assert.Equal(t, "Fund B", holdings[1].FundName)
+		assert.Equal(t, 4000, holdings[1].FundTotalUnits)
+		assert.InDelta(t, 25.0, holdings[1].Percentage(), 1e-9)
+
+		none, err := store.FindHoldingsByOwnerID(ctx, uuid.New())
+		require.NoError(t, err)
+		assert.Empty(t, none)
+	})
+
+	t.Run("Share classes keep an owner's holdings apart", func(t *testing.T) {
+		tc.Reset(ctx)
+		testFund := createTestFund(t, "Test Fund", 1000)
+		ownerID := createOwner(t, "Acme LLC")
+
+		classB, err := ownership.NewShareClass(testFund.ID, "Class B", 500)
+		require.NoError(t, err)
+		require.NoError(t, store.CreateShareClass(ctx, classB))
+
+		dup, err := ownership.NewShareClass(testFund.ID, "Class B", 10)
+		require.NoError(t, err)
+		assert.ErrorIs(t, store.CreateShareClass(ctx, dup), ownership.ErrDuplicateShareClass)
+
+		tx, err := tc.Pool().Begin(ctx)
+		require.NoError(t, err)
+		require.NoError(t, store.IncrementOrCreateTx(ctx, tx, testFund.ID, nil, ownerID, 1000))
+		require.NoError(t, store.IncrementOrCreateTx(ctx, tx, testFund.ID, &classB.ID, ownerID, 200))
+		defaultClass, err := store.FindShareClassTx(ctx, tx, testFund.ID, nil)
+		require.NoError(t, err)
+		found, err := store.FindShareClassTx(ctx, tx, testFund.ID, &classB.ID)
+		require.NoError(t, err)
+		missing := uuid.New()
+		_, err = store.FindShareClassTx(ctx, tx, testFund.ID, &missing)
+		assert.ErrorIs(t, err, ownership.ErrShareClassNotFound)
+		require.NoError(t, tx.Commit(ctx))
+
+		assert.True(t, defaultClass.Default)
+		assert.Equal(t, "Class A", defaultClass.Name)
+		assert.Equal(t, 1000, defaultClass.OutstandingUnits)
+		assert.Equal(t, 200, found.OutstandingUnits)
+		assert.Equal(t, 300, found.UnissuedUnits())
+
+		classes, err := store.ListShareClasses(ctx, testFund.ID)
+		require.NoError(t, err)
+		require.Len(t, classes, 2)
+		assert.Equal(t, defaultClass.ID, classes[0].ID)
+		assert.Equal(t, classB.ID, classes[1].ID)
+
+		entry, err := store.FindByFundAndOwner(ctx, testFund.ID, &classB.ID, "Acme LLC")
+		require.NoError(t, err)
+		assert.Equal(t, 200, entry.Units)
+		assert.Equal(t, "Class B", entry.ClassName)
+
+		view, err := store.FindByFundID(ctx, testFund.ID, ownership.ListParams{})
+		require.NoError(t, err)
+		require.Len(t, view.Entries, 2)
+		assert.Equal(t, defaultClass.ID, view.Entries[0].ClassID)
+		assert.Equal(t, classB.ID, view.Entries[1].ClassID)
+	})
+
 	t.Run("NewStore returns nil for nil db", func(t *testing.T) {
 		store := ownership.NewStore(nil)
 		assert.Nil(t, store)
@@ -4,9 +4,11 @@ import (
 	"embed"
 	"errors"
 	"fmt"
+	"io/fs"
 
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source"
 	"github.com/golang-migrate/migrate/v4/source/iofs"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/jackc/pgx/v5/stdlib"
@@ -15,76 +17,196 @@ import (
 //go:embed migrations/*
 var migrations embed.FS
 
-func Migrate(pool *pgxpool.Pool) error {
-	source, err := iofs.New(migrations, "migrations")
+var ErrSchemaDirty = errors.New("migrate: database schema is dirty")
+
+var ErrSchemaBehind = errors.New("migrate: database schema is behind the binary")
+
+type Migrator struct {
+	m *migrate.Migrate
+}
+
+type MigrationStatus struct {
+	Version uint
+	Dirty   bool
+	Latest  uint
+	Applied []uint
+	Pending []uint
+}
+
+func NewMigrator(pool *pgxpool.Pool) (*Migrator, error) {
+	src, err := newSource()
 	if err != nil {
-		return fmt.Errorf("migrate: failed to create source: %w", err)
+		return nil, err
 	}
 
 	db := stdlib.OpenDBFromPool(pool)
 
 	driver, err := postgres.WithInstance(db, &postgres.Config{})
 	if err != nil {
-		return fmt.Errorf("migrate: failed to create driver: %w", err)
+		return nil, fmt.Errorf("migrate: failed to create driver: %w", err)
 	}
 
-	m, err := migrate.NewWithInstance("iofs", source, "postgres", driver)
+	m, err := migrate.NewWithInstance("iofs", src, "postgres", driver)
 	if err != nil {
-		return fmt.Errorf("migrate: failed to create migrator: %w", err)
+		return nil, fmt.Errorf("migrate: failed to create migrator: %w", err)
 	}
-	defer m.Close()
 
-	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+	return &Migrator{m: m}, nil
+}
+
+func (mg *Migrator) Close() error {
+	srcErr, dbErr := mg.m.Close()
+	return errors.Join(srcErr, dbErr)
+}
+
+func (mg *Migrator) Up() error {
+	if err := mg.m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
 		return fmt.Errorf("migrate: failed to run migrations: %w", err)
 	}
+	return nil
+}
 
+func (mg *Migrator) Down() error {
+	if err := mg.m.Down(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return fmt.Errorf("migrate: failed to rollback migrations: %w", err)
+	}
 	return nil
 }
 
-func MigrateDown(pool *pgxpool.Pool) error {
-	source, err := iofs.New(migrations, "migrations")
-	if err != nil {
-		return fmt.Errorf("migrate: failed to create source: %w", err)
+func (mg *Migrator) Steps(n int) error {
+	if n == 0 {
+		return nil
+	}
+	if err := mg.m.Steps(n); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return fmt.Errorf("migrate: failed to apply %d steps: %w", n, err)
 	}
+	return nil
+}
 
-	db := stdlib.OpenDBFromPool(pool)
+func (mg *Migrator) Goto(version uint) error {
+	if err := mg.m.Migrate(version); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return fmt.Errorf("migrate: failed to migrate to version %d: %w", version, err)
+	}
+	return nil
+}
 
-	driver, err := postgres.WithInstance(db, &postgres.Config{})
+func (mg *Migrator) Force(version int) error {
+	if err := mg.m.Force(version); err != nil {
+		return fmt.Errorf("migrate: failed to force version %d: %w", version, err)
+	}
+	return nil
+}
+
+func (mg *Migrator) Version() (uint, bool, error) {
+	version, dirty, err := mg.m.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		return 0, false, nil
+	}
 	if err != nil {
-		return fmt.Errorf("migrate: failed to create driver: %w", err)
+		return 0, false, fmt.Errorf("migrate: failed to read version: %w", err)
 	}
+	return version, dirty, nil
+}
 
-	m, err := migrate.NewWithInstance("iofs", source, "postgres", driver)
+func (mg *Migrator) Status() (*MigrationStatus, error) {
+	version, dirty, err := mg.Version()
 	if err != nil {
-		return fmt.Errorf("migrate: failed to create migrator: %w", err)
+		return nil, err
 	}
-	defer m.Close()
 
-	if err := m.Down(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
-		return fmt.Errorf("migrate: failed to rollback migrations: %w", err)
+	available, err := AvailableVersions()
+	if err != nil {
+		return nil, err
+	}
+
+	status := &MigrationStatus{
+		Version: version,
+		Dirty:   dirty,
+		Applied: []uint{},
+		Pending: []uint{},
+	}
+	for _, v := range available {
+		if v <= version {
+			status.Applied = append(status.Applied, v)
+		} else {
+			status.Pending = append(status.Pending, v)
+		}
 	}
+	if len(available) > 0 {
+		status.Latest = available[len(available)-1]
+	}
+	return status, nil
+}
 
+func (mg *Migrator) CheckCurrent() error {
+	status, err := mg.Status()
+	if err != nil {
+		return err
+	}
+	if status.Dirty {
+		return fmt.Errorf("%w at version %d", ErrSchemaDirty, status.Version)
+	}
+	if len(status.Pending) > 0 {
+		return fmt.Errorf("%w: at version %d, binary requires %d", ErrSchemaBehind, status.Version, status.Latest)
+	}
 	return nil
 }
 
-func MigrateVersion(pool *pgxpool.Pool) (uint, bool, error) {
-	source, err := iofs.New(migrations, "migrations")
+func AvailableVersions() ([]uint, error) {
+	src, err := newSource()
 	if err != nil {
-		return 0, false, fmt.Errorf("migrate: failed to create source: %w", err)
+		return nil, err
 	}
+	defer src.Close()
 
-	db := stdlib.OpenDBFromPool(pool)
+	var versions []uint
+	v, err := src.First()
+	for err == nil {
+		versions = append(versions, v)
+		v, err = src.Next(v)
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("migrate: failed to read embedded migrations: %w", err)
+	}
+	return versions, nil
+}
 
-	driver, err := postgres.WithInstance(db, &postgres.Config{})
+func newSource() (source.Driver, error) {
+	src, err := iofs.New(migrations, "migrations")
 	if err != nil {
-		return 0, false, fmt.Errorf("migrate: failed to create driver: %w", err)
+		return nil, fmt.Errorf("migrate: failed to create source: %w", err)
 	}
+	return src, nil
+}
+
+func Migrate(pool *pgxpool.Pool) error {
+	return withMigrator(pool, (*Migrator).Up)
+}
+
+func MigrateDown(pool *pgxpool.Pool) error {
+	return withMigrator(pool, (*Migrator).Down)
+}
+
+func MigrateVersion(pool *pgxpool.Pool) (uint, bool, error) {
+	mg, err := NewMigrator(pool)
+	if err != nil {
+		return 0, false, err
+	}
+	defer mg.Close()
+
+	return mg.m.Version()
+}
+
+func CheckSchema(pool *pgxpool.Pool) error {
+	return withMigrator(pool, (*Migrator).CheckCurrent)
+}
 
-	m, err := migrate.NewWithInstance("iofs", source, "postgres", driver)
+func withMigrator(pool *pgxpool.Pool, fn func(*Migrator) error) error {
+	mg, err := NewMigrator(pool)
 	if err != nil {
-		return 0, false, fmt.Errorf("migrate: failed to create migrator: %w", err)
+		return err
 	}
-	defer m.Close()
+	defer mg.Close()
 
-	return m.Version()
+	return fn(mg)
 }
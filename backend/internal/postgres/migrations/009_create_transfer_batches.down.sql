@@ -0,0 +1,9 @@
+-- 009_create_transfer_batches.down.sql
+-- Removes transfer batches and the batch columns on transfers
+
+DROP INDEX IF EXISTS idx_transfers_batch_leg;
+ALTER TABLE transfers DROP CONSTRAINT IF EXISTS chk_transfers_batch_leg;
+ALTER TABLE transfers DROP COLUMN IF EXISTS leg_index;
+ALTER TABLE transfers DROP COLUMN IF EXISTS batch_id;
+
+DROP TABLE IF EXISTS transfer_batches;
@@ -0,0 +1,24 @@
+-- 009_create_transfer_batches.sql
+-- Groups multi-leg transfers executed atomically under a single idempotency key
+
+CREATE TABLE transfer_batches (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    fund_id UUID NOT NULL REFERENCES funds(id) ON DELETE CASCADE,
+    idempotency_key UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_transfer_batches_idempotency ON transfer_batches(idempotency_key) WHERE idempotency_key IS NOT NULL;
+
+COMMENT ON TABLE transfer_batches IS 'Atomic groups of transfer legs';
+COMMENT ON COLUMN transfer_batches.idempotency_key IS 'Client-generated UUID covering the whole batch';
+
+-- Each leg is an ordinary transfer row tagged with its batch and position
+ALTER TABLE transfers ADD COLUMN batch_id UUID REFERENCES transfer_batches(id) ON DELETE CASCADE;
+ALTER TABLE transfers ADD COLUMN leg_index INTEGER;
+ALTER TABLE transfers ADD CONSTRAINT chk_transfers_batch_leg CHECK ((batch_id IS NULL) = (leg_index IS NULL));
+
+CREATE UNIQUE INDEX idx_transfers_batch_leg ON transfers(batch_id, leg_index) WHERE batch_id IS NOT NULL;
+
+COMMENT ON COLUMN transfers.batch_id IS 'Batch this transfer was executed in, NULL for single transfers';
+COMMENT ON COLUMN transfers.leg_index IS 'Zero-based position of the leg within its batch';
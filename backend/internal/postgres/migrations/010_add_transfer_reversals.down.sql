@@ -0,0 +1,5 @@
+-- 010_add_transfer_reversals.down.sql
+-- Removes the reversal link from transfers
+
+DROP INDEX IF EXISTS idx_transfers_reverses;
+ALTER TABLE transfers DROP COLUMN IF EXISTS reverses_transfer_id;
@@ -0,0 +1,9 @@
+-- 010_add_transfer_reversals.sql
+-- Links compensating transfers to the transfer they reverse
+
+ALTER TABLE transfers ADD COLUMN reverses_transfer_id UUID REFERENCES transfers(id) ON DELETE CASCADE;
+
+-- A transfer can be reversed at most once
+CREATE UNIQUE INDEX idx_transfers_reverses ON transfers(reverses_transfer_id) WHERE reverses_transfer_id IS NOT NULL;
+
+COMMENT ON COLUMN transfers.reverses_transfer_id IS 'Original transfer this compensating transfer reverses, NULL for ordinary transfers';
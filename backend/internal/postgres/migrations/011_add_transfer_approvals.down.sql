@@ -0,0 +1,19 @@
+-- 011_add_transfer_approvals.down.sql
+-- Removes transfer approvals; transfers that never settled are dropped
+
+DELETE FROM transfers WHERE status <> 'approved';
+
+ALTER TABLE transfers ADD CONSTRAINT fk_transfer_to_owner
+    FOREIGN KEY (fund_id, to_owner) REFERENCES cap_table_entries(fund_id, owner_name);
+
+DROP INDEX IF EXISTS idx_transfers_pending_seller;
+ALTER TABLE transfers DROP COLUMN IF EXISTS rejection_reason;
+ALTER TABLE transfers DROP COLUMN IF EXISTS reviewed_at;
+ALTER TABLE transfers DROP COLUMN IF EXISTS reviewed_by;
+ALTER TABLE transfers DROP COLUMN IF EXISTS requested_at;
+ALTER TABLE transfers DROP COLUMN IF EXISTS requested_by;
+ALTER TABLE transfers DROP COLUMN IF EXISTS status;
+
+COMMENT ON COLUMN transfers.transferred_at IS 'Timestamp when transfer was executed';
+
+ALTER TABLE funds DROP COLUMN IF EXISTS approval_threshold;
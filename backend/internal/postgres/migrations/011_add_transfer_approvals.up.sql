@@ -0,0 +1,32 @@
+-- 011_add_transfer_approvals.sql
+-- Adds per-fund approval thresholds and a pending/approved/rejected lifecycle on transfers
+
+ALTER TABLE funds ADD COLUMN approval_threshold INTEGER CHECK (approval_threshold IS NULL OR approval_threshold > 0);
+
+COMMENT ON COLUMN funds.approval_threshold IS 'Transfers of more units than this require approval, NULL disables approvals';
+
+-- Existing transfers were settled immediately, so they start out approved
+ALTER TABLE transfers ADD COLUMN status TEXT NOT NULL DEFAULT 'approved'
+    CHECK (status IN ('pending', 'approved', 'rejected'));
+ALTER TABLE transfers ADD COLUMN requested_by TEXT;
+ALTER TABLE transfers ADD COLUMN requested_at TIMESTAMP WITH TIME ZONE;
+UPDATE transfers SET requested_at = transferred_at;
+ALTER TABLE transfers ALTER COLUMN requested_at SET NOT NULL;
+ALTER TABLE transfers ALTER COLUMN requested_at SET DEFAULT NOW();
+ALTER TABLE transfers ADD COLUMN reviewed_by TEXT;
+ALTER TABLE transfers ADD COLUMN reviewed_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE transfers ADD COLUMN rejection_reason TEXT;
+
+-- A pending transfer may name a recipient that holds nothing yet; its cap table entry is created on approval
+ALTER TABLE transfers DROP CONSTRAINT fk_transfer_to_owner;
+
+-- Pending transfers reserve the seller's units, so availability checks sum them per seller
+CREATE INDEX idx_transfers_pending_seller ON transfers(fund_id, from_owner) WHERE status = 'pending';
+
+COMMENT ON COLUMN transfers.status IS 'pending until reviewed, approved once units have moved, or rejected';
+COMMENT ON COLUMN transfers.requested_by IS 'Who submitted the transfer: the principal subject, or the name given in the request when authentication is disabled';
+COMMENT ON COLUMN transfers.requested_at IS 'Timestamp when the transfer was submitted';
+COMMENT ON COLUMN transfers.transferred_at IS 'Timestamp when units moved, or the submission time for transfers that have not settled';
+COMMENT ON COLUMN transfers.reviewed_by IS 'Person who approved or rejected a pending transfer';
+COMMENT ON COLUMN transfers.reviewed_at IS 'Timestamp of the approval or rejection';
+COMMENT ON COLUMN transfers.rejection_reason IS 'Optional reason recorded with a rejection';
@@ -0,0 +1,6 @@
+-- 012_create_outbox_and_webhooks.down.sql
+-- Removes the outbox and webhook tables
+
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhook_endpoints;
+DROP TABLE IF EXISTS outbox;
@@ -0,0 +1,54 @@
+-- 012_create_outbox_and_webhooks.sql
+-- Transactional outbox of domain events and webhook endpoints that receive them
+
+CREATE TABLE outbox (
+    sequence BIGSERIAL PRIMARY KEY,
+    id UUID NOT NULL UNIQUE,
+    event_type TEXT NOT NULL,
+    fund_id UUID NOT NULL REFERENCES funds(id) ON DELETE CASCADE,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    dispatched_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_outbox_undispatched ON outbox(sequence) WHERE dispatched_at IS NULL;
+
+COMMENT ON TABLE outbox IS 'Domain events written in the same transaction as the change they describe';
+COMMENT ON COLUMN outbox.sequence IS 'Monotonically increasing event number';
+COMMENT ON COLUMN outbox.id IS 'Event identifier sent to webhook receivers for deduplication';
+COMMENT ON COLUMN outbox.event_type IS 'Event name, e.g. fund.created or transfer.executed';
+COMMENT ON COLUMN outbox.payload IS 'Event body as JSON';
+COMMENT ON COLUMN outbox.dispatched_at IS 'When deliveries were created for every subscribed endpoint, NULL until then';
+
+CREATE TABLE webhook_endpoints (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    url TEXT NOT NULL,
+    secret TEXT NOT NULL,
+    event_types TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE webhook_endpoints IS 'Registered receivers of outbox events';
+COMMENT ON COLUMN webhook_endpoints.secret IS 'HMAC-SHA256 key used to sign deliveries';
+COMMENT ON COLUMN webhook_endpoints.event_types IS 'Subscribed event types, empty for all events';
+
+CREATE TABLE webhook_deliveries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    endpoint_id UUID NOT NULL REFERENCES webhook_endpoints(id) ON DELETE CASCADE,
+    event_sequence BIGINT NOT NULL REFERENCES outbox(sequence) ON DELETE CASCADE,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'delivered', 'dead')),
+    attempts INTEGER NOT NULL DEFAULT 0 CHECK (attempts >= 0),
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_status_code INTEGER,
+    last_error TEXT,
+    delivered_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_webhook_delivery UNIQUE (endpoint_id, event_sequence)
+);
+
+CREATE INDEX idx_webhook_deliveries_due ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX idx_webhook_deliveries_endpoint ON webhook_deliveries(endpoint_id, created_at);
+
+COMMENT ON TABLE webhook_deliveries IS 'One row per event per subscribed endpoint';
+COMMENT ON COLUMN webhook_deliveries.status IS 'pending until delivered, or dead once retries are exhausted';
+COMMENT ON COLUMN webhook_deliveries.next_attempt_at IS 'Earliest time of the next attempt; pushed forward while an attempt is in flight';
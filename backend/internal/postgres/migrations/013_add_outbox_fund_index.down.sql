@@ -0,0 +1,4 @@
+-- 013_add_outbox_fund_index.down.sql
+-- Removes the per-fund outbox index
+
+DROP INDEX IF EXISTS idx_outbox_fund_sequence;
@@ -0,0 +1,4 @@
+-- 013_add_outbox_fund_index.sql
+-- Supports reading a fund's events after a given sequence for the event stream
+
+CREATE INDEX idx_outbox_fund_sequence ON outbox(fund_id, sequence);
@@ -0,0 +1,4 @@
+-- 014_create_api_keys.down.sql
+-- Removes the API keys table
+
+DROP TABLE IF EXISTS api_keys;
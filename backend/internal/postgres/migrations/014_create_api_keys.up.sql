@@ -0,0 +1,18 @@
+-- 014_create_api_keys.sql
+-- API keys used to authenticate callers, stored as SHA-256 hashes
+
+CREATE TABLE api_keys (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    prefix TEXT NOT NULL,
+    key_hash BYTEA NOT NULL UNIQUE,
+    role TEXT NOT NULL CHECK (role IN ('viewer', 'operator', 'admin')),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    revoked_at TIMESTAMP WITH TIME ZONE
+);
+
+COMMENT ON TABLE api_keys IS 'API keys accepted in the X-API-Key header';
+COMMENT ON COLUMN api_keys.prefix IS 'First characters of the key, shown so operators can tell keys apart';
+COMMENT ON COLUMN api_keys.key_hash IS 'SHA-256 of the full key; the key itself is never stored';
+COMMENT ON COLUMN api_keys.role IS 'viewer reads cap tables, operator also creates transfers, admin can do everything';
+COMMENT ON COLUMN api_keys.revoked_at IS 'When the key stopped being accepted, NULL while active';
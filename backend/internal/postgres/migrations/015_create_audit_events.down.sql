@@ -0,0 +1,6 @@
+-- 015_create_audit_events.down.sql
+-- Removes the audit log
+
+DROP TRIGGER IF EXISTS audit_events_immutable ON audit_events;
+DROP FUNCTION IF EXISTS audit_events_immutable();
+DROP TABLE IF EXISTS audit_events;
@@ -0,0 +1,38 @@
+-- 015_create_audit_events.sql
+-- Append-only record of who changed what, written in the same transaction as the change
+
+CREATE TABLE audit_events (
+    id UUID PRIMARY KEY,
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    actor TEXT NOT NULL,
+    actor_name TEXT,
+    request_id TEXT,
+    client_ip TEXT,
+    operation TEXT NOT NULL,
+    fund_id UUID,
+    transfer_id UUID,
+    before_balances JSONB,
+    after_balances JSONB,
+    details JSONB
+);
+
+CREATE INDEX idx_audit_events_occurred_at ON audit_events(occurred_at, id);
+CREATE INDEX idx_audit_events_fund ON audit_events(fund_id, occurred_at) WHERE fund_id IS NOT NULL;
+CREATE INDEX idx_audit_events_actor ON audit_events(actor, occurred_at);
+
+CREATE FUNCTION audit_events_immutable() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_events_immutable
+    BEFORE UPDATE OR DELETE ON audit_events
+    FOR EACH ROW EXECUTE FUNCTION audit_events_immutable();
+
+COMMENT ON TABLE audit_events IS 'Who performed each mutating operation, from where, and what it changed';
+COMMENT ON COLUMN audit_events.actor IS 'Principal subject such as api_key:<id>, jwt:<sub> or cli:<user>; anonymous when auth is disabled';
+COMMENT ON COLUMN audit_events.request_id IS 'X-Request-Id of the HTTP request, NULL for CLI operations';
+COMMENT ON COLUMN audit_events.fund_id IS 'Fund the operation touched; no foreign key so events outlive a reset';
+COMMENT ON COLUMN audit_events.before_balances IS 'Owner name to units before the operation';
+COMMENT ON COLUMN audit_events.after_balances IS 'Owner name to units after the operation';
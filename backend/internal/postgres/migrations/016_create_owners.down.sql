@@ -0,0 +1,24 @@
+-- 016_create_owners.down.sql
+-- Removes the owners table and restores name-keyed foreign keys
+
+DROP INDEX IF EXISTS idx_transfers_to_owner_id;
+DROP INDEX IF EXISTS idx_transfers_from_owner_id;
+DROP INDEX IF EXISTS idx_transfers_pending_seller;
+CREATE INDEX idx_transfers_pending_seller ON transfers(fund_id, from_owner) WHERE status = 'pending';
+
+-- Renamed owners leave historical transfer names unmatched, so existing rows are not validated
+ALTER TABLE transfers
+    DROP CONSTRAINT IF EXISTS chk_different_owner_ids,
+    DROP CONSTRAINT IF EXISTS fk_transfer_from_owner,
+    DROP CONSTRAINT IF EXISTS fk_transfer_to_owner,
+    DROP COLUMN IF EXISTS from_owner_id,
+    DROP COLUMN IF EXISTS to_owner_id,
+    ADD CONSTRAINT fk_transfer_from_owner
+        FOREIGN KEY (fund_id, from_owner) REFERENCES cap_table_entries(fund_id, owner_name) NOT VALID;
+
+ALTER TABLE cap_table_entries
+    DROP CONSTRAINT IF EXISTS uq_cap_table_fund_owner_id,
+    DROP CONSTRAINT IF EXISTS fk_cap_table_owner,
+    DROP COLUMN IF EXISTS owner_id;
+
+DROP TABLE IF EXISTS owners;
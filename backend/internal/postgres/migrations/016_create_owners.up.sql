@@ -0,0 +1,77 @@
+-- 016_create_owners.sql
+-- Gives unit holders a stable identity and re-keys cap table entries and transfers on owner_id
+
+CREATE TABLE owners (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    legal_name TEXT NOT NULL,
+    type TEXT NOT NULL DEFAULT 'individual',
+    external_ref TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_owners_legal_name_length CHECK (LENGTH(legal_name) <= 255 AND LENGTH(legal_name) >= 1),
+    CONSTRAINT chk_owners_type CHECK (type IN ('individual', 'entity')),
+    CONSTRAINT chk_owners_external_ref_length CHECK (LENGTH(external_ref) <= 255 AND LENGTH(external_ref) >= 1)
+);
+
+CREATE UNIQUE INDEX idx_owners_external_ref ON owners(external_ref) WHERE external_ref IS NOT NULL;
+CREATE INDEX idx_owners_legal_name ON owners(legal_name);
+
+CREATE TRIGGER update_owners_timestamp
+    BEFORE UPDATE ON owners
+    FOR EACH ROW
+    EXECUTE FUNCTION update_timestamp();
+
+COMMENT ON TABLE owners IS 'Unit holders, shared across funds';
+COMMENT ON COLUMN owners.legal_name IS 'Legal name of the holder; copied to cap_table_entries.owner_name';
+COMMENT ON COLUMN owners.type IS 'individual or entity';
+COMMENT ON COLUMN owners.external_ref IS 'Identifier in an external system such as a CRM or KYC provider';
+
+-- Names were the identity until now, so each distinct name becomes one owner; recipients of
+-- pending transfers may not hold units yet
+INSERT INTO owners (legal_name)
+SELECT owner_name FROM cap_table_entries
+UNION
+SELECT to_owner FROM transfers;
+
+ALTER TABLE cap_table_entries ADD COLUMN owner_id UUID;
+
+UPDATE cap_table_entries e
+SET owner_id = o.id
+FROM owners o
+WHERE o.legal_name = e.owner_name;
+
+ALTER TABLE cap_table_entries
+    ALTER COLUMN owner_id SET NOT NULL,
+    ADD CONSTRAINT fk_cap_table_owner FOREIGN KEY (owner_id) REFERENCES owners(id),
+    ADD CONSTRAINT uq_cap_table_fund_owner_id UNIQUE (fund_id, owner_id);
+
+-- Transfer names stay as recorded; the ledger hash covers them alongside the owner IDs
+ALTER TABLE transfers
+    ADD COLUMN from_owner_id UUID,
+    ADD COLUMN to_owner_id UUID;
+
+UPDATE transfers t
+SET from_owner_id = f.id, to_owner_id = r.id
+FROM owners f, owners r
+WHERE f.legal_name = t.from_owner AND r.legal_name = t.to_owner;
+
+ALTER TABLE transfers
+    DROP CONSTRAINT fk_transfer_from_owner,
+    ALTER COLUMN from_owner_id SET NOT NULL,
+    ALTER COLUMN to_owner_id SET NOT NULL,
+    ADD CONSTRAINT fk_transfer_from_owner
+        FOREIGN KEY (fund_id, from_owner_id) REFERENCES cap_table_entries(fund_id, owner_id),
+    ADD CONSTRAINT fk_transfer_to_owner
+        FOREIGN KEY (to_owner_id) REFERENCES owners(id),
+    ADD CONSTRAINT chk_different_owner_ids CHECK (from_owner_id <> to_owner_id);
+
+DROP INDEX IF EXISTS idx_transfers_pending_seller;
+CREATE INDEX idx_transfers_pending_seller ON transfers(fund_id, from_owner_id) WHERE status = 'pending';
+CREATE INDEX idx_transfers_from_owner_id ON transfers(fund_id, from_owner_id, transferred_at DESC);
+CREATE INDEX idx_transfers_to_owner_id ON transfers(fund_id, to_owner_id, transferred_at DESC);
+
+COMMENT ON COLUMN cap_table_entries.owner_name IS 'Current legal name of the owner, kept in sync with owners.legal_name';
+COMMENT ON COLUMN transfers.from_owner IS 'Name of the sender when the transfer was recorded';
+COMMENT ON COLUMN transfers.to_owner IS 'Name of the recipient when the transfer was recorded';
+COMMENT ON COLUMN transfers.from_owner_id IS 'Owner transferring units';
+COMMENT ON COLUMN transfers.to_owner_id IS 'Owner receiving units';
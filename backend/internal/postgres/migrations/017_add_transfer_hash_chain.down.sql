@@ -0,0 +1,13 @@
+-- 017_add_transfer_hash_chain.down.sql
+-- Removes the transfer hash chain
+
+DROP INDEX IF EXISTS idx_transfers_ledger;
+
+ALTER TABLE funds
+    DROP COLUMN IF EXISTS ledger_head,
+    DROP COLUMN IF EXISTS ledger_sequence;
+
+ALTER TABLE transfers
+    DROP COLUMN IF EXISTS hash,
+    DROP COLUMN IF EXISTS prev_hash,
+    DROP COLUMN IF EXISTS ledger_sequence;
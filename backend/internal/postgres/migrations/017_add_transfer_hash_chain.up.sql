@@ -0,0 +1,91 @@
+-- 017_add_transfer_hash_chain.sql
+-- Chains each fund's transfers with SHA-256 hashes so edits made outside the service are detectable
+
+ALTER TABLE transfers
+    ADD COLUMN ledger_sequence BIGINT,
+    ADD COLUMN prev_hash BYTEA,
+    ADD COLUMN hash BYTEA;
+
+ALTER TABLE funds
+    ADD COLUMN ledger_sequence BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN ledger_head BYTEA;
+
+CREATE UNIQUE INDEX idx_transfers_ledger ON transfers(fund_id, ledger_sequence) WHERE ledger_sequence IS NOT NULL;
+
+CREATE OR REPLACE FUNCTION pg_temp.ledger_field(v TEXT) RETURNS TEXT AS $$
+    SELECT octet_length(COALESCE(v, '')) || ':' || COALESCE(v, '') || E'\n'
+$$ LANGUAGE sql IMMUTABLE;
+
+CREATE OR REPLACE FUNCTION pg_temp.ledger_micros(ts TIMESTAMP WITH TIME ZONE) RETURNS TEXT AS $$
+    SELECT (EXTRACT(EPOCH FROM ts) * 1000000)::bigint::text
+$$ LANGUAGE sql IMMUTABLE;
+
+-- Transfers join the chain once their review decision is final, so pending transfers stay unchained.
+-- Share classes and requesters are recorded later; every existing transfer is in the default class
+-- and has no recorded requester, which the hash encodes as empty fields.
+DO $$
+DECLARE
+    t RECORD;
+    current_fund UUID;
+    seq BIGINT;
+    prev BYTEA;
+BEGIN
+    FOR t IN SELECT * FROM transfers WHERE status <> 'pending' ORDER BY fund_id, requested_at, id LOOP
+        IF current_fund IS DISTINCT FROM t.fund_id THEN
+            current_fund := t.fund_id;
+            seq := 0;
+            prev := NULL;
+        END IF;
+        seq := seq + 1;
+
+        UPDATE transfers
+        SET ledger_sequence = seq,
+            prev_hash = prev,
+            hash = sha256(convert_to(
+                pg_temp.ledger_field(seq::text) ||
+                pg_temp.ledger_field(encode(prev, 'hex')) ||
+                pg_temp.ledger_field(t.id::text) ||
+                pg_temp.ledger_field(t.fund_id::text) ||
+                pg_temp.ledger_field(t.from_owner) ||
+                pg_temp.ledger_field(t.to_owner) ||
+                pg_temp.ledger_field(t.from_owner_id::text) ||
+                pg_temp.ledger_field(t.to_owner_id::text) ||
+                pg_temp.ledger_field(t.units::text) ||
+                pg_temp.ledger_field(t.idempotency_key::text) ||
+                pg_temp.ledger_field(t.batch_id::text) ||
+                pg_temp.ledger_field(t.leg_index::text) ||
+                pg_temp.ledger_field(t.reverses_transfer_id::text) ||
+                pg_temp.ledger_field(pg_temp.ledger_micros(t.requested_at)) ||
+                pg_temp.ledger_field(NULL) ||
+                pg_temp.ledger_field(t.requested_by) ||
+                pg_temp.ledger_field(t.status) ||
+                pg_temp.ledger_field(t.reviewed_by) ||
+                pg_temp.ledger_field(pg_temp.ledger_micros(t.reviewed_at)) ||
+                pg_temp.ledger_field(pg_temp.ledger_micros(t.transferred_at)) ||
+                pg_temp.ledger_field(t.rejection_reason),
+                'UTF8'))
+        WHERE id = t.id
+        RETURNING hash INTO prev;
+    END LOOP;
+END;
+$$;
+
+DROP FUNCTION pg_temp.ledger_micros(TIMESTAMP WITH TIME ZONE);
+DROP FUNCTION pg_temp.ledger_field(TEXT);
+
+UPDATE funds f
+SET ledger_sequence = head.ledger_sequence,
+    ledger_head = head.hash
+FROM (
+    SELECT DISTINCT ON (fund_id) fund_id, ledger_sequence, hash
+    FROM transfers
+    WHERE ledger_sequence IS NOT NULL
+    ORDER BY fund_id, ledger_sequence DESC
+) head
+WHERE f.id = head.fund_id;
+
+COMMENT ON COLUMN transfers.ledger_sequence IS 'Position of the transfer in its fund''s hash chain, starting at 1; NULL while pending review';
+COMMENT ON COLUMN transfers.prev_hash IS 'Hash of the previous transfer in the fund, NULL for the first';
+COMMENT ON COLUMN transfers.hash IS 'SHA-256 over the transfer''s fields including owner IDs, its review decision and prev_hash';
+COMMENT ON COLUMN funds.ledger_sequence IS 'Number of approved and rejected transfers in the fund''s hash chain';
+COMMENT ON COLUMN funds.ledger_head IS 'Hash of the fund''s latest transfer, suitable for external anchoring';
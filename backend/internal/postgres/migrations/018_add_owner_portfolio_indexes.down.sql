@@ -0,0 +1,6 @@
+-- 018_add_owner_portfolio_indexes.down.sql
+-- Removes the cross-fund owner indexes
+
+DROP INDEX IF EXISTS idx_transfers_to_owner_id_all_funds;
+DROP INDEX IF EXISTS idx_transfers_from_owner_id_all_funds;
+DROP INDEX IF EXISTS idx_cap_table_owner_id;
@@ -0,0 +1,6 @@
+-- 018_add_owner_portfolio_indexes.sql
+-- Supports looking up an owner's holdings and transfers across every fund
+
+CREATE INDEX idx_cap_table_owner_id ON cap_table_entries(owner_id) WHERE deleted_at IS NULL;
+CREATE INDEX idx_transfers_from_owner_id_all_funds ON transfers(from_owner_id, transferred_at);
+CREATE INDEX idx_transfers_to_owner_id_all_funds ON transfers(to_owner_id, transferred_at);
@@ -0,0 +1,6 @@
+-- 019_drop_transfer_owner_name_indexes.down.sql
+-- Restores the name-keyed transfer indexes
+
+DROP INDEX IF EXISTS idx_transfers_fund_units;
+CREATE INDEX idx_transfers_from_owner ON transfers(fund_id, from_owner, transferred_at DESC);
+CREATE INDEX idx_transfers_to_owner ON transfers(fund_id, to_owner, transferred_at DESC);
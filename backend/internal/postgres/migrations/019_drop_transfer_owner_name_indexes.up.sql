@@ -0,0 +1,6 @@
+-- 019_drop_transfer_owner_name_indexes.sql
+-- Transfer filters match owners by ID, so the name-keyed indexes from 004 are no longer read
+
+DROP INDEX IF EXISTS idx_transfers_from_owner;
+DROP INDEX IF EXISTS idx_transfers_to_owner;
+CREATE INDEX idx_transfers_fund_units ON transfers(fund_id, units);
@@ -0,0 +1,4 @@
+-- 020_add_transfer_keyset_index.down.sql
+-- Removes the transfer keyset index
+
+DROP INDEX IF EXISTS idx_transfers_fund_keyset;
@@ -0,0 +1,4 @@
+-- 020_add_transfer_keyset_index.sql
+-- Matches the transfer list ordering so cursor pages seek straight to their first row
+
+CREATE INDEX idx_transfers_fund_keyset ON transfers(fund_id, transferred_at, (COALESCE(leg_index, -1)), id);
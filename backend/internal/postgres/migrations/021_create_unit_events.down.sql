@@ -0,0 +1,6 @@
+-- 021_create_unit_events.down.sql
+-- Removes the unit event ledger
+
+COMMENT ON COLUMN funds.total_units IS 'Total units issued, immutable after creation';
+
+DROP TABLE IF EXISTS unit_events;
@@ -0,0 +1,42 @@
+-- 021_create_unit_events.sql
+-- Records issuances and redemptions that change a fund's total units, starting with each fund's opening allocation
+
+CREATE TABLE unit_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    fund_id UUID NOT NULL REFERENCES funds(id) ON DELETE CASCADE,
+    owner_id UUID NOT NULL,
+    kind VARCHAR(16) NOT NULL CHECK (kind IN ('issuance', 'redemption')),
+    units INTEGER NOT NULL CHECK (units > 0),
+    total_units INTEGER NOT NULL CHECK (total_units > 0),
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_unit_event_owner
+        FOREIGN KEY (fund_id, owner_id) REFERENCES cap_table_entries(fund_id, owner_id)
+);
+
+CREATE INDEX idx_unit_events_fund ON unit_events(fund_id, occurred_at, id);
+
+-- Each existing holder opened with their current units less the net units approved transfers moved to them,
+-- which covers funds created with a single initial owner as well as imported cap tables
+INSERT INTO unit_events (fund_id, owner_id, kind, units, total_units, occurred_at)
+SELECT o.fund_id, o.owner_id, 'issuance', o.opening,
+    SUM(o.opening) OVER (PARTITION BY o.fund_id ORDER BY o.acquired_at, o.id),
+    o.created_at
+FROM (
+    SELECT e.fund_id, e.owner_id, e.acquired_at, e.id, f.created_at,
+        e.units
+            - COALESCE((SELECT SUM(t.units) FROM transfers t
+                WHERE t.fund_id = e.fund_id AND t.to_owner_id = e.owner_id AND t.status = 'approved'), 0)
+            + COALESCE((SELECT SUM(t.units) FROM transfers t
+                WHERE t.fund_id = e.fund_id AND t.from_owner_id = e.owner_id AND t.status = 'approved'), 0) AS opening
+    FROM cap_table_entries e
+    JOIN funds f ON f.id = e.fund_id
+) o
+WHERE o.opening > 0;
+
+COMMENT ON TABLE unit_events IS 'Issuances and redemptions that change a fund''s total units';
+COMMENT ON COLUMN unit_events.owner_id IS 'Owner whose cap table entry received or gave up the units';
+COMMENT ON COLUMN unit_events.kind IS 'issuance adds units, redemption removes them';
+COMMENT ON COLUMN unit_events.units IS 'Number of units issued or redeemed';
+COMMENT ON COLUMN unit_events.total_units IS 'Fund total units after the event';
+COMMENT ON COLUMN unit_events.occurred_at IS 'Timestamp when the event was applied';
+COMMENT ON COLUMN funds.total_units IS 'Units outstanding; changed only by the issuances and redemptions in unit_events';
@@ -0,0 +1,6 @@
+-- 022_add_fund_status.down.sql
+-- Removes fund lifecycle status
+
+DROP INDEX IF EXISTS idx_funds_status_created;
+ALTER TABLE funds DROP COLUMN IF EXISTS status_changed_at;
+ALTER TABLE funds DROP COLUMN IF EXISTS status;
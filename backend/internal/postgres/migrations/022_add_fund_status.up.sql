@@ -0,0 +1,15 @@
+-- 022_add_fund_status.sql
+-- Adds a lifecycle status to funds so wound-down funds stop accepting transfers
+
+ALTER TABLE funds ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'open'
+    CHECK (status IN ('open', 'closed', 'suspended', 'liquidated'));
+ALTER TABLE funds ADD COLUMN status_changed_at TIMESTAMP WITH TIME ZONE;
+UPDATE funds SET status_changed_at = created_at;
+ALTER TABLE funds ALTER COLUMN status_changed_at SET NOT NULL;
+ALTER TABLE funds ALTER COLUMN status_changed_at SET DEFAULT NOW();
+
+-- Listing funds by status keeps the created_at ordering used for pagination
+CREATE INDEX idx_funds_status_created ON funds(status, created_at DESC, id DESC);
+
+COMMENT ON COLUMN funds.status IS 'open accepts transfers and unit changes; closed, suspended and liquidated do not';
+COMMENT ON COLUMN funds.status_changed_at IS 'Timestamp of the last status transition, or creation';
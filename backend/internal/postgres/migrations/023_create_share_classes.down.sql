@@ -0,0 +1,38 @@
+-- 023_create_share_classes.down.sql
+-- Removes share classes and restores fund-wide holdings; only safe while every fund has a single class
+
+DROP INDEX IF EXISTS idx_transfers_pending_seller;
+CREATE INDEX idx_transfers_pending_seller ON transfers(fund_id, from_owner_id) WHERE status = 'pending';
+DROP INDEX IF EXISTS idx_cap_table_fund_units;
+CREATE INDEX idx_cap_table_fund_units ON cap_table_entries(fund_id, units DESC, owner_name ASC) WHERE deleted_at IS NULL;
+
+DROP TRIGGER IF EXISTS set_unit_event_share_class ON unit_events;
+DROP TRIGGER IF EXISTS set_transfer_share_class ON transfers;
+DROP TRIGGER IF EXISTS set_cap_table_entry_share_class ON cap_table_entries;
+DROP FUNCTION IF EXISTS set_default_share_class();
+
+ALTER TABLE unit_events
+    DROP CONSTRAINT IF EXISTS fk_unit_event_owner,
+    DROP COLUMN IF EXISTS class_id;
+ALTER TABLE transfers
+    DROP CONSTRAINT IF EXISTS fk_transfer_from_owner,
+    DROP COLUMN IF EXISTS class_id;
+
+ALTER TABLE cap_table_entries
+    DROP CONSTRAINT IF EXISTS uq_cap_table_class_owner_name,
+    DROP CONSTRAINT IF EXISTS uq_cap_table_class_owner_id,
+    DROP CONSTRAINT IF EXISTS fk_cap_table_share_class,
+    DROP COLUMN IF EXISTS class_id,
+    ADD CONSTRAINT cap_table_entries_fund_id_owner_name_key UNIQUE (fund_id, owner_name),
+    ADD CONSTRAINT uq_cap_table_fund_owner_id UNIQUE (fund_id, owner_id);
+
+ALTER TABLE transfers
+    ADD CONSTRAINT fk_transfer_from_owner
+        FOREIGN KEY (fund_id, from_owner_id) REFERENCES cap_table_entries(fund_id, owner_id);
+ALTER TABLE unit_events
+    ADD CONSTRAINT fk_unit_event_owner
+        FOREIGN KEY (fund_id, owner_id) REFERENCES cap_table_entries(fund_id, owner_id);
+
+DROP TRIGGER IF EXISTS create_fund_default_share_class ON funds;
+DROP FUNCTION IF EXISTS create_default_share_class();
+DROP TABLE IF EXISTS share_classes;
@@ -0,0 +1,99 @@
+-- 023_create_share_classes.sql
+-- Splits each fund into share classes with their own authorized units and keys holdings and transfers on class
+
+CREATE TABLE share_classes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    fund_id UUID NOT NULL REFERENCES funds(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    authorized_units INTEGER NOT NULL,
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_share_class_name_length CHECK (LENGTH(TRIM(name)) >= 1),
+    CONSTRAINT chk_share_class_authorized_units CHECK (authorized_units > 0),
+    CONSTRAINT uq_share_class_fund_name UNIQUE (fund_id, name),
+    CONSTRAINT uq_share_class_fund_id UNIQUE (fund_id, id)
+);
+
+CREATE UNIQUE INDEX idx_share_classes_default ON share_classes(fund_id) WHERE is_default;
+
+-- Every fund gets a default class so requests that do not name a class keep working
+CREATE FUNCTION create_default_share_class() RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO share_classes (fund_id, name, authorized_units, is_default, created_at)
+    VALUES (NEW.id, 'Class A', 2147483647, TRUE, COALESCE(NEW.created_at, NOW()));
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER create_fund_default_share_class
+    AFTER INSERT ON funds
+    FOR EACH ROW EXECUTE FUNCTION create_default_share_class();
+
+INSERT INTO share_classes (fund_id, name, authorized_units, is_default, created_at)
+SELECT id, 'Class A', 2147483647, TRUE, created_at FROM funds;
+
+-- Rows inserted without a class belong to the fund's default class
+CREATE FUNCTION set_default_share_class() RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.class_id IS NULL THEN
+        SELECT id INTO NEW.class_id FROM share_classes WHERE fund_id = NEW.fund_id AND is_default;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE transfers DROP CONSTRAINT fk_transfer_from_owner;
+ALTER TABLE unit_events DROP CONSTRAINT fk_unit_event_owner;
+
+ALTER TABLE cap_table_entries ADD COLUMN class_id UUID;
+ALTER TABLE transfers ADD COLUMN class_id UUID;
+ALTER TABLE unit_events ADD COLUMN class_id UUID;
+
+ALTER TABLE cap_table_entries DISABLE TRIGGER update_cap_table_entries_timestamp;
+UPDATE cap_table_entries e SET class_id = c.id FROM share_classes c WHERE c.fund_id = e.fund_id;
+ALTER TABLE cap_table_entries ENABLE TRIGGER update_cap_table_entries_timestamp;
+UPDATE transfers t SET class_id = c.id FROM share_classes c WHERE c.fund_id = t.fund_id;
+UPDATE unit_events u SET class_id = c.id FROM share_classes c WHERE c.fund_id = u.fund_id;
+
+ALTER TABLE cap_table_entries
+    DROP CONSTRAINT uq_cap_table_fund_owner_id,
+    DROP CONSTRAINT cap_table_entries_fund_id_owner_name_key,
+    ALTER COLUMN class_id SET NOT NULL,
+    ADD CONSTRAINT fk_cap_table_share_class
+        FOREIGN KEY (fund_id, class_id) REFERENCES share_classes(fund_id, id),
+    ADD CONSTRAINT uq_cap_table_class_owner_id UNIQUE (fund_id, class_id, owner_id),
+    ADD CONSTRAINT uq_cap_table_class_owner_name UNIQUE (fund_id, class_id, owner_name);
+
+ALTER TABLE transfers
+    ALTER COLUMN class_id SET NOT NULL,
+    ADD CONSTRAINT fk_transfer_from_owner
+        FOREIGN KEY (fund_id, class_id, from_owner_id) REFERENCES cap_table_entries(fund_id, class_id, owner_id);
+
+ALTER TABLE unit_events
+    ALTER COLUMN class_id SET NOT NULL,
+    ADD CONSTRAINT fk_unit_event_owner
+        FOREIGN KEY (fund_id, class_id, owner_id) REFERENCES cap_table_entries(fund_id, class_id, owner_id);
+
+CREATE TRIGGER set_cap_table_entry_share_class
+    BEFORE INSERT ON cap_table_entries
+    FOR EACH ROW EXECUTE FUNCTION set_default_share_class();
+
+CREATE TRIGGER set_transfer_share_class
+    BEFORE INSERT ON transfers
+    FOR EACH ROW EXECUTE FUNCTION set_default_share_class();
+
+CREATE TRIGGER set_unit_event_share_class
+    BEFORE INSERT ON unit_events
+    FOR EACH ROW EXECUTE FUNCTION set_default_share_class();
+
+DROP INDEX IF EXISTS idx_cap_table_fund_units;
+CREATE INDEX idx_cap_table_fund_units ON cap_table_entries(fund_id, units DESC, owner_name ASC, class_id ASC) WHERE deleted_at IS NULL;
+DROP INDEX IF EXISTS idx_transfers_pending_seller;
+CREATE INDEX idx_transfers_pending_seller ON transfers(fund_id, class_id, from_owner_id) WHERE status = 'pending';
+
+COMMENT ON TABLE share_classes IS 'Classes of units within a fund, such as Class A and Class B';
+COMMENT ON COLUMN share_classes.authorized_units IS 'Upper bound on units outstanding in the class';
+COMMENT ON COLUMN share_classes.is_default IS 'Class used when a request does not name one; exactly one per fund';
+COMMENT ON COLUMN cap_table_entries.class_id IS 'Share class the units belong to';
+COMMENT ON COLUMN transfers.class_id IS 'Share class the units moved within';
+COMMENT ON COLUMN unit_events.class_id IS 'Share class the units were issued into or redeemed from';
@@ -0,0 +1,5 @@
+-- 024_create_distributions.down.sql
+-- Removes the distribution ledger
+
+DROP TABLE IF EXISTS distribution_line_items;
+DROP TABLE IF EXISTS distributions;
@@ -0,0 +1,35 @@
+-- 024_create_distributions.sql
+-- Records pro-rata distributions of an amount across a fund's holders as of a record date
+
+CREATE TABLE distributions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    fund_id UUID NOT NULL REFERENCES funds(id) ON DELETE CASCADE,
+    total_amount BIGINT NOT NULL CHECK (total_amount > 0),
+    record_date TIMESTAMP WITH TIME ZONE NOT NULL,
+    total_units BIGINT NOT NULL CHECK (total_units > 0),
+    holders INTEGER NOT NULL CHECK (holders > 0),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_distributions_fund ON distributions(fund_id, created_at, id);
+
+CREATE TABLE distribution_line_items (
+    distribution_id UUID NOT NULL REFERENCES distributions(id) ON DELETE CASCADE,
+    owner_id UUID NOT NULL REFERENCES owners(id),
+    owner_name VARCHAR(255) NOT NULL,
+    units BIGINT NOT NULL CHECK (units > 0),
+    amount BIGINT NOT NULL CHECK (amount >= 0),
+    PRIMARY KEY (distribution_id, owner_id)
+);
+
+CREATE INDEX idx_distribution_line_items_order ON distribution_line_items(distribution_id, amount DESC, units DESC, owner_id ASC);
+
+COMMENT ON TABLE distributions IS 'Amounts allocated across a fund''s holders in proportion to their units';
+COMMENT ON COLUMN distributions.total_amount IS 'Amount distributed in minor currency units; equals the sum of the line item amounts';
+COMMENT ON COLUMN distributions.record_date IS 'Instant whose cap table the amount was allocated against';
+COMMENT ON COLUMN distributions.total_units IS 'Units outstanding at the record date';
+COMMENT ON COLUMN distributions.holders IS 'Number of line items';
+COMMENT ON TABLE distribution_line_items IS 'Each owner''s share of a distribution';
+COMMENT ON COLUMN distribution_line_items.owner_name IS 'Owner''s name in the cap table at the record date';
+COMMENT ON COLUMN distribution_line_items.units IS 'Units held at the record date across all share classes';
+COMMENT ON COLUMN distribution_line_items.amount IS 'Pro-rata share in minor currency units after largest-remainder rounding';
@@ -0,0 +1,6 @@
+-- 025_create_commitments.down.sql
+-- Removes commitments and the capital call ledger
+
+DROP TABLE IF EXISTS capital_call_line_items;
+DROP TABLE IF EXISTS capital_calls;
+DROP TABLE IF EXISTS commitments;
@@ -0,0 +1,49 @@
+-- 025_create_commitments.sql
+-- Tracks investors' capital commitments to a fund and the capital calls drawn against them
+
+CREATE TABLE commitments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    fund_id UUID NOT NULL REFERENCES funds(id) ON DELETE CASCADE,
+    owner_id UUID NOT NULL REFERENCES owners(id),
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    called_amount BIGINT NOT NULL DEFAULT 0 CHECK (called_amount >= 0 AND called_amount <= amount),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT commitments_fund_owner_key UNIQUE (fund_id, owner_id)
+);
+
+CREATE INDEX idx_commitments_fund ON commitments(fund_id, created_at, id);
+
+CREATE TABLE capital_calls (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    fund_id UUID NOT NULL REFERENCES funds(id) ON DELETE CASCADE,
+    total_amount BIGINT NOT NULL CHECK (total_amount > 0),
+    investors INTEGER NOT NULL CHECK (investors > 0),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_capital_calls_fund ON capital_calls(fund_id, created_at, id);
+
+CREATE TABLE capital_call_line_items (
+    capital_call_id UUID NOT NULL REFERENCES capital_calls(id) ON DELETE CASCADE,
+    owner_id UUID NOT NULL REFERENCES owners(id),
+    owner_name VARCHAR(255) NOT NULL,
+    commitment BIGINT NOT NULL CHECK (commitment > 0),
+    amount BIGINT NOT NULL CHECK (amount >= 0),
+    unfunded BIGINT NOT NULL CHECK (unfunded >= 0),
+    PRIMARY KEY (capital_call_id, owner_id)
+);
+
+CREATE INDEX idx_capital_call_line_items_order ON capital_call_line_items(capital_call_id, amount DESC, commitment DESC, owner_id ASC);
+
+COMMENT ON TABLE commitments IS 'Capital each investor has committed to a fund, one row per fund and owner';
+COMMENT ON COLUMN commitments.amount IS 'Committed capital in minor currency units';
+COMMENT ON COLUMN commitments.called_amount IS 'Capital called so far; amount - called_amount is the unfunded commitment';
+COMMENT ON TABLE capital_calls IS 'Amounts drawn from a fund''s investors in proportion to their commitments';
+COMMENT ON COLUMN capital_calls.total_amount IS 'Amount called in minor currency units; equals the sum of the line item amounts';
+COMMENT ON COLUMN capital_calls.investors IS 'Number of line items';
+COMMENT ON TABLE capital_call_line_items IS 'Each investor''s share of a capital call';
+COMMENT ON COLUMN capital_call_line_items.owner_name IS 'Investor''s legal name when the call was made';
+COMMENT ON COLUMN capital_call_line_items.commitment IS 'Investor''s commitment when the call was made';
+COMMENT ON COLUMN capital_call_line_items.amount IS 'Pro-rata share in minor currency units after largest-remainder rounding, capped at the unfunded commitment';
+COMMENT ON COLUMN capital_call_line_items.unfunded IS 'Investor''s unfunded commitment after the call';
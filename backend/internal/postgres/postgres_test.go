@@ -54,45 +54,51 @@ func TestFKConstraintEnforcement(t *testing.T) {
 	`).Scan(&fundID)
 	require.NoError(t, err)
 
+	alice, bob := uuid.New(), uuid.New()
 	_, err = pool.Exec(ctx, `
-		INSERT INTO cap_table_entries (fund_id, owner_name, units)
-		VALUES ($1, 'Alice', 500), ($1, 'Bob', 500)
-	`, fundID)
+		INSERT INTO owners (id, legal_name) VALUES ($1, 'Alice'), ($2, 'Bob')
+	`, alice, bob)
+	require.NoError(t, err)
+
+	_, err = pool.Exec(ctx, `
+		INSERT INTO cap_table_entries (fund_id, owner_id, owner_name, units)
+		VALUES ($1, $2, 'Alice', 500), ($1, $3, 'Bob', 500)
+	`, fundID, alice, bob)
 	require.NoError(t, err)
 
 	t.Run("valid transfer with existing owners succeeds", func(t *testing.T) {
 		_, err := pool.Exec(ctx, `
-			INSERT INTO transfers (fund_id, from_owner, to_owner, units)
-			VALUES ($1, 'Alice', 'Bob', 100)
-		`, fundID)
+			INSERT INTO transfers (fund_id, from_owner, from_owner_id, to_owner, to_owner_id, units)
+			VALUES ($1, 'Alice', $2, 'Bob', $3, 100)
+		`, fundID, alice, bob)
 		assert.NoError(t, err)
 	})
 
 	t.Run("transfer with non-existent from_owner fails", func(t *testing.T) {
 		_, err := pool.Exec(ctx, `
-			INSERT INTO transfers (fund_id, from_owner, to_owner, units)
-			VALUES ($1, 'NonExistent', 'Bob', 100)
-		`, fundID)
+			INSERT INTO transfers (fund_id, from_owner, from_owner_id, to_owner, to_owner_id, units)
+			VALUES ($1, 'NonExistent', $2, 'Bob', $3, 100)
+		`, fundID, uuid.New(), bob)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "violates foreign key constraint")
 	})
 
 	t.Run("transfer with non-existent to_owner fails", func(t *testing.T) {
 		_, err := pool.Exec(ctx, `
-			INSERT INTO transfers (fund_id, from_owner, to_owner, units)
-			VALUES ($1, 'Alice', 'NonExistent', 100)
-		`, fundID)
+			INSERT INTO transfers (fund_id, from_owner, from_owner_id, to_owner, to_owner_id, units)
+			VALUES ($1, 'Alice', $2, 'NonExistent', $3, 100)
+		`, fundID, alice, uuid.New())
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "violates foreign key constraint")
 	})
 
 	t.Run("self-transfer fails with check constraint", func(t *testing.T) {
 		_, err := pool.Exec(ctx, `
-			INSERT INTO transfers (fund_id, from_owner, to_owner, units)
-			VALUES ($1, 'Alice', 'Alice', 100)
-		`, fundID)
+			INSERT INTO transfers (fund_id, from_owner, from_owner_id, to_owner, to_owner_id, units)
+			VALUES ($1, 'Alice', $2, 'Alice', $2, 100)
+		`, fundID, alice)
 		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "chk_different_owners")
+		assert.Contains(t, err.Error(), "chk_different_owner")
 	})
 }
 
@@ -114,8 +120,9 @@ func TestAutomaticUpdatedAtTrigger(t *testing.T) {
 	var entryID uuid.UUID
 	var createdAt, updatedAt time.Time
 	err = pool.QueryRow(ctx, `
-		INSERT INTO cap_table_entries (fund_id, owner_name, units)
-		VALUES ($1, 'Alice', 500)
+		WITH o AS (INSERT INTO owners (legal_name) VALUES ('Alice') RETURNING id)
+		INSERT INTO cap_table_entries (fund_id, owner_id, owner_name, units)
+		SELECT $1, o.id, 'Alice', 500 FROM o
 		RETURNING id, acquired_at, updated_at
 	`, fundID).Scan(&entryID, &createdAt, &updatedAt)
 	require.NoError(t, err)
@@ -152,42 +159,48 @@ func TestIdempotencyKeyUniqueness(t *testing.T) {
 	`).Scan(&fundID)
 	require.NoError(t, err)
 
+	alice, bob := uuid.New(), uuid.New()
+	_, err = pool.Exec(ctx, `
+		INSERT INTO owners (id, legal_name) VALUES ($1, 'Alice'), ($2, 'Bob')
+	`, alice, bob)
+	require.NoError(t, err)
+
 	_, err = pool.Exec(ctx, `
-		INSERT INTO cap_table_entries (fund_id, owner_name, units)
-		VALUES ($1, 'Alice', 500), ($1, 'Bob', 500)
-	`, fundID)
+		INSERT INTO cap_table_entries (fund_id, owner_id, owner_name, units)
+		VALUES ($1, $2, 'Alice', 500), ($1, $3, 'Bob', 500)
+	`, fundID, alice, bob)
 	require.NoError(t, err)
 
 	idempotencyKey := uuid.New()
 
 	t.Run("first transfer with idempotency key succeeds", func(t *testing.T) {
 		_, err := pool.Exec(ctx, `
-			INSERT INTO transfers (fund_id, from_owner, to_owner, units, idempotency_key)
-			VALUES ($1, 'Alice', 'Bob', 100, $2)
-		`, fundID, idempotencyKey)
+			INSERT INTO transfers (fund_id, from_owner, from_owner_id, to_owner, to_owner_id, units, idempotency_key)
+			VALUES ($1, 'Alice', $2, 'Bob', $3, 100, $4)
+		`, fundID, alice, bob, idempotencyKey)
 		assert.NoError(t, err)
 	})
 
 	t.Run("duplicate idempotency key fails", func(t *testing.T) {
 		_, err := pool.Exec(ctx, `
-			INSERT INTO transfers (fund_id, from_owner, to_owner, units, idempotency_key)
-			VALUES ($1, 'Alice', 'Bob', 100, $2)
-		`, fundID, idempotencyKey)
+			INSERT INTO transfers (fund_id, from_owner, from_owner_id, to_owner, to_owner_id, units, idempotency_key)
+			VALUES ($1, 'Alice', $2, 'Bob', $3, 100, $4)
+		`, fundID, alice, bob, idempotencyKey)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "duplicate key")
 	})
 
 	t.Run("null idempotency keys are allowed multiple times", func(t *testing.T) {
 		_, err := pool.Exec(ctx, `
-			INSERT INTO transfers (fund_id, from_owner, to_owner, units, idempotency_key)
-			VALUES ($1, 'Alice', 'Bob', 50, NULL)
-		`, fundID)
+			INSERT INTO transfers (fund_id, from_owner, from_owner_id, to_owner, to_owner_id, units, idempotency_key)
+			VALUES ($1, 'Alice', $2, 'Bob', $3, 50, NULL)
+		`, fundID, alice, bob)
 		assert.NoError(t, err)
 
 		_, err = pool.Exec(ctx, `
-			INSERT INTO transfers (fund_id, from_owner, to_owner, units, idempotency_key)
-			VALUES ($1, 'Alice', 'Bob', 50, NULL)
-		`, fundID)
+			INSERT INTO transfers (fund_id, from_owner, from_owner_id, to_owner, to_owner_id, units, idempotency_key)
+			VALUES ($1, 'Alice', $2, 'Bob', $3, 50, NULL)
+		`, fundID, alice, bob)
 		assert.NoError(t, err)
 	})
 }
@@ -200,7 +213,7 @@ func TestMigrations(t *testing.T) {
 		version, dirty, err := postgres.MigrateVersion(pool)
 		require.NoError(t, err)
 		assert.False(t, dirty)
-		assert.EqualValues(t, 8, version)
+		assert.EqualValues(t, 25, version)
 	})
 
 	t.Run("funds table exists", func(t *testing.T) {
@@ -345,5 +358,116 @@ func TestMigrateUpNoChange(t *testing.T) {
 	version, dirty, err := postgres.MigrateVersion(pool)
 	require.NoError(t, err)
 	assert.False(t, dirty)
-	assert.EqualValues(t, 8, version)
+	assert.EqualValues(t, 25, version)
+}
+
+func TestMigrator(t *testing.T) {
+	ctx := context.Background()
+
+	tc, err := postgres.NewTestContainer(ctx)
+	require.NoError(t, err)
+	defer tc.Cleanup(ctx)
+
+	mg, err := postgres.NewMigrator(tc.Pool())
+	require.NoError(t, err)
+	defer mg.Close()
+
+	available, err := postgres.AvailableVersions()
+	require.NoError(t, err)
+	latest := available[len(available)-1]
+
+	t.Run("status reports everything applied", func(t *testing.T) {
+		status, err := mg.Status()
+		require.NoError(t, err)
+		assert.Equal(t, latest, status.Version)
+		assert.Equal(t, available, status.Applied)
+		assert.Empty(t, status.Pending)
+		assert.NoError(t, mg.CheckCurrent())
+	})
+
+	t.Run("steps down leaves pending versions", func(t *testing.T) {
+		require.NoError(t, mg.Steps(-2))
+
+		status, err := mg.Status()
+		require.NoError(t, err)
+		assert.Equal(t, available[len(available)-3], status.Version)
+		assert.Equal(t, available[len(available)-2:], status.Pending)
+		assert.ErrorIs(t, mg.CheckCurrent(), postgres.ErrSchemaBehind)
+	})
+
+	t.Run("goto migrates to a specific version", func(t *testing.T) {
+		require.NoError(t, mg.Goto(latest))
+
+		version, dirty, err := mg.Version()
+		require.NoError(t, err)
+		assert.False(t, dirty)
+		assert.Equal(t, latest, version)
+	})
+
+	t.Run("force clears dirty state", func(t *testing.T) {
+		_, err := tc.Pool().Exec(ctx, `UPDATE schema_migrations SET dirty = true`)
+		require.NoError(t, err)
+		assert.ErrorIs(t, mg.CheckCurrent(), postgres.ErrSchemaDirty)
+
+		require.NoError(t, mg.Force(int(latest)))
+		assert.NoError(t, mg.CheckCurrent())
+	})
+}
+
+func TestUnitEventsBackfill(t *testing.T) {
+	ctx := context.Background()
+
+	tc, err := postgres.NewTestContainer(ctx)
+	require.NoError(t, err)
+	defer tc.Cleanup(ctx)
+
+	mg, err := postgres.NewMigrator(tc.Pool())
+	require.NoError(t, err)
+	defer mg.Close()
+
+	require.NoError(t, mg.Goto(20))
+
+	pool := tc.Pool()
+	var fundID uuid.UUID
+	err = pool.QueryRow(ctx, `INSERT INTO funds (name, total_units) VALUES ('Imported Fund', 1000) RETURNING id`).Scan(&fundID)
+	require.NoError(t, err)
+
+	alice, bob, carol := uuid.New(), uuid.New(), uuid.New()
+	_, err = pool.Exec(ctx, `
+		INSERT INTO owners (id, legal_name) VALUES ($1, 'Alice'), ($2, 'Bob'), ($3, 'Carol')
+	`, alice, bob, carol)
+	require.NoError(t, err)
+	_, err = pool.Exec(ctx, `
+		INSERT INTO cap_table_entries (fund_id, owner_id, owner_name, units)
+		VALUES ($1, $2, 'Alice', 600), ($1, $3, 'Bob', 300), ($1, $4, 'Carol', 100)
+	`, fundID, alice, bob, carol)
+	require.NoError(t, err)
+	_, err = pool.Exec(ctx, `
+		INSERT INTO transfers (fund_id, from_owner, from_owner_id, to_owner, to_owner_id, units, status)
+		VALUES ($1, 'Alice', $2, 'Carol', $4, 100, 'approved'), ($1, 'Bob', $3, 'Carol', $4, 50, 'pending')
+	`, fundID, alice, bob, carol)
+	require.NoError(t, err)
+
+	require.NoError(t, mg.Goto(21))
+
+	rows, err := pool.Query(ctx, `SELECT owner_id, kind, units FROM unit_events WHERE fund_id = $1`, fundID)
+	require.NoError(t, err)
+	openings := make(map[uuid.UUID]int)
+	for rows.Next() {
+		var (
+			ownerID uuid.UUID
+			kind    string
+			units   int
+		)
+		require.NoError(t, rows.Scan(&ownerID, &kind, &units))
+		assert.Equal(t, "issuance", kind)
+		openings[ownerID] = units
+	}
+	require.NoError(t, rows.Err())
+	assert.Equal(t, map[uuid.UUID]int{alice: 700, bob: 300}, openings)
+
+	var total int
+	err = pool.QueryRow(ctx, `SELECT MAX(total_units) FROM unit_events WHERE fund_id = $1`, fundID).Scan(&total)
+	require.NoError(t, err)
+	assert.Equal(t, 1000, total)
 }
@@ -102,7 +102,7 @@ func (tc *TestContainer) Cleanup(ctx context.Context) error {
 
 func (tc *TestContainer) Reset(ctx context.Context) error {
 	_, err := tc.pool.Exec(ctx, `
-		TRUNCATE TABLE transfers, cap_table_entries, funds CASCADE
+		TRUNCATE TABLE capital_call_line_items, capital_calls, commitments, distribution_line_items, distributions, transfers, unit_events, cap_table_entries, share_classes, owners, funds, webhook_endpoints, api_keys, audit_events CASCADE
 	`)
 	return err
 }
@@ -0,0 +1,7 @@
+package reconciliation
+
+import "time"
+
+type Config struct {
+	Interval time.Duration `envconfig:"RECONCILIATION_INTERVAL" default:"1h"`
+}
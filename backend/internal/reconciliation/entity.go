@@ -0,0 +1,130 @@
+package reconciliation
+
+import (
+	"sort"
+	"time"
+
+	"github.com/arowden/augment-fund/internal/ownership"
+	"github.com/google/uuid"
+)
+
+type Fund struct {
+	ID   uuid.UUID
+	Name string
+}
+
+type Holding struct {
+	OwnerName string
+	Units     int
+}
+
+type Snapshot struct {
+	TakenAt    time.Time
+	TotalUnits int
+	Ledger     *ownership.Ledger
+	Recorded   map[ownership.Holder]Holding
+}
+
+type OwnerDrift struct {
+	OwnerID       uuid.UUID
+	OwnerName     string
+	ClassID       uuid.UUID
+	RecordedUnits int
+	ReplayedUnits int
+}
+
+func (d OwnerDrift) Delta() int {
+	return d.RecordedUnits - d.ReplayedUnits
+}
+
+type FundResult struct {
+	FundID        uuid.UUID
+	FundName      string
+	TotalUnits    int
+	RecordedUnits int
+	Owners        []OwnerDrift
+}
+
+func (r *FundResult) Balanced() bool {
+	return r.RecordedUnits == r.TotalUnits && len(r.Owners) == 0
+}
+
+func (r *FundResult) UnitsDrift() int {
+	return r.RecordedUnits - r.TotalUnits
+}
+
+type Report struct {
+	StartedAt    time.Time
+	CompletedAt  time.Time
+	FundsChecked int
+	Drifted      []*FundResult
+}
+
+func (r *Report) Balanced() bool {
+	return len(r.Drifted) == 0
+}
+
+func (r *Report) AbsoluteDrift() int {
+	total := 0
+	for _, f := range r.Drifted {
+		total += abs(f.UnitsDrift())
+		for _, o := range f.Owners {
+			total += abs(o.Delta())
+		}
+	}
+	return total
+}
+
+func Reconcile(f Fund, snap *Snapshot) *FundResult {
+	result := &FundResult{
+		FundID:     f.ID,
+		FundName:   f.Name,
+		TotalUnits: snap.TotalUnits,
+		Owners:     []OwnerDrift{},
+	}
+
+	replayed := make(map[ownership.Holder]Holding)
+	for _, e := range snap.Ledger.Replay(snap.TakenAt) {
+		replayed[e.Holder()] = Holding{OwnerName: e.OwnerName, Units: e.Units}
+	}
+
+	holders := make(map[ownership.Holder]string, len(replayed)+len(snap.Recorded))
+	for key, h := range replayed {
+		holders[key] = h.OwnerName
+	}
+	for key, h := range snap.Recorded {
+		result.RecordedUnits += h.Units
+		holders[key] = h.OwnerName
+	}
+
+	for key, name := range holders {
+		if snap.Recorded[key].Units != replayed[key].Units {
+			result.Owners = append(result.Owners, OwnerDrift{
+				OwnerID:       key.OwnerID,
+				OwnerName:     name,
+				ClassID:       key.ClassID,
+				RecordedUnits: snap.Recorded[key].Units,
+				ReplayedUnits: replayed[key].Units,
+			})
+		}
+	}
+	sort.Slice(result.Owners, func(i, j int) bool {
+		a, b := result.Owners[i], result.Owners[j]
+		if a.OwnerName != b.OwnerName {
+			return a.OwnerName < b.OwnerName
+		}
+		if a.OwnerID != b.OwnerID {
+			return a.OwnerID.String() < b.OwnerID.String()
+		}
+		return a.ClassID.String() < b.ClassID.String()
+	})
+
+	return result
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,119 @@
+package reconciliation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/arowden/augment-fund/internal/ownership"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconcile(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	f := Fund{ID: uuid.New(), Name: "Growth Fund I"}
+	founder, alice, mallory := uuid.New(), uuid.New(), uuid.New()
+	ledger := &ownership.Ledger{
+		FundID:    f.ID,
+		CreatedAt: created,
+		Movements: []ownership.Movement{
+			{ToOwner: "Founder", ToOwnerID: founder, Units: 1000, At: created},
+			{FromOwner: "Founder", FromOwnerID: founder, ToOwner: "Alice", ToOwnerID: alice, Units: 300, At: created.Add(time.Hour)},
+		},
+	}
+	takenAt := created.Add(24 * time.Hour)
+
+	t.Run("balanced fund has no drift", func(t *testing.T) {
+		result := Reconcile(f, &Snapshot{
+			TakenAt:    takenAt,
+			TotalUnits: 1000,
+			Ledger:     ledger,
+			Recorded:   map[ownership.Holder]Holding{{OwnerID: founder}: {"Founder", 700}, {OwnerID: alice}: {"Alice", 300}},
+		})
+		assert.True(t, result.Balanced())
+		assert.Equal(t, 1000, result.RecordedUnits)
+		assert.Empty(t, result.Owners)
+	})
+
+	t.Run("detects total units drift", func(t *testing.T) {
+		result := Reconcile(f, &Snapshot{
+			TakenAt:    takenAt,
+			TotalUnits: 1000,
+			Ledger:     ledger,
+			Recorded:   map[ownership.Holder]Holding{{OwnerID: founder}: {"Founder", 700}, {OwnerID: alice}: {"Alice", 250}},
+		})
+		assert.False(t, result.Balanced())
+		assert.Equal(t, -50, result.UnitsDrift())
+		require.Len(t, result.Owners, 1)
+		assert.Equal(t, OwnerDrift{OwnerID: alice, OwnerName: "Alice", RecordedUnits: 250, ReplayedUnits: 300}, result.Owners[0])
+	})
+
+	t.Run("detects balances that disagree with history but still sum correctly", func(t *testing.T) {
+		result := Reconcile(f, &Snapshot{
+			TakenAt:    takenAt,
+			TotalUnits: 1000,
+			Ledger:     ledger,
+			Recorded:   map[ownership.Holder]Holding{{OwnerID: founder}: {"Founder", 600}, {OwnerID: alice}: {"Alice", 300}, {OwnerID: mallory}: {"Mallory", 100}},
+		})
+		assert.False(t, result.Balanced())
+		assert.Equal(t, 0, result.UnitsDrift())
+		require.Len(t, result.Owners, 2)
+		assert.Equal(t, "Founder", result.Owners[0].OwnerName)
+		assert.Equal(t, -100, result.Owners[0].Delta())
+		assert.Equal(t, "Mallory", result.Owners[1].OwnerName)
+		assert.Equal(t, 100, result.Owners[1].Delta())
+	})
+
+	t.Run("detects owners missing from the cap table", func(t *testing.T) {
+		result := Reconcile(f, &Snapshot{
+			TakenAt:    takenAt,
+			TotalUnits: 1000,
+			Ledger:     ledger,
+			Recorded:   map[ownership.Holder]Holding{{OwnerID: founder}: {"Founder", 1000}},
+		})
+		require.Len(t, result.Owners, 2)
+		assert.Equal(t, OwnerDrift{OwnerID: alice, OwnerName: "Alice", RecordedUnits: 0, ReplayedUnits: 300}, result.Owners[0])
+	})
+
+	t.Run("replays issuances and redemptions against the current total", func(t *testing.T) {
+		l := &ownership.Ledger{
+			FundID:    f.ID,
+			CreatedAt: created,
+			Movements: append(append([]ownership.Movement{}, ledger.Movements...),
+				ownership.Movement{ToOwner: "Alice", ToOwnerID: alice, Units: 500, At: created.Add(2 * time.Hour)},
+				ownership.Movement{FromOwner: "Founder", FromOwnerID: founder, Units: 200, At: created.Add(3 * time.Hour)},
+			),
+		}
+		result := Reconcile(f, &Snapshot{
+			TakenAt:    takenAt,
+			TotalUnits: 1300,
+			Ledger:     l,
+			Recorded:   map[ownership.Holder]Holding{{OwnerID: founder}: {"Founder", 500}, {OwnerID: alice}: {"Alice", 800}},
+		})
+		assert.True(t, result.Balanced())
+		assert.Equal(t, 1300, result.TotalUnits)
+	})
+
+	t.Run("matches owners by ID after a rename", func(t *testing.T) {
+		result := Reconcile(f, &Snapshot{
+			TakenAt:    takenAt,
+			TotalUnits: 1000,
+			Ledger:     ledger,
+			Recorded:   map[ownership.Holder]Holding{{OwnerID: founder}: {"Founder Holdings", 700}, {OwnerID: alice}: {"Alice Smith", 300}},
+		})
+		assert.True(t, result.Balanced())
+	})
+}
+
+func TestReport_AbsoluteDrift(t *testing.T) {
+	report := &Report{
+		Drifted: []*FundResult{
+			{TotalUnits: 1000, RecordedUnits: 950, Owners: []OwnerDrift{{RecordedUnits: 250, ReplayedUnits: 300}}},
+			{TotalUnits: 500, RecordedUnits: 500, Owners: []OwnerDrift{{RecordedUnits: 10, ReplayedUnits: 0}, {RecordedUnits: 0, ReplayedUnits: 10}}},
+		},
+	}
+	assert.False(t, report.Balanced())
+	assert.Equal(t, 120, report.AbsoluteDrift())
+	assert.True(t, (&Report{}).Balanced())
+}
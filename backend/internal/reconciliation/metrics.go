@@ -0,0 +1,46 @@
+package reconciliation
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const meterName = "github.com/arowden/augment-fund/internal/reconciliation"
+
+func RegisterMetrics(svc *Service) error {
+	meter := otel.Meter(meterName)
+
+	_, err := meter.Int64ObservableGauge(
+		"reconciliation_drifted_funds",
+		metric.WithDescription("Funds whose cap table failed the last units-balance reconciliation"),
+		metric.WithUnit("{funds}"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			if report := svc.LastReport(); report != nil {
+				o.Observe(int64(len(report.Drifted)))
+			}
+			return nil
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.Int64ObservableGauge(
+		"reconciliation_drift_units",
+		metric.WithDescription("Absolute units of drift found by the last reconciliation"),
+		metric.WithUnit("{units}"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			if report := svc.LastReport(); report != nil {
+				o.Observe(int64(report.AbsoluteDrift()))
+			}
+			return nil
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
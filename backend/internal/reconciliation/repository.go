@@ -0,0 +1,13 @@
+package reconciliation
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	ListFunds(ctx context.Context) ([]Fund, error)
+
+	Snapshot(ctx context.Context, fundID uuid.UUID) (*Snapshot, error)
+}
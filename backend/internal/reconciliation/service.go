@@ -0,0 +1,119 @@
+package reconciliation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+type Service struct {
+	repo Repository
+	log  *slog.Logger
+
+	mu   sync.Mutex
+	last *Report
+}
+
+type ServiceOption func(*Service)
+
+func WithRepository(r Repository) ServiceOption {
+	return func(s *Service) { s.repo = r }
+}
+
+func WithLogger(l *slog.Logger) ServiceOption {
+	return func(s *Service) { s.log = l }
+}
+
+func NewService(opts ...ServiceOption) (*Service, error) {
+	s := &Service{log: slog.Default()}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.repo == nil {
+		return nil, errors.New("reconciliation: repository is required")
+	}
+	return s, nil
+}
+
+func (s *Service) Run(ctx context.Context) (*Report, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	report := &Report{StartedAt: time.Now(), Drifted: []*FundResult{}}
+
+	funds, err := s.repo.ListFunds(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range funds {
+		snap, err := s.repo.Snapshot(ctx, f.ID)
+		if err != nil {
+			return nil, fmt.Errorf("reconcile fund %s: %w", f.ID, err)
+		}
+		result := Reconcile(f, snap)
+		if !result.Balanced() {
+			report.Drifted = append(report.Drifted, result)
+		}
+		report.FundsChecked++
+	}
+
+	report.CompletedAt = time.Now()
+	s.last = report
+	return report, nil
+}
+
+func (s *Service) LastReport() *Report {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.last
+}
+
+func (s *Service) Schedule(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		s.runScheduled(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Service) runScheduled(ctx context.Context) {
+	report, err := s.Run(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			s.log.Error("reconciliation run failed", slog.String("error", err.Error()))
+		}
+		return
+	}
+
+	if report.Balanced() {
+		s.log.Info("reconciliation completed",
+			slog.Int("fundsChecked", report.FundsChecked),
+			slog.Duration("duration", report.CompletedAt.Sub(report.StartedAt)),
+		)
+		return
+	}
+
+	for _, f := range report.Drifted {
+		s.log.Warn("units-balance invariant violated",
+			slog.String("fundId", f.FundID.String()),
+			slog.Int("totalUnits", f.TotalUnits),
+			slog.Int("recordedUnits", f.RecordedUnits),
+			slog.Int("ownersDrifted", len(f.Owners)),
+		)
+	}
+}
@@ -0,0 +1,149 @@
+package reconciliation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/arowden/augment-fund/internal/ownership"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRepository struct {
+	listFundsFunc func(ctx context.Context) ([]Fund, error)
+	snapshotFunc  func(ctx context.Context, fundID uuid.UUID) (*Snapshot, error)
+}
+
+func (m *mockRepository) ListFunds(ctx context.Context) ([]Fund, error) {
+	if m.listFundsFunc != nil {
+		return m.listFundsFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *mockRepository) Snapshot(ctx context.Context, fundID uuid.UUID) (*Snapshot, error) {
+	if m.snapshotFunc != nil {
+		return m.snapshotFunc(ctx, fundID)
+	}
+	return nil, errors.New("snapshot not configured")
+}
+
+var founderID = uuid.New()
+
+func snapshotOf(fundID uuid.UUID, total, recorded int) *Snapshot {
+	created := time.Now().Add(-time.Hour)
+	return &Snapshot{
+		TakenAt:    time.Now(),
+		TotalUnits: total,
+		Ledger: &ownership.Ledger{
+			FundID:    fundID,
+			CreatedAt: created,
+			Movements: []ownership.Movement{{ToOwner: "Founder", ToOwnerID: founderID, Units: total, At: created}},
+		},
+		Recorded: map[ownership.Holder]Holding{{OwnerID: founderID}: {OwnerName: "Founder", Units: recorded}},
+	}
+}
+
+func TestNewService(t *testing.T) {
+	t.Run("returns error when repository is nil", func(t *testing.T) {
+		svc, err := NewService()
+		assert.Nil(t, svc)
+		assert.ErrorContains(t, err, "repository is required")
+	})
+
+	t.Run("creates service with repository", func(t *testing.T) {
+		svc, err := NewService(WithRepository(&mockRepository{}))
+		require.NoError(t, err)
+		assert.NotNil(t, svc)
+	})
+}
+
+func TestService_Run(t *testing.T) {
+	balanced := Fund{ID: uuid.New(), Name: "Balanced"}
+	drifted := Fund{ID: uuid.New(), Name: "Drifted"}
+
+	repo := &mockRepository{
+		listFundsFunc: func(ctx context.Context) ([]Fund, error) {
+			return []Fund{balanced, drifted}, nil
+		},
+		snapshotFunc: func(ctx context.Context, fundID uuid.UUID) (*Snapshot, error) {
+			if fundID == balanced.ID {
+				return snapshotOf(fundID, 1000, 1000), nil
+			}
+			return snapshotOf(fundID, 500, 400), nil
+		},
+	}
+
+	t.Run("reports only drifted funds", func(t *testing.T) {
+		svc, err := NewService(WithRepository(repo))
+		require.NoError(t, err)
+		assert.Nil(t, svc.LastReport())
+
+		report, err := svc.Run(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 2, report.FundsChecked)
+		require.Len(t, report.Drifted, 1)
+		assert.Equal(t, drifted.ID, report.Drifted[0].FundID)
+		assert.Equal(t, 400, report.Drifted[0].RecordedUnits)
+		assert.False(t, report.CompletedAt.Before(report.StartedAt))
+		assert.Same(t, report, svc.LastReport())
+	})
+
+	t.Run("propagates snapshot errors", func(t *testing.T) {
+		svc, err := NewService(WithRepository(&mockRepository{
+			listFundsFunc: repo.listFundsFunc,
+		}))
+		require.NoError(t, err)
+
+		report, err := svc.Run(context.Background())
+		assert.Nil(t, report)
+		assert.ErrorContains(t, err, balanced.ID.String())
+		assert.Nil(t, svc.LastReport())
+	})
+}
+
+func TestService_Schedule(t *testing.T) {
+	t.Run("runs immediately and stops with the context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		runs := make(chan struct{}, 1)
+		svc, err := NewService(WithRepository(&mockRepository{
+			listFundsFunc: func(context.Context) ([]Fund, error) {
+				select {
+				case runs <- struct{}{}:
+				default:
+				}
+				return nil, nil
+			},
+		}))
+		require.NoError(t, err)
+
+		done := make(chan struct{})
+		go func() {
+			svc.Schedule(ctx, time.Hour)
+			close(done)
+		}()
+
+		select {
+		case <-runs:
+		case <-time.After(time.Second):
+			t.Fatal("scheduled run did not start")
+		}
+		cancel()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("scheduler did not stop")
+		}
+	})
+
+	t.Run("disabled when interval is not positive", func(t *testing.T) {
+		svc, err := NewService(WithRepository(&mockRepository{}))
+		require.NoError(t, err)
+		svc.Schedule(context.Background(), 0)
+		assert.Nil(t, svc.LastReport())
+	})
+}
@@ -0,0 +1,98 @@
+package reconciliation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/arowden/augment-fund/internal/ownership"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type DB interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error)
+}
+
+type Store struct {
+	db DB
+}
+
+func NewStore(db DB) *Store {
+	if db == nil {
+		return nil
+	}
+	return &Store{db: db}
+}
+
+func (s *Store) ListFunds(ctx context.Context) ([]Fund, error) {
+	const query = `
+		SELECT id, name
+		FROM funds
+		ORDER BY created_at ASC, id ASC
+	`
+	rows, err := s.db.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("list funds for reconciliation: %w", err)
+	}
+	defer rows.Close()
+
+	var funds []Fund
+	for rows.Next() {
+		var f Fund
+		if err := rows.Scan(&f.ID, &f.Name); err != nil {
+			return nil, fmt.Errorf("scan fund row: %w", err)
+		}
+		funds = append(funds, f)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate fund rows: %w", err)
+	}
+	return funds, nil
+}
+
+func (s *Store) Snapshot(ctx context.Context, fundID uuid.UUID) (*Snapshot, error) {
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, fmt.Errorf("begin snapshot: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	snap := &Snapshot{Recorded: make(map[ownership.Holder]Holding)}
+	err = tx.QueryRow(ctx, `SELECT clock_timestamp(), total_units FROM funds WHERE id = $1`, fundID).Scan(&snap.TakenAt, &snap.TotalUnits)
+	if err != nil {
+		return nil, fmt.Errorf("read total units of fund %s: %w", fundID, err)
+	}
+
+	const query = `
+		SELECT owner_id, class_id, owner_name, units
+		FROM cap_table_entries
+		WHERE fund_id = $1 AND deleted_at IS NULL
+	`
+	rows, err := tx.Query(ctx, query, fundID)
+	if err != nil {
+		return nil, fmt.Errorf("find cap table entries for fund %s: %w", fundID, err)
+	}
+	for rows.Next() {
+		var (
+			key ownership.Holder
+			h   Holding
+		)
+		if err := rows.Scan(&key.OwnerID, &key.ClassID, &h.OwnerName, &h.Units); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("scan cap table entry row: %w", err)
+		}
+		snap.Recorded[key] = h
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate cap table entry rows: %w", err)
+	}
+
+	snap.Ledger, err = ownership.NewStore(tx).FindLedger(ctx, fundID, snap.TakenAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return snap, nil
+}
@@ -0,0 +1,114 @@
+package reconciliation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arowden/augment-fund/internal/fund"
+	"github.com/arowden/augment-fund/internal/owner"
+	"github.com/arowden/augment-fund/internal/ownership"
+	"github.com/arowden/augment-fund/internal/postgres"
+	"github.com/arowden/augment-fund/internal/reconciliation"
+	"github.com/arowden/augment-fund/internal/transfer"
+	"github.com/google/uuid"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx := context.Background()
+	tc, err := postgres.NewTestContainer(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() { tc.Cleanup(ctx) })
+
+	ownershipStore := ownership.NewStore(tc.Pool())
+	ownerStore := owner.NewStore(tc.Pool())
+	fundService, err := fund.NewService(
+		fund.NewStore(tc.Pool()),
+		fund.WithPool(tc.Pool()),
+		fund.WithOwnershipRepository(ownershipStore),
+		fund.WithOwnerRepository(ownerStore),
+	)
+	require.NoError(t, err)
+	transferService, err := transfer.NewService(
+		transfer.WithRepository(transfer.NewStore(tc.Pool())),
+		transfer.WithOwnershipRepository(ownershipStore),
+		transfer.WithOwnerRepository(ownerStore),
+		transfer.WithPool(tc.Pool()),
+	)
+	require.NoError(t, err)
+
+	svc, err := reconciliation.NewService(reconciliation.WithRepository(reconciliation.NewStore(tc.Pool())))
+	require.NoError(t, err)
+
+	seed := func(t *testing.T) *fund.Fund {
+		f, err := fundService.CreateFundWithInitialOwner(ctx, "Reconciled Fund", 1000, "Founder")
+		require.NoError(t, err)
+		_, err = transferService.ExecuteTransfer(ctx, transfer.Request{FundID: f.ID, FromOwner: "Founder", ToOwner: "Alice", Units: 300})
+		require.NoError(t, err)
+		return f
+	}
+
+	t.Run("Snapshot captures balances and history", func(t *testing.T) {
+		tc.Reset(ctx)
+		f := seed(t)
+
+		snap, err := reconciliation.NewStore(tc.Pool()).Snapshot(ctx, f.ID)
+		require.NoError(t, err)
+		recorded := make(map[string]int, len(snap.Recorded))
+		for _, h := range snap.Recorded {
+			recorded[h.OwnerName] = h.Units
+		}
+		assert.Equal(t, map[string]int{"Founder": 700, "Alice": 300}, recorded)
+		assert.Equal(t, 1000, snap.TotalUnits)
+		require.Len(t, snap.Ledger.Movements, 2)
+		assert.Equal(t, "Founder", snap.Ledger.Movements[0].ToOwner)
+		assert.Equal(t, uuid.Nil, snap.Ledger.Movements[0].FromOwnerID)
+	})
+
+	t.Run("Run stays balanced across issuances and redemptions", func(t *testing.T) {
+		tc.Reset(ctx)
+		f := seed(t)
+
+		_, err := fundService.IssueUnits(ctx, fund.UnitEventRequest{FundID: f.ID, Owner: "Bob", Units: 500})
+		require.NoError(t, err)
+		_, err = fundService.RedeemUnits(ctx, fund.UnitEventRequest{FundID: f.ID, Owner: "Alice", Units: 100})
+		require.NoError(t, err)
+
+		report, err := svc.Run(ctx)
+		require.NoError(t, err)
+		assert.True(t, report.Balanced())
+	})
+
+	t.Run("Run reports a consistent database as balanced", func(t *testing.T) {
+		tc.Reset(ctx)
+		seed(t)
+
+		report, err := svc.Run(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, report.FundsChecked)
+		assert.True(t, report.Balanced())
+	})
+
+	t.Run("Run detects a tampered cap table entry", func(t *testing.T) {
+		tc.Reset(ctx)
+		f := seed(t)
+
+		_, err := tc.Pool().Exec(ctx, `UPDATE cap_table_entries SET units = 250 WHERE fund_id = $1 AND owner_name = 'Alice'`, f.ID)
+		require.NoError(t, err)
+
+		report, err := svc.Run(ctx)
+		require.NoError(t, err)
+		require.Len(t, report.Drifted, 1)
+		assert.Equal(t, f.ID, report.Drifted[0].FundID)
+		assert.Equal(t, 950, report.Drifted[0].RecordedUnits)
+		require.Len(t, report.Drifted[0].Owners, 1)
+		assert.Equal(t, "Alice", report.Drifted[0].Owners[0].OwnerName)
+		assert.Equal(t, 300, report.Drifted[0].Owners[0].ReplayedUnits)
+	})
+}
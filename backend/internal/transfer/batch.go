@@ -0,0 +1,97 @@
+package transfer
+
+import (
+	"bytes"
+	"sort"
+	"time"
+
+	"github.com/arowden/augment-fund/internal/owner"
+	"github.com/arowden/augment-fund/internal/ownership"
+	"github.com/google/uuid"
+)
+
+const MaxBatchLegs = 100
+
+type Leg struct {
+	FromOwner   string
+	FromOwnerID *uuid.UUID
+	ToOwner     string
+	ToOwnerID   *uuid.UUID
+	ClassID     *uuid.UUID
+	Units       int
+}
+
+type BatchRequest struct {
+	FundID         uuid.UUID
+	Legs           []Leg
+	IdempotencyKey *uuid.UUID
+}
+
+type Batch struct {
+	ID             uuid.UUID
+	FundID         uuid.UUID
+	IdempotencyKey *uuid.UUID
+	CreatedAt      time.Time
+	Transfers      []*Transfer
+}
+
+func (b *Batch) matches(req BatchRequest) bool {
+	if b.FundID != req.FundID || len(b.Transfers) != len(req.Legs) {
+		return false
+	}
+	for i, t := range b.Transfers {
+		leg := req.Legs[i]
+		if !sameOwner(leg.FromOwnerID, leg.FromOwner, t.FromOwnerID, t.FromOwner) ||
+			!sameOwner(leg.ToOwnerID, leg.ToOwner, t.ToOwnerID, t.ToOwner) ||
+			!sameClass(leg.ClassID, t) ||
+			t.Units != leg.Units {
+			return false
+		}
+	}
+	return true
+}
+
+type resolvedLeg struct {
+	from  *owner.Owner
+	to    *owner.Owner
+	class *ownership.ShareClass
+	units int
+}
+
+func (l resolvedLeg) sender() ownership.Holder {
+	return ownership.Holder{OwnerID: l.from.ID, ClassID: l.class.ID}
+}
+
+func (l resolvedLeg) recipient() ownership.Holder {
+	return ownership.Holder{OwnerID: l.to.ID, ClassID: l.class.ID}
+}
+
+func legHolders(legs []resolvedLeg) []ownership.Holder {
+	seen := make(map[ownership.Holder]struct{}, 2*len(legs))
+	holders := make([]ownership.Holder, 0, 2*len(legs))
+	for _, leg := range legs {
+		for _, h := range []ownership.Holder{leg.sender(), leg.recipient()} {
+			if _, ok := seen[h]; !ok {
+				seen[h] = struct{}{}
+				holders = append(holders, h)
+			}
+		}
+	}
+	sortHolders(holders)
+	return holders
+}
+
+func sortHolders(holders []ownership.Holder) {
+	sort.Slice(holders, func(i, j int) bool {
+		if c := bytes.Compare(holders[i].OwnerID[:], holders[j].OwnerID[:]); c != 0 {
+			return c < 0
+		}
+		return bytes.Compare(holders[i].ClassID[:], holders[j].ClassID[:]) < 0
+	})
+}
+
+func sortOwnerIDs(ids []uuid.UUID) {
+	sort.Slice(ids, func(i, j int) bool {
+		return bytes.Compare(ids[i][:], ids[j][:]) < 0
+	})
+}
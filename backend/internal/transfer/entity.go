@@ -6,12 +6,71 @@ import (
 	"github.com/google/uuid"
 )
 
+type Status string
+
+const (
+	StatusPending  Status = "pending"
+	StatusApproved Status = "approved"
+	StatusRejected Status = "rejected"
+)
+
 type Transfer struct {
 	ID             uuid.UUID
 	FundID         uuid.UUID
 	FromOwner      string
 	ToOwner        string
+	FromOwnerID    uuid.UUID
+	ToOwnerID      uuid.UUID
+	ClassID        uuid.UUID
+	ClassName      string
+	DefaultClass   bool
 	Units          int
 	IdempotencyKey *uuid.UUID
 	TransferredAt  time.Time
+	BatchID        *uuid.UUID
+	LegIndex       *int
+
+	ReversesTransferID   *uuid.UUID
+	ReversedByTransferID *uuid.UUID
+
+	Status          Status
+	RequestedAt     time.Time
+	RequestedBy     *string
+	ReviewedBy      *string
+	ReviewedAt      *time.Time
+	RejectionReason *string
+
+	LedgerSequence *int64
+	PrevHash       []byte
+	Hash           []byte
+}
+
+func (t *Transfer) scanTargets() []any {
+	return []any{
+		&t.ID,
+		&t.FundID,
+		&t.FromOwner,
+		&t.ToOwner,
+		&t.FromOwnerID,
+		&t.ToOwnerID,
+		&t.ClassID,
+		&t.ClassName,
+		&t.DefaultClass,
+		&t.Units,
+		&t.IdempotencyKey,
+		&t.TransferredAt,
+		&t.BatchID,
+		&t.LegIndex,
+		&t.ReversesTransferID,
+		&t.ReversedByTransferID,
+		&t.Status,
+		&t.RequestedAt,
+		&t.RequestedBy,
+		&t.ReviewedBy,
+		&t.ReviewedAt,
+		&t.RejectionReason,
+		&t.LedgerSequence,
+		&t.PrevHash,
+		&t.Hash,
+	}
 }
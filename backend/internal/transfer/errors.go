@@ -13,10 +13,57 @@ var ErrSelfTransfer = fmt.Errorf("cannot transfer to self")
 
 var ErrInvalidUnits = fmt.Errorf("units must be between %d and %d", validation.MinUnits, validation.MaxUnits)
 
-var ErrInvalidOwner = fmt.Errorf("owner name must be non-empty (max %d chars)", validation.MaxNameLength)
+var ErrInvalidOwner = fmt.Errorf("owner must be given by ID or by a non-empty name (max %d chars)", validation.MaxNameLength)
 
 var ErrOwnerNotFound = errors.New("owner not found")
 
+var ErrOwnerConflict = errors.New("owner cannot be resolved unambiguously in this fund")
+
 var ErrNilTransfer = errors.New("transfer: cannot operate on nil transfer")
 
 var ErrDuplicateIdempotencyKey = errors.New("idempotency key already used with different transfer data")
+
+var ErrTransferNotFound = errors.New("transfer not found")
+
+var ErrFundNotFound = errors.New("fund not found")
+
+var ErrShareClassNotFound = errors.New("share class not found")
+
+var ErrFundNotOpen = errors.New("fund is not open for transfers")
+
+var ErrAlreadyReversed = errors.New("transfer has already been reversed")
+
+var ErrNotPending = errors.New("transfer is not pending approval")
+
+var ErrNotApproved = errors.New("only approved transfers can be reversed")
+
+var ErrApprovalRequired = errors.New("transfer exceeds the fund's approval threshold and must be submitted on its own")
+
+var ErrSelfReview = errors.New("transfer must be reviewed by someone other than its requester")
+
+var ErrUnknownRequester = errors.New("transfer has no recorded requester and cannot be approved")
+
+var ErrInvalidRequester = fmt.Errorf("requester name must be non-empty (max %d chars)", validation.MaxNameLength)
+
+var ErrInvalidReviewer = fmt.Errorf("reviewer name must be non-empty (max %d chars)", validation.MaxNameLength)
+
+var ErrInvalidReason = fmt.Errorf("rejection reason must be at most %d chars", MaxReasonLength)
+
+var ErrInvalidFilter = errors.New("invalid transfer filter")
+
+var ErrEmptyBatch = errors.New("batch must contain at least one leg")
+
+var ErrTooManyLegs = fmt.Errorf("batch must contain at most %d legs", MaxBatchLegs)
+
+type LegError struct {
+	Index int
+	Err   error
+}
+
+func (e *LegError) Error() string {
+	return fmt.Sprintf("leg %d: %v", e.Index, e.Err)
+}
+
+func (e *LegError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,48 @@
+package transfer
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type executedEvent struct {
+	ID                 uuid.UUID  `json:"id"`
+	FundID             uuid.UUID  `json:"fundId"`
+	FromOwner          string     `json:"fromOwner"`
+	FromOwnerID        uuid.UUID  `json:"fromOwnerId"`
+	ToOwner            string     `json:"toOwner"`
+	ToOwnerID          uuid.UUID  `json:"toOwnerId"`
+	ClassID            uuid.UUID  `json:"classId"`
+	Units              int        `json:"units"`
+	TransferredAt      time.Time  `json:"transferredAt"`
+	BatchID            *uuid.UUID `json:"batchId,omitempty"`
+	LegIndex           *int       `json:"legIndex,omitempty"`
+	ReversesTransferID *uuid.UUID `json:"reversesTransferId,omitempty"`
+}
+
+type balanceChangedEvent struct {
+	OwnerID    uuid.UUID `json:"ownerId"`
+	OwnerName  string    `json:"ownerName"`
+	ClassID    uuid.UUID `json:"classId"`
+	Units      int       `json:"units"`
+	Delta      int       `json:"delta"`
+	TransferID uuid.UUID `json:"transferId"`
+}
+
+func newExecutedEvent(t *Transfer) executedEvent {
+	return executedEvent{
+		ID:                 t.ID,
+		FundID:             t.FundID,
+		FromOwner:          t.FromOwner,
+		FromOwnerID:        t.FromOwnerID,
+		ToOwner:            t.ToOwner,
+		ToOwnerID:          t.ToOwnerID,
+		ClassID:            t.ClassID,
+		Units:              t.Units,
+		TransferredAt:      t.TransferredAt,
+		BatchID:            t.BatchID,
+		LegIndex:           t.LegIndex,
+		ReversesTransferID: t.ReversesTransferID,
+	}
+}
@@ -0,0 +1,114 @@
+package transfer
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SortOrder string
+
+const (
+	SortAscending  SortOrder = "asc"
+	SortDescending SortOrder = "desc"
+)
+
+type Filter struct {
+	OwnerID     *uuid.UUID
+	FromOwnerID *uuid.UUID
+	ToOwnerID   *uuid.UUID
+	Since       *time.Time
+	Until       *time.Time
+	MinUnits    *int
+	MaxUnits    *int
+	Status      *Status
+	Sort        SortOrder
+}
+
+func (f Filter) Validate() error {
+	if f.Since != nil && f.Until != nil && !f.Since.Before(*f.Until) {
+		return fmt.Errorf("%w: since must be before until", ErrInvalidFilter)
+	}
+	if (f.MinUnits != nil && *f.MinUnits < 0) || (f.MaxUnits != nil && *f.MaxUnits < 0) {
+		return fmt.Errorf("%w: unit bounds must not be negative", ErrInvalidFilter)
+	}
+	if f.MinUnits != nil && f.MaxUnits != nil && *f.MinUnits > *f.MaxUnits {
+		return fmt.Errorf("%w: minUnits must not exceed maxUnits", ErrInvalidFilter)
+	}
+	switch f.Sort {
+	case "", SortAscending, SortDescending:
+	default:
+		return fmt.Errorf("%w: sort must be %q or %q", ErrInvalidFilter, SortAscending, SortDescending)
+	}
+	return nil
+}
+
+func (f Filter) where(fundID uuid.UUID) (string, []any) {
+	conds := []string{"t.fund_id = $1"}
+	args := []any{fundID}
+	add := func(cond string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+
+	if f.OwnerID != nil {
+		add("(t.from_owner_id = $%[1]d OR t.to_owner_id = $%[1]d)", *f.OwnerID)
+	}
+	if f.FromOwnerID != nil {
+		add("t.from_owner_id = $%d", *f.FromOwnerID)
+	}
+	if f.ToOwnerID != nil {
+		add("t.to_owner_id = $%d", *f.ToOwnerID)
+	}
+	if f.Since != nil {
+		add("t.transferred_at >= $%d", *f.Since)
+	}
+	if f.Until != nil {
+		add("t.transferred_at < $%d", *f.Until)
+	}
+	if f.MinUnits != nil {
+		add("t.units >= $%d", *f.MinUnits)
+	}
+	if f.MaxUnits != nil {
+		add("t.units <= $%d", *f.MaxUnits)
+	}
+	if f.Status != nil {
+		add("t.status = $%d", *f.Status)
+	}
+	return "WHERE " + strings.Join(conds, " AND "), args
+}
+
+func (f Filter) orderBy() string {
+	if f.Sort == SortDescending {
+		return "ORDER BY t.transferred_at DESC, COALESCE(t.leg_index, -1) DESC, t.id DESC"
+	}
+	return "ORDER BY t.transferred_at ASC, COALESCE(t.leg_index, -1) ASC, t.id ASC"
+}
+
+const transferCursorKind = "transfers"
+
+type transferCursor struct {
+	TransferredAt time.Time `json:"transferredAt"`
+	LegIndex      int       `json:"legIndex"`
+	ID            uuid.UUID `json:"id"`
+	Descending    bool      `json:"desc,omitempty"`
+}
+
+func newTransferCursor(t *Transfer, descending bool) transferCursor {
+	legIndex := -1
+	if t.LegIndex != nil {
+		legIndex = *t.LegIndex
+	}
+	return transferCursor{TransferredAt: t.TransferredAt, LegIndex: legIndex, ID: t.ID, Descending: descending}
+}
+
+func (c transferCursor) predicate(argc int) (string, []any) {
+	op := ">"
+	if c.Descending {
+		op = "<"
+	}
+	cond := fmt.Sprintf("(t.transferred_at, COALESCE(t.leg_index, -1), t.id) %s ($%d::timestamptz, $%d::int, $%d::uuid)", op, argc+1, argc+2, argc+3)
+	return cond, []any{c.TransferredAt, c.LegIndex, c.ID}
+}
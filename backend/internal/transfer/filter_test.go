@@ -0,0 +1,69 @@
+package transfer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilter_Validate(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-time.Hour)
+	negative, low, high := -1, 10, 100
+
+	tests := []struct {
+		name    string
+		filter  Filter
+		wantErr bool
+	}{
+		{"empty filter", Filter{}, false},
+		{"full filter", Filter{Since: &earlier, Until: &now, MinUnits: &low, MaxUnits: &high, Sort: SortDescending}, false},
+		{"equal unit bounds", Filter{MinUnits: &low, MaxUnits: &low}, false},
+		{"since after until", Filter{Since: &now, Until: &earlier}, true},
+		{"since equals until", Filter{Since: &now, Until: &now}, true},
+		{"negative min units", Filter{MinUnits: &negative}, true},
+		{"negative max units", Filter{MaxUnits: &negative}, true},
+		{"min above max", Filter{MinUnits: &high, MaxUnits: &low}, true},
+		{"unknown sort", Filter{Sort: SortOrder("sideways")}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.filter.Validate()
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidFilter)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFilter_Where(t *testing.T) {
+	fundID := uuid.New()
+
+	t.Run("empty filter only scopes to the fund", func(t *testing.T) {
+		where, args := Filter{}.where(fundID)
+		assert.Equal(t, "WHERE t.fund_id = $1", where)
+		assert.Equal(t, []any{fundID}, args)
+	})
+
+	t.Run("adds only the predicates that are set", func(t *testing.T) {
+		ownerID := uuid.New()
+		since := time.Now()
+		maxUnits := 50
+		where, args := Filter{OwnerID: &ownerID, Since: &since, MaxUnits: &maxUnits}.where(fundID)
+		assert.Equal(t, "WHERE t.fund_id = $1 AND (t.from_owner_id = $2 OR t.to_owner_id = $2) AND t.transferred_at >= $3 AND t.units <= $4", where)
+		require.Len(t, args, 4)
+		assert.Equal(t, ownerID, args[1])
+		assert.Equal(t, since, args[2])
+		assert.Equal(t, maxUnits, args[3])
+	})
+
+	t.Run("sort order", func(t *testing.T) {
+		assert.Contains(t, Filter{}.orderBy(), "t.transferred_at ASC")
+		assert.Contains(t, Filter{Sort: SortDescending}.orderBy(), "t.transferred_at DESC")
+	})
+}
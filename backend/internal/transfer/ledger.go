@@ -0,0 +1,186 @@
+package transfer
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/arowden/augment-fund/internal/fundstatus"
+	"github.com/google/uuid"
+)
+
+const ledgerPageSize = 1000
+
+type BreakReason string
+
+const (
+	BreakSequenceGap      BreakReason = "sequence_gap"
+	BreakPrevHashMismatch BreakReason = "prev_hash_mismatch"
+	BreakHashMismatch     BreakReason = "hash_mismatch"
+	BreakUnchained        BreakReason = "unchained"
+	BreakHeadMismatch     BreakReason = "head_mismatch"
+)
+
+type LedgerHead struct {
+	FundID     uuid.UUID
+	FundStatus fundstatus.Status
+	Sequence   int64
+	Hash       []byte
+}
+
+func (h *LedgerHead) RequireOpen() error {
+	if h.FundStatus != fundstatus.Open {
+		return fmt.Errorf("%w (%s)", ErrFundNotOpen, h.FundStatus)
+	}
+	return nil
+}
+
+func (h *LedgerHead) Append(t *Transfer) {
+	seq := h.Sequence + 1
+	t.LedgerSequence = &seq
+	t.PrevHash = h.Hash
+	t.Hash = t.ComputeHash()
+
+	h.Sequence = seq
+	h.Hash = t.Hash
+}
+
+type LedgerBreak struct {
+	Sequence     int64
+	TransferID   *uuid.UUID
+	Reason       BreakReason
+	ExpectedHash []byte
+	ActualHash   []byte
+}
+
+type LedgerVerification struct {
+	Head    LedgerHead
+	Checked int
+	Break   *LedgerBreak
+}
+
+func (v *LedgerVerification) Valid() bool {
+	return v.Break == nil
+}
+
+func (t *Transfer) ComputeHash() []byte {
+	var b strings.Builder
+	field := func(v string) {
+		fmt.Fprintf(&b, "%d:%s\n", len(v), v)
+	}
+
+	var seq int64
+	if t.LedgerSequence != nil {
+		seq = *t.LedgerSequence
+	}
+	field(strconv.FormatInt(seq, 10))
+	field(hex.EncodeToString(t.PrevHash))
+	field(t.ID.String())
+	field(t.FundID.String())
+	field(t.FromOwner)
+	field(t.ToOwner)
+	field(t.FromOwnerID.String())
+	field(t.ToOwnerID.String())
+	field(strconv.Itoa(t.Units))
+	field(optionalUUID(t.IdempotencyKey))
+	field(optionalUUID(t.BatchID))
+	field(optionalInt(t.LegIndex))
+	field(optionalUUID(t.ReversesTransferID))
+	field(strconv.FormatInt(t.RequestedAt.UnixMicro(), 10))
+	if t.DefaultClass || t.ClassID == uuid.Nil {
+		field("")
+	} else {
+		field(t.ClassID.String())
+	}
+	field(optionalString(t.RequestedBy))
+	field(string(t.Status))
+	field(optionalString(t.ReviewedBy))
+	field(optionalTime(t.ReviewedAt))
+	field(strconv.FormatInt(t.TransferredAt.UnixMicro(), 10))
+	field(optionalString(t.RejectionReason))
+
+	sum := sha256.Sum256([]byte(b.String()))
+	return sum[:]
+}
+
+type ledgerVerifier struct {
+	head     LedgerHead
+	expected int64
+	prev     []byte
+	checked  int
+}
+
+func newLedgerVerifier(head LedgerHead) *ledgerVerifier {
+	return &ledgerVerifier{head: head, expected: 1}
+}
+
+func (v *ledgerVerifier) check(t *Transfer) *LedgerBreak {
+	id := t.ID
+	brk := &LedgerBreak{Sequence: v.expected, TransferID: &id}
+
+	switch {
+	case t.LedgerSequence == nil || *t.LedgerSequence != v.expected:
+		brk.Reason = BreakSequenceGap
+		return brk
+	case !bytes.Equal(t.PrevHash, v.prev):
+		brk.Reason = BreakPrevHashMismatch
+		brk.ExpectedHash = v.prev
+		brk.ActualHash = t.PrevHash
+		return brk
+	}
+	if computed := t.ComputeHash(); !bytes.Equal(computed, t.Hash) {
+		brk.Reason = BreakHashMismatch
+		brk.ExpectedHash = computed
+		brk.ActualHash = t.Hash
+		return brk
+	}
+
+	v.prev = t.Hash
+	v.expected++
+	v.checked++
+	return nil
+}
+
+func (v *ledgerVerifier) finish() *LedgerBreak {
+	if v.checked == int(v.head.Sequence) && bytes.Equal(v.prev, v.head.Hash) {
+		return nil
+	}
+	return &LedgerBreak{
+		Sequence:     v.head.Sequence,
+		Reason:       BreakHeadMismatch,
+		ExpectedHash: v.head.Hash,
+		ActualHash:   v.prev,
+	}
+}
+
+func optionalUUID(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+func optionalString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func optionalTime(ts *time.Time) string {
+	if ts == nil {
+		return ""
+	}
+	return strconv.FormatInt(ts.UnixMicro(), 10)
+}
+
+func optionalInt(n *int) string {
+	if n == nil {
+		return ""
+	}
+	return strconv.Itoa(*n)
+}
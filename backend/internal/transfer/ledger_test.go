@@ -0,0 +1,204 @@
+package transfer
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func chain(t *testing.T, n int) (*LedgerHead, []*Transfer) {
+	t.Helper()
+	fundID := uuid.New()
+	head := &LedgerHead{FundID: fundID}
+	now := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	transfers := make([]*Transfer, n)
+	for i := range transfers {
+		at := now.Add(time.Duration(i) * time.Second)
+		transfers[i] = &Transfer{ID: uuid.New(), FundID: fundID, FromOwner: "Alice", ToOwner: "Bob", Units: 10 + i, Status: StatusApproved, RequestedAt: at, TransferredAt: at}
+		head.Append(transfers[i])
+	}
+	return head, transfers
+}
+
+func verify(head *LedgerHead, transfers []*Transfer) (int, *LedgerBreak) {
+	v := newLedgerVerifier(*head)
+	for _, tr := range transfers {
+		if brk := v.check(tr); brk != nil {
+			return v.checked, brk
+		}
+	}
+	return v.checked, v.finish()
+}
+
+func TestTransfer_ComputeHash(t *testing.T) {
+	seq := int64(1)
+	leg := 0
+	tr := &Transfer{
+		ID:             uuid.MustParse("7c9e6679-7425-40de-944b-e07fc1f90ae7"),
+		FundID:         uuid.MustParse("550e8400-e29b-41d4-a716-446655440000"),
+		FromOwner:      "Alice",
+		ToOwner:        "Bob",
+		FromOwnerID:    uuid.MustParse("9b2d6c1e-3f4a-4b5c-8d7e-0f1a2b3c4d5e"),
+		ToOwnerID:      uuid.MustParse("1f0e2d3c-4b5a-4697-a8b9-cadbecfd0e1f"),
+		Units:          100,
+		BatchID:        ptrUUID(uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")),
+		LegIndex:       &leg,
+		Status:         StatusApproved,
+		RequestedAt:    time.Date(2024, 3, 1, 9, 0, 0, 123456000, time.UTC),
+		TransferredAt:  time.Date(2024, 3, 1, 9, 0, 0, 123456000, time.UTC),
+		LedgerSequence: &seq,
+	}
+
+	assert.Equal(t, "33ed3be7b75da00df448c6dcc5aa02029ec498b9908d07215e109827108cfca2", hex.EncodeToString(tr.ComputeHash()))
+
+	t.Run("covers every immutable field", func(t *testing.T) {
+		base := tr.ComputeHash()
+		changed := *tr
+		changed.ToOwner = "Carol"
+		assert.NotEqual(t, base, changed.ComputeHash())
+
+		changed = *tr
+		changed.PrevHash = []byte{1}
+		assert.NotEqual(t, base, changed.ComputeHash())
+
+		changed = *tr
+		changed.FromOwner, changed.ToOwner = "Ali", "ceBob"
+		assert.NotEqual(t, base, changed.ComputeHash())
+
+		changed = *tr
+		changed.ToOwnerID = uuid.New()
+		assert.NotEqual(t, base, changed.ComputeHash())
+
+		changed = *tr
+		changed.FromOwnerID, changed.ToOwnerID = tr.ToOwnerID, tr.FromOwnerID
+		assert.NotEqual(t, base, changed.ComputeHash())
+	})
+
+	t.Run("covers the class outside the default class", func(t *testing.T) {
+		base := tr.ComputeHash()
+		changed := *tr
+		changed.ClassID = uuid.New()
+		changed.DefaultClass = true
+		assert.Equal(t, base, changed.ComputeHash())
+
+		changed.DefaultClass = false
+		classB := changed.ComputeHash()
+		assert.NotEqual(t, base, classB)
+
+		changed.ClassID = uuid.New()
+		assert.NotEqual(t, classB, changed.ComputeHash())
+	})
+
+	t.Run("covers the review decision", func(t *testing.T) {
+		base := tr.ComputeHash()
+		changed := *tr
+		changed.Status = StatusRejected
+		assert.NotEqual(t, base, changed.ComputeHash())
+
+		reviewer := "Compliance"
+		changed = *tr
+		changed.ReviewedBy = &reviewer
+		assert.NotEqual(t, base, changed.ComputeHash())
+
+		changed = *tr
+		changed.TransferredAt = changed.TransferredAt.Add(time.Microsecond)
+		assert.NotEqual(t, base, changed.ComputeHash())
+
+		reason := "KYC"
+		changed = *tr
+		changed.RejectionReason = &reason
+		assert.NotEqual(t, base, changed.ComputeHash())
+	})
+}
+
+func TestLedgerHead_Append(t *testing.T) {
+	head, transfers := chain(t, 3)
+
+	assert.EqualValues(t, 3, head.Sequence)
+	assert.Equal(t, transfers[2].Hash, head.Hash)
+	assert.Nil(t, transfers[0].PrevHash)
+	for i, tr := range transfers {
+		require.NotNil(t, tr.LedgerSequence)
+		assert.EqualValues(t, i+1, *tr.LedgerSequence)
+		if i > 0 {
+			assert.Equal(t, transfers[i-1].Hash, tr.PrevHash)
+		}
+	}
+}
+
+func TestLedgerHead_RequireOpen(t *testing.T) {
+	assert.NoError(t, (&LedgerHead{FundStatus: "open"}).RequireOpen())
+
+	err := (&LedgerHead{FundStatus: "suspended"}).RequireOpen()
+	assert.ErrorIs(t, err, ErrFundNotOpen)
+	assert.ErrorContains(t, err, "suspended")
+}
+
+func TestLedgerVerifier(t *testing.T) {
+	t.Run("intact chain", func(t *testing.T) {
+		head, transfers := chain(t, 3)
+		checked, brk := verify(head, transfers)
+		assert.Nil(t, brk)
+		assert.Equal(t, 3, checked)
+	})
+
+	t.Run("empty chain", func(t *testing.T) {
+		checked, brk := verify(&LedgerHead{}, nil)
+		assert.Nil(t, brk)
+		assert.Zero(t, checked)
+	})
+
+	t.Run("edited content", func(t *testing.T) {
+		head, transfers := chain(t, 3)
+		transfers[1].Units = 1000
+		checked, brk := verify(head, transfers)
+		require.NotNil(t, brk)
+		assert.Equal(t, BreakHashMismatch, brk.Reason)
+		assert.EqualValues(t, 2, brk.Sequence)
+		assert.Equal(t, transfers[1].ID, *brk.TransferID)
+		assert.Equal(t, 1, checked)
+	})
+
+	t.Run("edited review decision", func(t *testing.T) {
+		head, transfers := chain(t, 3)
+		transfers[2].Status = StatusRejected
+		_, brk := verify(head, transfers)
+		require.NotNil(t, brk)
+		assert.Equal(t, BreakHashMismatch, brk.Reason)
+		assert.EqualValues(t, 3, brk.Sequence)
+	})
+
+	t.Run("deleted transfer", func(t *testing.T) {
+		head, transfers := chain(t, 3)
+		_, brk := verify(head, []*Transfer{transfers[0], transfers[2]})
+		require.NotNil(t, brk)
+		assert.Equal(t, BreakSequenceGap, brk.Reason)
+		assert.EqualValues(t, 2, brk.Sequence)
+	})
+
+	t.Run("relinked transfer", func(t *testing.T) {
+		head, transfers := chain(t, 3)
+		transfers[2].PrevHash = transfers[0].Hash
+		_, brk := verify(head, transfers)
+		require.NotNil(t, brk)
+		assert.Equal(t, BreakPrevHashMismatch, brk.Reason)
+		assert.Equal(t, transfers[1].Hash, brk.ExpectedHash)
+	})
+
+	t.Run("truncated tail", func(t *testing.T) {
+		head, transfers := chain(t, 3)
+		_, brk := verify(head, transfers[:2])
+		require.NotNil(t, brk)
+		assert.Equal(t, BreakHeadMismatch, brk.Reason)
+		assert.Equal(t, head.Hash, brk.ExpectedHash)
+		assert.Equal(t, transfers[1].Hash, brk.ActualHash)
+	})
+}
+
+func ptrUUID(id uuid.UUID) *uuid.UUID {
+	return &id
+}
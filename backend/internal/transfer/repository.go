@@ -15,6 +15,7 @@ type TransferList struct {
 	TotalCount int
 	Limit      int
 	Offset     int
+	NextCursor string
 }
 
 type Repository interface {
@@ -22,7 +23,35 @@ type Repository interface {
 
 	CreateTx(ctx context.Context, tx pgx.Tx, transfer *Transfer) error
 
-	FindByFundID(ctx context.Context, fundID uuid.UUID, params ListParams) (*TransferList, error)
+	FindByFundID(ctx context.Context, fundID uuid.UUID, filter Filter, params ListParams) (*TransferList, error)
+
+	StreamByFundID(ctx context.Context, fundID uuid.UUID, filter Filter, fn func(*Transfer) error) error
+
+	FindByOwnerID(ctx context.Context, ownerID uuid.UUID, params ListParams) (*TransferList, error)
+
+	FindByStatus(ctx context.Context, fundID uuid.UUID, status Status, params ListParams) (*TransferList, error)
 
 	FindByIdempotencyKey(ctx context.Context, tx pgx.Tx, key uuid.UUID) (*Transfer, error)
+
+	FindByIDForUpdateTx(ctx context.Context, tx pgx.Tx, fundID, id uuid.UUID) (*Transfer, error)
+
+	CreateBatchTx(ctx context.Context, tx pgx.Tx, batch *Batch) error
+
+	FindBatchByIdempotencyKey(ctx context.Context, tx pgx.Tx, key uuid.UUID) (*Batch, error)
+
+	UpdateReviewTx(ctx context.Context, tx pgx.Tx, transfer *Transfer) error
+
+	PendingUnitsTx(ctx context.Context, tx pgx.Tx, fundID, classID, ownerID uuid.UUID) (int, error)
+
+	FindApprovalThresholdTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID) (*int, error)
+
+	LockLedgerHeadTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID) (*LedgerHead, error)
+
+	UpdateLedgerHeadTx(ctx context.Context, tx pgx.Tx, head *LedgerHead) error
+
+	FindLedgerHeadTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID) (*LedgerHead, error)
+
+	FindLedgerPageTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, afterSequence int64, limit int) ([]*Transfer, error)
+
+	FindFirstUnchainedTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID) (*Transfer, error)
 }
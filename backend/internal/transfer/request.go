@@ -1,11 +1,50 @@
 package transfer
 
-import "github.com/google/uuid"
+import (
+	"strings"
+
+	"github.com/google/uuid"
+)
 
 type Request struct {
 	FundID         uuid.UUID
 	FromOwner      string
+	FromOwnerID    *uuid.UUID
 	ToOwner        string
+	ToOwnerID      *uuid.UUID
+	ClassID        *uuid.UUID
 	Units          int
 	IdempotencyKey *uuid.UUID
+	RequestedBy    string
+}
+
+func (r Request) matches(t *Transfer) bool {
+	return t.FundID == r.FundID &&
+		t.Units == r.Units &&
+		sameClass(r.ClassID, t) &&
+		sameOwner(r.FromOwnerID, r.FromOwner, t.FromOwnerID, t.FromOwner) &&
+		sameOwner(r.ToOwnerID, r.ToOwner, t.ToOwnerID, t.ToOwner)
+}
+
+func sameOwner(id *uuid.UUID, name string, recordedID uuid.UUID, recordedName string) bool {
+	if id != nil {
+		return *id == recordedID
+	}
+	return strings.TrimSpace(name) == recordedName
+}
+
+func sameClass(id *uuid.UUID, t *Transfer) bool {
+	if id != nil {
+		return *id == t.ClassID
+	}
+	return t.DefaultClass
+}
+
+const MaxReasonLength = 1000
+
+type Review struct {
+	FundID     uuid.UUID
+	TransferID uuid.UUID
+	Reviewer   string
+	Reason     *string
 }
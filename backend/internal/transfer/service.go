@@ -4,17 +4,27 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/arowden/augment-fund/internal/audit"
+	"github.com/arowden/augment-fund/internal/auth"
+	"github.com/arowden/augment-fund/internal/outbox"
+	"github.com/arowden/augment-fund/internal/owner"
 	"github.com/arowden/augment-fund/internal/ownership"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type Service struct {
 	repo          Repository
 	ownershipRepo ownership.Repository
+	ownerRepo     owner.Repository
 	pool          *pgxpool.Pool
 	validator     *Validator
+	outbox        outbox.Writer
+	audit         audit.Writer
 }
 
 type ServiceOption func(*Service)
@@ -27,10 +37,22 @@ func WithOwnershipRepository(r ownership.Repository) ServiceOption {
 	return func(s *Service) { s.ownershipRepo = r }
 }
 
+func WithOwnerRepository(r owner.Repository) ServiceOption {
+	return func(s *Service) { s.ownerRepo = r }
+}
+
 func WithPool(p *pgxpool.Pool) ServiceOption {
 	return func(s *Service) { s.pool = p }
 }
 
+func WithOutbox(w outbox.Writer) ServiceOption {
+	return func(s *Service) { s.outbox = w }
+}
+
+func WithAudit(w audit.Writer) ServiceOption {
+	return func(s *Service) { s.audit = w }
+}
+
 func NewService(opts ...ServiceOption) (*Service, error) {
 	s := &Service{validator: NewValidator()}
 	for _, opt := range opts {
@@ -42,6 +64,9 @@ func NewService(opts ...ServiceOption) (*Service, error) {
 	if s.ownershipRepo == nil {
 		return nil, errors.New("transfer: ownership repository is required")
 	}
+	if s.ownerRepo == nil {
+		return nil, errors.New("transfer: owner repository is required")
+	}
 	if s.pool == nil {
 		return nil, errors.New("transfer: pool is required")
 	}
@@ -65,48 +90,96 @@ func (s *Service) ExecuteTransfer(ctx context.Context, req Request) (*Transfer,
 			return nil, fmt.Errorf("check idempotency key: %w", err)
 		}
 		if existing != nil {
-			if existing.FundID != req.FundID ||
-				existing.FromOwner != req.FromOwner ||
-				existing.ToOwner != req.ToOwner ||
-				existing.Units != req.Units {
+			if !req.matches(existing) {
 				return nil, ErrDuplicateIdempotencyKey
 			}
 			return existing, nil
 		}
 	}
 
-	fromEntry, err := s.ownershipRepo.FindByFundAndOwnerForUpdateTx(ctx, tx, req.FundID, req.FromOwner)
+	head, err := s.repo.LockLedgerHeadTx(ctx, tx, req.FundID)
 	if err != nil {
-		if errors.Is(err, ownership.ErrOwnerNotFound) {
-			return nil, ErrOwnerNotFound
-		}
-		return nil, fmt.Errorf("lock from_owner: %w", err)
+		return nil, err
+	}
+	if err := head.RequireOpen(); err != nil {
+		return nil, err
 	}
 
-	if fromEntry.Units < req.Units {
-		return nil, ErrInsufficientUnits
+	class, err := s.resolveClass(ctx, tx, req.FundID, req.ClassID)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := s.ownershipRepo.DecrementUnitsTx(ctx, tx, fromEntry.ID, req.Units); err != nil {
-		return nil, fmt.Errorf("decrement from_owner: %w", err)
+	fromEntry, err := s.lockSender(ctx, tx, req.FundID, class.ID, req.FromOwnerID, req.FromOwner)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := s.ownershipRepo.IncrementOrCreateTx(ctx, tx, req.FundID, req.ToOwner, req.Units); err != nil {
-		return nil, fmt.Errorf("upsert to_owner: %w", err)
+	recipient, err := s.resolveOwner(ctx, tx, req.FundID, req.ToOwnerID, req.ToOwner)
+	if err != nil {
+		return nil, err
+	}
+	if recipient.ID == fromEntry.OwnerID {
+		return nil, ErrSelfTransfer
+	}
+
+	available, err := s.availableUnits(ctx, tx, fromEntry)
+	if err != nil {
+		return nil, err
+	}
+	if available < req.Units {
+		return nil, ErrInsufficientUnits
+	}
+
+	threshold, err := s.repo.FindApprovalThresholdTx(ctx, tx, req.FundID)
+	if err != nil {
+		return nil, err
 	}
 
 	transfer := &Transfer{
 		ID:             uuid.New(),
 		FundID:         req.FundID,
-		FromOwner:      req.FromOwner,
-		ToOwner:        req.ToOwner,
+		FromOwner:      fromEntry.OwnerName,
+		ToOwner:        recipient.LegalName,
+		FromOwnerID:    fromEntry.OwnerID,
+		ToOwnerID:      recipient.ID,
+		ClassID:        class.ID,
+		ClassName:      class.Name,
+		DefaultClass:   class.Default,
 		Units:          req.Units,
 		IdempotencyKey: req.IdempotencyKey,
+		RequestedBy:    requester(ctx, req.RequestedBy),
+		Status:         StatusApproved,
 	}
 
-	if err := s.repo.CreateTx(ctx, tx, transfer); err != nil {
+	if threshold != nil && req.Units > *threshold {
+		if transfer.RequestedBy == nil {
+			return nil, ErrInvalidRequester
+		}
+		transfer.Status = StatusPending
+	} else if err := s.settle(ctx, tx, fromEntry, recipient.ID, req.Units); err != nil {
+		return nil, err
+	}
+
+	if err := s.appendToLedger(ctx, tx, head, transfer); err != nil {
 		return nil, fmt.Errorf("record transfer: %w", err)
 	}
+	if err := s.repo.UpdateLedgerHeadTx(ctx, tx, head); err != nil {
+		return nil, err
+	}
+	if transfer.Status == StatusApproved {
+		if err := s.publishSettled(ctx, tx, transfer); err != nil {
+			return nil, err
+		}
+	}
+
+	operation, deltas := audit.OperationTransferExecute, transferDeltas(transfer, transfer.Units)
+	if transfer.Status == StatusPending {
+		operation, deltas = audit.OperationTransferRequest, transferDeltas(transfer, 0)
+	}
+	if err := s.recordAudit(ctx, tx, audit.NewEvent(ctx, operation).ForTransfer(transfer.ID), transfer.FundID, deltas, transferDetails(transfer)); err != nil {
+		return nil, err
+	}
 
 	if err := tx.Commit(ctx); err != nil {
 		return nil, fmt.Errorf("commit: %w", err)
@@ -115,6 +188,665 @@ func (s *Service) ExecuteTransfer(ctx context.Context, req Request) (*Transfer,
 	return transfer, nil
 }
 
-func (s *Service) ListTransfers(ctx context.Context, fundID uuid.UUID, params ListParams) (*TransferList, error) {
-	return s.repo.FindByFundID(ctx, fundID, params)
+func (s *Service) ExecuteBatch(ctx context.Context, req BatchRequest) (*Batch, error) {
+	if len(req.Legs) == 0 {
+		return nil, ErrEmptyBatch
+	}
+	if len(req.Legs) > MaxBatchLegs {
+		return nil, ErrTooManyLegs
+	}
+	for i, leg := range req.Legs {
+		legReq := Request{FundID: req.FundID, FromOwner: leg.FromOwner, FromOwnerID: leg.FromOwnerID, ToOwner: leg.ToOwner, ToOwnerID: leg.ToOwnerID, ClassID: leg.ClassID, Units: leg.Units}
+		if err := s.validator.ValidateBasic(legReq); err != nil {
+			return nil, &LegError{Index: i, Err: err}
+		}
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if req.IdempotencyKey != nil {
+		existing, err := s.repo.FindBatchByIdempotencyKey(ctx, tx, *req.IdempotencyKey)
+		if err != nil {
+			return nil, fmt.Errorf("check idempotency key: %w", err)
+		}
+		if existing != nil {
+			if !existing.matches(req) {
+				return nil, ErrDuplicateIdempotencyKey
+			}
+			return existing, nil
+		}
+	}
+
+	head, err := s.repo.LockLedgerHeadTx(ctx, tx, req.FundID)
+	if err != nil {
+		return nil, err
+	}
+	if err := head.RequireOpen(); err != nil {
+		return nil, err
+	}
+
+	threshold, err := s.repo.FindApprovalThresholdTx(ctx, tx, req.FundID)
+	if err != nil {
+		return nil, err
+	}
+	if threshold != nil {
+		for i, leg := range req.Legs {
+			if leg.Units > *threshold {
+				return nil, &LegError{Index: i, Err: ErrApprovalRequired}
+			}
+		}
+	}
+
+	legs := make([]resolvedLeg, len(req.Legs))
+	var defaultClass *ownership.ShareClass
+	classes := make(map[uuid.UUID]*ownership.ShareClass)
+	for i, leg := range req.Legs {
+		class := defaultClass
+		if leg.ClassID != nil {
+			class = classes[*leg.ClassID]
+		}
+		if class == nil {
+			var err error
+			if class, err = s.resolveClass(ctx, tx, req.FundID, leg.ClassID); err != nil {
+				return nil, &LegError{Index: i, Err: err}
+			}
+			if leg.ClassID == nil {
+				defaultClass = class
+			} else {
+				classes[class.ID] = class
+			}
+		}
+
+		from, err := s.resolveOwner(ctx, tx, req.FundID, leg.FromOwnerID, leg.FromOwner)
+		if err != nil {
+			return nil, &LegError{Index: i, Err: err}
+		}
+		to, err := s.resolveOwner(ctx, tx, req.FundID, leg.ToOwnerID, leg.ToOwner)
+		if err != nil {
+			return nil, &LegError{Index: i, Err: err}
+		}
+		if from.ID == to.ID {
+			return nil, &LegError{Index: i, Err: ErrSelfTransfer}
+		}
+		legs[i] = resolvedLeg{from: from, to: to, class: class, units: leg.Units}
+	}
+
+	entries := make(map[ownership.Holder]*ownership.Entry)
+	available := make(map[ownership.Holder]int)
+	for _, holder := range legHolders(legs) {
+		entry, err := s.ownershipRepo.FindByFundAndOwnerIDForUpdateTx(ctx, tx, req.FundID, &holder.ClassID, holder.OwnerID)
+		if errors.Is(err, ownership.ErrOwnerNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("lock owner %s: %w", holder.OwnerID, err)
+		}
+		units, err := s.availableUnits(ctx, tx, entry)
+		if err != nil {
+			return nil, err
+		}
+		entries[holder] = entry
+		available[holder] = units
+	}
+
+	batch := &Batch{
+		ID:             uuid.New(),
+		FundID:         req.FundID,
+		IdempotencyKey: req.IdempotencyKey,
+		Transfers:      make([]*Transfer, len(req.Legs)),
+	}
+	if err := s.repo.CreateBatchTx(ctx, tx, batch); err != nil {
+		return nil, fmt.Errorf("record batch: %w", err)
+	}
+
+	for i, leg := range legs {
+		from, ok := entries[leg.sender()]
+		if !ok {
+			return nil, &LegError{Index: i, Err: ErrOwnerNotFound}
+		}
+		if available[leg.sender()] < leg.units {
+			return nil, &LegError{Index: i, Err: ErrInsufficientUnits}
+		}
+
+		if err := s.settle(ctx, tx, from, leg.to.ID, leg.units); err != nil {
+			return nil, fmt.Errorf("leg %d: %w", i, err)
+		}
+		available[leg.sender()] -= leg.units
+		available[leg.recipient()] += leg.units
+
+		to, ok := entries[leg.recipient()]
+		if !ok {
+			var err error
+			to, err = s.ownershipRepo.FindByFundAndOwnerIDForUpdateTx(ctx, tx, req.FundID, &leg.class.ID, leg.to.ID)
+			if err != nil {
+				return nil, fmt.Errorf("leg %d: reload to_owner: %w", i, err)
+			}
+			entries[leg.recipient()] = to
+		}
+
+		index := i
+		t := &Transfer{
+			ID:           uuid.New(),
+			FundID:       req.FundID,
+			FromOwner:    from.OwnerName,
+			ToOwner:      to.OwnerName,
+			FromOwnerID:  from.OwnerID,
+			ToOwnerID:    to.OwnerID,
+			ClassID:      leg.class.ID,
+			ClassName:    leg.class.Name,
+			DefaultClass: leg.class.Default,
+			Units:        leg.units,
+			BatchID:      &batch.ID,
+			LegIndex:     &index,
+			Status:       StatusApproved,
+		}
+		if err := s.appendToLedger(ctx, tx, head, t); err != nil {
+			return nil, fmt.Errorf("leg %d: record transfer: %w", i, err)
+		}
+		if err := s.publishSettled(ctx, tx, t); err != nil {
+			return nil, fmt.Errorf("leg %d: %w", i, err)
+		}
+		batch.Transfers[i] = t
+	}
+
+	if err := s.repo.UpdateLedgerHeadTx(ctx, tx, head); err != nil {
+		return nil, err
+	}
+
+	deltas := make(map[ownership.Holder]int)
+	transferIDs := make([]uuid.UUID, len(batch.Transfers))
+	for i, t := range batch.Transfers {
+		deltas[ownership.Holder{OwnerID: t.FromOwnerID, ClassID: t.ClassID}] -= t.Units
+		deltas[ownership.Holder{OwnerID: t.ToOwnerID, ClassID: t.ClassID}] += t.Units
+		transferIDs[i] = t.ID
+	}
+	details := map[string]any{"batchId": batch.ID, "transferIds": transferIDs}
+	if err := s.recordAudit(ctx, tx, audit.NewEvent(ctx, audit.OperationTransferBatch), req.FundID, deltas, details); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+
+	return batch, nil
+}
+
+func (s *Service) ReverseTransfer(ctx context.Context, fundID, transferID uuid.UUID) (*Transfer, error) {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	head, err := s.repo.LockLedgerHeadTx(ctx, tx, fundID)
+	if err != nil {
+		if errors.Is(err, ErrFundNotFound) {
+			return nil, ErrTransferNotFound
+		}
+		return nil, err
+	}
+	if err := head.RequireOpen(); err != nil {
+		return nil, err
+	}
+
+	original, err := s.repo.FindByIDForUpdateTx(ctx, tx, fundID, transferID)
+	if err != nil {
+		return nil, err
+	}
+	if original.Status != StatusApproved {
+		return nil, ErrNotApproved
+	}
+	if original.ReversedByTransferID != nil {
+		return nil, ErrAlreadyReversed
+	}
+
+	ownerIDs := []uuid.UUID{original.FromOwnerID, original.ToOwnerID}
+	sortOwnerIDs(ownerIDs)
+	entries := make(map[uuid.UUID]*ownership.Entry, len(ownerIDs))
+	for _, ownerID := range ownerIDs {
+		entry, err := s.ownershipRepo.FindByFundAndOwnerIDForUpdateTx(ctx, tx, fundID, &original.ClassID, ownerID)
+		if errors.Is(err, ownership.ErrOwnerNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("lock owner %s: %w", ownerID, err)
+		}
+		entries[ownerID] = entry
+	}
+
+	recipient, ok := entries[original.ToOwnerID]
+	if !ok {
+		return nil, ErrInsufficientUnits
+	}
+	available, err := s.availableUnits(ctx, tx, recipient)
+	if err != nil {
+		return nil, err
+	}
+	if available < original.Units {
+		return nil, ErrInsufficientUnits
+	}
+
+	if err := s.settle(ctx, tx, recipient, original.FromOwnerID, original.Units); err != nil {
+		return nil, err
+	}
+
+	senderName := original.FromOwner
+	if sender, ok := entries[original.FromOwnerID]; ok {
+		senderName = sender.OwnerName
+	}
+	reversal := &Transfer{
+		ID:                 uuid.New(),
+		FundID:             fundID,
+		FromOwner:          recipient.OwnerName,
+		ToOwner:            senderName,
+		FromOwnerID:        original.ToOwnerID,
+		ToOwnerID:          original.FromOwnerID,
+		ClassID:            original.ClassID,
+		ClassName:          original.ClassName,
+		DefaultClass:       original.DefaultClass,
+		Units:              original.Units,
+		ReversesTransferID: &original.ID,
+		Status:             StatusApproved,
+	}
+	if err := s.appendToLedger(ctx, tx, head, reversal); err != nil {
+		return nil, fmt.Errorf("record reversal: %w", err)
+	}
+	if err := s.repo.UpdateLedgerHeadTx(ctx, tx, head); err != nil {
+		return nil, err
+	}
+	if err := s.publishSettled(ctx, tx, reversal); err != nil {
+		return nil, err
+	}
+	if err := s.recordAudit(ctx, tx, audit.NewEvent(ctx, audit.OperationTransferReverse).ForTransfer(reversal.ID), fundID, transferDeltas(reversal, reversal.Units), transferDetails(reversal)); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+
+	return reversal, nil
+}
+
+func (s *Service) ApproveTransfer(ctx context.Context, review Review) (*Transfer, error) {
+	return s.review(ctx, review, StatusApproved)
+}
+
+func (s *Service) RejectTransfer(ctx context.Context, review Review) (*Transfer, error) {
+	return s.review(ctx, review, StatusRejected)
+}
+
+func (s *Service) review(ctx context.Context, review Review, decision Status) (*Transfer, error) {
+	if p, ok := auth.PrincipalFromContext(ctx); ok {
+		review.Reviewer = p.Subject
+	}
+	if err := s.validator.ValidateReview(review); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	head, err := s.repo.LockLedgerHeadTx(ctx, tx, review.FundID)
+	if errors.Is(err, ErrFundNotFound) {
+		return nil, ErrTransferNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if decision == StatusApproved {
+		if err := head.RequireOpen(); err != nil {
+			return nil, err
+		}
+	}
+
+	t, err := s.repo.FindByIDForUpdateTx(ctx, tx, review.FundID, review.TransferID)
+	if err != nil {
+		return nil, err
+	}
+	if t.Status != StatusPending {
+		return nil, ErrNotPending
+	}
+	if decision == StatusApproved && t.RequestedBy == nil {
+		return nil, ErrUnknownRequester
+	}
+	reviewer := strings.TrimSpace(review.Reviewer)
+	if t.RequestedBy != nil && *t.RequestedBy == reviewer {
+		return nil, ErrSelfReview
+	}
+
+	if decision == StatusApproved {
+		fromEntry, err := s.lockSender(ctx, tx, t.FundID, t.ClassID, &t.FromOwnerID, "")
+		if err != nil {
+			return nil, err
+		}
+		if fromEntry.Units < t.Units {
+			return nil, ErrInsufficientUnits
+		}
+		if err := s.settle(ctx, tx, fromEntry, t.ToOwnerID, t.Units); err != nil {
+			return nil, err
+		}
+	}
+
+	reviewedAt := time.Now().UTC().Truncate(time.Microsecond)
+	t.Status = decision
+	t.ReviewedBy = &reviewer
+	t.ReviewedAt = &reviewedAt
+	if decision == StatusApproved {
+		t.TransferredAt = reviewedAt
+	} else {
+		t.RejectionReason = review.Reason
+	}
+	head.Append(t)
+	if err := s.repo.UpdateReviewTx(ctx, tx, t); err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateLedgerHeadTx(ctx, tx, head); err != nil {
+		return nil, err
+	}
+	if decision == StatusApproved {
+		if err := s.publishSettled(ctx, tx, t); err != nil {
+			return nil, err
+		}
+	}
+
+	operation, deltas := audit.OperationTransferApprove, transferDeltas(t, t.Units)
+	if decision == StatusRejected {
+		operation, deltas = audit.OperationTransferReject, transferDeltas(t, 0)
+	}
+	details := transferDetails(t)
+	details["reviewer"] = reviewer
+	if t.RejectionReason != nil {
+		details["reason"] = *t.RejectionReason
+	}
+	if err := s.recordAudit(ctx, tx, audit.NewEvent(ctx, operation).ForTransfer(t.ID), t.FundID, deltas, details); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+
+	return t, nil
+}
+
+func (s *Service) ListPendingTransfers(ctx context.Context, fundID uuid.UUID, params ListParams) (*TransferList, error) {
+	return s.repo.FindByStatus(ctx, fundID, StatusPending, params)
+}
+
+func (s *Service) VerifyLedger(ctx context.Context, fundID uuid.UUID) (*LedgerVerification, error) {
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	head, err := s.repo.FindLedgerHeadTx(ctx, tx, fundID)
+	if err != nil {
+		return nil, err
+	}
+
+	verifier := newLedgerVerifier(*head)
+	result := &LedgerVerification{Head: *head}
+	var after int64
+	for {
+		page, err := s.repo.FindLedgerPageTx(ctx, tx, fundID, after, ledgerPageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range page {
+			if brk := verifier.check(t); brk != nil {
+				result.Checked = verifier.checked
+				result.Break = brk
+				return result, nil
+			}
+			after = *t.LedgerSequence
+		}
+		if len(page) < ledgerPageSize {
+			break
+		}
+	}
+	result.Checked = verifier.checked
+
+	unchained, err := s.repo.FindFirstUnchainedTx(ctx, tx, fundID)
+	if err != nil {
+		return nil, err
+	}
+	if unchained != nil {
+		result.Break = &LedgerBreak{TransferID: &unchained.ID, Reason: BreakUnchained, ActualHash: unchained.Hash}
+		if unchained.LedgerSequence != nil {
+			result.Break.Sequence = *unchained.LedgerSequence
+		}
+		return result, nil
+	}
+
+	result.Break = verifier.finish()
+	return result, nil
+}
+
+func (s *Service) appendToLedger(ctx context.Context, tx pgx.Tx, head *LedgerHead, t *Transfer) error {
+	if t.RequestedBy == nil {
+		t.RequestedBy = requester(ctx, "")
+	}
+	if t.Status == "" {
+		t.Status = StatusApproved
+	}
+	t.RequestedAt = time.Now().UTC().Truncate(time.Microsecond)
+	t.TransferredAt = t.RequestedAt
+	if t.Status != StatusPending {
+		head.Append(t)
+	}
+	return s.repo.CreateTx(ctx, tx, t)
+}
+
+func requester(ctx context.Context, name string) *string {
+	if p, ok := auth.PrincipalFromContext(ctx); ok {
+		return &p.Subject
+	}
+	if name = strings.TrimSpace(name); name != "" {
+		return &name
+	}
+	return nil
+}
+
+func (s *Service) resolveClass(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, classID *uuid.UUID) (*ownership.ShareClass, error) {
+	class, err := s.ownershipRepo.FindShareClassTx(ctx, tx, fundID, classID)
+	if errors.Is(err, ownership.ErrShareClassNotFound) {
+		return nil, ErrShareClassNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("find share class: %w", err)
+	}
+	return class, nil
+}
+
+func (s *Service) lockSender(ctx context.Context, tx pgx.Tx, fundID, classID uuid.UUID, ownerID *uuid.UUID, name string) (*ownership.Entry, error) {
+	var (
+		entry *ownership.Entry
+		err   error
+	)
+	if ownerID != nil {
+		entry, err = s.ownershipRepo.FindByFundAndOwnerIDForUpdateTx(ctx, tx, fundID, &classID, *ownerID)
+	} else {
+		entry, err = s.ownershipRepo.FindByFundAndOwnerForUpdateTx(ctx, tx, fundID, &classID, strings.TrimSpace(name))
+	}
+	if errors.Is(err, ownership.ErrOwnerNotFound) {
+		return nil, ErrOwnerNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("lock from_owner: %w", err)
+	}
+	return entry, nil
+}
+
+func (s *Service) resolveOwner(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, ownerID *uuid.UUID, name string) (*owner.Owner, error) {
+	var (
+		o   *owner.Owner
+		err error
+	)
+	if ownerID != nil {
+		o, err = s.ownerRepo.FindByIDTx(ctx, tx, *ownerID)
+	} else {
+		o, err = s.ownerRepo.ResolveTx(ctx, tx, fundID, strings.TrimSpace(name))
+	}
+	if errors.Is(err, owner.ErrNotFound) {
+		return nil, ErrOwnerNotFound
+	}
+	if errors.Is(err, owner.ErrAmbiguousName) {
+		return nil, fmt.Errorf("%w: %w", ErrOwnerConflict, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("resolve owner: %w", err)
+	}
+	return o, nil
+}
+
+func (s *Service) availableUnits(ctx context.Context, tx pgx.Tx, entry *ownership.Entry) (int, error) {
+	reserved, err := s.repo.PendingUnitsTx(ctx, tx, entry.FundID, entry.ClassID, entry.OwnerID)
+	if err != nil {
+		return 0, err
+	}
+	return entry.Units - reserved, nil
+}
+
+func (s *Service) settle(ctx context.Context, tx pgx.Tx, from *ownership.Entry, toOwnerID uuid.UUID, units int) error {
+	if err := s.ownershipRepo.DecrementUnitsTx(ctx, tx, from.ID, units); err != nil {
+		return fmt.Errorf("decrement from_owner: %w", err)
+	}
+	from.Units -= units
+
+	if err := s.ownershipRepo.IncrementOrCreateTx(ctx, tx, from.FundID, &from.ClassID, toOwnerID, units); err != nil {
+		if errors.Is(err, ownership.ErrOwnerNameTaken) {
+			return fmt.Errorf("%w: %w", ErrOwnerConflict, err)
+		}
+		return fmt.Errorf("upsert to_owner: %w", err)
+	}
+	return nil
+}
+
+func (s *Service) publishSettled(ctx context.Context, tx pgx.Tx, t *Transfer) error {
+	if s.outbox == nil {
+		return nil
+	}
+	if err := s.publish(ctx, tx, outbox.EventTransferExecuted, t.FundID, newExecutedEvent(t)); err != nil {
+		return err
+	}
+
+	changes := []struct {
+		ownerID uuid.UUID
+		delta   int
+	}{
+		{ownerID: t.FromOwnerID, delta: -t.Units},
+		{ownerID: t.ToOwnerID, delta: t.Units},
+	}
+	for _, c := range changes {
+		entry, err := s.ownershipRepo.FindByFundAndOwnerIDForUpdateTx(ctx, tx, t.FundID, &t.ClassID, c.ownerID)
+		if err != nil {
+			return fmt.Errorf("read balance of owner %s: %w", c.ownerID, err)
+		}
+		payload := balanceChangedEvent{
+			OwnerID:    entry.OwnerID,
+			OwnerName:  entry.OwnerName,
+			ClassID:    entry.ClassID,
+			Units:      entry.Units,
+			Delta:      c.delta,
+			TransferID: t.ID,
+		}
+		if err := s.publish(ctx, tx, outbox.EventBalanceChanged, t.FundID, payload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Service) publish(ctx context.Context, tx pgx.Tx, eventType string, fundID uuid.UUID, payload any) error {
+	event, err := outbox.NewEvent(eventType, fundID, payload)
+	if err != nil {
+		return err
+	}
+	return s.outbox.AppendTx(ctx, tx, event)
+}
+
+func (s *Service) recordAudit(ctx context.Context, tx pgx.Tx, event *audit.Event, fundID uuid.UUID, deltas map[ownership.Holder]int, details map[string]any) error {
+	if s.audit == nil {
+		return nil
+	}
+
+	holders := make([]ownership.Holder, 0, len(deltas))
+	classes := make(map[uuid.UUID]struct{})
+	for holder := range deltas {
+		holders = append(holders, holder)
+		classes[holder.ClassID] = struct{}{}
+	}
+	sortHolders(holders)
+
+	event.ForFund(fundID)
+	event.Before = make(map[string]int, len(holders))
+	event.After = make(map[string]int, len(holders))
+	event.Details = details
+	for _, holder := range holders {
+		name, units := holder.OwnerID.String(), 0
+		entry, err := s.ownershipRepo.FindByFundAndOwnerIDForUpdateTx(ctx, tx, fundID, &holder.ClassID, holder.OwnerID)
+		switch {
+		case err == nil:
+			name, units = entry.OwnerName, entry.Units
+			if len(classes) > 1 {
+				name = fmt.Sprintf("%s (%s)", entry.OwnerName, entry.ClassName)
+			}
+		case !errors.Is(err, ownership.ErrOwnerNotFound):
+			return fmt.Errorf("read balance of owner %s: %w", holder.OwnerID, err)
+		}
+		event.After[name] = units
+		event.Before[name] = units - deltas[holder]
+	}
+	return s.audit.AppendTx(ctx, tx, event)
+}
+
+func transferDeltas(t *Transfer, units int) map[ownership.Holder]int {
+	return map[ownership.Holder]int{
+		{OwnerID: t.FromOwnerID, ClassID: t.ClassID}: -units,
+		{OwnerID: t.ToOwnerID, ClassID: t.ClassID}:   units,
+	}
+}
+
+func transferDetails(t *Transfer) map[string]any {
+	details := map[string]any{
+		"fromOwner":   t.FromOwner,
+		"fromOwnerId": t.FromOwnerID,
+		"toOwner":     t.ToOwner,
+		"toOwnerId":   t.ToOwnerID,
+		"classId":     t.ClassID,
+		"units":       t.Units,
+		"status":      string(t.Status),
+	}
+	if t.ReversesTransferID != nil {
+		details["reversesTransferId"] = *t.ReversesTransferID
+	}
+	return details
+}
+
+func (s *Service) ListTransfers(ctx context.Context, fundID uuid.UUID, filter Filter, params ListParams) (*TransferList, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+	return s.repo.FindByFundID(ctx, fundID, filter, params)
+}
+
+func (s *Service) ExportTransfers(ctx context.Context, fundID uuid.UUID, filter Filter, fn func(*Transfer) error) error {
+	if err := filter.Validate(); err != nil {
+		return err
+	}
+	return s.repo.StreamByFundID(ctx, fundID, filter, fn)
+}
+
+func (s *Service) ListOwnerTransfers(ctx context.Context, ownerID uuid.UUID, params ListParams) (*TransferList, error) {
+	return s.repo.FindByOwnerID(ctx, ownerID, params)
 }
@@ -1,10 +1,14 @@
 package transfer
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"sort"
 	"testing"
+	"time"
 
+	"github.com/arowden/augment-fund/internal/owner"
 	"github.com/arowden/augment-fund/internal/ownership"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -13,19 +17,31 @@ import (
 )
 
 type mockRepository struct {
-	findByFundIDFunc         func(ctx context.Context, fundID uuid.UUID, params ListParams) (*TransferList, error)
+	findByFundIDFunc         func(ctx context.Context, fundID uuid.UUID, filter Filter, params ListParams) (*TransferList, error)
 	findByIdempotencyKeyFunc func(ctx context.Context, tx pgx.Tx, key uuid.UUID) (*Transfer, error)
 	createFunc               func(ctx context.Context, t *Transfer) error
 	createTxFunc             func(ctx context.Context, tx pgx.Tx, t *Transfer) error
+	streamByFundIDFunc       func(ctx context.Context, fundID uuid.UUID, filter Filter, fn func(*Transfer) error) error
 }
 
-func (m *mockRepository) FindByFundID(ctx context.Context, fundID uuid.UUID, params ListParams) (*TransferList, error) {
+func (m *mockRepository) FindByFundID(ctx context.Context, fundID uuid.UUID, filter Filter, params ListParams) (*TransferList, error) {
 	if m.findByFundIDFunc != nil {
-		return m.findByFundIDFunc(ctx, fundID, params)
+		return m.findByFundIDFunc(ctx, fundID, filter, params)
 	}
 	return &TransferList{Transfers: []*Transfer{}}, nil
 }
 
+func (m *mockRepository) StreamByFundID(ctx context.Context, fundID uuid.UUID, filter Filter, fn func(*Transfer) error) error {
+	if m.streamByFundIDFunc != nil {
+		return m.streamByFundIDFunc(ctx, fundID, filter, fn)
+	}
+	return nil
+}
+
+func (m *mockRepository) FindByOwnerID(ctx context.Context, ownerID uuid.UUID, params ListParams) (*TransferList, error) {
+	return &TransferList{Transfers: []*Transfer{}}, nil
+}
+
 func (m *mockRepository) FindByIdempotencyKey(ctx context.Context, tx pgx.Tx, key uuid.UUID) (*Transfer, error) {
 	if m.findByIdempotencyKeyFunc != nil {
 		return m.findByIdempotencyKeyFunc(ctx, tx, key)
@@ -47,6 +63,54 @@ func (m *mockRepository) CreateTx(ctx context.Context, tx pgx.Tx, t *Transfer) e
 	return nil
 }
 
+func (m *mockRepository) CreateBatchTx(ctx context.Context, tx pgx.Tx, batch *Batch) error {
+	return nil
+}
+
+func (m *mockRepository) FindBatchByIdempotencyKey(ctx context.Context, tx pgx.Tx, key uuid.UUID) (*Batch, error) {
+	return nil, nil
+}
+
+func (m *mockRepository) FindByIDForUpdateTx(ctx context.Context, tx pgx.Tx, fundID, id uuid.UUID) (*Transfer, error) {
+	return nil, ErrTransferNotFound
+}
+
+func (m *mockRepository) FindByStatus(ctx context.Context, fundID uuid.UUID, status Status, params ListParams) (*TransferList, error) {
+	return &TransferList{Transfers: []*Transfer{}}, nil
+}
+
+func (m *mockRepository) UpdateReviewTx(ctx context.Context, tx pgx.Tx, transfer *Transfer) error {
+	return nil
+}
+
+func (m *mockRepository) PendingUnitsTx(ctx context.Context, tx pgx.Tx, fundID, classID, ownerID uuid.UUID) (int, error) {
+	return 0, nil
+}
+
+func (m *mockRepository) FindApprovalThresholdTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID) (*int, error) {
+	return nil, nil
+}
+
+func (m *mockRepository) LockLedgerHeadTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID) (*LedgerHead, error) {
+	return &LedgerHead{FundID: fundID}, nil
+}
+
+func (m *mockRepository) UpdateLedgerHeadTx(ctx context.Context, tx pgx.Tx, head *LedgerHead) error {
+	return nil
+}
+
+func (m *mockRepository) FindLedgerHeadTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID) (*LedgerHead, error) {
+	return &LedgerHead{FundID: fundID}, nil
+}
+
+func (m *mockRepository) FindLedgerPageTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, afterSequence int64, limit int) ([]*Transfer, error) {
+	return nil, nil
+}
+
+func (m *mockRepository) FindFirstUnchainedTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID) (*Transfer, error) {
+	return nil, nil
+}
+
 type mockOwnershipRepository struct{}
 
 func (m *mockOwnershipRepository) Create(ctx context.Context, entry *ownership.Entry) error {
@@ -61,11 +125,23 @@ func (m *mockOwnershipRepository) FindByFundID(ctx context.Context, fundID uuid.
 	return nil, nil
 }
 
-func (m *mockOwnershipRepository) FindByFundAndOwner(ctx context.Context, fundID uuid.UUID, ownerName string) (*ownership.Entry, error) {
+func (m *mockOwnershipRepository) StreamByFundID(ctx context.Context, fundID uuid.UUID, fn func(*ownership.Entry) error) error {
+	return nil
+}
+
+func (m *mockOwnershipRepository) FindHoldingsByOwnerID(ctx context.Context, ownerID uuid.UUID) ([]*ownership.Holding, error) {
+	return nil, nil
+}
+
+func (m *mockOwnershipRepository) FindByFundAndOwner(ctx context.Context, fundID uuid.UUID, classID *uuid.UUID, ownerName string) (*ownership.Entry, error) {
+	return nil, nil
+}
+
+func (m *mockOwnershipRepository) FindByFundAndOwnerForUpdateTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, classID *uuid.UUID, ownerName string) (*ownership.Entry, error) {
 	return nil, nil
 }
 
-func (m *mockOwnershipRepository) FindByFundAndOwnerForUpdateTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, ownerName string) (*ownership.Entry, error) {
+func (m *mockOwnershipRepository) FindByFundAndOwnerIDForUpdateTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, classID *uuid.UUID, ownerID uuid.UUID) (*ownership.Entry, error) {
 	return nil, nil
 }
 
@@ -73,7 +149,7 @@ func (m *mockOwnershipRepository) DecrementUnitsTx(ctx context.Context, tx pgx.T
 	return nil
 }
 
-func (m *mockOwnershipRepository) IncrementOrCreateTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, ownerName string, units int) error {
+func (m *mockOwnershipRepository) IncrementOrCreateTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, classID *uuid.UUID, ownerID uuid.UUID, units int) error {
 	return nil
 }
 
@@ -85,6 +161,52 @@ func (m *mockOwnershipRepository) UpsertTx(ctx context.Context, tx pgx.Tx, entry
 	return nil
 }
 
+func (m *mockOwnershipRepository) FindLedger(ctx context.Context, fundID uuid.UUID, until time.Time) (*ownership.Ledger, error) {
+	return nil, ownership.ErrNotFound
+}
+
+func (m *mockOwnershipRepository) CreateShareClass(ctx context.Context, class *ownership.ShareClass) error {
+	return nil
+}
+
+func (m *mockOwnershipRepository) ListShareClasses(ctx context.Context, fundID uuid.UUID) ([]*ownership.ShareClass, error) {
+	return []*ownership.ShareClass{}, nil
+}
+
+func (m *mockOwnershipRepository) FindShareClassTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, classID *uuid.UUID) (*ownership.ShareClass, error) {
+	return nil, ownership.ErrShareClassNotFound
+}
+
+type mockOwnerRepository struct{}
+
+func (m *mockOwnerRepository) Create(ctx context.Context, o *owner.Owner) error {
+	return nil
+}
+
+func (m *mockOwnerRepository) CreateTx(ctx context.Context, tx pgx.Tx, o *owner.Owner) error {
+	return nil
+}
+
+func (m *mockOwnerRepository) FindByID(ctx context.Context, id uuid.UUID) (*owner.Owner, error) {
+	return nil, owner.NotFoundError(id)
+}
+
+func (m *mockOwnerRepository) FindByIDTx(ctx context.Context, tx pgx.Tx, id uuid.UUID) (*owner.Owner, error) {
+	return nil, owner.NotFoundError(id)
+}
+
+func (m *mockOwnerRepository) List(ctx context.Context, params owner.ListParams) (*owner.ListResult, error) {
+	return &owner.ListResult{}, nil
+}
+
+func (m *mockOwnerRepository) Update(ctx context.Context, o *owner.Owner) error {
+	return nil
+}
+
+func (m *mockOwnerRepository) ResolveTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, legalName string) (*owner.Owner, error) {
+	return owner.NewOwner(legalName, owner.TypeIndividual, nil)
+}
+
 func TestNewService(t *testing.T) {
 	t.Run("returns error when repository is nil", func(t *testing.T) {
 		svc, err := NewService()
@@ -101,12 +223,23 @@ func TestNewService(t *testing.T) {
 		assert.Contains(t, err.Error(), "ownership repository is required")
 	})
 
+	t.Run("returns error when owner repository is nil", func(t *testing.T) {
+		svc, err := NewService(
+			WithRepository(&mockRepository{}),
+			WithOwnershipRepository(&mockOwnershipRepository{}),
+		)
+		assert.Nil(t, svc)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "owner repository is required")
+	})
+
 	t.Run("returns error when pool is nil", func(t *testing.T) {
 		repo := &mockRepository{}
 		ownershipRepo := &mockOwnershipRepository{}
 		svc, err := NewService(
 			WithRepository(repo),
 			WithOwnershipRepository(ownershipRepo),
+			WithOwnerRepository(&mockOwnerRepository{}),
 		)
 		assert.Nil(t, svc)
 		assert.Error(t, err)
@@ -187,6 +320,75 @@ func TestService_ExecuteTransfer_Validation(t *testing.T) {
 	})
 }
 
+func TestService_ExecuteBatch_Validation(t *testing.T) {
+	svc := &Service{validator: NewValidator()}
+	fundID := uuid.New()
+
+	t.Run("returns error for empty batch", func(t *testing.T) {
+		_, err := svc.ExecuteBatch(context.Background(), BatchRequest{FundID: fundID})
+		assert.ErrorIs(t, err, ErrEmptyBatch)
+	})
+
+	t.Run("returns error for too many legs", func(t *testing.T) {
+		legs := make([]Leg, MaxBatchLegs+1)
+		for i := range legs {
+			legs[i] = Leg{FromOwner: "Alice", ToOwner: "Bob", Units: 1}
+		}
+		_, err := svc.ExecuteBatch(context.Background(), BatchRequest{FundID: fundID, Legs: legs})
+		assert.ErrorIs(t, err, ErrTooManyLegs)
+	})
+
+	t.Run("reports index of first invalid leg", func(t *testing.T) {
+		_, err := svc.ExecuteBatch(context.Background(), BatchRequest{
+			FundID: fundID,
+			Legs: []Leg{
+				{FromOwner: "Alice", ToOwner: "Bob", Units: 10},
+				{FromOwner: "Alice", ToOwner: "Alice", Units: 10},
+				{FromOwner: "Alice", ToOwner: "Carol", Units: 0},
+			},
+		})
+		var legErr *LegError
+		require.ErrorAs(t, err, &legErr)
+		assert.Equal(t, 1, legErr.Index)
+		assert.ErrorIs(t, err, ErrSelfTransfer)
+	})
+}
+
+func TestService_Review_Validation(t *testing.T) {
+	svc := &Service{validator: NewValidator()}
+
+	_, err := svc.ApproveTransfer(context.Background(), Review{FundID: uuid.New(), TransferID: uuid.New()})
+	assert.ErrorIs(t, err, ErrInvalidReviewer)
+
+	_, err = svc.RejectTransfer(context.Background(), Review{FundID: uuid.New(), TransferID: uuid.New(), Reviewer: " "})
+	assert.ErrorIs(t, err, ErrInvalidReviewer)
+}
+
+func TestLegHolders(t *testing.T) {
+	seller, amy, bob := &owner.Owner{ID: uuid.New()}, &owner.Owner{ID: uuid.New()}, &owner.Owner{ID: uuid.New()}
+	classA, classB := &ownership.ShareClass{ID: uuid.New()}, &ownership.ShareClass{ID: uuid.New()}
+	holders := legHolders([]resolvedLeg{
+		{from: seller, to: amy, class: classA},
+		{from: seller, to: bob, class: classA},
+		{from: amy, to: bob, class: classA},
+		{from: seller, to: amy, class: classB},
+	})
+
+	assert.ElementsMatch(t, []ownership.Holder{
+		{OwnerID: seller.ID, ClassID: classA.ID},
+		{OwnerID: amy.ID, ClassID: classA.ID},
+		{OwnerID: bob.ID, ClassID: classA.ID},
+		{OwnerID: seller.ID, ClassID: classB.ID},
+		{OwnerID: amy.ID, ClassID: classB.ID},
+	}, holders)
+	assert.True(t, sort.SliceIsSorted(holders, func(i, j int) bool {
+		if c := bytes.Compare(holders[i].OwnerID[:], holders[j].OwnerID[:]); c != 0 {
+			return c < 0
+		}
+		return bytes.Compare(holders[i].ClassID[:], holders[j].ClassID[:]) < 0
+	}))
+}
+
 func TestService_ListTransfers(t *testing.T) {
 
 	fundID := uuid.New()
@@ -201,14 +403,14 @@ func TestService_ListTransfers(t *testing.T) {
 
 	t.Run("returns transfer list from repository", func(t *testing.T) {
 		repo := &mockRepository{
-			findByFundIDFunc: func(ctx context.Context, fID uuid.UUID, params ListParams) (*TransferList, error) {
+			findByFundIDFunc: func(ctx context.Context, fID uuid.UUID, filter Filter, params ListParams) (*TransferList, error) {
 				return expectedList, nil
 			},
 		}
 
 		svc := &Service{repo: repo, validator: NewValidator()}
 
-		result, err := svc.ListTransfers(context.Background(), fundID, ListParams{})
+		result, err := svc.ListTransfers(context.Background(), fundID, Filter{}, ListParams{})
 		require.NoError(t, err)
 		assert.Equal(t, expectedList, result)
 	})
@@ -216,7 +418,7 @@ func TestService_ListTransfers(t *testing.T) {
 	t.Run("passes params to repository", func(t *testing.T) {
 		var receivedParams ListParams
 		repo := &mockRepository{
-			findByFundIDFunc: func(ctx context.Context, fID uuid.UUID, params ListParams) (*TransferList, error) {
+			findByFundIDFunc: func(ctx context.Context, fID uuid.UUID, filter Filter, params ListParams) (*TransferList, error) {
 				receivedParams = params
 				return &TransferList{Transfers: []*Transfer{}}, nil
 			},
@@ -225,23 +427,122 @@ func TestService_ListTransfers(t *testing.T) {
 		svc := &Service{repo: repo, validator: NewValidator()}
 		params := ListParams{Limit: 50, Offset: 10}
 
-		_, err := svc.ListTransfers(context.Background(), fundID, params)
+		_, err := svc.ListTransfers(context.Background(), fundID, Filter{}, params)
 		require.NoError(t, err)
 		assert.Equal(t, params, receivedParams)
 	})
 
+	t.Run("passes filter to repository", func(t *testing.T) {
+		var receivedFilter Filter
+		repo := &mockRepository{
+			findByFundIDFunc: func(ctx context.Context, fID uuid.UUID, filter Filter, params ListParams) (*TransferList, error) {
+				receivedFilter = filter
+				return &TransferList{Transfers: []*Transfer{}}, nil
+			},
+		}
+
+		svc := &Service{repo: repo, validator: NewValidator()}
+		ownerID := uuid.New()
+		minUnits := 100
+		filter := Filter{OwnerID: &ownerID, MinUnits: &minUnits, Sort: SortDescending}
+
+		_, err := svc.ListTransfers(context.Background(), fundID, filter, ListParams{})
+		require.NoError(t, err)
+		assert.Equal(t, filter, receivedFilter)
+	})
+
+	t.Run("rejects an invalid filter without querying", func(t *testing.T) {
+		repo := &mockRepository{
+			findByFundIDFunc: func(ctx context.Context, fID uuid.UUID, filter Filter, params ListParams) (*TransferList, error) {
+				t.Fatal("repository should not be called")
+				return nil, nil
+			},
+		}
+
+		svc := &Service{repo: repo, validator: NewValidator()}
+		minUnits, maxUnits := 500, 100
+
+		_, err := svc.ListTransfers(context.Background(), fundID, Filter{MinUnits: &minUnits, MaxUnits: &maxUnits}, ListParams{})
+		assert.ErrorIs(t, err, ErrInvalidFilter)
+	})
+
 	t.Run("propagates repository error", func(t *testing.T) {
 		repoErr := errors.New("database error")
 		repo := &mockRepository{
-			findByFundIDFunc: func(ctx context.Context, fID uuid.UUID, params ListParams) (*TransferList, error) {
+			findByFundIDFunc: func(ctx context.Context, fID uuid.UUID, filter Filter, params ListParams) (*TransferList, error) {
 				return nil, repoErr
 			},
 		}
 
 		svc := &Service{repo: repo, validator: NewValidator()}
 
-		result, err := svc.ListTransfers(context.Background(), fundID, ListParams{})
+		result, err := svc.ListTransfers(context.Background(), fundID, Filter{}, ListParams{})
 		assert.Nil(t, result)
 		assert.Equal(t, repoErr, err)
 	})
 }
+
+func TestService_ExportTransfers(t *testing.T) {
+	fundID := uuid.New()
+
+	t.Run("streams every transfer from repository", func(t *testing.T) {
+		rows := []*Transfer{
+			{ID: uuid.New(), FundID: fundID, FromOwner: "Alice", ToOwner: "Bob", Units: 100},
+			{ID: uuid.New(), FundID: fundID, FromOwner: "Bob", ToOwner: "Carol", Units: 40},
+		}
+		var receivedFilter Filter
+		repo := &mockRepository{
+			streamByFundIDFunc: func(ctx context.Context, fID uuid.UUID, filter Filter, fn func(*Transfer) error) error {
+				receivedFilter = filter
+				for _, r := range rows {
+					if err := fn(r); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		}
+
+		svc := &Service{repo: repo, validator: NewValidator()}
+		filter := Filter{Sort: SortDescending}
+
+		var got []*Transfer
+		err := svc.ExportTransfers(context.Background(), fundID, filter, func(t *Transfer) error {
+			got = append(got, t)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, rows, got)
+		assert.Equal(t, filter, receivedFilter)
+	})
+
+	t.Run("stops when the callback fails", func(t *testing.T) {
+		writeErr := errors.New("client gone")
+		repo := &mockRepository{
+			streamByFundIDFunc: func(ctx context.Context, fID uuid.UUID, filter Filter, fn func(*Transfer) error) error {
+				return fn(&Transfer{ID: uuid.New()})
+			},
+		}
+
+		svc := &Service{repo: repo, validator: NewValidator()}
+
+		err := svc.ExportTransfers(context.Background(), fundID, Filter{}, func(*Transfer) error { return writeErr })
+		assert.ErrorIs(t, err, writeErr)
+	})
+
+	t.Run("rejects an invalid filter without querying", func(t *testing.T) {
+		repo := &mockRepository{
+			streamByFundIDFunc: func(ctx context.Context, fID uuid.UUID, filter Filter, fn func(*Transfer) error) error {
+				t.Fatal("repository should not be called")
+				return nil
+			},
+		}
+
+		svc := &Service{repo: repo, validator: NewValidator()}
+		since := time.Now()
+		until := since.Add(-time.Hour)
+
+		err := svc.ExportTransfers(context.Background(), fundID, Filter{Since: &since, Until: &until}, func(*Transfer) error { return nil })
+		assert.ErrorIs(t, err, ErrInvalidFilter)
+	})
+}
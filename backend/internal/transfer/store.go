@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/arowden/augment-fund/internal/validation"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -16,6 +18,20 @@ type DB interface {
 	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
 }
 
+const transferColumns = `t.id, t.fund_id, t.from_owner, t.to_owner, t.from_owner_id, t.to_owner_id,
+		t.class_id, c.name AS class_name, c.is_default AS class_default, t.units, t.idempotency_key, t.transferred_at,
+		t.batch_id, t.leg_index, t.reverses_transfer_id,
+		(SELECT r.id FROM transfers r WHERE r.reverses_transfer_id = t.id) AS reversed_by_transfer_id,
+		t.status, t.requested_at, t.requested_by, t.reviewed_by, t.reviewed_at, t.rejection_reason,
+		t.ledger_sequence, t.prev_hash, t.hash`
+
+func optionalClassID(id uuid.UUID) *uuid.UUID {
+	if id == uuid.Nil {
+		return nil
+	}
+	return &id
+}
+
 type Store struct {
 	db DB
 }
@@ -41,37 +57,179 @@ func (s *Store) create(ctx context.Context, db DB, transfer *Transfer) error {
 	}
 
 	const query = `
-		INSERT INTO transfers (id, fund_id, from_owner, to_owner, units, idempotency_key, transferred_at)
-		VALUES ($1, $2, $3, $4, $5, $6, NOW())
-		RETURNING transferred_at
+		INSERT INTO transfers (id, fund_id, from_owner, to_owner, from_owner_id, to_owner_id, units, idempotency_key, batch_id, leg_index,
+			reverses_transfer_id, status, ledger_sequence, prev_hash, hash, requested_at, transferred_at, class_id, requested_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, COALESCE($16, NOW()), COALESCE($19, NOW()), $17, $18)
+		RETURNING requested_at, transferred_at, class_id
 	`
+	if transfer.Status == "" {
+		transfer.Status = StatusApproved
+	}
+	var requestedAt, transferredAt *time.Time
+	if !transfer.RequestedAt.IsZero() {
+		requestedAt = &transfer.RequestedAt
+	}
+	if !transfer.TransferredAt.IsZero() {
+		transferredAt = &transfer.TransferredAt
+	}
 	err := db.QueryRow(ctx, query,
 		transfer.ID,
 		transfer.FundID,
 		transfer.FromOwner,
 		transfer.ToOwner,
+		transfer.FromOwnerID,
+		transfer.ToOwnerID,
 		transfer.Units,
 		transfer.IdempotencyKey,
-	).Scan(&transfer.TransferredAt)
+		transfer.BatchID,
+		transfer.LegIndex,
+		transfer.ReversesTransferID,
+		transfer.Status,
+		transfer.LedgerSequence,
+		transfer.PrevHash,
+		transfer.Hash,
+		requestedAt,
+		optionalClassID(transfer.ClassID),
+		transfer.RequestedBy,
+		transferredAt,
+	).Scan(&transfer.RequestedAt, &transfer.TransferredAt, &transfer.ClassID)
 	if err != nil {
 		return fmt.Errorf("create transfer %s: %w", transfer.ID, err)
 	}
 	return nil
 }
 
-func (s *Store) FindByFundID(ctx context.Context, fundID uuid.UUID, params ListParams) (*TransferList, error) {
+func (s *Store) FindByFundID(ctx context.Context, fundID uuid.UUID, filter Filter, params ListParams) (*TransferList, error) {
+	return s.find(ctx, fundID, filter, params)
+}
+
+func (s *Store) FindByStatus(ctx context.Context, fundID uuid.UUID, status Status, params ListParams) (*TransferList, error) {
+	return s.find(ctx, fundID, Filter{Status: &status}, params)
+}
+
+func (s *Store) find(ctx context.Context, fundID uuid.UUID, filter Filter, params ListParams) (*TransferList, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	params = params.Normalize()
+
+	where, args := filter.where(fundID)
+	var rows pgx.Rows
+	var err error
+	if params.Cursor != "" {
+		var after transferCursor
+		if err := validation.DecodeCursor(transferCursorKind, params.Cursor, &after); err != nil {
+			return nil, err
+		}
+		if after.Descending != (filter.Sort == SortDescending) {
+			return nil, fmt.Errorf("%w: cursor was issued for a different sort order", validation.ErrInvalidCursor)
+		}
+		keyset, keyArgs := after.predicate(len(args))
+		query := fmt.Sprintf(`
+			SELECT `+transferColumns+`, 0 AS total
+			FROM transfers t
+			JOIN share_classes c ON c.id = t.class_id
+			%s AND %s
+			%s
+			LIMIT $%d
+		`, where, keyset, filter.orderBy(), len(args)+len(keyArgs)+1)
+		rows, err = s.db.Query(ctx, query, append(append(args, keyArgs...), params.Limit+1)...)
+	} else {
+		query := fmt.Sprintf(`
+			SELECT `+transferColumns+`, COUNT(*) OVER() AS total
+			FROM transfers t
+			JOIN share_classes c ON c.id = t.class_id
+			%s
+			%s
+			LIMIT $%d OFFSET $%d
+		`, where, filter.orderBy(), len(args)+1, len(args)+2)
+		rows, err = s.db.Query(ctx, query, append(args, params.Limit+1, params.Offset)...)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("find transfers for fund %s: %w", fundID, err)
+	}
+	defer rows.Close()
+
+	transfers := make([]*Transfer, 0, params.Limit+1)
+	var total int
+	for rows.Next() {
+		var t Transfer
+		if err := rows.Scan(append(t.scanTargets(), &total)...); err != nil {
+			return nil, fmt.Errorf("scan transfer row: %w", err)
+		}
+		transfers = append(transfers, &t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate transfer rows: %w", err)
+	}
+
+	if len(transfers) == 0 && params.Offset > 0 {
+		countQuery := `SELECT COUNT(*) FROM transfers t ` + where
+		if err := s.db.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+			return nil, fmt.Errorf("count transfers: %w", err)
+		}
+	}
+
+	transfers, next, err := validation.NextPage(transferCursorKind, transfers, params.Limit, func(t *Transfer) any {
+		return newTransferCursor(t, filter.Sort == SortDescending)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &TransferList{
+		Transfers:  transfers,
+		TotalCount: total,
+		Limit:      params.Limit,
+		Offset:     params.Offset,
+		NextCursor: next,
+	}, nil
+}
+
+func (s *Store) StreamByFundID(ctx context.Context, fundID uuid.UUID, filter Filter, fn func(*Transfer) error) error {
+	where, args := filter.where(fundID)
+	query := fmt.Sprintf(`
+		SELECT `+transferColumns+`
+		FROM transfers t
+		JOIN share_classes c ON c.id = t.class_id
+		%s
+		%s
+	`, where, filter.orderBy())
+	rows, err := s.db.Query(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("stream transfers for fund %s: %w", fundID, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var t Transfer
+		if err := rows.Scan(t.scanTargets()...); err != nil {
+			return fmt.Errorf("scan transfer row: %w", err)
+		}
+		if err := fn(&t); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("iterate transfer rows: %w", err)
+	}
+	return nil
+}
+
+func (s *Store) FindByOwnerID(ctx context.Context, ownerID uuid.UUID, params ListParams) (*TransferList, error) {
 	params = params.Normalize()
 
 	const query = `
-		SELECT id, fund_id, from_owner, to_owner, units, idempotency_key, transferred_at, COUNT(*) OVER() AS total
-		FROM transfers
-		WHERE fund_id = $1
-		ORDER BY transferred_at ASC, id ASC
+		SELECT ` + transferColumns + `, COUNT(*) OVER() AS total
+		FROM transfers t
+		JOIN share_classes c ON c.id = t.class_id
+		WHERE t.from_owner_id = $1 OR t.to_owner_id = $1
+		ORDER BY t.transferred_at ASC, t.leg_index ASC NULLS FIRST, t.id ASC
 		LIMIT $2 OFFSET $3
 	`
-	rows, err := s.db.Query(ctx, query, fundID, params.Limit, params.Offset)
+	rows, err := s.db.Query(ctx, query, ownerID, params.Limit, params.Offset)
 	if err != nil {
-		return nil, fmt.Errorf("find transfers for fund %s: %w", fundID, err)
+		return nil, fmt.Errorf("find transfers for owner %s: %w", ownerID, err)
 	}
 	defer rows.Close()
 
@@ -79,16 +237,7 @@ func (s *Store) FindByFundID(ctx context.Context, fundID uuid.UUID, params ListP
 	var total int
 	for rows.Next() {
 		var t Transfer
-		if err := rows.Scan(
-			&t.ID,
-			&t.FundID,
-			&t.FromOwner,
-			&t.ToOwner,
-			&t.Units,
-			&t.IdempotencyKey,
-			&t.TransferredAt,
-			&total,
-		); err != nil {
+		if err := rows.Scan(append(t.scanTargets(), &total)...); err != nil {
 			return nil, fmt.Errorf("scan transfer row: %w", err)
 		}
 		transfers = append(transfers, &t)
@@ -98,8 +247,8 @@ func (s *Store) FindByFundID(ctx context.Context, fundID uuid.UUID, params ListP
 	}
 
 	if len(transfers) == 0 && params.Offset > 0 {
-		const countQuery = `SELECT COUNT(*) FROM transfers WHERE fund_id = $1`
-		if err := s.db.QueryRow(ctx, countQuery, fundID).Scan(&total); err != nil {
+		const countQuery = `SELECT COUNT(*) FROM transfers WHERE from_owner_id = $1 OR to_owner_id = $1`
+		if err := s.db.QueryRow(ctx, countQuery, ownerID).Scan(&total); err != nil {
 			return nil, fmt.Errorf("count transfers: %w", err)
 		}
 	}
@@ -114,20 +263,13 @@ func (s *Store) FindByFundID(ctx context.Context, fundID uuid.UUID, params ListP
 
 func (s *Store) FindByIdempotencyKey(ctx context.Context, tx pgx.Tx, key uuid.UUID) (*Transfer, error) {
 	const query = `
-		SELECT id, fund_id, from_owner, to_owner, units, idempotency_key, transferred_at
-		FROM transfers
-		WHERE idempotency_key = $1
+		SELECT ` + transferColumns + `
+		FROM transfers t
+		JOIN share_classes c ON c.id = t.class_id
+		WHERE t.idempotency_key = $1
 	`
 	var t Transfer
-	err := tx.QueryRow(ctx, query, key).Scan(
-		&t.ID,
-		&t.FundID,
-		&t.FromOwner,
-		&t.ToOwner,
-		&t.Units,
-		&t.IdempotencyKey,
-		&t.TransferredAt,
-	)
+	err := tx.QueryRow(ctx, query, key).Scan(t.scanTargets()...)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, nil
 	}
@@ -136,3 +278,209 @@ func (s *Store) FindByIdempotencyKey(ctx context.Context, tx pgx.Tx, key uuid.UU
 	}
 	return &t, nil
 }
+
+func (s *Store) CreateBatchTx(ctx context.Context, tx pgx.Tx, batch *Batch) error {
+	const query = `
+		INSERT INTO transfer_batches (id, fund_id, idempotency_key, created_at)
+		VALUES ($1, $2, $3, NOW())
+		RETURNING created_at
+	`
+	if err := tx.QueryRow(ctx, query, batch.ID, batch.FundID, batch.IdempotencyKey).Scan(&batch.CreatedAt); err != nil {
+		return fmt.Errorf("create transfer batch %s: %w", batch.ID, err)
+	}
+	return nil
+}
+
+func (s *Store) FindBatchByIdempotencyKey(ctx context.Context, tx pgx.Tx, key uuid.UUID) (*Batch, error) {
+	const batchQuery = `
+		SELECT id, fund_id, idempotency_key, created_at
+		FROM transfer_batches
+		WHERE idempotency_key = $1
+	`
+	var b Batch
+	err := tx.QueryRow(ctx, batchQuery, key).Scan(&b.ID, &b.FundID, &b.IdempotencyKey, &b.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("find transfer batch by idempotency key %s: %w", key, err)
+	}
+
+	const legsQuery = `
+		SELECT ` + transferColumns + `
+		FROM transfers t
+		JOIN share_classes c ON c.id = t.class_id
+		WHERE t.batch_id = $1
+		ORDER BY t.leg_index ASC
+	`
+	rows, err := tx.Query(ctx, legsQuery, b.ID)
+	if err != nil {
+		return nil, fmt.Errorf("find legs for transfer batch %s: %w", b.ID, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var t Transfer
+		if err := rows.Scan(t.scanTargets()...); err != nil {
+			return nil, fmt.Errorf("scan transfer row: %w", err)
+		}
+		b.Transfers = append(b.Transfers, &t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate transfer rows: %w", err)
+	}
+	return &b, nil
+}
+
+func (s *Store) FindByIDForUpdateTx(ctx context.Context, tx pgx.Tx, fundID, id uuid.UUID) (*Transfer, error) {
+	const query = `
+		SELECT ` + transferColumns + `
+		FROM transfers t
+		JOIN share_classes c ON c.id = t.class_id
+		WHERE t.fund_id = $1 AND t.id = $2
+		FOR UPDATE OF t
+	`
+	var t Transfer
+	err := tx.QueryRow(ctx, query, fundID, id).Scan(t.scanTargets()...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrTransferNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("lock transfer %s: %w", id, err)
+	}
+	return &t, nil
+}
+
+func (s *Store) UpdateReviewTx(ctx context.Context, tx pgx.Tx, t *Transfer) error {
+	const query = `
+		UPDATE transfers
+		SET status = $2,
+			reviewed_by = $3,
+			rejection_reason = $4,
+			reviewed_at = $5,
+			transferred_at = $6,
+			ledger_sequence = $7,
+			prev_hash = $8,
+			hash = $9
+		WHERE id = $1
+		RETURNING reviewed_at, transferred_at
+	`
+	err := tx.QueryRow(ctx, query, t.ID, t.Status, t.ReviewedBy, t.RejectionReason, t.ReviewedAt, t.TransferredAt,
+		t.LedgerSequence, t.PrevHash, t.Hash).Scan(&t.ReviewedAt, &t.TransferredAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrTransferNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("record review of transfer %s: %w", t.ID, err)
+	}
+	return nil
+}
+
+func (s *Store) PendingUnitsTx(ctx context.Context, tx pgx.Tx, fundID, classID, ownerID uuid.UUID) (int, error) {
+	const query = `
+		SELECT COALESCE(SUM(units), 0)
+		FROM transfers
+		WHERE fund_id = $1 AND class_id = $2 AND from_owner_id = $3 AND status = 'pending'
+	`
+	var units int
+	if err := tx.QueryRow(ctx, query, fundID, classID, ownerID).Scan(&units); err != nil {
+		return 0, fmt.Errorf("sum pending units for owner %s in fund %s: %w", ownerID, fundID, err)
+	}
+	return units, nil
+}
+
+func (s *Store) FindApprovalThresholdTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID) (*int, error) {
+	const query = `SELECT approval_threshold FROM funds WHERE id = $1`
+	var threshold *int
+	err := tx.QueryRow(ctx, query, fundID).Scan(&threshold)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("find approval threshold for fund %s: %w", fundID, err)
+	}
+	return threshold, nil
+}
+
+func (s *Store) LockLedgerHeadTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID) (*LedgerHead, error) {
+	const query = `SELECT status, ledger_sequence, ledger_head FROM funds WHERE id = $1 FOR UPDATE`
+	head := &LedgerHead{FundID: fundID}
+	err := tx.QueryRow(ctx, query, fundID).Scan(&head.FundStatus, &head.Sequence, &head.Hash)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrFundNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("lock ledger head for fund %s: %w", fundID, err)
+	}
+	return head, nil
+}
+
+func (s *Store) UpdateLedgerHeadTx(ctx context.Context, tx pgx.Tx, head *LedgerHead) error {
+	const query = `UPDATE funds SET ledger_sequence = $2, ledger_head = $3 WHERE id = $1`
+	if _, err := tx.Exec(ctx, query, head.FundID, head.Sequence, head.Hash); err != nil {
+		return fmt.Errorf("update ledger head for fund %s: %w", head.FundID, err)
+	}
+	return nil
+}
+
+func (s *Store) FindLedgerHeadTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID) (*LedgerHead, error) {
+	const query = `SELECT status, ledger_sequence, ledger_head FROM funds WHERE id = $1`
+	head := &LedgerHead{FundID: fundID}
+	err := tx.QueryRow(ctx, query, fundID).Scan(&head.FundStatus, &head.Sequence, &head.Hash)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrFundNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("find ledger head for fund %s: %w", fundID, err)
+	}
+	return head, nil
+}
+
+func (s *Store) FindLedgerPageTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID, afterSequence int64, limit int) ([]*Transfer, error) {
+	const query = `
+		SELECT ` + transferColumns + `
+		FROM transfers t
+		JOIN share_classes c ON c.id = t.class_id
+		WHERE t.fund_id = $1 AND t.ledger_sequence > $2
+		ORDER BY t.ledger_sequence ASC
+		LIMIT $3
+	`
+	rows, err := tx.Query(ctx, query, fundID, afterSequence, limit)
+	if err != nil {
+		return nil, fmt.Errorf("read ledger for fund %s: %w", fundID, err)
+	}
+	defer rows.Close()
+
+	var transfers []*Transfer
+	for rows.Next() {
+		var t Transfer
+		if err := rows.Scan(t.scanTargets()...); err != nil {
+			return nil, fmt.Errorf("scan ledger row: %w", err)
+		}
+		transfers = append(transfers, &t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate ledger rows: %w", err)
+	}
+	return transfers, nil
+}
+
+func (s *Store) FindFirstUnchainedTx(ctx context.Context, tx pgx.Tx, fundID uuid.UUID) (*Transfer, error) {
+	const query = `
+		SELECT ` + transferColumns + `
+		FROM transfers t
+		JOIN share_classes c ON c.id = t.class_id
+		WHERE t.fund_id = $1 AND t.status <> 'pending' AND (t.ledger_sequence IS NULL OR t.hash IS NULL)
+		ORDER BY t.requested_at ASC, t.id ASC
+		LIMIT 1
+	`
+	var t Transfer
+	err := tx.QueryRow(ctx, query, fundID).Scan(t.scanTargets()...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("find unchained transfers for fund %s: %w", fundID, err)
+	}
+	return &t, nil
+}
@@ -3,11 +3,14 @@ package transfer
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/arowden/augment-fund/internal/auth"
 	"github.com/arowden/augment-fund/internal/fund"
+	"github.com/arowden/augment-fund/internal/owner"
 	"github.com/arowden/augment-fund/internal/ownership"
 	"github.com/arowden/augment-fund/internal/postgres"
 	"github.com/arowden/augment-fund/internal/validation"
@@ -29,6 +32,7 @@ func TestStore(t *testing.T) {
 	store := NewStore(tc.Pool())
 	fundStore := fund.NewStore(tc.Pool())
 	ownershipStore := ownership.NewStore(tc.Pool())
+	ownerStore := owner.NewStore(tc.Pool())
 
 	createTestFund := func(t *testing.T, name string, units int) *fund.Fund {
 		f, err := fund.NewFund(name, units)
@@ -37,10 +41,15 @@ func TestStore(t *testing.T) {
 		return f
 	}
 
-	createOwnership := func(t *testing.T, fundID uuid.UUID, owner string, units int) *ownership.Entry {
-		entry, err := ownership.NewCapTableEntry(fundID, owner, units)
+	ownerIDs := make(map[string]uuid.UUID)
+	createOwnership := func(t *testing.T, fundID uuid.UUID, name string, units int) *ownership.Entry {
+		o, err := owner.NewOwner(name, owner.TypeIndividual, nil)
+		require.NoError(t, err)
+		require.NoError(t, ownerStore.Create(ctx, o))
+		entry, err := ownership.NewCapTableEntry(fundID, o.ID, name, units)
 		require.NoError(t, err)
 		require.NoError(t, ownershipStore.Create(ctx, entry))
+		ownerIDs[name] = o.ID
 		return entry
 	}
 
@@ -54,7 +63,9 @@ func TestStore(t *testing.T) {
 			ID:            uuid.New(),
 			FundID:        testFund.ID,
 			FromOwner:     "Alice",
+			FromOwnerID:   ownerIDs["Alice"],
 			ToOwner:       "Bob",
+			ToOwnerID:     ownerIDs["Bob"],
 			Units:         100,
 			TransferredAt: time.Now(),
 		}
@@ -62,7 +73,7 @@ func TestStore(t *testing.T) {
 		err := store.Create(ctx, transfer)
 		require.NoError(t, err)
 
-		list, err := store.FindByFundID(ctx, testFund.ID, ListParams{Limit: 10})
+		list, err := store.FindByFundID(ctx, testFund.ID, Filter{}, ListParams{Limit: 10})
 		require.NoError(t, err)
 		require.Len(t, list.Transfers, 1)
 		assert.Equal(t, transfer.ID, list.Transfers[0].ID)
@@ -89,7 +100,9 @@ func TestStore(t *testing.T) {
 			ID:             uuid.New(),
 			FundID:         testFund.ID,
 			FromOwner:      "Alice",
+			FromOwnerID:    ownerIDs["Alice"],
 			ToOwner:        "Bob",
+			ToOwnerID:      ownerIDs["Bob"],
 			Units:          100,
 			IdempotencyKey: &idempotencyKey,
 			TransferredAt:  time.Now(),
@@ -98,7 +111,7 @@ func TestStore(t *testing.T) {
 		err := store.Create(ctx, transfer)
 		require.NoError(t, err)
 
-		list, err := store.FindByFundID(ctx, testFund.ID, ListParams{})
+		list, err := store.FindByFundID(ctx, testFund.ID, Filter{}, ListParams{})
 		require.NoError(t, err)
 		require.Len(t, list.Transfers, 1)
 		require.NotNil(t, list.Transfers[0].IdempotencyKey)
@@ -115,7 +128,9 @@ func TestStore(t *testing.T) {
 			ID:            uuid.New(),
 			FundID:        testFund.ID,
 			FromOwner:     "Alice",
+			FromOwnerID:   ownerIDs["Alice"],
 			ToOwner:       "Bob",
+			ToOwnerID:     ownerIDs["Bob"],
 			Units:         100,
 			TransferredAt: time.Now(),
 		}
@@ -129,7 +144,7 @@ func TestStore(t *testing.T) {
 		err = tx.Rollback(ctx)
 		require.NoError(t, err)
 
-		list, err := store.FindByFundID(ctx, testFund.ID, ListParams{})
+		list, err := store.FindByFundID(ctx, testFund.ID, Filter{}, ListParams{})
 		require.NoError(t, err)
 		assert.Empty(t, list.Transfers)
 	})
@@ -144,7 +159,9 @@ func TestStore(t *testing.T) {
 			ID:            uuid.New(),
 			FundID:        testFund.ID,
 			FromOwner:     "Alice",
+			FromOwnerID:   ownerIDs["Alice"],
 			ToOwner:       "Bob",
+			ToOwnerID:     ownerIDs["Bob"],
 			Units:         100,
 			TransferredAt: time.Now(),
 		}
@@ -158,7 +175,7 @@ func TestStore(t *testing.T) {
 		err = tx.Commit(ctx)
 		require.NoError(t, err)
 
-		list, err := store.FindByFundID(ctx, testFund.ID, ListParams{})
+		list, err := store.FindByFundID(ctx, testFund.ID, Filter{}, ListParams{})
 		require.NoError(t, err)
 		require.Len(t, list.Transfers, 1)
 		assert.Equal(t, transfer.ID, list.Transfers[0].ID)
@@ -168,7 +185,7 @@ func TestStore(t *testing.T) {
 		tc.Reset(ctx)
 		nonExistentFundID := uuid.New()
 
-		list, err := store.FindByFundID(ctx, nonExistentFundID, ListParams{})
+		list, err := store.FindByFundID(ctx, nonExistentFundID, Filter{}, ListParams{})
 		require.NoError(t, err)
 		assert.NotNil(t, list.Transfers)
 		assert.Empty(t, list.Transfers)
@@ -183,15 +200,15 @@ func TestStore(t *testing.T) {
 		createOwnership(t, testFund.ID, "Charlie", 200)
 
 		transfers := []*Transfer{
-			{ID: uuid.New(), FundID: testFund.ID, FromOwner: "Alice", ToOwner: "Bob", Units: 100},
-			{ID: uuid.New(), FundID: testFund.ID, FromOwner: "Bob", ToOwner: "Charlie", Units: 50},
-			{ID: uuid.New(), FundID: testFund.ID, FromOwner: "Charlie", ToOwner: "Alice", Units: 25},
+			{ID: uuid.New(), FundID: testFund.ID, FromOwner: "Alice", ToOwner: "Bob", FromOwnerID: ownerIDs["Alice"], ToOwnerID: ownerIDs["Bob"], Units: 100},
+			{ID: uuid.New(), FundID: testFund.ID, FromOwner: "Bob", ToOwner: "Charlie", FromOwnerID: ownerIDs["Bob"], ToOwnerID: ownerIDs["Charlie"], Units: 50},
+			{ID: uuid.New(), FundID: testFund.ID, FromOwner: "Charlie", ToOwner: "Alice", FromOwnerID: ownerIDs["Charlie"], ToOwnerID: ownerIDs["Alice"], Units: 25},
 		}
 		for _, tr := range transfers {
 			require.NoError(t, store.Create(ctx, tr))
 		}
 
-		list, err := store.FindByFundID(ctx, testFund.ID, ListParams{Limit: 10})
+		list, err := store.FindByFundID(ctx, testFund.ID, Filter{}, ListParams{Limit: 10})
 		require.NoError(t, err)
 		require.Len(t, list.Transfers, 3)
 		assert.Equal(t, 3, list.TotalCount)
@@ -213,16 +230,18 @@ func TestStore(t *testing.T) {
 
 		for i := 0; i < 5; i++ {
 			transfer := &Transfer{
-				ID:        uuid.New(),
-				FundID:    testFund.ID,
-				FromOwner: "Alice",
-				ToOwner:   "Bob",
-				Units:     (i + 1) * 10,
+				ID:          uuid.New(),
+				FundID:      testFund.ID,
+				FromOwner:   "Alice",
+				FromOwnerID: ownerIDs["Alice"],
+				ToOwner:     "Bob",
+				ToOwnerID:   ownerIDs["Bob"],
+				Units:       (i + 1) * 10,
 			}
 			require.NoError(t, store.Create(ctx, transfer))
 		}
 
-		list, err := store.FindByFundID(ctx, testFund.ID, ListParams{Limit: 2})
+		list, err := store.FindByFundID(ctx, testFund.ID, Filter{}, ListParams{Limit: 2})
 		require.NoError(t, err)
 		assert.Len(t, list.Transfers, 2)
 		assert.Equal(t, 5, list.TotalCount)
@@ -238,20 +257,22 @@ func TestStore(t *testing.T) {
 		transfers := make([]*Transfer, 5)
 		for i := 0; i < 5; i++ {
 			transfers[i] = &Transfer{
-				ID:        uuid.New(),
-				FundID:    testFund.ID,
-				FromOwner: "Alice",
-				ToOwner:   "Bob",
-				Units:     (i + 1) * 10,
+				ID:          uuid.New(),
+				FundID:      testFund.ID,
+				FromOwner:   "Alice",
+				FromOwnerID: ownerIDs["Alice"],
+				ToOwner:     "Bob",
+				ToOwnerID:   ownerIDs["Bob"],
+				Units:       (i + 1) * 10,
 			}
 			require.NoError(t, store.Create(ctx, transfers[i]))
 		}
 
-		allList, err := store.FindByFundID(ctx, testFund.ID, ListParams{Limit: 10})
+		allList, err := store.FindByFundID(ctx, testFund.ID, Filter{}, ListParams{Limit: 10})
 		require.NoError(t, err)
 		require.Len(t, allList.Transfers, 5)
 
-		list, err := store.FindByFundID(ctx, testFund.ID, ListParams{Limit: 2, Offset: 2})
+		list, err := store.FindByFundID(ctx, testFund.ID, Filter{}, ListParams{Limit: 2, Offset: 2})
 		require.NoError(t, err)
 		assert.Len(t, list.Transfers, 2)
 		assert.Equal(t, 5, list.TotalCount)
@@ -271,13 +292,15 @@ func TestStore(t *testing.T) {
 			ID:            uuid.New(),
 			FundID:        testFund.ID,
 			FromOwner:     "Alice",
+			FromOwnerID:   ownerIDs["Alice"],
 			ToOwner:       "Bob",
+			ToOwnerID:     ownerIDs["Bob"],
 			Units:         100,
 			TransferredAt: time.Now(),
 		}
 		require.NoError(t, store.Create(ctx, transfer))
 
-		list, err := store.FindByFundID(ctx, testFund.ID, ListParams{Offset: 100})
+		list, err := store.FindByFundID(ctx, testFund.ID, Filter{}, ListParams{Offset: 100})
 		require.NoError(t, err)
 		assert.Empty(t, list.Transfers)
 		assert.Equal(t, 1, list.TotalCount)
@@ -293,13 +316,15 @@ func TestStore(t *testing.T) {
 			ID:            uuid.New(),
 			FundID:        testFund.ID,
 			FromOwner:     "Alice",
+			FromOwnerID:   ownerIDs["Alice"],
 			ToOwner:       "Bob",
+			ToOwnerID:     ownerIDs["Bob"],
 			Units:         100,
 			TransferredAt: time.Now(),
 		}
 		require.NoError(t, store.Create(ctx, transfer))
 
-		list, err := store.FindByFundID(ctx, testFund.ID, ListParams{Limit: -1, Offset: -5})
+		list, err := store.FindByFundID(ctx, testFund.ID, Filter{}, ListParams{Limit: -1, Offset: -5})
 		require.NoError(t, err)
 		assert.Equal(t, validation.DefaultLimit, list.Limit)
 		assert.Equal(t, 0, list.Offset)
@@ -315,17 +340,170 @@ func TestStore(t *testing.T) {
 			ID:            uuid.New(),
 			FundID:        testFund.ID,
 			FromOwner:     "Alice",
+			FromOwnerID:   ownerIDs["Alice"],
 			ToOwner:       "Bob",
+			ToOwnerID:     ownerIDs["Bob"],
 			Units:         100,
 			TransferredAt: time.Now(),
 		}
 		require.NoError(t, store.Create(ctx, transfer))
 
-		list, err := store.FindByFundID(ctx, testFund.ID, ListParams{Limit: 9999})
+		list, err := store.FindByFundID(ctx, testFund.ID, Filter{}, ListParams{Limit: 9999})
 		require.NoError(t, err)
 		assert.Equal(t, validation.MaxLimit, list.Limit)
 	})
 
+	t.Run("FindByFundID applies filters and sort order", func(t *testing.T) {
+		tc.Reset(ctx)
+		testFund := createTestFund(t, "Filter Fund", 1000)
+		createOwnership(t, testFund.ID, "Alice", 500)
+		createOwnership(t, testFund.ID, "Bob", 300)
+		createOwnership(t, testFund.ID, "Carol", 200)
+
+		record := func(from, to string, units int) *Transfer {
+			tr := &Transfer{
+				ID:          uuid.New(),
+				FundID:      testFund.ID,
+				FromOwner:   from,
+				FromOwnerID: ownerIDs[from],
+				ToOwner:     to,
+				ToOwnerID:   ownerIDs[to],
+				Units:       units,
+			}
+			require.NoError(t, store.Create(ctx, tr))
+			time.Sleep(10 * time.Millisecond)
+			return tr
+		}
+		first := record("Alice", "Bob", 100)
+		second := record("Bob", "Carol", 50)
+		third := record("Carol", "Alice", 20)
+
+		ids := func(list *TransferList) []uuid.UUID {
+			out := make([]uuid.UUID, len(list.Transfers))
+			for i, tr := range list.Transfers {
+				out[i] = tr.ID
+			}
+			return out
+		}
+
+		bob := ownerIDs["Bob"]
+		list, err := store.FindByFundID(ctx, testFund.ID, Filter{OwnerID: &bob}, ListParams{})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{first.ID, second.ID}, ids(list))
+		assert.Equal(t, 2, list.TotalCount)
+
+		list, err = store.FindByFundID(ctx, testFund.ID, Filter{FromOwnerID: &bob}, ListParams{})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{second.ID}, ids(list))
+
+		alice := ownerIDs["Alice"]
+		list, err = store.FindByFundID(ctx, testFund.ID, Filter{ToOwnerID: &alice}, ListParams{})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{third.ID}, ids(list))
+
+		minUnits, maxUnits := 30, 100
+		list, err = store.FindByFundID(ctx, testFund.ID, Filter{MinUnits: &minUnits, MaxUnits: &maxUnits, Sort: SortDescending}, ListParams{})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{second.ID, first.ID}, ids(list))
+
+		since, until := second.TransferredAt, third.TransferredAt
+		list, err = store.FindByFundID(ctx, testFund.ID, Filter{Since: &since, Until: &until}, ListParams{})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{second.ID}, ids(list))
+
+		list, err = store.FindByFundID(ctx, testFund.ID, Filter{OwnerID: &bob}, ListParams{Offset: 5})
+		require.NoError(t, err)
+		assert.Empty(t, list.Transfers)
+		assert.Equal(t, 2, list.TotalCount)
+	})
+
+	t.Run("StreamByFundID yields every matching transfer in order", func(t *testing.T) {
+		tc.Reset(ctx)
+		testFund := createTestFund(t, "Stream Fund", 1000)
+		createOwnership(t, testFund.ID, "Alice", 900)
+		createOwnership(t, testFund.ID, "Bob", 100)
+
+		var created []uuid.UUID
+		for _, units := range []int{10, 20, 30} {
+			tr := &Transfer{
+				ID:          uuid.New(),
+				FundID:      testFund.ID,
+				FromOwner:   "Alice",
+				FromOwnerID: ownerIDs["Alice"],
+				ToOwner:     "Bob",
+				ToOwnerID:   ownerIDs["Bob"],
+				Units:       units,
+			}
+			require.NoError(t, store.Create(ctx, tr))
+			created = append(created, tr.ID)
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		var streamed []uuid.UUID
+		minUnits := 20
+		err := store.StreamByFundID(ctx, testFund.ID, Filter{MinUnits: &minUnits, Sort: SortDescending}, func(tr *Transfer) error {
+			streamed = append(streamed, tr.ID)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{created[2], created[1]}, streamed)
+
+		stop := errors.New("stop")
+		calls := 0
+		err = store.StreamByFundID(ctx, testFund.ID, Filter{}, func(*Transfer) error {
+			calls++
+			return stop
+		})
+		assert.ErrorIs(t, err, stop)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("FindByFundID pages by cursor in either direction", func(t *testing.T) {
+		tc.Reset(ctx)
+		testFund := createTestFund(t, "Cursor Fund", 1000)
+		createOwnership(t, testFund.ID, "Alice", 900)
+		createOwnership(t, testFund.ID, "Bob", 100)
+
+		var created []uuid.UUID
+		for i := 0; i < 3; i++ {
+			tr := &Transfer{
+				ID:          uuid.New(),
+				FundID:      testFund.ID,
+				FromOwner:   "Alice",
+				FromOwnerID: ownerIDs["Alice"],
+				ToOwner:     "Bob",
+				ToOwnerID:   ownerIDs["Bob"],
+				Units:       10,
+			}
+			require.NoError(t, store.Create(ctx, tr))
+			created = append(created, tr.ID)
+		}
+
+		collect := func(filter Filter) []uuid.UUID {
+			var ids []uuid.UUID
+			params := ListParams{Limit: 2}
+			for {
+				list, err := store.FindByFundID(ctx, testFund.ID, filter, params)
+				require.NoError(t, err)
+				for _, tr := range list.Transfers {
+					ids = append(ids, tr.ID)
+				}
+				if list.NextCursor == "" {
+					return ids
+				}
+				params.Cursor = list.NextCursor
+			}
+		}
+
+		assert.Equal(t, created, collect(Filter{}))
+		assert.Equal(t, []uuid.UUID{created[2], created[1], created[0]}, collect(Filter{Sort: SortDescending}))
+
+		first, err := store.FindByFundID(ctx, testFund.ID, Filter{}, ListParams{Limit: 1})
+		require.NoError(t, err)
+		_, err = store.FindByFundID(ctx, testFund.ID, Filter{Sort: SortDescending}, ListParams{Limit: 1, Cursor: first.NextCursor})
+		assert.ErrorIs(t, err, validation.ErrInvalidCursor)
+	})
+
 	t.Run("FindByIdempotencyKey returns nil when not found", func(t *testing.T) {
 		tc.Reset(ctx)
 		nonExistentKey := uuid.New()
@@ -350,7 +528,9 @@ func TestStore(t *testing.T) {
 			ID:             uuid.New(),
 			FundID:         testFund.ID,
 			FromOwner:      "Alice",
+			FromOwnerID:    ownerIDs["Alice"],
 			ToOwner:        "Bob",
+			ToOwnerID:      ownerIDs["Bob"],
 			Units:          100,
 			IdempotencyKey: &idempotencyKey,
 			TransferredAt:  time.Now(),
@@ -388,7 +568,9 @@ func TestStore(t *testing.T) {
 			ID:            uuid.New(),
 			FundID:        fund1.ID,
 			FromOwner:     "Alice",
+			FromOwnerID:   ownerIDs["Alice"],
 			ToOwner:       "Bob",
+			ToOwnerID:     ownerIDs["Bob"],
 			Units:         100,
 			TransferredAt: time.Now(),
 		}
@@ -398,18 +580,20 @@ func TestStore(t *testing.T) {
 			ID:            uuid.New(),
 			FundID:        fund2.ID,
 			FromOwner:     "Charlie",
+			FromOwnerID:   ownerIDs["Charlie"],
 			ToOwner:       "Dave",
+			ToOwnerID:     ownerIDs["Dave"],
 			Units:         200,
 			TransferredAt: time.Now(),
 		}
 		require.NoError(t, store.Create(ctx, transfer2))
 
-		list1, err := store.FindByFundID(ctx, fund1.ID, ListParams{})
+		list1, err := store.FindByFundID(ctx, fund1.ID, Filter{}, ListParams{})
 		require.NoError(t, err)
 		require.Len(t, list1.Transfers, 1)
 		assert.Equal(t, transfer1.ID, list1.Transfers[0].ID)
 
-		list2, err := store.FindByFundID(ctx, fund2.ID, ListParams{})
+		list2, err := store.FindByFundID(ctx, fund2.ID, Filter{}, ListParams{})
 		require.NoError(t, err)
 		require.Len(t, list2.Transfers, 1)
 		assert.Equal(t, transfer2.ID, list2.Transfers[0].ID)
@@ -428,6 +612,7 @@ func TestTransferService(t *testing.T) {
 
 	fundStore := fund.NewStore(tc.Pool())
 	ownershipStore := ownership.NewStore(tc.Pool())
+	ownerStore := owner.NewStore(tc.Pool())
 	transferStore := NewStore(tc.Pool())
 
 	createTestFund := func(t *testing.T, name string, units int) *fund.Fund {
@@ -437,8 +622,11 @@ func TestTransferService(t *testing.T) {
 		return f
 	}
 
-	createOwnership := func(t *testing.T, fundID uuid.UUID, owner string, units int) *ownership.Entry {
-		entry, err := ownership.NewCapTableEntry(fundID, owner, units)
+	createOwnership := func(t *testing.T, fundID uuid.UUID, name string, units int) *ownership.Entry {
+		o, err := owner.NewOwner(name, owner.TypeIndividual, nil)
+		require.NoError(t, err)
+		require.NoError(t, ownerStore.Create(ctx, o))
+		entry, err := ownership.NewCapTableEntry(fundID, o.ID, name, units)
 		require.NoError(t, err)
 		require.NoError(t, ownershipStore.Create(ctx, entry))
 		return entry
@@ -452,6 +640,7 @@ func TestTransferService(t *testing.T) {
 		svc, err := NewService(
 			WithRepository(transferStore),
 			WithOwnershipRepository(ownershipStore),
+			WithOwnerRepository(ownerStore),
 			WithPool(tc.Pool()),
 		)
 		require.NoError(t, err)
@@ -470,11 +659,11 @@ func TestTransferService(t *testing.T) {
 		assert.Equal(t, "Bob", transfer.ToOwner)
 		assert.Equal(t, 100, transfer.Units)
 
-		aliceEntry, err := ownershipStore.FindByFundAndOwner(ctx, testFund.ID, "Alice")
+		aliceEntry, err := ownershipStore.FindByFundAndOwner(ctx, testFund.ID, nil, "Alice")
 		require.NoError(t, err)
 		assert.Equal(t, 400, aliceEntry.Units)
 
-		bobEntry, err := ownershipStore.FindByFundAndOwner(ctx, testFund.ID, "Bob")
+		bobEntry, err := ownershipStore.FindByFundAndOwner(ctx, testFund.ID, nil, "Bob")
 		require.NoError(t, err)
 		assert.Equal(t, 100, bobEntry.Units)
 	})
@@ -488,6 +677,7 @@ func TestTransferService(t *testing.T) {
 		svc, err := NewService(
 			WithRepository(transferStore),
 			WithOwnershipRepository(ownershipStore),
+			WithOwnerRepository(ownerStore),
 			WithPool(tc.Pool()),
 		)
 		require.NoError(t, err)
@@ -503,11 +693,11 @@ func TestTransferService(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, transfer)
 
-		aliceEntry, err := ownershipStore.FindByFundAndOwner(ctx, testFund.ID, "Alice")
+		aliceEntry, err := ownershipStore.FindByFundAndOwner(ctx, testFund.ID, nil, "Alice")
 		require.NoError(t, err)
 		assert.Equal(t, 400, aliceEntry.Units)
 
-		bobEntry, err := ownershipStore.FindByFundAndOwner(ctx, testFund.ID, "Bob")
+		bobEntry, err := ownershipStore.FindByFundAndOwner(ctx, testFund.ID, nil, "Bob")
 		require.NoError(t, err)
 		assert.Equal(t, 300, bobEntry.Units)
 	})
@@ -519,6 +709,7 @@ func TestTransferService(t *testing.T) {
 		svc, err := NewService(
 			WithRepository(transferStore),
 			WithOwnershipRepository(ownershipStore),
+			WithOwnerRepository(ownerStore),
 			WithPool(tc.Pool()),
 		)
 		require.NoError(t, err)
@@ -542,6 +733,7 @@ func TestTransferService(t *testing.T) {
 		svc, err := NewService(
 			WithRepository(transferStore),
 			WithOwnershipRepository(ownershipStore),
+			WithOwnerRepository(ownerStore),
 			WithPool(tc.Pool()),
 		)
 		require.NoError(t, err)
@@ -556,7 +748,7 @@ func TestTransferService(t *testing.T) {
 		_, err = svc.ExecuteTransfer(ctx, req)
 		assert.ErrorIs(t, err, ErrInsufficientUnits)
 
-		aliceEntry, err := ownershipStore.FindByFundAndOwner(ctx, testFund.ID, "Alice")
+		aliceEntry, err := ownershipStore.FindByFundAndOwner(ctx, testFund.ID, nil, "Alice")
 		require.NoError(t, err)
 		assert.Equal(t, 50, aliceEntry.Units)
 	})
@@ -569,6 +761,7 @@ func TestTransferService(t *testing.T) {
 		svc, err := NewService(
 			WithRepository(transferStore),
 			WithOwnershipRepository(ownershipStore),
+			WithOwnerRepository(ownerStore),
 			WithPool(tc.Pool()),
 		)
 		require.NoError(t, err)
@@ -592,11 +785,11 @@ func TestTransferService(t *testing.T) {
 
 		assert.Equal(t, transfer1.ID, transfer2.ID)
 
-		aliceEntry, err := ownershipStore.FindByFundAndOwner(ctx, testFund.ID, "Alice")
+		aliceEntry, err := ownershipStore.FindByFundAndOwner(ctx, testFund.ID, nil, "Alice")
 		require.NoError(t, err)
 		assert.Equal(t, 400, aliceEntry.Units)
 
-		bobEntry, err := ownershipStore.FindByFundAndOwner(ctx, testFund.ID, "Bob")
+		bobEntry, err := ownershipStore.FindByFundAndOwner(ctx, testFund.ID, nil, "Bob")
 		require.NoError(t, err)
 		assert.Equal(t, 100, bobEntry.Units)
 	})
@@ -609,6 +802,7 @@ func TestTransferService(t *testing.T) {
 		svc, err := NewService(
 			WithRepository(transferStore),
 			WithOwnershipRepository(ownershipStore),
+			WithOwnerRepository(ownerStore),
 			WithPool(tc.Pool()),
 		)
 		require.NoError(t, err)
@@ -646,6 +840,7 @@ func TestTransferService(t *testing.T) {
 		svc, err := NewService(
 			WithRepository(transferStore),
 			WithOwnershipRepository(ownershipStore),
+			WithOwnerRepository(ownerStore),
 			WithPool(tc.Pool()),
 		)
 		require.NoError(t, err)
@@ -680,11 +875,11 @@ func TestTransferService(t *testing.T) {
 
 		assert.Equal(t, numGoroutines, successCount)
 
-		aliceEntry, err := ownershipStore.FindByFundAndOwner(ctx, testFund.ID, "Alice")
+		aliceEntry, err := ownershipStore.FindByFundAndOwner(ctx, testFund.ID, nil, "Alice")
 		require.NoError(t, err)
 		assert.Equal(t, 0, aliceEntry.Units)
 
-		bobEntry, err := ownershipStore.FindByFundAndOwner(ctx, testFund.ID, "Bob")
+		bobEntry, err := ownershipStore.FindByFundAndOwner(ctx, testFund.ID, nil, "Bob")
 		require.NoError(t, err)
 		assert.Equal(t, 1000, bobEntry.Units)
 	})
@@ -697,6 +892,7 @@ func TestTransferService(t *testing.T) {
 		svc, err := NewService(
 			WithRepository(transferStore),
 			WithOwnershipRepository(ownershipStore),
+			WithOwnerRepository(ownerStore),
 			WithPool(tc.Pool()),
 		)
 		require.NoError(t, err)
@@ -731,15 +927,630 @@ func TestTransferService(t *testing.T) {
 
 		assert.Equal(t, 2, successCount)
 
-		aliceEntry, err := ownershipStore.FindByFundAndOwner(ctx, testFund.ID, "Alice")
+		aliceEntry, err := ownershipStore.FindByFundAndOwner(ctx, testFund.ID, nil, "Alice")
 		require.NoError(t, err)
 		assert.Equal(t, 0, aliceEntry.Units)
 
-		bobEntry, err := ownershipStore.FindByFundAndOwner(ctx, testFund.ID, "Bob")
+		bobEntry, err := ownershipStore.FindByFundAndOwner(ctx, testFund.ID, nil, "Bob")
 		require.NoError(t, err)
 		assert.Equal(t, 100, bobEntry.Units)
 	})
 
+	t.Run("ExecuteBatch applies every leg atomically", func(t *testing.T) {
+		tc.Reset(ctx)
+		testFund := createTestFund(t, "Test Fund", 1000)
+		createOwnership(t, testFund.ID, "Seller", 1000)
+
+		svc, err := NewService(
+			WithRepository(transferStore),
+			WithOwnershipRepository(ownershipStore),
+			WithOwnerRepository(ownerStore),
+			WithPool(tc.Pool()),
+		)
+		require.NoError(t, err)
+
+		batch, err := svc.ExecuteBatch(ctx, BatchRequest{
+			FundID: testFund.ID,
+			Legs: []Leg{
+				{FromOwner: "Seller", ToOwner: "Alice", Units: 300},
+				{FromOwner: "Seller", ToOwner: "Bob", Units: 200},
+				{FromOwner: "Alice", ToOwner: "Carol", Units: 50},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, batch.Transfers, 3)
+		for i, tr := range batch.Transfers {
+			assert.Equal(t, batch.ID, *tr.BatchID)
+			assert.Equal(t, i, *tr.LegIndex)
+		}
+
+		for owner, units := range map[string]int{"Seller": 500, "Alice": 250, "Bob": 200, "Carol": 50} {
+			entry, err := ownershipStore.FindByFundAndOwner(ctx, testFund.ID, nil, owner)
+			require.NoError(t, err)
+			assert.Equal(t, units, entry.Units, owner)
+		}
+	})
+
+	t.Run("ExecuteBatch rolls back every leg when one fails", func(t *testing.T) {
+		tc.Reset(ctx)
+		testFund := createTestFund(t, "Test Fund", 1000)
+		createOwnership(t, testFund.ID, "Seller", 500)
+
+		svc, err := NewService(
+			WithRepository(transferStore),
+			WithOwnershipRepository(ownershipStore),
+			WithOwnerRepository(ownerStore),
+			WithPool(tc.Pool()),
+		)
+		require.NoError(t, err)
+
+		_, err = svc.ExecuteBatch(ctx, BatchRequest{
+			FundID: testFund.ID,
+			Legs: []Leg{
+				{FromOwner: "Seller", ToOwner: "Alice", Units: 300},
+				{FromOwner: "Seller", ToOwner: "Bob", Units: 300},
+			},
+		})
+		var legErr *LegError
+		require.ErrorAs(t, err, &legErr)
+		assert.Equal(t, 1, legErr.Index)
+		assert.ErrorIs(t, err, ErrInsufficientUnits)
+
+		seller, err := ownershipStore.FindByFundAndOwner(ctx, testFund.ID, nil, "Seller")
+		require.NoError(t, err)
+		assert.Equal(t, 500, seller.Units)
+
+		_, err = ownershipStore.FindByFundAndOwner(ctx, testFund.ID, nil, "Alice")
+		assert.ErrorIs(t, err, ownership.ErrOwnerNotFound)
+
+		list, err := transferStore.FindByFundID(ctx, testFund.ID, Filter{}, ListParams{})
+		require.NoError(t, err)
+		assert.Equal(t, 0, list.TotalCount)
+	})
+
+	t.Run("ExecuteBatch idempotency covers the whole batch", func(t *testing.T) {
+		tc.Reset(ctx)
+		testFund := createTestFund(t, "Test Fund", 1000)
+		createOwnership(t, testFund.ID, "Seller", 1000)
+
+		svc, err := NewService(
+			WithRepository(transferStore),
+			WithOwnershipRepository(ownershipStore),
+			WithOwnerRepository(ownerStore),
+			WithPool(tc.Pool()),
+		)
+		require.NoError(t, err)
+
+		key := uuid.New()
+		req := BatchRequest{
+			FundID:         testFund.ID,
+			IdempotencyKey: &key,
+			Legs: []Leg{
+				{FromOwner: "Seller", ToOwner: "Alice", Units: 100},
+				{FromOwner: "Seller", ToOwner: "Bob", Units: 100},
+			},
+		}
+
+		first, err := svc.ExecuteBatch(ctx, req)
+		require.NoError(t, err)
+
+		second, err := svc.ExecuteBatch(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, first.ID, second.ID)
+		require.Len(t, second.Transfers, 2)
+		assert.Equal(t, first.Transfers[1].ID, second.Transfers[1].ID)
+
+		seller, err := ownershipStore.FindByFundAndOwner(ctx, testFund.ID, nil, "Seller")
+		require.NoError(t, err)
+		assert.Equal(t, 800, seller.Units)
+
+		req.Legs = req.Legs[:1]
+		_, err = svc.ExecuteBatch(ctx, req)
+		assert.ErrorIs(t, err, ErrDuplicateIdempotencyKey)
+	})
+
+	t.Run("ExecuteBatch concurrent opposing batches do not deadlock", func(t *testing.T) {
+		tc.Reset(ctx)
+		testFund := createTestFund(t, "Test Fund", 10000)
+		createOwnership(t, testFund.ID, "Alice", 5000)
+		createOwnership(t, testFund.ID, "Bob", 5000)
+
+		svc, err := NewService(
+			WithRepository(transferStore),
+			WithOwnershipRepository(ownershipStore),
+			WithOwnerRepository(ownerStore),
+			WithPool(tc.Pool()),
+		)
+		require.NoError(t, err)
+
+		var wg sync.WaitGroup
+		errs := make(chan error, 20)
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				from, to := "Alice", "Bob"
+				if i%2 == 1 {
+					from, to = to, from
+				}
+				_, err := svc.ExecuteBatch(ctx, BatchRequest{
+					FundID: testFund.ID,
+					Legs: []Leg{
+						{FromOwner: from, ToOwner: to, Units: 10},
+						{FromOwner: to, ToOwner: from, Units: 5},
+					},
+				})
+				errs <- err
+			}(i)
+		}
+		wg.Wait()
+		close(errs)
+
+		for err := range errs {
+			assert.NoError(t, err)
+		}
+
+		alice, err := ownershipStore.FindByFundAndOwner(ctx, testFund.ID, nil, "Alice")
+		require.NoError(t, err)
+		assert.Equal(t, 5000, alice.Units)
+	})
+
+	t.Run("ReverseTransfer restores balances and links both transfers", func(t *testing.T) {
+		tc.Reset(ctx)
+		testFund := createTestFund(t, "Test Fund", 1000)
+		createOwnership(t, testFund.ID, "Alice", 500)
+
+		svc, err := NewService(
+			WithRepository(transferStore),
+			WithOwnershipRepository(ownershipStore),
+			WithOwnerRepository(ownerStore),
+			WithPool(tc.Pool()),
+		)
+		require.NoError(t, err)
+
+		original, err := svc.ExecuteTransfer(ctx, Request{FundID: testFund.ID, FromOwner: "Alice", ToOwner: "Bob", Units: 200})
+		require.NoError(t, err)
+
+		reversal, err := svc.ReverseTransfer(ctx, testFund.ID, original.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Bob", reversal.FromOwner)
+		assert.Equal(t, "Alice", reversal.ToOwner)
+		assert.Equal(t, 200, reversal.Units)
+		require.NotNil(t, reversal.ReversesTransferID)
+		assert.Equal(t, original.ID, *reversal.ReversesTransferID)
+
+		for owner, units := range map[string]int{"Alice": 500, "Bob": 0} {
+			entry, err := ownershipStore.FindByFundAndOwner(ctx, testFund.ID, nil, owner)
+			require.NoError(t, err)
+			assert.Equal(t, units, entry.Units, owner)
+		}
+
+		list, err := svc.ListTransfers(ctx, testFund.ID, Filter{}, ListParams{})
+		require.NoError(t, err)
+		require.Len(t, list.Transfers, 2)
+		require.NotNil(t, list.Transfers[0].ReversedByTransferID)
+		assert.Equal(t, reversal.ID, *list.Transfers[0].ReversedByTransferID)
+		assert.Nil(t, list.Transfers[0].ReversesTransferID)
+		require.NotNil(t, list.Transfers[1].ReversesTransferID)
+		assert.Equal(t, original.ID, *list.Transfers[1].ReversesTransferID)
+		assert.Nil(t, list.Transfers[1].ReversedByTransferID)
+	})
+
+	t.Run("ReverseTransfer refuses a second reversal", func(t *testing.T) {
+		tc.Reset(ctx)
+		testFund := createTestFund(t, "Test Fund", 1000)
+		createOwnership(t, testFund.ID, "Alice", 500)
+
+		svc, err := NewService(
+			WithRepository(transferStore),
+			WithOwnershipRepository(ownershipStore),
+			WithOwnerRepository(ownerStore),
+			WithPool(tc.Pool()),
+		)
+		require.NoError(t, err)
+
+		original, err := svc.ExecuteTransfer(ctx, Request{FundID: testFund.ID, FromOwner: "Alice", ToOwner: "Bob", Units: 100})
+		require.NoError(t, err)
+
+		_, err = svc.ReverseTransfer(ctx, testFund.ID, original.ID)
+		require.NoError(t, err)
+
+		_, err = svc.ReverseTransfer(ctx, testFund.ID, original.ID)
+		assert.ErrorIs(t, err, ErrAlreadyReversed)
+
+		alice, err := ownershipStore.FindByFundAndOwner(ctx, testFund.ID, nil, "Alice")
+		require.NoError(t, err)
+		assert.Equal(t, 500, alice.Units)
+	})
+
+	t.Run("ReverseTransfer refuses when the recipient no longer holds the units", func(t *testing.T) {
+		tc.Reset(ctx)
+		testFund := createTestFund(t, "Test Fund", 1000)
+		createOwnership(t, testFund.ID, "Alice", 500)
+
+		svc, err := NewService(
+			WithRepository(transferStore),
+			WithOwnershipRepository(ownershipStore),
+			WithOwnerRepository(ownerStore),
+			WithPool(tc.Pool()),
+		)
+		require.NoError(t, err)
+
+		original, err := svc.ExecuteTransfer(ctx, Request{FundID: testFund.ID, FromOwner: "Alice", ToOwner: "Bob", Units: 100})
+		require.NoError(t, err)
+		_, err = svc.ExecuteTransfer(ctx, Request{FundID: testFund.ID, FromOwner: "Bob", ToOwner: "Carol", Units: 60})
+		require.NoError(t, err)
+
+		_, err = svc.ReverseTransfer(ctx, testFund.ID, original.ID)
+		assert.ErrorIs(t, err, ErrInsufficientUnits)
+
+		bob, err := ownershipStore.FindByFundAndOwner(ctx, testFund.ID, nil, "Bob")
+		require.NoError(t, err)
+		assert.Equal(t, 40, bob.Units)
+	})
+
+	t.Run("ReverseTransfer unknown transfer", func(t *testing.T) {
+		tc.Reset(ctx)
+		testFund := createTestFund(t, "Test Fund", 1000)
+
+		svc, err := NewService(
+			WithRepository(transferStore),
+			WithOwnershipRepository(ownershipStore),
+			WithOwnerRepository(ownerStore),
+			WithPool(tc.Pool()),
+		)
+		require.NoError(t, err)
+
+		_, err = svc.ReverseTransfer(ctx, testFund.ID, uuid.New())
+		assert.ErrorIs(t, err, ErrTransferNotFound)
+	})
+
+	t.Run("ExecuteTransfer above the fund threshold is held pending and reserves units", func(t *testing.T) {
+		tc.Reset(ctx)
+		testFund := createTestFund(t, "Test Fund", 1000)
+		createOwnership(t, testFund.ID, "Alice", 500)
+		threshold := 100
+		require.NoError(t, fundStore.UpdateApprovalThreshold(ctx, testFund.ID, &threshold))
+
+		svc, err := NewService(
+			WithRepository(transferStore),
+			WithOwnershipRepository(ownershipStore),
+			WithOwnerRepository(ownerStore),
+			WithPool(tc.Pool()),
+		)
+		require.NoError(t, err)
+
+		small, err := svc.ExecuteTransfer(ctx, Request{FundID: testFund.ID, FromOwner: "Alice", ToOwner: "Bob", Units: 100})
+		require.NoError(t, err)
+		assert.Equal(t, StatusApproved, small.Status)
+
+		_, err = svc.ExecuteTransfer(ctx, Request{FundID: testFund.ID, FromOwner: "Alice", ToOwner: "Carol", Units: 300})
+		assert.ErrorIs(t, err, ErrInvalidRequester)
+
+		pending, err := svc.ExecuteTransfer(ctx, Request{FundID: testFund.ID, FromOwner: "Alice", ToOwner: "Carol", Units: 300, RequestedBy: " Operator "})
+		require.NoError(t, err)
+		assert.Equal(t, StatusPending, pending.Status)
+		require.NotNil(t, pending.RequestedBy)
+		assert.Equal(t, "Operator", *pending.RequestedBy)
+
+		alice, err := ownershipStore.FindByFundAndOwner(ctx, testFund.ID, nil, "Alice")
+		require.NoError(t, err)
+		assert.Equal(t, 400, alice.Units)
+
+		_, err = svc.ExecuteTransfer(ctx, Request{FundID: testFund.ID, FromOwner: "Alice", ToOwner: "Bob", Units: 101})
+		assert.ErrorIs(t, err, ErrInsufficientUnits)
+
+		_, err = svc.ExecuteBatch(ctx, BatchRequest{FundID: testFund.ID, Legs: []Leg{
+			{FromOwner: "Alice", ToOwner: "Bob", Units: 60},
+			{FromOwner: "Alice", ToOwner: "Dave", Units: 60},
+		}})
+		assert.ErrorIs(t, err, ErrInsufficientUnits)
+
+		list, err := svc.ListPendingTransfers(ctx, testFund.ID, ListParams{})
+		require.NoError(t, err)
+		require.Len(t, list.Transfers, 1)
+		assert.Equal(t, pending.ID, list.Transfers[0].ID)
+	})
+
+	t.Run("ApproveTransfer settles a pending transfer", func(t *testing.T) {
+		tc.Reset(ctx)
+		testFund := createTestFund(t, "Test Fund", 1000)
+		createOwnership(t, testFund.ID, "Alice", 500)
+		threshold := 100
+		require.NoError(t, fundStore.UpdateApprovalThreshold(ctx, testFund.ID, &threshold))
+
+		svc, err := NewService(
+			WithRepository(transferStore),
+			WithOwnershipRepository(ownershipStore),
+			WithOwnerRepository(ownerStore),
+			WithPool(tc.Pool()),
+		)
+		require.NoError(t, err)
+
+		pending, err := svc.ExecuteTransfer(ctx, Request{FundID: testFund.ID, FromOwner: "Alice", ToOwner: "Bob", Units: 300, RequestedBy: "Operator"})
+		require.NoError(t, err)
+
+		approved, err := svc.ApproveTransfer(ctx, Review{FundID: testFund.ID, TransferID: pending.ID, Reviewer: " Compliance "})
+		require.NoError(t, err)
+		assert.Equal(t, StatusApproved, approved.Status)
+		require.NotNil(t, approved.ReviewedBy)
+		assert.Equal(t, "Compliance", *approved.ReviewedBy)
+		assert.NotNil(t, approved.ReviewedAt)
+
+		for owner, units := range map[string]int{"Alice": 200, "Bob": 300} {
+			entry, err := ownershipStore.FindByFundAndOwner(ctx, testFund.ID, nil, owner)
+			require.NoError(t, err)
+			assert.Equal(t, units, entry.Units, owner)
+		}
+
+		_, err = svc.ApproveTransfer(ctx, Review{FundID: testFund.ID, TransferID: pending.ID, Reviewer: "Compliance"})
+		assert.ErrorIs(t, err, ErrNotPending)
+
+		ledger, err := ownershipStore.FindLedger(ctx, testFund.ID, time.Now())
+		require.NoError(t, err)
+		require.Len(t, ledger.Movements, 1)
+		assert.Equal(t, 300, ledger.Movements[0].Units)
+	})
+
+	t.Run("ApproveTransfer refuses a review by the requester", func(t *testing.T) {
+		tc.Reset(ctx)
+		testFund := createTestFund(t, "Test Fund", 1000)
+		createOwnership(t, testFund.ID, "Alice", 500)
+		threshold := 100
+		require.NoError(t, fundStore.UpdateApprovalThreshold(ctx, testFund.ID, &threshold))
+
+		svc, err := NewService(
+			WithRepository(transferStore),
+			WithOwnershipRepository(ownershipStore),
+			WithOwnerRepository(ownerStore),
+			WithPool(tc.Pool()),
+		)
+		require.NoError(t, err)
+
+		requester := auth.WithPrincipal(ctx, &auth.Principal{Subject: "api_key:requester", Role: auth.RoleOperator})
+		reviewer := auth.WithPrincipal(ctx, &auth.Principal{Subject: "jwt:compliance@example.com", Role: auth.RoleOperator})
+
+		pending, err := svc.ExecuteTransfer(requester, Request{FundID: testFund.ID, FromOwner: "Alice", ToOwner: "Bob", Units: 300})
+		require.NoError(t, err)
+		require.NotNil(t, pending.RequestedBy)
+		assert.Equal(t, "api_key:requester", *pending.RequestedBy)
+
+		_, err = svc.ApproveTransfer(requester, Review{FundID: testFund.ID, TransferID: pending.ID, Reviewer: "Compliance"})
+		assert.ErrorIs(t, err, ErrSelfReview)
+		_, err = svc.RejectTransfer(requester, Review{FundID: testFund.ID, TransferID: pending.ID})
+		assert.ErrorIs(t, err, ErrSelfReview)
+
+		alice, err := ownershipStore.FindByFundAndOwner(ctx, testFund.ID, nil, "Alice")
+		require.NoError(t, err)
+		assert.Equal(t, 200, alice.Units)
+
+		approved, err := svc.ApproveTransfer(reviewer, Review{FundID: testFund.ID, TransferID: pending.ID})
+		require.NoError(t, err)
+		assert.Equal(t, StatusApproved, approved.Status)
+		require.NotNil(t, approved.ReviewedBy)
+		assert.Equal(t, "jwt:compliance@example.com", *approved.ReviewedBy)
+
+		list, err := transferStore.FindByFundID(ctx, testFund.ID, Filter{}, ListParams{})
+		require.NoError(t, err)
+		require.Len(t, list.Transfers, 1)
+		assert.Equal(t, pending.RequestedBy, list.Transfers[0].RequestedBy)
+	})
+
+	t.Run("ApproveTransfer refuses a transfer without a recorded requester", func(t *testing.T) {
+		tc.Reset(ctx)
+		testFund := createTestFund(t, "Test Fund", 1000)
+		createOwnership(t, testFund.ID, "Alice", 500)
+		threshold := 100
+		require.NoError(t, fundStore.UpdateApprovalThreshold(ctx, testFund.ID, &threshold))
+
+		svc, err := NewService(
+			WithRepository(transferStore),
+			WithOwnershipRepository(ownershipStore),
+			WithOwnerRepository(ownerStore),
+			WithPool(tc.Pool()),
+		)
+		require.NoError(t, err)
+
+		pending, err := svc.ExecuteTransfer(ctx, Request{FundID: testFund.ID, FromOwner: "Alice", ToOwner: "Bob", Units: 300, RequestedBy: "Operator"})
+		require.NoError(t, err)
+		_, err = tc.Pool().Exec(ctx, `UPDATE transfers SET requested_by = NULL WHERE id = $1`, pending.ID)
+		require.NoError(t, err)
+
+		_, err = svc.ApproveTransfer(ctx, Review{FundID: testFund.ID, TransferID: pending.ID, Reviewer: "Compliance"})
+		assert.ErrorIs(t, err, ErrUnknownRequester)
+
+		rejected, err := svc.RejectTransfer(ctx, Review{FundID: testFund.ID, TransferID: pending.ID, Reviewer: "Compliance"})
+		require.NoError(t, err)
+		assert.Equal(t, StatusRejected, rejected.Status)
+	})
+
+	t.Run("RejectTransfer releases reserved units", func(t *testing.T) {
+		tc.Reset(ctx)
+		testFund := createTestFund(t, "Test Fund", 1000)
+		createOwnership(t, testFund.ID, "Alice", 500)
+		threshold := 100
+		require.NoError(t, fundStore.UpdateApprovalThreshold(ctx, testFund.ID, &threshold))
+
+		svc, err := NewService(
+			WithRepository(transferStore),
+			WithOwnershipRepository(ownershipStore),
+			WithOwnerRepository(ownerStore),
+			WithPool(tc.Pool()),
+		)
+		require.NoError(t, err)
+
+		pending, err := svc.ExecuteTransfer(ctx, Request{FundID: testFund.ID, FromOwner: "Alice", ToOwner: "Bob", Units: 450, RequestedBy: "Operator"})
+		require.NoError(t, err)
+
+		_, err = svc.ReverseTransfer(ctx, testFund.ID, pending.ID)
+		assert.ErrorIs(t, err, ErrNotApproved)
+
+		reason := "counterparty not onboarded"
+		rejected, err := svc.RejectTransfer(ctx, Review{FundID: testFund.ID, TransferID: pending.ID, Reviewer: "Compliance", Reason: &reason})
+		require.NoError(t, err)
+		assert.Equal(t, StatusRejected, rejected.Status)
+		require.NotNil(t, rejected.RejectionReason)
+		assert.Equal(t, reason, *rejected.RejectionReason)
+
+		alice, err := ownershipStore.FindByFundAndOwner(ctx, testFund.ID, nil, "Alice")
+		require.NoError(t, err)
+		assert.Equal(t, 500, alice.Units)
+		_, err = ownershipStore.FindByFundAndOwner(ctx, testFund.ID, nil, "Bob")
+		assert.ErrorIs(t, err, ownership.ErrOwnerNotFound)
+
+		_, err = svc.ExecuteTransfer(ctx, Request{FundID: testFund.ID, FromOwner: "Alice", ToOwner: "Bob", Units: 100})
+		require.NoError(t, err)
+
+		ledger, err := ownershipStore.FindLedger(ctx, testFund.ID, time.Now())
+		require.NoError(t, err)
+		assert.Len(t, ledger.Movements, 1)
+	})
+
+	t.Run("VerifyLedger detects edits made outside the service", func(t *testing.T) {
+		tc.Reset(ctx)
+		testFund := createTestFund(t, "Test Fund", 1000)
+		createOwnership(t, testFund.ID, "Alice", 500)
+
+		svc, err := NewService(
+			WithRepository(transferStore),
+			WithOwnershipRepository(ownershipStore),
+			WithOwnerRepository(ownerStore),
+			WithPool(tc.Pool()),
+		)
+		require.NoError(t, err)
+
+		var transfers []*Transfer
+		for i := 0; i < 3; i++ {
+			tr, err := svc.ExecuteTransfer(ctx, Request{FundID: testFund.ID, FromOwner: "Alice", ToOwner: "Bob", Units: 10})
+			require.NoError(t, err)
+			transfers = append(transfers, tr)
+		}
+		reversal, err := svc.ReverseTransfer(ctx, testFund.ID, transfers[0].ID)
+		require.NoError(t, err)
+		require.NotNil(t, reversal.LedgerSequence)
+		assert.EqualValues(t, 4, *reversal.LedgerSequence)
+		assert.Equal(t, transfers[2].Hash, reversal.PrevHash)
+
+		result, err := svc.VerifyLedger(ctx, testFund.ID)
+		require.NoError(t, err)
+		assert.True(t, result.Valid())
+		assert.Equal(t, 4, result.Checked)
+		assert.EqualValues(t, 4, result.Head.Sequence)
+		assert.Equal(t, reversal.Hash, result.Head.Hash)
+
+		f, err := fundStore.FindByID(ctx, testFund.ID)
+		require.NoError(t, err)
+		assert.Equal(t, reversal.Hash, f.LedgerHead)
+
+		_, err = tc.Pool().Exec(ctx, `UPDATE transfers SET units = 11 WHERE id = $1`, transfers[1].ID)
+		require.NoError(t, err)
+
+		result, err = svc.VerifyLedger(ctx, testFund.ID)
+		require.NoError(t, err)
+		require.NotNil(t, result.Break)
+		assert.Equal(t, BreakHashMismatch, result.Break.Reason)
+		assert.EqualValues(t, 2, result.Break.Sequence)
+		assert.Equal(t, transfers[1].ID, *result.Break.TransferID)
+		assert.Equal(t, 1, result.Checked)
+
+		_, err = tc.Pool().Exec(ctx, `UPDATE transfers SET units = 10 WHERE id = $1`, transfers[1].ID)
+		require.NoError(t, err)
+		_, err = tc.Pool().Exec(ctx, `DELETE FROM transfers WHERE id = $1`, reversal.ID)
+		require.NoError(t, err)
+
+		result, err = svc.VerifyLedger(ctx, testFund.ID)
+		require.NoError(t, err)
+		require.NotNil(t, result.Break)
+		assert.Equal(t, BreakHeadMismatch, result.Break.Reason)
+
+		_, err = svc.VerifyLedger(ctx, uuid.New())
+		assert.ErrorIs(t, err, ErrFundNotFound)
+	})
+
+	t.Run("VerifyLedger chains review decisions", func(t *testing.T) {
+		tc.Reset(ctx)
+		testFund := createTestFund(t, "Test Fund", 1000)
+		createOwnership(t, testFund.ID, "Alice", 500)
+		threshold := 100
+		require.NoError(t, fundStore.UpdateApprovalThreshold(ctx, testFund.ID, &threshold))
+
+		svc, err := NewService(
+			WithRepository(transferStore),
+			WithOwnershipRepository(ownershipStore),
+			WithOwnerRepository(ownerStore),
+			WithPool(tc.Pool()),
+		)
+		require.NoError(t, err)
+
+		pending, err := svc.ExecuteTransfer(ctx, Request{FundID: testFund.ID, FromOwner: "Alice", ToOwner: "Bob", Units: 300, RequestedBy: "Operator"})
+		require.NoError(t, err)
+		assert.Nil(t, pending.LedgerSequence)
+
+		result, err := svc.VerifyLedger(ctx, testFund.ID)
+		require.NoError(t, err)
+		assert.True(t, result.Valid())
+		assert.Zero(t, result.Checked)
+
+		approved, err := svc.ApproveTransfer(ctx, Review{FundID: testFund.ID, TransferID: pending.ID, Reviewer: "Compliance"})
+		require.NoError(t, err)
+		require.NotNil(t, approved.LedgerSequence)
+		assert.EqualValues(t, 1, *approved.LedgerSequence)
+
+		result, err = svc.VerifyLedger(ctx, testFund.ID)
+		require.NoError(t, err)
+		assert.True(t, result.Valid())
+		assert.Equal(t, 1, result.Checked)
+		assert.Equal(t, approved.Hash, result.Head.Hash)
+
+		_, err = tc.Pool().Exec(ctx, `UPDATE transfers SET status = 'rejected' WHERE id = $1`, approved.ID)
+		require.NoError(t, err)
+
+		result, err = svc.VerifyLedger(ctx, testFund.ID)
+		require.NoError(t, err)
+		require.NotNil(t, result.Break)
+		assert.Equal(t, BreakHashMismatch, result.Break.Reason)
+		assert.Equal(t, approved.ID, *result.Break.TransferID)
+
+		_, err = tc.Pool().Exec(ctx, `UPDATE transfers SET status = 'approved', reviewed_by = 'Someone Else' WHERE id = $1`, approved.ID)
+		require.NoError(t, err)
+
+		result, err = svc.VerifyLedger(ctx, testFund.ID)
+		require.NoError(t, err)
+		require.NotNil(t, result.Break)
+		assert.Equal(t, BreakHashMismatch, result.Break.Reason)
+	})
+
+	t.Run("VerifyLedger detects a reassigned owner ID", func(t *testing.T) {
+		tc.Reset(ctx)
+		testFund := createTestFund(t, "Test Fund", 1000)
+		createOwnership(t, testFund.ID, "Alice", 500)
+
+		svc, err := NewService(
+			WithRepository(transferStore),
+			WithOwnershipRepository(ownershipStore),
+			WithOwnerRepository(ownerStore),
+			WithPool(tc.Pool()),
+		)
+		require.NoError(t, err)
+
+		tr, err := svc.ExecuteTransfer(ctx, Request{FundID: testFund.ID, FromOwner: "Alice", ToOwner: "Bob", Units: 100})
+		require.NoError(t, err)
+		require.NotNil(t, tr.LedgerSequence)
+
+		result, err := svc.VerifyLedger(ctx, testFund.ID)
+		require.NoError(t, err)
+		assert.True(t, result.Valid())
+
+		_, err = tc.Pool().Exec(ctx, `UPDATE transfers SET to_owner_id = from_owner_id WHERE id = $1`, tr.ID)
+		require.NoError(t, err)
+
+		result, err = svc.VerifyLedger(ctx, testFund.ID)
+		require.NoError(t, err)
+		require.NotNil(t, result.Break)
+		assert.Equal(t, BreakHashMismatch, result.Break.Reason)
+		assert.Equal(t, tr.ID, *result.Break.TransferID)
+	})
+
 	t.Run("ListTransfers returns transfer history", func(t *testing.T) {
 		tc.Reset(ctx)
 		testFund := createTestFund(t, "Test Fund", 1000)
@@ -748,6 +1559,7 @@ func TestTransferService(t *testing.T) {
 		svc, err := NewService(
 			WithRepository(transferStore),
 			WithOwnershipRepository(ownershipStore),
+			WithOwnerRepository(ownerStore),
 			WithPool(tc.Pool()),
 		)
 		require.NoError(t, err)
@@ -768,7 +1580,7 @@ func TestTransferService(t *testing.T) {
 		})
 		require.NoError(t, err)
 
-		list, err := svc.ListTransfers(ctx, testFund.ID, ListParams{})
+		list, err := svc.ListTransfers(ctx, testFund.ID, Filter{}, ListParams{})
 		require.NoError(t, err)
 		assert.Len(t, list.Transfers, 2)
 		assert.Equal(t, 2, list.TotalCount)
@@ -777,6 +1589,7 @@ func TestTransferService(t *testing.T) {
 	t.Run("NewService fails without repository", func(t *testing.T) {
 		_, err := NewService(
 			WithOwnershipRepository(ownershipStore),
+			WithOwnerRepository(ownerStore),
 			WithPool(tc.Pool()),
 		)
 		assert.Error(t, err)
@@ -796,6 +1609,7 @@ func TestTransferService(t *testing.T) {
 		_, err := NewService(
 			WithRepository(transferStore),
 			WithOwnershipRepository(ownershipStore),
+			WithOwnerRepository(ownerStore),
 		)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "pool is required")
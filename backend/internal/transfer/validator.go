@@ -6,6 +6,7 @@ import (
 
 	"github.com/arowden/augment-fund/internal/ownership"
 	"github.com/arowden/augment-fund/internal/validation"
+	"github.com/google/uuid"
 )
 
 type Validator struct{}
@@ -15,24 +16,32 @@ func NewValidator() *Validator {
 }
 
 func (v *Validator) ValidateBasic(req Request) error {
-	fromOwner := strings.TrimSpace(req.FromOwner)
-	toOwner := strings.TrimSpace(req.ToOwner)
-
-	if fromOwner == "" || utf8.RuneCountInString(fromOwner) > validation.MaxNameLength {
-		return ErrInvalidOwner
-	}
-	if toOwner == "" || utf8.RuneCountInString(toOwner) > validation.MaxNameLength {
+	if !validOwnerRef(req.FromOwnerID, req.FromOwner) || !validOwnerRef(req.ToOwnerID, req.ToOwner) {
 		return ErrInvalidOwner
 	}
 	if req.Units <= 0 || req.Units > validation.MaxUnits {
 		return ErrInvalidUnits
 	}
-	if fromOwner == toOwner {
+	if req.FromOwnerID != nil && req.ToOwnerID != nil && *req.FromOwnerID == *req.ToOwnerID {
 		return ErrSelfTransfer
 	}
+	if req.FromOwnerID == nil && req.ToOwnerID == nil && strings.TrimSpace(req.FromOwner) == strings.TrimSpace(req.ToOwner) {
+		return ErrSelfTransfer
+	}
+	if utf8.RuneCountInString(strings.TrimSpace(req.RequestedBy)) > validation.MaxNameLength {
+		return ErrInvalidRequester
+	}
 	return nil
 }
 
+func validOwnerRef(id *uuid.UUID, name string) bool {
+	name = strings.TrimSpace(name)
+	if utf8.RuneCountInString(name) > validation.MaxNameLength {
+		return false
+	}
+	return id != nil || name != ""
+}
+
 func (v *Validator) Validate(req Request, fromEntry *ownership.Entry) error {
 	if err := v.ValidateBasic(req); err != nil {
 		return err
@@ -45,3 +54,14 @@ func (v *Validator) Validate(req Request, fromEntry *ownership.Entry) error {
 	}
 	return nil
 }
+
+func (v *Validator) ValidateReview(r Review) error {
+	reviewer := strings.TrimSpace(r.Reviewer)
+	if reviewer == "" || utf8.RuneCountInString(reviewer) > validation.MaxNameLength {
+		return ErrInvalidReviewer
+	}
+	if r.Reason != nil && utf8.RuneCountInString(*r.Reason) > MaxReasonLength {
+		return ErrInvalidReason
+	}
+	return nil
+}
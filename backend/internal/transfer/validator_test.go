@@ -1,6 +1,7 @@
 package transfer
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/arowden/augment-fund/internal/ownership"
@@ -121,6 +122,18 @@ func TestValidator_ValidateBasic(t *testing.T) {
 		err := v.ValidateBasic(req)
 		assert.ErrorIs(t, err, ErrSelfTransfer)
 	})
+
+	t.Run("overlong requester returns ErrInvalidRequester", func(t *testing.T) {
+		req := Request{
+			FundID:      fundID,
+			FromOwner:   "Alice",
+			ToOwner:     "Bob",
+			Units:       100,
+			RequestedBy: strings.Repeat("a", 256),
+		}
+		err := v.ValidateBasic(req)
+		assert.ErrorIs(t, err, ErrInvalidRequester)
+	})
 }
 
 func TestValidator_Validate(t *testing.T) {
@@ -206,3 +219,21 @@ func TestValidator_Validate(t *testing.T) {
 		assert.ErrorIs(t, err, ErrInvalidOwner)
 	})
 }
+
+func TestValidator_ValidateReview(t *testing.T) {
+	v := NewValidator()
+
+	t.Run("valid review passes validation", func(t *testing.T) {
+		reason := "exceeds concentration limit"
+		assert.NoError(t, v.ValidateReview(Review{Reviewer: "Compliance Officer", Reason: &reason}))
+	})
+
+	t.Run("whitespace-only reviewer returns ErrInvalidReviewer", func(t *testing.T) {
+		assert.ErrorIs(t, v.ValidateReview(Review{Reviewer: "   "}), ErrInvalidReviewer)
+	})
+
+	t.Run("overlong reason returns ErrInvalidReason", func(t *testing.T) {
+		reason := strings.Repeat("a", MaxReasonLength+1)
+		assert.ErrorIs(t, v.ValidateReview(Review{Reviewer: "Compliance Officer", Reason: &reason}), ErrInvalidReason)
+	})
+}
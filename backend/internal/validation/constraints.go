@@ -1,5 +1,7 @@
 package validation
 
+import "fmt"
+
 const (
 	MinNameLength = 1
 	MaxNameLength = 255
@@ -22,6 +24,7 @@ const (
 type ListParams struct {
 	Limit  int
 	Offset int
+	Cursor string
 }
 
 func (p ListParams) Normalize() ListParams {
@@ -31,8 +34,15 @@ func (p ListParams) Normalize() ListParams {
 	if p.Limit > MaxLimit {
 		p.Limit = MaxLimit
 	}
-	if p.Offset < 0 {
+	if p.Offset < 0 || p.Cursor != "" {
 		p.Offset = 0
 	}
 	return p
 }
+
+func (p ListParams) Validate() error {
+	if p.Cursor != "" && p.Offset > 0 {
+		return fmt.Errorf("%w: cursor cannot be combined with offset", ErrInvalidCursor)
+	}
+	return nil
+}
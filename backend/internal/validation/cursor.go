@@ -0,0 +1,57 @@
+package validation
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+type cursorEnvelope struct {
+	Kind string          `json:"k"`
+	Key  json.RawMessage `json:"v"`
+}
+
+func EncodeCursor(kind string, key any) (string, error) {
+	raw, err := json.Marshal(key)
+	if err != nil {
+		return "", fmt.Errorf("encode %s cursor: %w", kind, err)
+	}
+	data, err := json.Marshal(cursorEnvelope{Kind: kind, Key: raw})
+	if err != nil {
+		return "", fmt.Errorf("encode %s cursor: %w", kind, err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func DecodeCursor(kind, token string, key any) error {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return fmt.Errorf("%w: not a valid token", ErrInvalidCursor)
+	}
+	var env cursorEnvelope
+	if err := json.Unmarshal(data, &env); err != nil || env.Kind != kind {
+		return fmt.Errorf("%w: not a %s cursor", ErrInvalidCursor, kind)
+	}
+	dec := json.NewDecoder(bytes.NewReader(env.Key))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(key); err != nil {
+		return fmt.Errorf("%w: not a %s cursor", ErrInvalidCursor, kind)
+	}
+	return nil
+}
+
+func NextPage[T any](kind string, items []T, limit int, key func(T) any) ([]T, string, error) {
+	if len(items) <= limit {
+		return items, "", nil
+	}
+	items = items[:limit]
+	token, err := EncodeCursor(kind, key(items[limit-1]))
+	if err != nil {
+		return nil, "", err
+	}
+	return items, token, nil
+}
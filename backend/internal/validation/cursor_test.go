@@ -0,0 +1,74 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testKey struct {
+	Units int    `json:"units"`
+	Name  string `json:"name"`
+}
+
+func TestCursor_RoundTrip(t *testing.T) {
+	token, err := EncodeCursor("test", testKey{Units: 500, Name: "Alice"})
+	require.NoError(t, err)
+	assert.NotContains(t, token, "Alice")
+
+	var key testKey
+	require.NoError(t, DecodeCursor("test", token, &key))
+	assert.Equal(t, testKey{Units: 500, Name: "Alice"}, key)
+}
+
+func TestDecodeCursor_Invalid(t *testing.T) {
+	other, err := EncodeCursor("other", testKey{Units: 1})
+	require.NoError(t, err)
+	unknownField, err := EncodeCursor("test", map[string]any{"units": 1, "extra": true})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"not base64", "%%%"},
+		{"not json", "bm90IGpzb24"},
+		{"different kind", other},
+		{"unknown field", unknownField},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var key testKey
+			assert.ErrorIs(t, DecodeCursor("test", tt.token, &key), ErrInvalidCursor)
+		})
+	}
+}
+
+func TestNextPage(t *testing.T) {
+	key := func(n int) any { return testKey{Units: n} }
+
+	t.Run("last page has no cursor", func(t *testing.T) {
+		items, next, err := NextPage("test", []int{1, 2}, 2, key)
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 2}, items)
+		assert.Empty(t, next)
+	})
+
+	t.Run("extra row is trimmed and keys the cursor", func(t *testing.T) {
+		items, next, err := NextPage("test", []int{1, 2, 3}, 2, key)
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 2}, items)
+
+		var got testKey
+		require.NoError(t, DecodeCursor("test", next, &got))
+		assert.Equal(t, 2, got.Units)
+	})
+}
+
+func TestListParams_Validate(t *testing.T) {
+	assert.NoError(t, ListParams{Offset: 10}.Validate())
+	assert.NoError(t, ListParams{Cursor: "abc"}.Validate())
+	assert.ErrorIs(t, ListParams{Cursor: "abc", Offset: 10}.Validate(), ErrInvalidCursor)
+	assert.Equal(t, 0, ListParams{Cursor: "abc", Offset: -1}.Normalize().Offset)
+}
@@ -0,0 +1,15 @@
+package webhook
+
+import "time"
+
+type Config struct {
+	PollInterval time.Duration `envconfig:"WEBHOOK_POLL_INTERVAL" default:"1s"`
+	Timeout      time.Duration `envconfig:"WEBHOOK_TIMEOUT" default:"10s"`
+	MaxAttempts  int           `envconfig:"WEBHOOK_MAX_ATTEMPTS" default:"8"`
+	BackoffBase  time.Duration `envconfig:"WEBHOOK_BACKOFF_BASE" default:"5s"`
+	BackoffMax   time.Duration `envconfig:"WEBHOOK_BACKOFF_MAX" default:"1h"`
+}
+
+func (c Config) RetryPolicy() RetryPolicy {
+	return RetryPolicy{MaxAttempts: c.MaxAttempts, BaseDelay: c.BackoffBase, MaxDelay: c.BackoffMax}
+}
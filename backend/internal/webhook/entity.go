@@ -0,0 +1,110 @@
+package webhook
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/arowden/augment-fund/internal/outbox"
+	"github.com/google/uuid"
+)
+
+type DeliveryStatus string
+
+const (
+	DeliveryPending   DeliveryStatus = "pending"
+	DeliveryDelivered DeliveryStatus = "delivered"
+	DeliveryDead      DeliveryStatus = "dead"
+)
+
+type Endpoint struct {
+	ID         uuid.UUID
+	URL        string
+	Secret     string
+	EventTypes []string
+	CreatedAt  time.Time
+}
+
+func NewEndpoint(rawURL string, eventTypes []string) (*Endpoint, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, ErrInvalidURL
+	}
+	for _, t := range eventTypes {
+		if !outbox.IsEventType(t) {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidEventType, t)
+		}
+	}
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+
+	secret, err := newSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Endpoint{
+		ID:         uuid.New(),
+		URL:        u.String(),
+		Secret:     secret,
+		EventTypes: eventTypes,
+		CreatedAt:  time.Now(),
+	}, nil
+}
+
+func (e *Endpoint) Subscribes(eventType string) bool {
+	if len(e.EventTypes) == 0 {
+		return true
+	}
+	for _, t := range e.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func newSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate webhook secret: %w", err)
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+type Delivery struct {
+	ID             uuid.UUID
+	EndpointID     uuid.UUID
+	Status         DeliveryStatus
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastStatusCode *int
+	LastError      *string
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time
+	Event          outbox.Event
+}
+
+func (d *Delivery) scanTargets() []any {
+	return []any{
+		&d.ID, &d.EndpointID, &d.Status, &d.Attempts, &d.NextAttemptAt,
+		&d.LastStatusCode, &d.LastError, &d.DeliveredAt, &d.CreatedAt,
+		&d.Event.Sequence, &d.Event.ID, &d.Event.Type, &d.Event.FundID, &d.Event.Payload, &d.Event.CreatedAt,
+	}
+}
+
+type Target struct {
+	Delivery *Delivery
+	URL      string
+	Secret   string
+}
+
+type DeliveryList struct {
+	Deliveries []*Delivery
+	TotalCount int
+	Limit      int
+	Offset     int
+}
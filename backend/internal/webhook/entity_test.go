@@ -0,0 +1,39 @@
+package webhook
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/arowden/augment-fund/internal/outbox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEndpoint(t *testing.T) {
+	t.Run("generates a secret", func(t *testing.T) {
+		e, err := NewEndpoint("https://example.com/hooks", nil)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(e.Secret, "whsec_"))
+		assert.Empty(t, e.EventTypes)
+		assert.True(t, e.Subscribes(outbox.EventFundCreated))
+	})
+
+	t.Run("filters by event type", func(t *testing.T) {
+		e, err := NewEndpoint("http://localhost:9000/hooks", []string{outbox.EventTransferExecuted})
+		require.NoError(t, err)
+		assert.True(t, e.Subscribes(outbox.EventTransferExecuted))
+		assert.False(t, e.Subscribes(outbox.EventFundCreated))
+	})
+
+	t.Run("rejects invalid urls", func(t *testing.T) {
+		for _, u := range []string{"", "example.com/hooks", "ftp://example.com", "https://"} {
+			_, err := NewEndpoint(u, nil)
+			assert.ErrorIs(t, err, ErrInvalidURL, u)
+		}
+	})
+
+	t.Run("rejects unknown event types", func(t *testing.T) {
+		_, err := NewEndpoint("https://example.com/hooks", []string{"fund.deleted"})
+		assert.ErrorIs(t, err, ErrInvalidEventType)
+	})
+}
@@ -0,0 +1,22 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidURL = errors.New("webhook url must be an absolute http or https URL")
+
+var ErrInvalidEventType = errors.New("unknown webhook event type")
+
+var ErrEndpointNotFound = errors.New("webhook endpoint not found")
+
+var ErrDeliveryNotFound = errors.New("webhook delivery not found")
+
+var ErrDeliveryNotDead = errors.New("only dead-lettered deliveries can be retried")
+
+var ErrInvalidSignature = errors.New("webhook signature does not match")
+
+func unexpectedStatus(code int) error {
+	return fmt.Errorf("receiver responded with status %d", code)
+}
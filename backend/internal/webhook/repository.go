@@ -0,0 +1,31 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/arowden/augment-fund/internal/validation"
+	"github.com/google/uuid"
+)
+
+type ListParams = validation.ListParams
+
+type Repository interface {
+	CreateEndpoint(ctx context.Context, endpoint *Endpoint) error
+
+	FindEndpoint(ctx context.Context, id uuid.UUID) (*Endpoint, error)
+
+	ListEndpoints(ctx context.Context) ([]*Endpoint, error)
+
+	DeleteEndpoint(ctx context.Context, id uuid.UUID) error
+
+	ListDeliveries(ctx context.Context, endpointID uuid.UUID, status *DeliveryStatus, params ListParams) (*DeliveryList, error)
+
+	RequeueDelivery(ctx context.Context, endpointID, deliveryID uuid.UUID) (*Delivery, error)
+
+	FanOut(ctx context.Context, limit int) (int, error)
+
+	ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*Target, error)
+
+	RecordAttempt(ctx context.Context, delivery *Delivery) error
+}
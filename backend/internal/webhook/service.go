@@ -0,0 +1,225 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultBatchSize = 100
+	maxErrorLength   = 500
+)
+
+type Service struct {
+	repo      Repository
+	client    *http.Client
+	log       *slog.Logger
+	policy    RetryPolicy
+	batchSize int
+}
+
+type ServiceOption func(*Service)
+
+func WithRepository(r Repository) ServiceOption {
+	return func(s *Service) { s.repo = r }
+}
+
+func WithHTTPClient(c *http.Client) ServiceOption {
+	return func(s *Service) { s.client = c }
+}
+
+func WithLogger(l *slog.Logger) ServiceOption {
+	return func(s *Service) { s.log = l }
+}
+
+func WithRetryPolicy(p RetryPolicy) ServiceOption {
+	return func(s *Service) { s.policy = p }
+}
+
+func WithBatchSize(n int) ServiceOption {
+	return func(s *Service) { s.batchSize = n }
+}
+
+func NewService(opts ...ServiceOption) (*Service, error) {
+	s := &Service{
+		client:    &http.Client{Timeout: 10 * time.Second},
+		log:       slog.Default(),
+		policy:    RetryPolicy{MaxAttempts: 8, BaseDelay: 5 * time.Second, MaxDelay: time.Hour},
+		batchSize: defaultBatchSize,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.repo == nil {
+		return nil, errors.New("webhook: repository is required")
+	}
+	return s, nil
+}
+
+func (s *Service) RegisterEndpoint(ctx context.Context, url string, eventTypes []string) (*Endpoint, error) {
+	endpoint, err := NewEndpoint(url, eventTypes)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.CreateEndpoint(ctx, endpoint); err != nil {
+		return nil, err
+	}
+	return endpoint, nil
+}
+
+func (s *Service) ListEndpoints(ctx context.Context) ([]*Endpoint, error) {
+	return s.repo.ListEndpoints(ctx)
+}
+
+func (s *Service) DeleteEndpoint(ctx context.Context, id uuid.UUID) error {
+	return s.repo.DeleteEndpoint(ctx, id)
+}
+
+func (s *Service) ListDeliveries(ctx context.Context, endpointID uuid.UUID, status *DeliveryStatus, params ListParams) (*DeliveryList, error) {
+	if _, err := s.repo.FindEndpoint(ctx, endpointID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListDeliveries(ctx, endpointID, status, params)
+}
+
+func (s *Service) RetryDelivery(ctx context.Context, endpointID, deliveryID uuid.UUID) (*Delivery, error) {
+	return s.repo.RequeueDelivery(ctx, endpointID, deliveryID)
+}
+
+func (s *Service) Dispatch(ctx context.Context) (int, error) {
+	if _, err := s.repo.FanOut(ctx, s.batchSize); err != nil {
+		return 0, err
+	}
+
+	targets, err := s.repo.ClaimDue(ctx, s.batchSize, s.lease())
+	if err != nil {
+		return 0, err
+	}
+
+	var wg sync.WaitGroup
+	for _, t := range targets {
+		wg.Add(1)
+		go func(t *Target) {
+			defer wg.Done()
+			s.deliver(ctx, t)
+		}(t)
+	}
+	wg.Wait()
+
+	return len(targets), nil
+}
+
+func (s *Service) Schedule(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.Dispatch(ctx); err != nil && ctx.Err() == nil {
+			s.log.Error("webhook dispatch failed", slog.String("error", err.Error()))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Service) lease() time.Duration {
+	if s.client.Timeout > 0 {
+		return 2 * s.client.Timeout
+	}
+	return time.Minute
+}
+
+func (s *Service) deliver(ctx context.Context, t *Target) {
+	d := t.Delivery
+	statusCode, err := s.post(ctx, t)
+	if ctx.Err() != nil {
+		return
+	}
+
+	now := time.Now()
+	d.Attempts++
+	if statusCode != 0 {
+		d.LastStatusCode = &statusCode
+	}
+
+	if err == nil {
+		d.Status = DeliveryDelivered
+		d.DeliveredAt = &now
+		d.LastError = nil
+	} else {
+		msg := err.Error()
+		if len(msg) > maxErrorLength {
+			msg = msg[:maxErrorLength]
+		}
+		d.LastError = &msg
+		if s.policy.Exhausted(d.Attempts) {
+			d.Status = DeliveryDead
+			s.log.Warn("webhook delivery dead-lettered",
+				slog.String("deliveryId", d.ID.String()),
+				slog.String("endpointId", d.EndpointID.String()),
+				slog.String("eventId", d.Event.ID.String()),
+				slog.Int("attempts", d.Attempts),
+				slog.String("error", msg),
+			)
+		} else {
+			d.NextAttemptAt = now.Add(s.policy.Delay(d.Attempts))
+		}
+	}
+
+	if err := s.repo.RecordAttempt(ctx, d); err != nil {
+		s.log.Error("failed to record webhook attempt",
+			slog.String("deliveryId", d.ID.String()),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+func (s *Service) post(ctx context.Context, t *Target) (int, error) {
+	d := t.Delivery
+	body, err := json.Marshal(d.Event.Envelope())
+	if err != nil {
+		return 0, fmt.Errorf("marshal event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("build request: %w", err)
+	}
+	now := time.Now()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEventID, d.Event.ID.String())
+	req.Header.Set(HeaderEventType, d.Event.Type)
+	req.Header.Set(HeaderDeliveryID, d.ID.String())
+	req.Header.Set(HeaderTimestamp, fmt.Sprintf("%d", now.Unix()))
+	req.Header.Set(HeaderSignature, Sign(t.Secret, now, body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, unexpectedStatus(resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,238 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/arowden/augment-fund/internal/outbox"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRepository struct {
+	mu       sync.Mutex
+	due      []*Target
+	recorded []*Delivery
+
+	fanOutFunc        func(ctx context.Context, limit int) (int, error)
+	findEndpointFunc  func(ctx context.Context, id uuid.UUID) (*Endpoint, error)
+	listDeliveriesHit bool
+}
+
+func (m *mockRepository) CreateEndpoint(ctx context.Context, endpoint *Endpoint) error {
+	return nil
+}
+
+func (m *mockRepository) FindEndpoint(ctx context.Context, id uuid.UUID) (*Endpoint, error) {
+	if m.findEndpointFunc != nil {
+		return m.findEndpointFunc(ctx, id)
+	}
+	return &Endpoint{ID: id}, nil
+}
+
+func (m *mockRepository) ListEndpoints(ctx context.Context) ([]*Endpoint, error) {
+	return nil, nil
+}
+
+func (m *mockRepository) DeleteEndpoint(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+func (m *mockRepository) ListDeliveries(ctx context.Context, endpointID uuid.UUID, status *DeliveryStatus, params ListParams) (*DeliveryList, error) {
+	m.listDeliveriesHit = true
+	return &DeliveryList{}, nil
+}
+
+func (m *mockRepository) RequeueDelivery(ctx context.Context, endpointID, deliveryID uuid.UUID) (*Delivery, error) {
+	return nil, ErrDeliveryNotFound
+}
+
+func (m *mockRepository) FanOut(ctx context.Context, limit int) (int, error) {
+	if m.fanOutFunc != nil {
+		return m.fanOutFunc(ctx, limit)
+	}
+	return 0, nil
+}
+
+func (m *mockRepository) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*Target, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	due := m.due
+	m.due = nil
+	return due, nil
+}
+
+func (m *mockRepository) RecordAttempt(ctx context.Context, delivery *Delivery) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	copied := *delivery
+	m.recorded = append(m.recorded, &copied)
+	return nil
+}
+
+func newTarget(url string, attempts int) *Target {
+	return &Target{
+		URL:    url,
+		Secret: "whsec_test",
+		Delivery: &Delivery{
+			ID:         uuid.New(),
+			EndpointID: uuid.New(),
+			Status:     DeliveryPending,
+			Attempts:   attempts,
+			Event: outbox.Event{
+				Sequence:  42,
+				ID:        uuid.New(),
+				Type:      outbox.EventTransferExecuted,
+				FundID:    uuid.New(),
+				Payload:   json.RawMessage(`{"units":100}`),
+				CreatedAt: time.Now(),
+			},
+		},
+	}
+}
+
+func TestNewService(t *testing.T) {
+	t.Run("returns error when repository is nil", func(t *testing.T) {
+		svc, err := NewService()
+		assert.Nil(t, svc)
+		assert.ErrorContains(t, err, "repository is required")
+	})
+}
+
+func TestService_Dispatch(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, BaseDelay: time.Minute, MaxDelay: time.Hour}
+
+	t.Run("delivers signed events", func(t *testing.T) {
+		var (
+			gotBody    []byte
+			gotHeaders http.Header
+		)
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotBody, _ = io.ReadAll(r.Body)
+			gotHeaders = r.Header.Clone()
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer receiver.Close()
+
+		target := newTarget(receiver.URL, 0)
+		repo := &mockRepository{due: []*Target{target}}
+		svc, err := NewService(WithRepository(repo), WithRetryPolicy(policy))
+		require.NoError(t, err)
+
+		n, err := svc.Dispatch(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+
+		require.Len(t, repo.recorded, 1)
+		d := repo.recorded[0]
+		assert.Equal(t, DeliveryDelivered, d.Status)
+		assert.Equal(t, 1, d.Attempts)
+		require.NotNil(t, d.LastStatusCode)
+		assert.Equal(t, http.StatusNoContent, *d.LastStatusCode)
+		assert.NotNil(t, d.DeliveredAt)
+
+		assert.Equal(t, target.Delivery.Event.ID.String(), gotHeaders.Get(HeaderEventID))
+		assert.Equal(t, outbox.EventTransferExecuted, gotHeaders.Get(HeaderEventType))
+		assert.Equal(t, target.Delivery.ID.String(), gotHeaders.Get(HeaderDeliveryID))
+		assert.NoError(t, Verify("whsec_test", gotHeaders.Get(HeaderTimestamp), gotHeaders.Get(HeaderSignature), gotBody))
+
+		var env map[string]any
+		require.NoError(t, json.Unmarshal(gotBody, &env))
+		assert.Equal(t, outbox.EventTransferExecuted, env["type"])
+		assert.EqualValues(t, 42, env["sequence"])
+		assert.Equal(t, map[string]any{"units": float64(100)}, env["data"])
+	})
+
+	t.Run("schedules a retry with backoff on failure", func(t *testing.T) {
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer receiver.Close()
+
+		repo := &mockRepository{due: []*Target{newTarget(receiver.URL, 1)}}
+		svc, err := NewService(WithRepository(repo), WithRetryPolicy(policy))
+		require.NoError(t, err)
+
+		before := time.Now()
+		_, err = svc.Dispatch(context.Background())
+		require.NoError(t, err)
+
+		require.Len(t, repo.recorded, 1)
+		d := repo.recorded[0]
+		assert.Equal(t, DeliveryPending, d.Status)
+		assert.Equal(t, 2, d.Attempts)
+		require.NotNil(t, d.LastStatusCode)
+		assert.Equal(t, http.StatusInternalServerError, *d.LastStatusCode)
+		require.NotNil(t, d.LastError)
+		assert.Contains(t, *d.LastError, "500")
+		assert.WithinDuration(t, before.Add(2*time.Minute), d.NextAttemptAt, 5*time.Second)
+	})
+
+	t.Run("dead-letters after the last attempt", func(t *testing.T) {
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer receiver.Close()
+
+		repo := &mockRepository{due: []*Target{newTarget(receiver.URL, 2)}}
+		svc, err := NewService(WithRepository(repo), WithRetryPolicy(policy))
+		require.NoError(t, err)
+
+		_, err = svc.Dispatch(context.Background())
+		require.NoError(t, err)
+
+		require.Len(t, repo.recorded, 1)
+		assert.Equal(t, DeliveryDead, repo.recorded[0].Status)
+		assert.Equal(t, 3, repo.recorded[0].Attempts)
+	})
+
+	t.Run("records connection errors", func(t *testing.T) {
+		receiver := httptest.NewServer(http.NotFoundHandler())
+		url := receiver.URL
+		receiver.Close()
+
+		repo := &mockRepository{due: []*Target{newTarget(url, 0)}}
+		svc, err := NewService(WithRepository(repo), WithRetryPolicy(policy))
+		require.NoError(t, err)
+
+		_, err = svc.Dispatch(context.Background())
+		require.NoError(t, err)
+
+		require.Len(t, repo.recorded, 1)
+		d := repo.recorded[0]
+		assert.Equal(t, DeliveryPending, d.Status)
+		assert.Nil(t, d.LastStatusCode)
+		assert.NotNil(t, d.LastError)
+	})
+
+	t.Run("stops when fan-out fails", func(t *testing.T) {
+		repo := &mockRepository{fanOutFunc: func(ctx context.Context, limit int) (int, error) {
+			return 0, errors.New("db down")
+		}}
+		svc, err := NewService(WithRepository(repo))
+		require.NoError(t, err)
+
+		_, err = svc.Dispatch(context.Background())
+		assert.ErrorContains(t, err, "db down")
+	})
+}
+
+func TestService_ListDeliveries(t *testing.T) {
+	repo := &mockRepository{findEndpointFunc: func(ctx context.Context, id uuid.UUID) (*Endpoint, error) {
+		return nil, ErrEndpointNotFound
+	}}
+	svc, err := NewService(WithRepository(repo))
+	require.NoError(t, err)
+
+	_, err = svc.ListDeliveries(context.Background(), uuid.New(), nil, ListParams{})
+	assert.ErrorIs(t, err, ErrEndpointNotFound)
+	assert.False(t, repo.listDeliveriesHit)
+}
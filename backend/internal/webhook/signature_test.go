@@ -0,0 +1,45 @@
+package webhook
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSign(t *testing.T) {
+	ts := time.Unix(1700000000, 0)
+	body := []byte(`{"id":"evt"}`)
+
+	t.Run("is deterministic", func(t *testing.T) {
+		assert.Equal(t, Sign("whsec_a", ts, body), Sign("whsec_a", ts, body))
+	})
+
+	t.Run("depends on secret, timestamp and body", func(t *testing.T) {
+		sig := Sign("whsec_a", ts, body)
+		assert.NotEqual(t, sig, Sign("whsec_b", ts, body))
+		assert.NotEqual(t, sig, Sign("whsec_a", ts.Add(time.Second), body))
+		assert.NotEqual(t, sig, Sign("whsec_a", ts, []byte(`{"id":"other"}`)))
+	})
+
+	t.Run("verifies its own signature", func(t *testing.T) {
+		sig := Sign("whsec_a", ts, body)
+		assert.NoError(t, Verify("whsec_a", strconv.FormatInt(ts.Unix(), 10), sig, body))
+		assert.ErrorIs(t, Verify("whsec_b", strconv.FormatInt(ts.Unix(), 10), sig, body), ErrInvalidSignature)
+		assert.ErrorIs(t, Verify("whsec_a", "not-a-number", sig, body), ErrInvalidSignature)
+	})
+}
+
+func TestRetryPolicy(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 4, BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+
+	assert.Equal(t, time.Second, p.Delay(1))
+	assert.Equal(t, 2*time.Second, p.Delay(2))
+	assert.Equal(t, 4*time.Second, p.Delay(3))
+	assert.Equal(t, 5*time.Second, p.Delay(4))
+	assert.Equal(t, 5*time.Second, p.Delay(30))
+
+	assert.False(t, p.Exhausted(3))
+	assert.True(t, p.Exhausted(4))
+}
@@ -0,0 +1,268 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type DB interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+const deliveryColumns = `d.id, d.endpoint_id, d.status, d.attempts, d.next_attempt_at,
+		d.last_status_code, d.last_error, d.delivered_at, d.created_at,
+		o.sequence, o.id, o.event_type, o.fund_id, o.payload, o.created_at`
+
+type Store struct {
+	db DB
+}
+
+func NewStore(db DB) *Store {
+	if db == nil {
+		return nil
+	}
+	return &Store{db: db}
+}
+
+func (s *Store) CreateEndpoint(ctx context.Context, endpoint *Endpoint) error {
+	const query = `
+		INSERT INTO webhook_endpoints (id, url, secret, event_types, created_at)
+		VALUES ($1, $2, $3, $4, NOW())
+		RETURNING created_at
+	`
+	err := s.db.QueryRow(ctx, query, endpoint.ID, endpoint.URL, endpoint.Secret, endpoint.EventTypes).Scan(&endpoint.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("create webhook endpoint %s: %w", endpoint.ID, err)
+	}
+	return nil
+}
+
+func (s *Store) FindEndpoint(ctx context.Context, id uuid.UUID) (*Endpoint, error) {
+	const query = `
+		SELECT id, url, secret, event_types, created_at
+		FROM webhook_endpoints
+		WHERE id = $1
+	`
+	var e Endpoint
+	err := s.db.QueryRow(ctx, query, id).Scan(&e.ID, &e.URL, &e.Secret, &e.EventTypes, &e.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrEndpointNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("find webhook endpoint %s: %w", id, err)
+	}
+	return &e, nil
+}
+
+func (s *Store) ListEndpoints(ctx context.Context) ([]*Endpoint, error) {
+	const query = `
+		SELECT id, url, secret, event_types, created_at
+		FROM webhook_endpoints
+		ORDER BY created_at ASC, id ASC
+	`
+	rows, err := s.db.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("list webhook endpoints: %w", err)
+	}
+	defer rows.Close()
+
+	endpoints := []*Endpoint{}
+	for rows.Next() {
+		var e Endpoint
+		if err := rows.Scan(&e.ID, &e.URL, &e.Secret, &e.EventTypes, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan webhook endpoint row: %w", err)
+		}
+		endpoints = append(endpoints, &e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate webhook endpoint rows: %w", err)
+	}
+	return endpoints, nil
+}
+
+func (s *Store) DeleteEndpoint(ctx context.Context, id uuid.UUID) error {
+	tag, err := s.db.Exec(ctx, `DELETE FROM webhook_endpoints WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("delete webhook endpoint %s: %w", id, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrEndpointNotFound
+	}
+	return nil
+}
+
+func (s *Store) ListDeliveries(ctx context.Context, endpointID uuid.UUID, status *DeliveryStatus, params ListParams) (*DeliveryList, error) {
+	params = params.Normalize()
+
+	const query = `
+		SELECT ` + deliveryColumns + `, COUNT(*) OVER() AS total
+		FROM webhook_deliveries d
+		JOIN outbox o ON o.sequence = d.event_sequence
+		WHERE d.endpoint_id = $1 AND ($2::text IS NULL OR d.status = $2)
+		ORDER BY d.event_sequence ASC
+		LIMIT $3 OFFSET $4
+	`
+	rows, err := s.db.Query(ctx, query, endpointID, status, params.Limit, params.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("list deliveries for webhook endpoint %s: %w", endpointID, err)
+	}
+	defer rows.Close()
+
+	deliveries := make([]*Delivery, 0, params.Limit)
+	var total int
+	for rows.Next() {
+		var d Delivery
+		if err := rows.Scan(append(d.scanTargets(), &total)...); err != nil {
+			return nil, fmt.Errorf("scan webhook delivery row: %w", err)
+		}
+		deliveries = append(deliveries, &d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate webhook delivery rows: %w", err)
+	}
+
+	if len(deliveries) == 0 && params.Offset > 0 {
+		const countQuery = `SELECT COUNT(*) FROM webhook_deliveries WHERE endpoint_id = $1 AND ($2::text IS NULL OR status = $2)`
+		if err := s.db.QueryRow(ctx, countQuery, endpointID, status).Scan(&total); err != nil {
+			return nil, fmt.Errorf("count webhook deliveries: %w", err)
+		}
+	}
+
+	return &DeliveryList{
+		Deliveries: deliveries,
+		TotalCount: total,
+		Limit:      params.Limit,
+		Offset:     params.Offset,
+	}, nil
+}
+
+func (s *Store) RequeueDelivery(ctx context.Context, endpointID, deliveryID uuid.UUID) (*Delivery, error) {
+	const query = `
+		WITH requeued AS (
+			UPDATE webhook_deliveries
+			SET status = 'pending', attempts = 0, next_attempt_at = NOW()
+			WHERE id = $1 AND endpoint_id = $2 AND status = 'dead'
+			RETURNING *
+		)
+		SELECT ` + deliveryColumns + `
+		FROM requeued d
+		JOIN outbox o ON o.sequence = d.event_sequence
+	`
+	var d Delivery
+	err := s.db.QueryRow(ctx, query, deliveryID, endpointID).Scan(d.scanTargets()...)
+	if err == nil {
+		return &d, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("requeue webhook delivery %s: %w", deliveryID, err)
+	}
+
+	var exists bool
+	const existsQuery = `SELECT EXISTS (SELECT 1 FROM webhook_deliveries WHERE id = $1 AND endpoint_id = $2)`
+	if err := s.db.QueryRow(ctx, existsQuery, deliveryID, endpointID).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("find webhook delivery %s: %w", deliveryID, err)
+	}
+	if !exists {
+		return nil, ErrDeliveryNotFound
+	}
+	return nil, ErrDeliveryNotDead
+}
+
+func (s *Store) FanOut(ctx context.Context, limit int) (int, error) {
+	const query = `
+		WITH events AS (
+			SELECT sequence, event_type
+			FROM outbox
+			WHERE dispatched_at IS NULL
+			ORDER BY sequence ASC
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		), fanned AS (
+			INSERT INTO webhook_deliveries (endpoint_id, event_sequence)
+			SELECT e.id, ev.sequence
+			FROM events ev
+			JOIN webhook_endpoints e ON cardinality(e.event_types) = 0 OR ev.event_type = ANY(e.event_types)
+			ON CONFLICT (endpoint_id, event_sequence) DO NOTHING
+		)
+		UPDATE outbox o
+		SET dispatched_at = NOW()
+		FROM events ev
+		WHERE o.sequence = ev.sequence
+	`
+	tag, err := s.db.Exec(ctx, query, limit)
+	if err != nil {
+		return 0, fmt.Errorf("fan out outbox events: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+func (s *Store) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*Target, error) {
+	const query = `
+		WITH due AS (
+			SELECT id
+			FROM webhook_deliveries
+			WHERE status = 'pending' AND next_attempt_at <= NOW()
+			ORDER BY next_attempt_at ASC, event_sequence ASC
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		), claimed AS (
+			UPDATE webhook_deliveries w
+			SET next_attempt_at = NOW() + make_interval(secs => $2)
+			FROM due
+			WHERE w.id = due.id
+			RETURNING w.*
+		)
+		SELECT ` + deliveryColumns + `, e.url, e.secret
+		FROM claimed d
+		JOIN webhook_endpoints e ON e.id = d.endpoint_id
+		JOIN outbox o ON o.sequence = d.event_sequence
+		ORDER BY o.sequence ASC
+	`
+	rows, err := s.db.Query(ctx, query, limit, lease.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("claim due webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	var targets []*Target
+	for rows.Next() {
+		t := Target{Delivery: &Delivery{}}
+		if err := rows.Scan(append(t.Delivery.scanTargets(), &t.URL, &t.Secret)...); err != nil {
+			return nil, fmt.Errorf("scan claimed webhook delivery row: %w", err)
+		}
+		targets = append(targets, &t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate claimed webhook delivery rows: %w", err)
+	}
+	return targets, nil
+}
+
+func (s *Store) RecordAttempt(ctx context.Context, delivery *Delivery) error {
+	const query = `
+		UPDATE webhook_deliveries
+		SET status = $2, attempts = $3, next_attempt_at = $4, last_status_code = $5, last_error = $6, delivered_at = $7
+		WHERE id = $1
+	`
+	_, err := s.db.Exec(ctx, query,
+		delivery.ID,
+		delivery.Status,
+		delivery.Attempts,
+		delivery.NextAttemptAt,
+		delivery.LastStatusCode,
+		delivery.LastError,
+		delivery.DeliveredAt,
+	)
+	if err != nil {
+		return fmt.Errorf("record attempt for webhook delivery %s: %w", delivery.ID, err)
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package webhook_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/arowden/augment-fund/internal/fund"
+	"github.com/arowden/augment-fund/internal/outbox"
+	"github.com/arowden/augment-fund/internal/owner"
+	"github.com/arowden/augment-fund/internal/ownership"
+	"github.com/arowden/augment-fund/internal/postgres"
+	"github.com/arowden/augment-fund/internal/transfer"
+	"github.com/arowden/augment-fund/internal/webhook"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx := context.Background()
+	tc, err := postgres.NewTestContainer(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() { tc.Cleanup(ctx) })
+
+	ownershipStore := ownership.NewStore(tc.Pool())
+	ownerStore := owner.NewStore(tc.Pool())
+	fundService, err := fund.NewService(
+		fund.NewStore(tc.Pool()),
+		fund.WithPool(tc.Pool()),
+		fund.WithOwnershipRepository(ownershipStore),
+		fund.WithOwnerRepository(ownerStore),
+		fund.WithOutbox(outbox.NewStore(tc.Pool())),
+	)
+	require.NoError(t, err)
+	transferService, err := transfer.NewService(
+		transfer.WithRepository(transfer.NewStore(tc.Pool())),
+		transfer.WithOwnershipRepository(ownershipStore),
+		transfer.WithOwnerRepository(ownerStore),
+		transfer.WithPool(tc.Pool()),
+		transfer.WithOutbox(outbox.NewStore(tc.Pool())),
+	)
+	require.NoError(t, err)
+
+	store := webhook.NewStore(tc.Pool())
+	policy := webhook.RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+
+	t.Run("delivers outbox events to subscribed endpoints", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		var received atomic.Int32
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received.Add(1)
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer receiver.Close()
+
+		svc, err := webhook.NewService(webhook.WithRepository(store), webhook.WithRetryPolicy(policy))
+		require.NoError(t, err)
+
+		all, err := svc.RegisterEndpoint(ctx, receiver.URL, nil)
+		require.NoError(t, err)
+		transfersOnly, err := svc.RegisterEndpoint(ctx, receiver.URL, []string{outbox.EventTransferExecuted})
+		require.NoError(t, err)
+
+		f, err := fundService.CreateFundWithInitialOwner(ctx, "Webhook Fund", 1000, "Founder")
+		require.NoError(t, err)
+		_, err = transferService.ExecuteTransfer(ctx, transfer.Request{FundID: f.ID, FromOwner: "Founder", ToOwner: "Alice", Units: 100})
+		require.NoError(t, err)
+
+		n, err := svc.Dispatch(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 5, n)
+		assert.EqualValues(t, 5, received.Load())
+
+		list, err := svc.ListDeliveries(ctx, all.ID, nil, webhook.ListParams{})
+		require.NoError(t, err)
+		require.Len(t, list.Deliveries, 4)
+		assert.Equal(t, outbox.EventFundCreated, list.Deliveries[0].Event.Type)
+		assert.Equal(t, outbox.EventTransferExecuted, list.Deliveries[1].Event.Type)
+		assert.Equal(t, outbox.EventBalanceChanged, list.Deliveries[2].Event.Type)
+		assert.Equal(t, outbox.EventBalanceChanged, list.Deliveries[3].Event.Type)
+		assert.Equal(t, webhook.DeliveryDelivered, list.Deliveries[0].Status)
+
+		list, err = svc.ListDeliveries(ctx, transfersOnly.ID, nil, webhook.ListParams{})
+		require.NoError(t, err)
+		require.Len(t, list.Deliveries, 1)
+		assert.Equal(t, outbox.EventTransferExecuted, list.Deliveries[0].Event.Type)
+
+		n, err = svc.Dispatch(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, n)
+	})
+
+	t.Run("dead-letters failing deliveries and retries them on request", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		var healthy atomic.Bool
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if healthy.Load() {
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer receiver.Close()
+
+		svc, err := webhook.NewService(webhook.WithRepository(store), webhook.WithRetryPolicy(policy))
+		require.NoError(t, err)
+		endpoint, err := svc.RegisterEndpoint(ctx, receiver.URL, nil)
+		require.NoError(t, err)
+
+		_, err = fundService.CreateFundWithInitialOwner(ctx, "Flaky Fund", 1000, "Founder")
+		require.NoError(t, err)
+
+		for i := 0; i < policy.MaxAttempts; i++ {
+			time.Sleep(10 * time.Millisecond)
+			_, err := svc.Dispatch(ctx)
+			require.NoError(t, err)
+		}
+
+		dead := webhook.DeliveryDead
+		list, err := svc.ListDeliveries(ctx, endpoint.ID, &dead, webhook.ListParams{})
+		require.NoError(t, err)
+		require.Len(t, list.Deliveries, 1)
+		d := list.Deliveries[0]
+		assert.Equal(t, policy.MaxAttempts, d.Attempts)
+		require.NotNil(t, d.LastStatusCode)
+		assert.Equal(t, http.StatusServiceUnavailable, *d.LastStatusCode)
+
+		_, err = svc.RetryDelivery(ctx, endpoint.ID, d.ID)
+		require.NoError(t, err)
+		_, err = svc.RetryDelivery(ctx, endpoint.ID, d.ID)
+		assert.ErrorIs(t, err, webhook.ErrDeliveryNotDead)
+
+		healthy.Store(true)
+		_, err = svc.Dispatch(ctx)
+		require.NoError(t, err)
+
+		delivered := webhook.DeliveryDelivered
+		list, err = svc.ListDeliveries(ctx, endpoint.ID, &delivered, webhook.ListParams{})
+		require.NoError(t, err)
+		assert.Len(t, list.Deliveries, 1)
+	})
+
+	t.Run("deleting an endpoint removes it", func(t *testing.T) {
+		tc.Reset(ctx)
+
+		e, err := webhook.NewEndpoint("https://example.com/hooks", nil)
+		require.NoError(t, err)
+		require.NoError(t, store.CreateEndpoint(ctx, e))
+		require.NoError(t, store.DeleteEndpoint(ctx, e.ID))
+		assert.ErrorIs(t, store.DeleteEndpoint(ctx, e.ID), webhook.ErrEndpointNotFound)
+	})
+}
@@ -11,10 +11,13 @@ export class CapTableService {
      * Get cap table for a fund
      * Returns the cap table entries for the specified fund with pagination support.
      * Each entry shows the owner, units held, percentage ownership, and acquisition date.
+     * When `asOf` is supplied, balances are reconstructed by replaying the fund's initial
+     * owner and every transfer executed at or before that instant.
      *
      * @param fundId The unique identifier of the fund
      * @param limit Maximum number of entries to return
      * @param offset Number of entries to skip
+     * @param asOf Reconstruct the cap table as it stood at this instant (RFC 3339)
      * @returns CapTable The cap table for the fund
      * @throws ApiError
      */
@@ -22,6 +25,7 @@ export class CapTableService {
         fundId: string,
         limit: number = 100,
         offset?: number,
+        asOf?: string,
     ): CancelablePromise<CapTable> {
         return __request(OpenAPI, {
             method: 'GET',
@@ -32,6 +36,7 @@ export class CapTableService {
             query: {
                 'limit': limit,
                 'offset': offset,
+                'asOf': asOf,
             },
             errors: {
                 400: `Invalid request parameters`,